@@ -1,11 +1,13 @@
 package defaultschema
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/yandex-cloud/terraform-provider-yandex/common"
 )
@@ -73,6 +75,50 @@ func DeletionProtection() *schema.BoolAttribute {
 	}
 }
 
+func FinalBackup() *schema.BoolAttribute {
+	return &schema.BoolAttribute{
+		MarkdownDescription: common.ResourceDescriptions["final_backup"],
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(false),
+	}
+}
+
+func RequireDisruptiveChangeAck() *schema.BoolAttribute {
+	return &schema.BoolAttribute{
+		MarkdownDescription: common.ResourceDescriptions["require_disruptive_change_ack"],
+		Optional:            true,
+	}
+}
+
+func HostUpdateStrategy() *schema.SingleNestedAttribute {
+	return &schema.SingleNestedAttribute{
+		MarkdownDescription: common.ResourceDescriptions["host_update_strategy"],
+		Optional:            true,
+		Attributes: map[string]schema.Attribute{
+			"max_unavailable": schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of hosts of a zone deleted at once. The default value is `1`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"order": schema.ListAttribute{
+				MarkdownDescription: "The order of the availability zones, e.g. `[\"ru-central1-a\", \"ru-central1-b\"]`. The zones not listed are changed after the listed ones in alphabetical order.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"pause_between_steps_seconds": schema.Int64Attribute{
+				MarkdownDescription: "The fixed pause in seconds after the hosts became alive and before the next step, e.g. to let the replicas catch up. The replication lag itself is not checked.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+		},
+	}
+}
+
 func SecurityGroupIds() *schema.SetAttribute {
 	return &schema.SetAttribute{
 		MarkdownDescription: common.ResourceDescriptions["security_group_ids"],
@@ -1,18 +1,21 @@
 package common
 
 var ResourceDescriptions = map[string]string{
-	"id":                     "The resource identifier.",
-	"folder_id":              "The folder identifier that resource belongs to. If it is not provided, the default provider `folder-id` is used.",
-	"name":                   "The resource name.",
-	"description":            "The resource description.",
-	"labels":                 "A set of key/value label pairs which assigned to resource.",
-	"created_at":             "The creation timestamp of the resource.",
-	"cloud_id":               "The `Cloud ID` which resource belongs to. If it is not provided, the default provider `cloud-id` is used.",
-	"zone":                   "The [availability zone](https://yandex.cloud/docs/overview/concepts/geo-scope) where resource is located. If it is not provided, the default provider zone will be used.",
-	"deletion_protection":    "The `true` value means that resource is protected from accidental deletion.",
-	"security_group_ids":     "The list of security groups applied to resource or their components.",
-	"service_account_id":     "[Service account](https://yandex.cloud/docs/iam/concepts/users/service-accounts) which linked to the resource.",
-	"subnet_ids":             "The list of VPC subnets identifiers which resource is attached.",
-	"network_id":             "The `VPC Network ID` of subnets which resource attached to.",
-	"disk_encryption_key_id": "ID of the KMS key used for cluster disk encryption. Encryption can`t be disabled for an existing cluster. If the source cluster is encrypted and you leave this field empty when restoring, the restored cluster will be created without encryption.",
+	"id":                            "The resource identifier.",
+	"folder_id":                     "The folder identifier that resource belongs to. If it is not provided, the default provider `folder-id` is used.",
+	"name":                          "The resource name.",
+	"description":                   "The resource description.",
+	"labels":                        "A set of key/value label pairs which assigned to resource.",
+	"created_at":                    "The creation timestamp of the resource.",
+	"cloud_id":                      "The `Cloud ID` which resource belongs to. If it is not provided, the default provider `cloud-id` is used.",
+	"zone":                          "The [availability zone](https://yandex.cloud/docs/overview/concepts/geo-scope) where resource is located. If it is not provided, the default provider zone will be used.",
+	"deletion_protection":           "The `true` value means that resource is protected from accidental deletion.",
+	"final_backup":                  "The `true` value means that a backup of the cluster is created before the cluster is deleted, e.g. on `terraform destroy`. Unlike `deletion_protection`, it does not prevent the deletion. Backups of a deleted cluster are kept according to the backup retention policy of the service.",
+	"host_update_strategy":          "The strategy of the hosts changes. If it is set, the hosts are created and deleted zone by zone in the given order, and all hosts of the cluster must become alive after each step before the next one is started. If it is not set, all hosts are changed at once.",
+	"require_disruptive_change_ack": "The `true` value turns the plan warnings about the settings changes that restart the cluster hosts or switch over the primary into errors, so such a change can't be applied unnoticed.",
+	"security_group_ids":            "The list of security groups applied to resource or their components.",
+	"service_account_id":            "[Service account](https://yandex.cloud/docs/iam/concepts/users/service-accounts) which linked to the resource.",
+	"subnet_ids":                    "The list of VPC subnets identifiers which resource is attached.",
+	"network_id":                    "The `VPC Network ID` of subnets which resource attached to.",
+	"disk_encryption_key_id":        "ID of the KMS key used for cluster disk encryption. Encryption can`t be disabled for an existing cluster. If the source cluster is encrypted and you leave this field empty when restoring, the restored cluster will be created without encryption.",
 }
@@ -0,0 +1,2 @@
+# terraform import yandex_alb_virtual_host_route.<resource Name> <http_router_id>/<vhost_name>/<route_name>
+terraform import yandex_alb_virtual_host_route.api ds7ph**********hm4in/my-virtual-host/api
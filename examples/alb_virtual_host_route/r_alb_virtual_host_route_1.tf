@@ -0,0 +1,40 @@
+//
+// Manage routes of a shared ALB Virtual Host separately
+//
+resource "yandex_alb_virtual_host" "my-vhost" {
+  name           = "my-virtual-host"
+  http_router_id = yandex_alb_http_router.my-router.id
+  ignore_routes  = true
+}
+
+resource "yandex_alb_virtual_host_route" "api" {
+  http_router_id    = yandex_alb_http_router.my-router.id
+  virtual_host_name = yandex_alb_virtual_host.my-vhost.name
+  name              = "api"
+  priority          = 0
+
+  http_route {
+    http_match {
+      path {
+        prefix = "/api/"
+      }
+    }
+    http_route_action {
+      backend_group_id = yandex_alb_backend_group.api-bg.id
+      timeout          = "3s"
+    }
+  }
+}
+
+resource "yandex_alb_virtual_host_route" "static" {
+  http_router_id    = yandex_alb_http_router.my-router.id
+  virtual_host_name = yandex_alb_virtual_host.my-vhost.name
+  name              = "static"
+  after             = yandex_alb_virtual_host_route.api.name
+
+  http_route {
+    http_route_action {
+      backend_group_id = yandex_alb_backend_group.static-bg.id
+    }
+  }
+}
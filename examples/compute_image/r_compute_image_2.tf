@@ -0,0 +1,12 @@
+//
+// Create a new Compute Image from a local file.
+//
+resource "yandex_compute_image" "local-image" {
+  name        = "my-local-image"
+  source_file = "${path.module}/build/image.qcow2"
+  os_type     = "LINUX"
+
+  timeouts {
+    create = "30m"
+  }
+}
@@ -57,4 +57,9 @@ resource "yandex_compute_instance_group" "group1" {
     max_expansion   = 2
     max_deleting    = 2
   }
+
+  wait_for_healthy {
+    min_healthy_percent = 100
+    timeout             = "15m"
+  }
 }
@@ -0,0 +1,16 @@
+//
+// Get the last lines of the serial port output of a Compute Instance.
+//
+data "yandex_compute_instance_serial_port_output" "my_instance" {
+  instance_id = "some_instance_id"
+  tail_lines  = 50
+  regex       = "Cloud-init v\\. \\S+ finished at .* Up ([0-9.]+) seconds"
+}
+
+output "cloud_init_output" {
+  value = data.yandex_compute_instance_serial_port_output.my_instance.contents
+}
+
+output "cloud_init_duration" {
+  value = one(data.yandex_compute_instance_serial_port_output.my_instance.matches)
+}
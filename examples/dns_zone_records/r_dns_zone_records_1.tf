@@ -0,0 +1,27 @@
+//
+// Manage all records of a DNS Zone, loading most of them from a zone file.
+//
+resource "yandex_dns_zone" "zone1" {
+  name   = "my-public-zone"
+  zone   = "example.com."
+  public = true
+}
+
+resource "yandex_dns_zone_records" "records" {
+  zone_id   = yandex_dns_zone.zone1.id
+  zone_file = file("${path.module}/example.com.zone")
+
+  recordset {
+    name = "www"
+    type = "A"
+    ttl  = 300
+    data = ["192.0.2.1", "192.0.2.2"]
+  }
+
+  recordset {
+    name = "@"
+    type = "CAA"
+    ttl  = 3600
+    data = ["0 issue \"letsencrypt.org\""]
+  }
+}
@@ -0,0 +1,14 @@
+//
+// Configure kubernetes provider with credentials of a service account.
+//
+ephemeral "yandex_kubernetes_cluster_credentials" "my_cluster" {
+  cluster_id         = "some_k8s_cluster_id"
+  endpoint_type      = "external"
+  service_account_id = "some_service_account_id"
+}
+
+provider "kubernetes" {
+  host                   = ephemeral.yandex_kubernetes_cluster_credentials.my_cluster.endpoint
+  cluster_ca_certificate = ephemeral.yandex_kubernetes_cluster_credentials.my_cluster.cluster_ca_certificate
+  token                  = ephemeral.yandex_kubernetes_cluster_credentials.my_cluster.token
+}
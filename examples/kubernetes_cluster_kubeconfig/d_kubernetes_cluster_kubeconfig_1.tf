@@ -0,0 +1,11 @@
+//
+// Write a kubeconfig for a Kubernetes cluster to a local file.
+//
+data "yandex_kubernetes_cluster_kubeconfig" "my_cluster" {
+  cluster_id = "some_k8s_cluster_id"
+}
+
+resource "local_sensitive_file" "kubeconfig" {
+  filename = "${path.module}/kubeconfig"
+  content  = data.yandex_kubernetes_cluster_kubeconfig.my_cluster.kubeconfig
+}
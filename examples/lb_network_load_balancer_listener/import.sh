@@ -0,0 +1,2 @@
+# terraform import yandex_lb_network_load_balancer_listener.<resource Name> <network_load_balancer_id>/<listener_name>
+terraform import yandex_lb_network_load_balancer_listener.https enpq3**********ud8ci/https
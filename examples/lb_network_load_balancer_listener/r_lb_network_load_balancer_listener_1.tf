@@ -0,0 +1,18 @@
+//
+// Manage listeners of a shared Network Load Balancer separately
+//
+resource "yandex_lb_network_load_balancer" "shared" {
+  name             = "shared-network-load-balancer"
+  ignore_listeners = true
+}
+
+resource "yandex_lb_network_load_balancer_listener" "https" {
+  network_load_balancer_id = yandex_lb_network_load_balancer.shared.id
+  name                     = "https"
+  port                     = 443
+  target_port              = 8443
+
+  external_address_spec {
+    ip_version = "ipv4"
+  }
+}
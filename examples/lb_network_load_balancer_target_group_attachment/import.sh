@@ -0,0 +1,2 @@
+# terraform import yandex_lb_network_load_balancer_target_group_attachment.<resource Name> <network_load_balancer_id>/<target_group_id>
+terraform import yandex_lb_network_load_balancer_target_group_attachment.web enpq3**********ud8ci/enp5f**********g0m3a
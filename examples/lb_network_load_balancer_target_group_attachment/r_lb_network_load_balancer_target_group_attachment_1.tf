@@ -0,0 +1,28 @@
+//
+// Attach a target group to a shared Network Load Balancer
+//
+resource "yandex_lb_network_load_balancer" "shared" {
+  name                          = "shared-network-load-balancer"
+  ignore_attached_target_groups = true
+
+  listener {
+    name = "https"
+    port = 443
+    external_address_spec {
+      ip_version = "ipv4"
+    }
+  }
+}
+
+resource "yandex_lb_network_load_balancer_target_group_attachment" "web" {
+  network_load_balancer_id = yandex_lb_network_load_balancer.shared.id
+  target_group_id          = yandex_compute_instance_group.web.load_balancer[0].target_group_id
+
+  healthcheck {
+    name = "http"
+    http_options {
+      port = 8080
+      path = "/ping"
+    }
+  }
+}
@@ -0,0 +1,17 @@
+//
+// Restore a PostgreSQL cluster from the latest backup taken before the incident.
+//
+data "yandex_mdb_backups" "before_incident" {
+  engine         = "postgresql"
+  cluster_id     = "some_cluster_id"
+  created_before = "2024-01-02T03:04:05"
+}
+
+resource "yandex_mdb_postgresql_cluster_v2" "clone" {
+  # ...
+
+  restore = {
+    backup_id = data.yandex_mdb_backups.before_incident.backups[0].id
+    time      = "2024-01-02T03:04:05"
+  }
+}
@@ -0,0 +1,19 @@
+//
+// Pass the connection string of a PostgreSQL database to an application.
+// The password is read from the Connection Manager secret of the user.
+//
+data "yandex_mdb_connection_string" "app" {
+  engine        = "postgresql"
+  cluster_id    = yandex_mdb_postgresql_cluster_v2.my_cluster.id
+  user_name     = yandex_mdb_postgresql_user.app.name
+  database_name = yandex_mdb_postgresql_database.app.name
+}
+
+resource "yandex_lockbox_secret_version" "app_config" {
+  secret_id = yandex_lockbox_secret.app_config.id
+
+  entries {
+    key        = "DATABASE_URL"
+    text_value = data.yandex_mdb_connection_string.app.uri
+  }
+}
@@ -0,0 +1,2 @@
+# terraform import yandex_mdb_kafka_cluster_v2.<resource Name> <resource Id>
+terraform import yandex_mdb_kafka_cluster_v2.my_cluster c9qe84oo6sm8gb6ljh3t
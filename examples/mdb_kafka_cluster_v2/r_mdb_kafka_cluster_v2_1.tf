@@ -0,0 +1,48 @@
+//
+// Create a new MDB Kafka Cluster (v2) with KRaft.
+//
+resource "yandex_mdb_kafka_cluster_v2" "my_cluster" {
+  name        = "test"
+  environment = "PRESTABLE"
+  network_id  = yandex_vpc_network.foo.id
+  subnet_ids  = [yandex_vpc_subnet.foo.id]
+
+  version       = "3.9"
+  zones         = ["ru-central1-a"]
+  brokers_count = 1
+
+  resources {
+    resource_preset_id = "s2.micro"
+    disk_type_id       = "network-ssd"
+    disk_size          = 32
+  }
+
+  kraft = {
+    resources = {
+      resource_preset_id = "s2.micro"
+      disk_type_id       = "network-ssd"
+      disk_size          = 10
+    }
+  }
+
+  kafka_config = {
+    compression_type        = "COMPRESSION_TYPE_ZSTD"
+    log_retention_ms        = "86400000"
+    sasl_enabled_mechanisms = "SASL_MECHANISM_SCRAM_SHA_256,SASL_MECHANISM_SCRAM_SHA_512"
+  }
+
+  maintenance_window = {
+    type = "WEEKLY"
+    day  = "MON"
+    hour = 3
+  }
+}
+
+// Auxiliary resources
+resource "yandex_vpc_network" "foo" {}
+
+resource "yandex_vpc_subnet" "foo" {
+  zone           = "ru-central1-a"
+  network_id     = yandex_vpc_network.foo.id
+  v4_cidr_blocks = ["10.5.0.0/24"]
+}
@@ -0,0 +1,2 @@
+# terraform import yandex_mdb_kafka_user_permission.<resource Name> <cluster_id>:<user_name>:<topic_name>:<role>
+terraform import yandex_mdb_kafka_user_permission.events_consumer c9q0rsb2u8jmq7knmq63:consumer:events:ACCESS_ROLE_CONSUMER
@@ -0,0 +1,10 @@
+//
+// Grant an MDB Kafka User a role on a Topic.
+//
+resource "yandex_mdb_kafka_user_permission" "events_consumer" {
+  cluster_id  = yandex_mdb_kafka_cluster.my_cluster.id
+  user_name   = yandex_mdb_kafka_user.consumer.name
+  topic_name  = yandex_mdb_kafka_topic.events.name
+  role        = "ACCESS_ROLE_CONSUMER"
+  allow_hosts = ["10.1.0.10"]
+}
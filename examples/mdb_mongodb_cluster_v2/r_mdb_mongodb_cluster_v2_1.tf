@@ -0,0 +1,54 @@
+//
+// Create a new sharded MDB MongoDB Cluster (v2).
+//
+resource "yandex_mdb_mongodb_cluster_v2" "my_cluster" {
+  name        = "test"
+  environment = "PRESTABLE"
+  network_id  = yandex_vpc_network.foo.id
+  version     = "7.0"
+
+  resources_mongod = {
+    resource_preset_id = "s2.micro"
+    disk_type_id       = "network-ssd"
+    disk_size          = 16
+  }
+
+  resources_mongoinfra = {
+    resource_preset_id = "s2.micro"
+    disk_type_id       = "network-ssd"
+    disk_size          = 10
+  }
+
+  hosts = {
+    "rs01-a" = {
+      zone       = "ru-central1-a"
+      subnet_id  = yandex_vpc_subnet.foo.id
+      shard_name = "rs01"
+    }
+    "rs02-a" = {
+      zone       = "ru-central1-a"
+      subnet_id  = yandex_vpc_subnet.foo.id
+      shard_name = "rs02"
+    }
+    "infra-a" = {
+      zone      = "ru-central1-a"
+      subnet_id = yandex_vpc_subnet.foo.id
+      type      = "MONGOINFRA"
+    }
+  }
+
+  maintenance_window = {
+    type = "WEEKLY"
+    day  = "MON"
+    hour = 3
+  }
+}
+
+// Auxiliary resources
+resource "yandex_vpc_network" "foo" {}
+
+resource "yandex_vpc_subnet" "foo" {
+  zone           = "ru-central1-a"
+  network_id     = yandex_vpc_network.foo.id
+  v4_cidr_blocks = ["10.5.0.0/24"]
+}
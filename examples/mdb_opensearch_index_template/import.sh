@@ -0,0 +1,2 @@
+# terraform import yandex_mdb_opensearch_index_template.<resource Name> <cluster_id>:<name>
+terraform import yandex_mdb_opensearch_index_template.logs c9q0rsb2u8jmq7knmq63:logs
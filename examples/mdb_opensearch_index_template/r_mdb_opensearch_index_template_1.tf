@@ -0,0 +1,18 @@
+//
+// Create an index template in a Managed OpenSearch cluster.
+//
+resource "yandex_mdb_opensearch_index_template" "logs" {
+  cluster_id     = yandex_mdb_opensearch_cluster.my_cluster.id
+  admin_password = var.opensearch_admin_password
+
+  name = "logs"
+  body = jsonencode({
+    index_patterns = ["logs-*"]
+    template = {
+      settings = {
+        number_of_shards   = 1
+        number_of_replicas = 1
+      }
+    }
+  })
+}
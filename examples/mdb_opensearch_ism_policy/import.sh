@@ -0,0 +1,2 @@
+# terraform import yandex_mdb_opensearch_ism_policy.<resource Name> <cluster_id>:<policy_id>
+terraform import yandex_mdb_opensearch_ism_policy.logs_retention c9q0rsb2u8jmq7knmq63:logs-retention
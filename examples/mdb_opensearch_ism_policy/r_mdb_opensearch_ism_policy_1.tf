@@ -0,0 +1,33 @@
+//
+// Create an Index State Management policy in a Managed OpenSearch cluster.
+//
+resource "yandex_mdb_opensearch_ism_policy" "logs_retention" {
+  cluster_id     = yandex_mdb_opensearch_cluster.my_cluster.id
+  admin_password = var.opensearch_admin_password
+
+  policy_id = "logs-retention"
+  body = jsonencode({
+    policy = {
+      description   = "Delete the logs after 30 days"
+      default_state = "hot"
+      states = [
+        {
+          name    = "hot"
+          actions = []
+          transitions = [
+            {
+              state_name = "delete"
+              conditions = { min_index_age = "30d" }
+            }
+          ]
+        },
+        {
+          name        = "delete"
+          actions     = [{ delete = {} }]
+          transitions = []
+        }
+      ]
+      ism_template = [{ index_patterns = ["logs-*"] }]
+    }
+  })
+}
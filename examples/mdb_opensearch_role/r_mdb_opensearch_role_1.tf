@@ -0,0 +1,19 @@
+//
+// Create a role of the security plugin in a Managed OpenSearch cluster.
+//
+resource "yandex_mdb_opensearch_role" "logs_reader" {
+  cluster_id     = yandex_mdb_opensearch_cluster.my_cluster.id
+  admin_password = var.opensearch_admin_password
+
+  name                = "logs_reader"
+  description         = "Read access to the logs"
+  cluster_permissions = ["cluster_composite_ops_ro"]
+
+  index_permissions = [
+    {
+      index_patterns  = ["logs-*"]
+      allowed_actions = ["read"]
+      masked_fields   = ["client_ip"]
+    }
+  ]
+}
@@ -0,0 +1,2 @@
+# terraform import yandex_mdb_opensearch_role_mapping.<resource Name> <cluster_id>:<role_name>
+terraform import yandex_mdb_opensearch_role_mapping.logs_reader c9q0rsb2u8jmq7knmq63:logs_reader
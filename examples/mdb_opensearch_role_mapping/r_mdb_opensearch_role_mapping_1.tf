@@ -0,0 +1,11 @@
+//
+// Map the users to a role of the security plugin in a Managed OpenSearch cluster.
+//
+resource "yandex_mdb_opensearch_role_mapping" "logs_reader" {
+  cluster_id     = yandex_mdb_opensearch_cluster.my_cluster.id
+  admin_password = var.opensearch_admin_password
+
+  role_name     = yandex_mdb_opensearch_role.logs_reader.name
+  users         = ["alice"]
+  backend_roles = ["analysts"]
+}
@@ -0,0 +1,15 @@
+//
+// Register an Object Storage bucket as a snapshot repository of a Managed OpenSearch cluster.
+//
+resource "yandex_storage_bucket" "snapshots" {
+  bucket = "my-opensearch-snapshots"
+}
+
+resource "yandex_mdb_opensearch_snapshot_repository" "snapshots" {
+  cluster_id     = yandex_mdb_opensearch_cluster.my_cluster.id
+  admin_password = var.opensearch_admin_password
+
+  name      = "snapshots"
+  bucket    = yandex_storage_bucket.snapshots.bucket
+  base_path = "my-cluster"
+}
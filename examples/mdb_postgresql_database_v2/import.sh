@@ -0,0 +1,2 @@
+# terraform import yandex_mdb_postgresql_database_v2.<resource Name> <cluster_id>:<database_name>
+terraform import yandex_mdb_postgresql_database_v2.my_db c9q0rsb2u8jmq7knmq63:testdb
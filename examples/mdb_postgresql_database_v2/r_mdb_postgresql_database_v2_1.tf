@@ -0,0 +1,19 @@
+//
+// Create a new MDB PostgreSQL Database.
+//
+resource "yandex_mdb_postgresql_database_v2" "my_db" {
+  cluster_id = yandex_mdb_postgresql_cluster_v2.my_cluster.id
+  name       = "testdb"
+  owner      = yandex_mdb_postgresql_user_v2.my_user.name
+  lc_collate = "en_US.UTF-8"
+  lc_type    = "en_US.UTF-8"
+
+  extension {
+    name = "uuid-ossp"
+  }
+
+  extension {
+    name    = "pg_trgm"
+    version = "1.6"
+  }
+}
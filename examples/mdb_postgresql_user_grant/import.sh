@@ -0,0 +1,2 @@
+# terraform import yandex_mdb_postgresql_user_grant.<resource Name> <cluster_id>:<user_name>:<grant>
+terraform import yandex_mdb_postgresql_user_grant.alice_admin c9q0rsb2u8jmq7knmq63:alice:mdb_admin
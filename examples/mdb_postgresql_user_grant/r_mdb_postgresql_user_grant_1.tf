@@ -0,0 +1,8 @@
+//
+// Grant a role to an MDB PostgreSQL database User.
+//
+resource "yandex_mdb_postgresql_user_grant" "alice_admin" {
+  cluster_id = yandex_mdb_postgresql_cluster_v2.my_cluster.id
+  user_name  = yandex_mdb_postgresql_user_v2.alice.name
+  grant      = "mdb_admin"
+}
@@ -0,0 +1,2 @@
+# terraform import yandex_mdb_postgresql_user_permission.<resource Name> <cluster_id>:<user_name>:<database_name>
+terraform import yandex_mdb_postgresql_user_permission.bob_testdb c9q0rsb2u8jmq7knmq63:bob:testdb
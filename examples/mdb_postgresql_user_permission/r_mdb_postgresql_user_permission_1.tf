@@ -0,0 +1,8 @@
+//
+// Grant an MDB PostgreSQL database User access to a Database.
+//
+resource "yandex_mdb_postgresql_user_permission" "bob_testdb" {
+  cluster_id    = yandex_mdb_postgresql_cluster_v2.my_cluster.id
+  user_name     = yandex_mdb_postgresql_user_v2.bob.name
+  database_name = yandex_mdb_postgresql_database_v2.testdb.name
+}
@@ -0,0 +1,2 @@
+# terraform import yandex_mdb_postgresql_user_v2.<resource Name> <cluster_id>:<user_name>
+terraform import yandex_mdb_postgresql_user_v2.my_user c9q0rsb2u8jmq7knmq63:alice
@@ -0,0 +1,16 @@
+//
+// Create a new MDB PostgreSQL database User.
+//
+resource "yandex_mdb_postgresql_user_v2" "my_user" {
+  cluster_id = yandex_mdb_postgresql_cluster_v2.my_cluster.id
+  name       = "alice"
+  password   = "password"
+  conn_limit = 50
+  settings = {
+    default_transaction_isolation = "TRANSACTION_ISOLATION_READ_COMMITTED"
+    log_min_duration_statement    = 5000
+  }
+  pgaudit = {
+    log = ["DDL", "ROLE"]
+  }
+}
@@ -0,0 +1,13 @@
+//
+// Move an existing MDB PostgreSQL database User to the new resource.
+//
+resource "yandex_mdb_postgresql_user_v2" "my_user" {
+  cluster_id = yandex_mdb_postgresql_cluster_v2.my_cluster.id
+  name       = "alice"
+  password   = "password"
+}
+
+moved {
+  from = yandex_mdb_postgresql_user.my_user
+  to   = yandex_mdb_postgresql_user_v2.my_user
+}
@@ -0,0 +1,10 @@
+//
+// Get information about existing Storage Bucket.
+//
+data "yandex_storage_bucket" "shared" {
+  bucket = "shared-artifacts"
+}
+
+output "shared_bucket_domain_name" {
+  value = data.yandex_storage_bucket.shared.bucket_domain_name
+}
@@ -0,0 +1,14 @@
+//
+// Create a new Storage Bucket CORS Configuration.
+//
+resource "yandex_storage_bucket_cors_configuration" "my_cors" {
+  bucket = "my_bucket_name"
+
+  cors_rule {
+    allowed_headers = ["*"]
+    allowed_methods = ["GET", "PUT"]
+    allowed_origins = ["https://example.com"]
+    expose_headers  = ["ETag"]
+    max_age_seconds = 3000
+  }
+}
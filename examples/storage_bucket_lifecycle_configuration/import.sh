@@ -0,0 +1,4 @@
+# The resource can be imported by using the name of the bucket.
+
+# terraform import yandex_storage_bucket_lifecycle_configuration.<resource_name> <bucket_name>
+terraform import yandex_storage_bucket_lifecycle_configuration.<resource_name> my_bucket_name
@@ -0,0 +1,45 @@
+//
+// Create a new Storage Bucket Lifecycle Configuration.
+//
+resource "yandex_storage_bucket_lifecycle_configuration" "my_lifecycle" {
+  bucket = "my_bucket_name"
+
+  rule {
+    id      = "logs"
+    enabled = true
+
+    filter {
+      prefix = "logs/"
+    }
+
+    transition {
+      days          = 30
+      storage_class = "COLD"
+    }
+
+    expiration {
+      days = 90
+    }
+  }
+
+  rule {
+    id      = "tmp"
+    enabled = true
+
+    filter {
+      and {
+        prefix                   = "tmp/"
+        object_size_greater_than = 1024
+        tags = {
+          temporary = "true"
+        }
+      }
+    }
+
+    abort_incomplete_multipart_upload_days = 1
+
+    noncurrent_version_expiration {
+      days = 7
+    }
+  }
+}
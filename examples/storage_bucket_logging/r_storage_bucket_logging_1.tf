@@ -0,0 +1,8 @@
+//
+// Create a new Storage Bucket Logging.
+//
+resource "yandex_storage_bucket_logging" "my_logging" {
+  bucket        = "my_bucket_name"
+  target_bucket = "my_log_bucket_name"
+  target_prefix = "log/"
+}
@@ -0,0 +1,18 @@
+//
+// Create a new Storage Bucket Object Lock Configuration.
+//
+resource "yandex_storage_bucket_versioning" "my_versioning" {
+  bucket  = "my_bucket_name"
+  enabled = true
+}
+
+resource "yandex_storage_bucket_object_lock_configuration" "my_object_lock" {
+  bucket = yandex_storage_bucket_versioning.my_versioning.bucket
+
+  rule {
+    default_retention {
+      mode = "GOVERNANCE"
+      days = 30
+    }
+  }
+}
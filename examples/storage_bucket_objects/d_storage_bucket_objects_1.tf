@@ -0,0 +1,12 @@
+//
+// List objects under a prefix and pick the latest artifact.
+//
+data "yandex_storage_bucket_objects" "releases" {
+  bucket    = "shared-artifacts"
+  prefix    = "releases/"
+  delimiter = "/"
+}
+
+locals {
+  latest_release = reverse(sort(data.yandex_storage_bucket_objects.releases.keys))[0]
+}
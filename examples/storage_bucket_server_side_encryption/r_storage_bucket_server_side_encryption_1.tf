@@ -0,0 +1,11 @@
+//
+// Create a new Storage Bucket Server Side Encryption.
+//
+resource "yandex_kms_symmetric_key" "my_key" {
+  name = "my-bucket-key"
+}
+
+resource "yandex_storage_bucket_server_side_encryption" "my_sse" {
+  bucket            = "my_bucket_name"
+  kms_master_key_id = yandex_kms_symmetric_key.my_key.id
+}
@@ -0,0 +1,7 @@
+//
+// Create a new Storage Bucket Versioning.
+//
+resource "yandex_storage_bucket_versioning" "my_versioning" {
+  bucket  = "my_bucket_name"
+  enabled = true
+}
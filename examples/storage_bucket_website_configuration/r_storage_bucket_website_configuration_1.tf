@@ -0,0 +1,19 @@
+//
+// Create a new Storage Bucket Website Configuration.
+//
+resource "yandex_storage_bucket_website_configuration" "my_website" {
+  bucket         = "my_bucket_name"
+  index_document = "index.html"
+  error_document = "error.html"
+
+  routing_rules = <<EOF
+[{
+  "Condition": {
+    "KeyPrefixEquals": "docs/"
+  },
+  "Redirect": {
+    "ReplaceKeyPrefixWith": "documents/"
+  }
+}]
+EOF
+}
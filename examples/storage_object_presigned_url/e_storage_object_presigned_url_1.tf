@@ -0,0 +1,23 @@
+//
+// Generate a time-limited download link for an object.
+//
+ephemeral "yandex_storage_object_presigned_url" "artifact" {
+  bucket     = "my_bucket_name"
+  key        = "releases/app-1.0.tar.gz"
+  expires_in = 86400
+
+  access_key = "YCAJEK..."
+  secret_key = var.storage_secret_key
+}
+
+resource "yandex_compute_instance" "partner" {
+  # ...
+
+  metadata = {
+    user-data = <<-EOT
+      #cloud-config
+      runcmd:
+        - curl -fsSL -o /opt/app.tar.gz "${ephemeral.yandex_storage_object_presigned_url.artifact.url}"
+    EOT
+  }
+}
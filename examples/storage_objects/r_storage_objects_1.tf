@@ -0,0 +1,13 @@
+//
+// Upload a static website build to the Storage Bucket.
+//
+resource "yandex_storage_objects" "frontend" {
+  bucket         = "my-frontend-bucket"
+  prefix         = "app/"
+  source_dir     = "${path.module}/dist"
+  delete_orphans = true
+
+  content_types = {
+    ".webmanifest" = "application/manifest+json"
+  }
+}
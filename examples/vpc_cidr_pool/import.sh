@@ -0,0 +1,2 @@
+# terraform import yandex_vpc_cidr_pool.<resource Name> <network Id>:<cidr>[,<cidr>...]
+terraform import yandex_vpc_cidr_pool.my_pool enp**********:10.2.0.0/16,10.3.0.0/16
@@ -0,0 +1,20 @@
+//
+// Create subnets with blocks allocated from a CIDR pool.
+//
+resource "yandex_vpc_cidr_pool" "my_pool" {
+  network_id     = yandex_vpc_network.lab-net.id
+  v4_cidr_blocks = ["10.2.0.0/16", "10.3.0.0/16"]
+}
+
+resource "yandex_vpc_subnet" "my_subnet" {
+  for_each = toset(["ru-central1-a", "ru-central1-b", "ru-central1-d"])
+
+  zone          = each.key
+  network_id    = yandex_vpc_network.lab-net.id
+  cidr_pool_id  = yandex_vpc_cidr_pool.my_pool.id
+  prefix_length = 24
+}
+
+resource "yandex_vpc_network" "lab-net" {
+  name = "lab-network"
+}
@@ -0,0 +1,158 @@
+// Package cidrpool allocates IPv4 subnet blocks from a pool of supernets.
+//
+// The pool is not stored in the cloud: its ID carries the network and the supernets,
+// and the used blocks are the blocks of the existing subnets of the network.
+package cidrpool
+
+import (
+	"fmt"
+	"net/netip"
+	"strings"
+)
+
+const (
+	// MinPrefixLength and MaxPrefixLength limit the size of a subnet.
+	MinPrefixLength = 16
+	MaxPrefixLength = 28
+
+	idSeparator       = ":"
+	supernetSeparator = ","
+)
+
+// ParseSupernets parses the IPv4 supernets of a pool. The supernets must not overlap.
+func ParseSupernets(cidrs []string) ([]netip.Prefix, error) {
+	if len(cidrs) == 0 {
+		return nil, fmt.Errorf("at least one supernet is required")
+	}
+
+	supernets, err := parsePrefixes(cidrs)
+	if err != nil {
+		return nil, err
+	}
+	for i, s := range supernets {
+		if s != s.Masked() {
+			return nil, fmt.Errorf("supernet %s has host bits set, expected %s", s, s.Masked())
+		}
+		for _, other := range supernets[:i] {
+			if s.Overlaps(other) {
+				return nil, fmt.Errorf("supernets %s and %s overlap", other, s)
+			}
+		}
+	}
+
+	return supernets, nil
+}
+
+// ParseUsed parses the IPv4 blocks of existing subnets. IPv6 blocks are skipped.
+func ParseUsed(cidrs []string) ([]netip.Prefix, error) {
+	var v4 []string
+	for _, c := range cidrs {
+		if !strings.Contains(c, ":") {
+			v4 = append(v4, c)
+		}
+	}
+	return parsePrefixes(v4)
+}
+
+func parsePrefixes(cidrs []string) ([]netip.Prefix, error) {
+	result := make([]netip.Prefix, 0, len(cidrs))
+	for _, c := range cidrs {
+		p, err := netip.ParsePrefix(c)
+		if err != nil {
+			return nil, err
+		}
+		if !p.Addr().Is4() {
+			return nil, fmt.Errorf("%s is not an IPv4 block", c)
+		}
+		result = append(result, p)
+	}
+	return result, nil
+}
+
+// Allocate returns the first block of the given prefix length within the supernets that does not overlap
+// any of the used blocks. Supernets are tried in order.
+func Allocate(supernets, used []netip.Prefix, prefixLength int) (netip.Prefix, error) {
+	if prefixLength < MinPrefixLength || prefixLength > MaxPrefixLength {
+		return netip.Prefix{}, fmt.Errorf("prefix length must be between %d and %d, got %d", MinPrefixLength, MaxPrefixLength, prefixLength)
+	}
+
+	size := uint64(1) << (32 - prefixLength)
+	for _, supernet := range supernets {
+		if supernet.Bits() > prefixLength {
+			continue
+		}
+
+		start, end := bounds(supernet)
+		for next := start; next+size-1 <= end; {
+			candidate := netip.PrefixFrom(fromUint(next), prefixLength)
+
+			var conflict *netip.Prefix
+			for i := range used {
+				if used[i].Overlaps(candidate) {
+					conflict = &used[i]
+					break
+				}
+			}
+			if conflict == nil {
+				return candidate, nil
+			}
+
+			// Skip to the first aligned block after the conflicting one.
+			_, conflictEnd := bounds(*conflict)
+			next = (conflictEnd/size + 1) * size
+		}
+	}
+
+	return netip.Prefix{}, fmt.Errorf("no free /%d block left in %s", prefixLength, join(supernets))
+}
+
+// Contains reports whether the block is inside one of the supernets.
+func Contains(supernets []netip.Prefix, block netip.Prefix) bool {
+	for _, s := range supernets {
+		if s.Bits() <= block.Bits() && s.Contains(block.Masked().Addr()) {
+			return true
+		}
+	}
+	return false
+}
+
+// FormatID returns the ID of the pool of the network.
+func FormatID(networkID string, supernets []netip.Prefix) string {
+	return networkID + idSeparator + join(supernets)
+}
+
+// ParseID returns the network and the supernets of the pool.
+func ParseID(id string) (string, []netip.Prefix, error) {
+	networkID, cidrs, ok := strings.Cut(id, idSeparator)
+	if !ok || networkID == "" || cidrs == "" {
+		return "", nil, fmt.Errorf("invalid CIDR pool ID %q, expected <network_id>%s<cidr>[%s<cidr>...]", id, idSeparator, supernetSeparator)
+	}
+
+	supernets, err := ParseSupernets(strings.Split(cidrs, supernetSeparator))
+	if err != nil {
+		return "", nil, fmt.Errorf("invalid CIDR pool ID %q: %s", id, err)
+	}
+	return networkID, supernets, nil
+}
+
+func join(prefixes []netip.Prefix) string {
+	s := make([]string, len(prefixes))
+	for i, p := range prefixes {
+		s[i] = p.String()
+	}
+	return strings.Join(s, supernetSeparator)
+}
+
+func bounds(p netip.Prefix) (uint64, uint64) {
+	start := toUint(p.Masked().Addr())
+	return start, start + (uint64(1) << (32 - p.Bits())) - 1
+}
+
+func toUint(a netip.Addr) uint64 {
+	b := a.As4()
+	return uint64(b[0])<<24 | uint64(b[1])<<16 | uint64(b[2])<<8 | uint64(b[3])
+}
+
+func fromUint(v uint64) netip.Addr {
+	return netip.AddrFrom4([4]byte{byte(v >> 24), byte(v >> 16), byte(v >> 8), byte(v)})
+}
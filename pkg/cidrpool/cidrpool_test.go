@@ -0,0 +1,143 @@
+package cidrpool
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func prefixes(cidrs ...string) []netip.Prefix {
+	result := make([]netip.Prefix, len(cidrs))
+	for i, c := range cidrs {
+		result[i] = netip.MustParsePrefix(c)
+	}
+	return result
+}
+
+func TestAllocate(t *testing.T) {
+	tests := []struct {
+		name         string
+		supernets    []netip.Prefix
+		used         []netip.Prefix
+		prefixLength int
+		expected     string
+	}{
+		{
+			name:         "empty pool",
+			supernets:    prefixes("10.0.0.0/16"),
+			prefixLength: 24,
+			expected:     "10.0.0.0/24",
+		},
+		{
+			name:         "skips used blocks",
+			supernets:    prefixes("10.0.0.0/16"),
+			used:         prefixes("10.0.0.0/24", "10.0.1.0/24"),
+			prefixLength: 24,
+			expected:     "10.0.2.0/24",
+		},
+		{
+			name:         "fills gaps",
+			supernets:    prefixes("10.0.0.0/16"),
+			used:         prefixes("10.0.2.0/24", "10.0.0.0/24"),
+			prefixLength: 24,
+			expected:     "10.0.1.0/24",
+		},
+		{
+			name:         "aligns after smaller block",
+			supernets:    prefixes("10.0.0.0/16"),
+			used:         prefixes("10.0.0.16/28"),
+			prefixLength: 24,
+			expected:     "10.0.1.0/24",
+		},
+		{
+			name:         "skips larger block",
+			supernets:    prefixes("10.0.0.0/16"),
+			used:         prefixes("10.0.0.0/20"),
+			prefixLength: 28,
+			expected:     "10.0.16.0/28",
+		},
+		{
+			name:         "ignores blocks outside of pool",
+			supernets:    prefixes("10.1.0.0/16"),
+			used:         prefixes("10.0.0.0/16", "192.168.0.0/24"),
+			prefixLength: 24,
+			expected:     "10.1.0.0/24",
+		},
+		{
+			name:         "uses next supernet",
+			supernets:    prefixes("10.0.0.0/24", "10.1.0.0/16"),
+			used:         prefixes("10.0.0.0/25"),
+			prefixLength: 24,
+			expected:     "10.1.0.0/24",
+		},
+		{
+			name:         "skips supernet smaller than block",
+			supernets:    prefixes("10.0.0.0/26", "10.1.0.0/16"),
+			prefixLength: 24,
+			expected:     "10.1.0.0/24",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			block, err := Allocate(tt.supernets, tt.used, tt.prefixLength)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, block.String())
+		})
+	}
+}
+
+func TestAllocateExhausted(t *testing.T) {
+	_, err := Allocate(prefixes("10.0.0.0/23"), prefixes("10.0.0.0/24", "10.0.1.0/24"), 24)
+	assert.EqualError(t, err, "no free /24 block left in 10.0.0.0/23")
+
+	_, err = Allocate(prefixes("10.0.0.0/16"), nil, 29)
+	assert.Error(t, err)
+}
+
+func TestParseSupernets(t *testing.T) {
+	supernets, err := ParseSupernets([]string{"10.0.0.0/16", "10.1.0.0/16"})
+	require.NoError(t, err)
+	assert.Equal(t, prefixes("10.0.0.0/16", "10.1.0.0/16"), supernets)
+
+	_, err = ParseSupernets(nil)
+	assert.Error(t, err)
+	_, err = ParseSupernets([]string{"10.0.0.1/16"})
+	assert.EqualError(t, err, "supernet 10.0.0.1/16 has host bits set, expected 10.0.0.0/16")
+	_, err = ParseSupernets([]string{"10.0.0.0/8", "10.1.0.0/16"})
+	assert.EqualError(t, err, "supernets 10.0.0.0/8 and 10.1.0.0/16 overlap")
+	_, err = ParseSupernets([]string{"fd00::/8"})
+	assert.Error(t, err)
+}
+
+func TestParseUsed(t *testing.T) {
+	used, err := ParseUsed([]string{"10.0.0.0/24", "fd00::/64"})
+	require.NoError(t, err)
+	assert.Equal(t, prefixes("10.0.0.0/24"), used)
+}
+
+func TestContains(t *testing.T) {
+	supernets := prefixes("10.0.0.0/16", "10.2.0.0/16")
+
+	assert.True(t, Contains(supernets, netip.MustParsePrefix("10.0.5.0/24")))
+	assert.True(t, Contains(supernets, netip.MustParsePrefix("10.2.0.0/16")))
+	assert.False(t, Contains(supernets, netip.MustParsePrefix("10.1.0.0/24")))
+	assert.False(t, Contains(supernets, netip.MustParsePrefix("10.0.0.0/15")))
+}
+
+func TestID(t *testing.T) {
+	id := FormatID("enp1234", prefixes("10.0.0.0/16", "10.1.0.0/16"))
+	assert.Equal(t, "enp1234:10.0.0.0/16,10.1.0.0/16", id)
+
+	networkID, supernets, err := ParseID(id)
+	require.NoError(t, err)
+	assert.Equal(t, "enp1234", networkID)
+	assert.Equal(t, prefixes("10.0.0.0/16", "10.1.0.0/16"), supernets)
+
+	for _, invalid := range []string{"", "enp1234", "enp1234:", ":10.0.0.0/16", "enp1234:10.0.0.0"} {
+		_, _, err := ParseID(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
@@ -0,0 +1,470 @@
+// Package dnsrecords implements validation and normalisation of DNS record sets
+// and parsing of RFC 1035 zone files.
+package dnsrecords
+
+import (
+	"fmt"
+	"net"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	// MaxTTL is the maximum time-to-live of a record set in seconds.
+	MaxTTL = 2147483647
+
+	// maxCharacterStringLength is the maximum length of a single TXT character-string.
+	maxCharacterStringLength = 255
+
+	maxNameLength  = 254
+	maxLabelLength = 63
+)
+
+// SupportedTypes lists record types that can be managed in a DNS zone.
+var SupportedTypes = []string{"A", "AAAA", "ANAME", "CAA", "CNAME", "HTTPS", "MX", "NS", "PTR", "SRV", "SVCB", "TXT"}
+
+var (
+	caaTagRe      = regexp.MustCompile(`^[a-zA-Z0-9]+$`)
+	svcParamKeyRe = regexp.MustCompile(`^[a-z0-9-]+$`)
+	labelRe       = regexp.MustCompile(`^(\*|[a-z0-9_]([a-z0-9_-]*[a-z0-9_])?)$`)
+)
+
+// RecordSet is a set of records of the same name and type.
+type RecordSet struct {
+	Name string
+	Type string
+	TTL  int64
+	Data []string
+}
+
+// Key identifies the record set in a zone.
+func (r RecordSet) Key() string {
+	return r.Name + " " + r.Type
+}
+
+// IsServiceManaged reports whether the record set is maintained by the DNS service itself:
+// the SOA record and NS records of the zone apex.
+func (r RecordSet) IsServiceManaged(origin string) bool {
+	return r.Type == "SOA" || (r.Type == "NS" && strings.EqualFold(r.Name, origin))
+}
+
+// Normalize validates the record set and returns it with absolute lowercase name,
+// uppercase type and sorted unique data in canonical presentation format.
+// Relative names are resolved against origin, "@" stands for the origin itself.
+func Normalize(r RecordSet, origin string) (RecordSet, error) {
+	name, err := NormalizeName(r.Name, origin)
+	if err != nil {
+		return RecordSet{}, err
+	}
+
+	recordType := strings.ToUpper(r.Type)
+	if !isSupportedType(recordType) {
+		return RecordSet{}, fmt.Errorf("record set %s: unsupported record type %q, expected one of %s", name, r.Type, strings.Join(SupportedTypes, ", "))
+	}
+
+	if r.TTL < 0 || r.TTL > MaxTTL {
+		return RecordSet{}, fmt.Errorf("record set %s %s: ttl must be between 0 and %d, got %d", name, recordType, MaxTTL, r.TTL)
+	}
+
+	if len(r.Data) == 0 {
+		return RecordSet{}, fmt.Errorf("record set %s %s: at least one record is required", name, recordType)
+	}
+
+	seen := make(map[string]struct{}, len(r.Data))
+	data := make([]string, 0, len(r.Data))
+	for _, value := range r.Data {
+		normalized, err := NormalizeData(recordType, value, origin)
+		if err != nil {
+			return RecordSet{}, fmt.Errorf("record set %s %s: %w", name, recordType, err)
+		}
+		if _, ok := seen[normalized]; ok {
+			continue
+		}
+		seen[normalized] = struct{}{}
+		data = append(data, normalized)
+	}
+	sort.Strings(data)
+
+	if recordType == "CNAME" && len(data) > 1 {
+		return RecordSet{}, fmt.Errorf("record set %s CNAME: only one record is allowed, got %d", name, len(data))
+	}
+
+	return RecordSet{
+		Name: name,
+		Type: recordType,
+		TTL:  r.TTL,
+		Data: data,
+	}, nil
+}
+
+// NormalizeName returns the absolute lowercase form of the domain name with a trailing dot.
+func NormalizeName(name, origin string) (string, error) {
+	origin = strings.ToLower(origin)
+	if origin != "" && !strings.HasSuffix(origin, ".") {
+		origin += "."
+	}
+
+	name = strings.ToLower(strings.TrimSpace(name))
+	switch {
+	case name == "" || name == "@":
+		if origin == "" {
+			return "", fmt.Errorf("name %q cannot be resolved without origin", name)
+		}
+		name = origin
+	case name == ".":
+		return name, nil
+	case !strings.HasSuffix(name, "."):
+		if origin == "" {
+			return "", fmt.Errorf("relative name %q cannot be resolved without origin", name)
+		}
+		if origin == "." {
+			name += "."
+		} else {
+			name = name + "." + origin
+		}
+	}
+
+	if len(name) > maxNameLength {
+		return "", fmt.Errorf("name %q is longer than %d characters", name, maxNameLength)
+	}
+	for _, label := range strings.Split(strings.TrimSuffix(name, "."), ".") {
+		if len(label) > maxLabelLength {
+			return "", fmt.Errorf("name %q has a label longer than %d characters", name, maxLabelLength)
+		}
+		if !labelRe.MatchString(label) {
+			return "", fmt.Errorf("name %q has an invalid label %q", name, label)
+		}
+	}
+
+	return name, nil
+}
+
+// NormalizeData validates a single record of the given type and returns it
+// in canonical presentation format.
+func NormalizeData(recordType, data, origin string) (string, error) {
+	fields := strings.Fields(data)
+	if len(fields) == 0 {
+		return "", fmt.Errorf("record data is empty")
+	}
+
+	data = strings.TrimSpace(data)
+	switch strings.ToUpper(recordType) {
+	case "A":
+		ip := net.ParseIP(data)
+		if ip == nil || ip.To4() == nil || strings.Contains(data, ":") {
+			return "", fmt.Errorf("invalid IPv4 address %q", data)
+		}
+		return ip.To4().String(), nil
+	case "AAAA":
+		ip := net.ParseIP(data)
+		if ip == nil || !strings.Contains(data, ":") {
+			return "", fmt.Errorf("invalid IPv6 address %q", data)
+		}
+		return ip.String(), nil
+	case "ANAME", "CNAME", "NS", "PTR":
+		if len(fields) != 1 {
+			return "", fmt.Errorf("expected a single domain name, got %q", data)
+		}
+		return NormalizeName(fields[0], origin)
+	case "MX":
+		return normalizeMX(fields, origin)
+	case "SRV":
+		return normalizeSRV(fields, origin)
+	case "CAA":
+		return normalizeCAA(data)
+	case "SVCB", "HTTPS":
+		return normalizeSVCB(fields, origin)
+	case "TXT":
+		return normalizeTXT(data)
+	}
+
+	return "", fmt.Errorf("unsupported record type %q", recordType)
+}
+
+func normalizeMX(fields []string, origin string) (string, error) {
+	if len(fields) != 2 {
+		return "", fmt.Errorf("MX record must be in the form \"<preference> <exchange>\", got %q", strings.Join(fields, " "))
+	}
+	preference, err := parseUint16("preference", fields[0])
+	if err != nil {
+		return "", err
+	}
+	exchange, err := NormalizeName(fields[1], origin)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%d %s", preference, exchange), nil
+}
+
+func normalizeSRV(fields []string, origin string) (string, error) {
+	if len(fields) != 4 {
+		return "", fmt.Errorf("SRV record must be in the form \"<priority> <weight> <port> <target>\", got %q", strings.Join(fields, " "))
+	}
+	var values [3]uint16
+	for i, name := range []string{"priority", "weight", "port"} {
+		value, err := parseUint16(name, fields[i])
+		if err != nil {
+			return "", err
+		}
+		values[i] = value
+	}
+	target, err := NormalizeName(fields[3], origin)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%d %d %d %s", values[0], values[1], values[2], target), nil
+}
+
+func normalizeCAA(data string) (string, error) {
+	rawFlags, rest := cutField(data)
+	rawTag, value := cutField(rest)
+	if value == "" {
+		return "", fmt.Errorf("CAA record must be in the form \"<flags> <tag> \\\"<value>\\\"\", got %q", data)
+	}
+	flags, err := strconv.ParseUint(rawFlags, 10, 8)
+	if err != nil {
+		return "", fmt.Errorf("invalid CAA flags %q: must be a number between 0 and 255", rawFlags)
+	}
+	tag := strings.ToLower(rawTag)
+	if !caaTagRe.MatchString(tag) {
+		return "", fmt.Errorf("invalid CAA tag %q: must consist of letters and digits", rawTag)
+	}
+
+	if strings.HasPrefix(value, `"`) {
+		strs, err := parseCharacterStrings(value)
+		if err != nil {
+			return "", err
+		}
+		if len(strs) != 1 {
+			return "", fmt.Errorf("CAA value must be a single string, got %q", value)
+		}
+		value = strs[0]
+	}
+
+	return fmt.Sprintf("%d %s %s", flags, tag, quoteCharacterString(value)), nil
+}
+
+func normalizeSVCB(fields []string, origin string) (string, error) {
+	if len(fields) < 2 {
+		return "", fmt.Errorf("SVCB record must be in the form \"<priority> <target> [<key>=<value> ...]\", got %q", strings.Join(fields, " "))
+	}
+	priority, err := parseUint16("priority", fields[0])
+	if err != nil {
+		return "", err
+	}
+	target := "."
+	if fields[1] != "." {
+		target, err = NormalizeName(fields[1], origin)
+		if err != nil {
+			return "", err
+		}
+	}
+	if priority == 0 && len(fields) > 2 {
+		return "", fmt.Errorf("SVCB record in alias mode (priority 0) cannot have parameters")
+	}
+
+	result := []string{strconv.Itoa(int(priority)), target}
+	for _, param := range fields[2:] {
+		key, value, hasValue := strings.Cut(param, "=")
+		key = strings.ToLower(key)
+		if !svcParamKeyRe.MatchString(key) {
+			return "", fmt.Errorf("invalid SVCB parameter %q", param)
+		}
+		if hasValue {
+			result = append(result, key+"="+value)
+		} else {
+			result = append(result, key)
+		}
+	}
+	return strings.Join(result, " "), nil
+}
+
+// normalizeTXT returns the record as a sequence of quoted character-strings,
+// splitting strings longer than 255 characters.
+func normalizeTXT(data string) (string, error) {
+	data = strings.TrimSpace(data)
+
+	// A value without quotes is a single string, e.g. "v=spf1 -all".
+	strs := []string{data}
+	if strings.Contains(data, `"`) {
+		var err error
+		strs, err = parseCharacterStrings(data)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	var parts []string
+	for _, s := range strs {
+		for len(s) > maxCharacterStringLength {
+			parts = append(parts, quoteCharacterString(s[:maxCharacterStringLength]))
+			s = s[maxCharacterStringLength:]
+		}
+		parts = append(parts, quoteCharacterString(s))
+	}
+	return strings.Join(parts, " "), nil
+}
+
+// parseCharacterStrings parses a whitespace separated sequence of character-strings,
+// each of them either quoted or a single word.
+func parseCharacterStrings(data string) ([]string, error) {
+	var result []string
+	for i := 0; i < len(data); {
+		switch {
+		case data[i] == ' ' || data[i] == '\t':
+			i++
+		case data[i] == '"':
+			var b strings.Builder
+			i++
+			closed := false
+			for i < len(data) && !closed {
+				switch data[i] {
+				case '\\':
+					s, n, err := unescape(data[i:])
+					if err != nil {
+						return nil, err
+					}
+					b.WriteString(s)
+					i += n
+				case '"':
+					closed = true
+					i++
+				default:
+					b.WriteByte(data[i])
+					i++
+				}
+			}
+			if !closed {
+				return nil, fmt.Errorf("unterminated quoted string in %q", data)
+			}
+			result = append(result, b.String())
+		default:
+			var b strings.Builder
+			for i < len(data) && data[i] != ' ' && data[i] != '\t' && data[i] != '"' {
+				if data[i] == '\\' {
+					s, n, err := unescape(data[i:])
+					if err != nil {
+						return nil, err
+					}
+					b.WriteString(s)
+					i += n
+					continue
+				}
+				b.WriteByte(data[i])
+				i++
+			}
+			result = append(result, b.String())
+		}
+	}
+	return result, nil
+}
+
+// unescape decodes an escape sequence "\X" or "\DDD" at the beginning of s
+// and returns the decoded value and the number of consumed bytes.
+func unescape(s string) (string, int, error) {
+	if len(s) < 2 {
+		return "", 0, fmt.Errorf("dangling escape character")
+	}
+	if s[1] >= '0' && s[1] <= '9' {
+		if len(s) < 4 {
+			return "", 0, fmt.Errorf("invalid escape sequence %q", s)
+		}
+		code, err := strconv.ParseUint(s[1:4], 10, 8)
+		if err != nil {
+			return "", 0, fmt.Errorf("invalid escape sequence %q", s[:4])
+		}
+		return string([]byte{byte(code)}), 4, nil
+	}
+	return s[1:2], 2, nil
+}
+
+func quoteCharacterString(s string) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case c == '"' || c == '\\':
+			b.WriteByte('\\')
+			b.WriteByte(c)
+		case c < 0x20 || c >= 0x7f:
+			fmt.Fprintf(&b, "\\%03d", c)
+		default:
+			b.WriteByte(c)
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
+
+// cutField splits s into its first whitespace separated field and the trimmed remainder.
+func cutField(s string) (string, string) {
+	s = strings.TrimSpace(s)
+	if i := strings.IndexAny(s, " \t"); i >= 0 {
+		return s[:i], strings.TrimSpace(s[i:])
+	}
+	return s, ""
+}
+
+func parseUint16(name, value string) (uint16, error) {
+	parsed, err := strconv.ParseUint(value, 10, 16)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s %q: must be a number between 0 and 65535", name, value)
+	}
+	return uint16(parsed), nil
+}
+
+func isSupportedType(recordType string) bool {
+	for _, t := range SupportedTypes {
+		if t == recordType {
+			return true
+		}
+	}
+	return false
+}
+
+// Diff compares normalized record sets and returns keys of current record sets missing
+// from desired ones and desired record sets that have to be created or replaced.
+func Diff(current, desired []RecordSet) (deletions []string, replacements []RecordSet) {
+	currentByKey := make(map[string]RecordSet, len(current))
+	for _, r := range current {
+		currentByKey[r.Key()] = r
+	}
+	desiredKeys := make(map[string]struct{}, len(desired))
+	for _, r := range desired {
+		desiredKeys[r.Key()] = struct{}{}
+		if existing, ok := currentByKey[r.Key()]; !ok || !Equal(existing, r) {
+			replacements = append(replacements, r)
+		}
+	}
+	for _, r := range current {
+		if _, ok := desiredKeys[r.Key()]; !ok {
+			deletions = append(deletions, r.Key())
+		}
+	}
+	return deletions, replacements
+}
+
+// Equal reports whether two normalized record sets are the same.
+func Equal(a, b RecordSet) bool {
+	if a.Key() != b.Key() || a.TTL != b.TTL || len(a.Data) != len(b.Data) {
+		return false
+	}
+	for i := range a.Data {
+		if a.Data[i] != b.Data[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Sort orders record sets by name and type.
+func Sort(records []RecordSet) {
+	sort.Slice(records, func(i, j int) bool {
+		if records[i].Name != records[j].Name {
+			return records[i].Name < records[j].Name
+		}
+		return records[i].Type < records[j].Type
+	})
+}
@@ -0,0 +1,147 @@
+package dnsrecords
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeName(t *testing.T) {
+	tests := []struct {
+		name     string
+		origin   string
+		expected string
+		err      string
+	}{
+		{name: "@", origin: "example.com.", expected: "example.com."},
+		{name: "", origin: "example.com", expected: "example.com."},
+		{name: "WWW", origin: "Example.com.", expected: "www.example.com."},
+		{name: "www.example.com.", origin: "other.org.", expected: "www.example.com."},
+		{name: "*.apps", origin: "example.com.", expected: "*.apps.example.com."},
+		{name: "_sip._tcp", origin: "example.com.", expected: "_sip._tcp.example.com."},
+		{name: "srv", origin: "", err: "cannot be resolved without origin"},
+		{name: "bad..name.", origin: "example.com.", err: "invalid label"},
+		{name: "-bad", origin: "example.com.", err: "invalid label"},
+		{name: strings.Repeat("a", 64), origin: "example.com.", err: "longer than 63"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := NormalizeName(tt.name, tt.origin)
+			if tt.err != "" {
+				assert.ErrorContains(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestNormalizeData(t *testing.T) {
+	longText := strings.Repeat("a", 300)
+
+	tests := []struct {
+		recordType string
+		data       string
+		expected   string
+		err        string
+	}{
+		{recordType: "A", data: " 192.168.0.1 ", expected: "192.168.0.1"},
+		{recordType: "A", data: "2001:db8::1", err: "invalid IPv4"},
+		{recordType: "A", data: "192.168.0.256", err: "invalid IPv4"},
+		{recordType: "AAAA", data: "2001:DB8:0:0::1", expected: "2001:db8::1"},
+		{recordType: "AAAA", data: "10.0.0.1", err: "invalid IPv6"},
+		{recordType: "CNAME", data: "www", expected: "www.example.com."},
+		{recordType: "CNAME", data: "www.example.org.", expected: "www.example.org."},
+		{recordType: "CNAME", data: "a b", err: "single domain name"},
+		{recordType: "MX", data: "10  mx1", expected: "10 mx1.example.com."},
+		{recordType: "MX", data: "mx1", err: "MX record must be"},
+		{recordType: "MX", data: "70000 mx1", err: "invalid preference"},
+		{recordType: "SRV", data: "10 5 5060 sip.example.org.", expected: "10 5 5060 sip.example.org."},
+		{recordType: "SRV", data: "10 5 sip", err: "SRV record must be"},
+		{recordType: "CAA", data: `0 ISSUE "letsencrypt.org"`, expected: `0 issue "letsencrypt.org"`},
+		{recordType: "CAA", data: `128 iodef mailto:security@example.com`, expected: `128 iodef "mailto:security@example.com"`},
+		{recordType: "CAA", data: `256 issue "ca.example"`, err: "invalid CAA flags"},
+		{recordType: "CAA", data: `0 issue`, err: "CAA record must be"},
+		{recordType: "SVCB", data: "1 svc ALPN=h2,h3 port=8443", expected: "1 svc.example.com. alpn=h2,h3 port=8443"},
+		{recordType: "HTTPS", data: "1 . alpn=h2", expected: "1 . alpn=h2"},
+		{recordType: "HTTPS", data: "0 www alpn=h2", err: "alias mode"},
+		{recordType: "TXT", data: "v=spf1 -all", expected: `"v=spf1 -all"`},
+		{recordType: "TXT", data: `"part one" "part \"two\""`, expected: `"part one" "part \"two\""`},
+		{recordType: "TXT", data: longText, expected: `"` + longText[:255] + `" "` + longText[255:] + `"`},
+		{recordType: "TXT", data: `"unterminated`, err: "unterminated quoted string"},
+		{recordType: "SOA", data: "ns1 admin 1 2 3 4 5", err: "unsupported record type"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.recordType+" "+tt.data, func(t *testing.T) {
+			actual, err := NormalizeData(tt.recordType, tt.data, "example.com.")
+			if tt.err != "" {
+				assert.ErrorContains(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, actual)
+
+			again, err := NormalizeData(tt.recordType, actual, "example.com.")
+			require.NoError(t, err)
+			assert.Equal(t, actual, again, "normalization must be idempotent")
+		})
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	actual, err := Normalize(RecordSet{
+		Name: "Mail",
+		Type: "mx",
+		TTL:  300,
+		Data: []string{"20 mx2", "10 mx1.example.com.", "10 MX1"},
+	}, "example.com.")
+	require.NoError(t, err)
+	assert.Equal(t, RecordSet{
+		Name: "mail.example.com.",
+		Type: "MX",
+		TTL:  300,
+		Data: []string{"10 mx1.example.com.", "20 mx2.example.com."},
+	}, actual)
+
+	_, err = Normalize(RecordSet{Name: "www", Type: "CNAME", TTL: 300, Data: []string{"a", "b"}}, "example.com.")
+	assert.ErrorContains(t, err, "only one record is allowed")
+
+	_, err = Normalize(RecordSet{Name: "www", Type: "LOC", TTL: 300, Data: []string{"x"}}, "example.com.")
+	assert.ErrorContains(t, err, "unsupported record type")
+
+	_, err = Normalize(RecordSet{Name: "www", Type: "A", TTL: -1, Data: []string{"10.0.0.1"}}, "example.com.")
+	assert.ErrorContains(t, err, "ttl must be between")
+}
+
+func TestDiff(t *testing.T) {
+	current := []RecordSet{
+		{Name: "a.example.com.", Type: "A", TTL: 300, Data: []string{"10.0.0.1"}},
+		{Name: "b.example.com.", Type: "A", TTL: 300, Data: []string{"10.0.0.2"}},
+		{Name: "c.example.com.", Type: "A", TTL: 300, Data: []string{"10.0.0.3"}},
+	}
+	desired := []RecordSet{
+		{Name: "a.example.com.", Type: "A", TTL: 300, Data: []string{"10.0.0.1"}},
+		{Name: "b.example.com.", Type: "A", TTL: 600, Data: []string{"10.0.0.2"}},
+		{Name: "d.example.com.", Type: "A", TTL: 300, Data: []string{"10.0.0.4"}},
+	}
+
+	deletions, replacements := Diff(current, desired)
+	assert.Equal(t, []string{"c.example.com. A"}, deletions)
+	assert.Equal(t, []RecordSet{desired[1], desired[2]}, replacements)
+
+	deletions, replacements = Diff(current, current)
+	assert.Empty(t, deletions)
+	assert.Empty(t, replacements)
+}
+
+func TestIsServiceManaged(t *testing.T) {
+	assert.True(t, RecordSet{Name: "example.com.", Type: "SOA"}.IsServiceManaged("example.com."))
+	assert.True(t, RecordSet{Name: "example.com.", Type: "NS"}.IsServiceManaged("example.com."))
+	assert.False(t, RecordSet{Name: "sub.example.com.", Type: "NS"}.IsServiceManaged("example.com."))
+	assert.False(t, RecordSet{Name: "example.com.", Type: "MX"}.IsServiceManaged("example.com."))
+}
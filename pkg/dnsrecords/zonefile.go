@@ -0,0 +1,308 @@
+package dnsrecords
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// zoneFileLine is a logical line of a zone file with parentheses unfolded and comments removed.
+type zoneFileLine struct {
+	number int
+	// indented is set when the line starts with a blank, i.e. the owner name is omitted.
+	indented bool
+	tokens   []string
+}
+
+// ParseZoneFile parses the content of an RFC 1035 zone file and returns its records grouped
+// into normalized record sets. Relative names are resolved against origin unless the file
+// changes it with the $ORIGIN directive. Records without TTL get the value of the $TTL directive
+// or, if it is absent, defaultTTL. Records maintained by the DNS service itself (SOA and NS of
+// the zone apex) are skipped.
+func ParseZoneFile(content, origin string, defaultTTL int64) ([]RecordSet, error) {
+	lines, err := splitZoneFile(content)
+	if err != nil {
+		return nil, err
+	}
+
+	zoneOrigin, err := NormalizeName(origin, ".")
+	if err != nil {
+		return nil, fmt.Errorf("invalid zone origin: %w", err)
+	}
+
+	var (
+		currentOrigin       = zoneOrigin
+		ttlDirective  int64 = -1
+		lastOwner     string
+		lastTTL       int64 = -1
+		records             = make(map[string]*RecordSet)
+		order         []string
+	)
+
+	for _, line := range lines {
+		tokens := line.tokens
+		if strings.HasPrefix(tokens[0], "$") {
+			switch strings.ToUpper(tokens[0]) {
+			case "$ORIGIN":
+				if len(tokens) != 2 {
+					return nil, fmt.Errorf("line %d: $ORIGIN directive requires a single domain name", line.number)
+				}
+				currentOrigin, err = NormalizeName(tokens[1], currentOrigin)
+				if err != nil {
+					return nil, fmt.Errorf("line %d: %w", line.number, err)
+				}
+			case "$TTL":
+				if len(tokens) != 2 {
+					return nil, fmt.Errorf("line %d: $TTL directive requires a single value", line.number)
+				}
+				ttlDirective, err = parseTTL(tokens[1])
+				if err != nil {
+					return nil, fmt.Errorf("line %d: %w", line.number, err)
+				}
+			default:
+				return nil, fmt.Errorf("line %d: unsupported directive %s", line.number, tokens[0])
+			}
+			continue
+		}
+
+		owner := lastOwner
+		if !line.indented {
+			owner, err = NormalizeName(tokens[0], currentOrigin)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", line.number, err)
+			}
+			tokens = tokens[1:]
+		}
+		if owner == "" {
+			return nil, fmt.Errorf("line %d: record has no owner name", line.number)
+		}
+		lastOwner = owner
+
+		ttl := int64(-1)
+		for i := 0; i < 2 && len(tokens) > 0; i++ {
+			if strings.EqualFold(tokens[0], "IN") {
+				tokens = tokens[1:]
+				continue
+			}
+			if isClass(tokens[0]) {
+				return nil, fmt.Errorf("line %d: unsupported class %s, only IN is supported", line.number, tokens[0])
+			}
+			if value, err := parseTTL(tokens[0]); err == nil && ttl < 0 {
+				ttl = value
+				tokens = tokens[1:]
+				continue
+			}
+			break
+		}
+		if len(tokens) == 0 {
+			return nil, fmt.Errorf("line %d: record has no type", line.number)
+		}
+
+		switch {
+		case ttl >= 0:
+			lastTTL = ttl
+		case ttlDirective >= 0:
+			ttl = ttlDirective
+		case lastTTL >= 0:
+			ttl = lastTTL
+		default:
+			ttl = defaultTTL
+		}
+
+		record := RecordSet{
+			Name: owner,
+			Type: strings.ToUpper(tokens[0]),
+		}
+		if record.IsServiceManaged(zoneOrigin) {
+			continue
+		}
+		if !isSupportedType(record.Type) {
+			return nil, fmt.Errorf("line %d: unsupported record type %q, expected one of %s", line.number, tokens[0], strings.Join(SupportedTypes, ", "))
+		}
+
+		data, err := NormalizeData(record.Type, strings.Join(tokens[1:], " "), currentOrigin)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: record %s %s: %w", line.number, record.Name, record.Type, err)
+		}
+
+		existing, ok := records[record.Key()]
+		if !ok {
+			record.TTL = ttl
+			existing = &record
+			records[record.Key()] = existing
+			order = append(order, record.Key())
+		}
+		// All records of a set share the same TTL, take the smallest one if they differ.
+		if ttl < existing.TTL {
+			existing.TTL = ttl
+		}
+		existing.Data = append(existing.Data, data)
+	}
+
+	result := make([]RecordSet, 0, len(order))
+	for _, key := range order {
+		normalized, err := Normalize(*records[key], zoneOrigin)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, normalized)
+	}
+	Sort(result)
+
+	return result, nil
+}
+
+// splitZoneFile splits the zone file content into logical lines of tokens.
+// Quoted strings are kept as single tokens with their quotes.
+func splitZoneFile(content string) ([]zoneFileLine, error) {
+	var (
+		lines       []zoneFileLine
+		current     zoneFileLine
+		token       strings.Builder
+		inToken     bool
+		inQuotes    bool
+		parentheses bool
+		lineNumber  = 1
+		lineStart   = true
+	)
+
+	flushToken := func() {
+		if inToken {
+			current.tokens = append(current.tokens, token.String())
+			token.Reset()
+			inToken = false
+		}
+	}
+	flushLine := func() {
+		flushToken()
+		if len(current.tokens) > 0 {
+			lines = append(lines, current)
+		}
+		current = zoneFileLine{}
+	}
+
+	for i := 0; i < len(content); i++ {
+		c := content[i]
+
+		if lineStart && !parentheses {
+			current.number = lineNumber
+			current.indented = c == ' ' || c == '\t'
+			lineStart = false
+		}
+
+		switch {
+		case c == '\\' && i+1 < len(content):
+			token.WriteByte(c)
+			token.WriteByte(content[i+1])
+			inToken = true
+			i++
+		case inQuotes:
+			token.WriteByte(c)
+			if c == '"' {
+				inQuotes = false
+			}
+			if c == '\n' {
+				return nil, fmt.Errorf("line %d: unterminated quoted string", lineNumber)
+			}
+		case c == '"':
+			token.WriteByte(c)
+			inToken = true
+			inQuotes = true
+		case c == ';':
+			for i+1 < len(content) && content[i+1] != '\n' {
+				i++
+			}
+		case c == '(':
+			if parentheses {
+				return nil, fmt.Errorf("line %d: nested parentheses", lineNumber)
+			}
+			flushToken()
+			parentheses = true
+		case c == ')':
+			if !parentheses {
+				return nil, fmt.Errorf("line %d: unbalanced parentheses", lineNumber)
+			}
+			flushToken()
+			parentheses = false
+		case c == '\n':
+			lineNumber++
+			if parentheses {
+				flushToken()
+			} else {
+				flushLine()
+				lineStart = true
+			}
+		case unicode.IsSpace(rune(c)):
+			flushToken()
+		default:
+			token.WriteByte(c)
+			inToken = true
+		}
+	}
+
+	if inQuotes {
+		return nil, fmt.Errorf("line %d: unterminated quoted string", lineNumber)
+	}
+	if parentheses {
+		return nil, fmt.Errorf("line %d: unbalanced parentheses", lineNumber)
+	}
+	flushLine()
+
+	return lines, nil
+}
+
+// parseTTL parses a TTL value given in seconds or with BIND-style units, e.g. "1h30m".
+func parseTTL(value string) (int64, error) {
+	if value == "" || value[0] < '0' || value[0] > '9' {
+		return 0, fmt.Errorf("invalid TTL %q", value)
+	}
+	if ttl, err := strconv.ParseInt(value, 10, 64); err == nil {
+		if ttl > MaxTTL {
+			return 0, fmt.Errorf("TTL %q is greater than %d", value, MaxTTL)
+		}
+		return ttl, nil
+	}
+
+	var ttl, number int64
+	hasNumber := false
+	for _, c := range strings.ToLower(value) {
+		if c >= '0' && c <= '9' {
+			number = number*10 + int64(c-'0')
+			hasNumber = true
+			if number > MaxTTL {
+				return 0, fmt.Errorf("TTL %q is greater than %d", value, MaxTTL)
+			}
+			continue
+		}
+		multiplier, ok := ttlUnits[c]
+		if !ok || !hasNumber {
+			return 0, fmt.Errorf("invalid TTL %q", value)
+		}
+		ttl += number * multiplier
+		number, hasNumber = 0, false
+	}
+	if hasNumber {
+		return 0, fmt.Errorf("invalid TTL %q: missing unit after the last number", value)
+	}
+	if ttl > MaxTTL {
+		return 0, fmt.Errorf("TTL %q is greater than %d", value, MaxTTL)
+	}
+	return ttl, nil
+}
+
+var ttlUnits = map[rune]int64{
+	's': 1,
+	'm': 60,
+	'h': 60 * 60,
+	'd': 24 * 60 * 60,
+	'w': 7 * 24 * 60 * 60,
+}
+
+func isClass(token string) bool {
+	switch strings.ToUpper(token) {
+	case "IN", "CH", "CS", "HS":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,101 @@
+package dnsrecords
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseZoneFile(t *testing.T) {
+	const zoneFile = `
+$TTL 1h
+@       IN  SOA   ns1.yandexcloud.net. mx.cloud.yandex.net. (
+                  2024010101 ; serial
+                  3600       ; refresh
+                  600        ; retry
+                  1209600    ; expire
+                  300 )      ; minimum
+@       IN  NS    ns1.yandexcloud.net.
+@       IN  NS    ns2.yandexcloud.net.
+@           MX    10 mx1
+            MX    20 mx2.example.com.
+@       300 IN TXT "v=spf1 include:_spf.example.net ~all"
+www     IN  300   A 192.0.2.1
+www         600   A 192.0.2.2 ; the smallest TTL wins
+ftp         CNAME www
+sub         NS    ns1.sub   ; delegation
+_sip._tcp   SRV   10 5 5060 sip
+@           CAA   0 issue "letsencrypt.org"
+
+$ORIGIN dev.example.com.
+api     30  AAAA  2001:DB8::1
+`
+
+	records, err := ParseZoneFile(zoneFile, "example.com", 60)
+	require.NoError(t, err)
+
+	assert.Equal(t, []RecordSet{
+		{Name: "_sip._tcp.example.com.", Type: "SRV", TTL: 3600, Data: []string{"10 5 5060 sip.example.com."}},
+		{Name: "api.dev.example.com.", Type: "AAAA", TTL: 30, Data: []string{"2001:db8::1"}},
+		{Name: "example.com.", Type: "CAA", TTL: 3600, Data: []string{`0 issue "letsencrypt.org"`}},
+		{Name: "example.com.", Type: "MX", TTL: 3600, Data: []string{"10 mx1.example.com.", "20 mx2.example.com."}},
+		{Name: "example.com.", Type: "TXT", TTL: 300, Data: []string{`"v=spf1 include:_spf.example.net ~all"`}},
+		{Name: "ftp.example.com.", Type: "CNAME", TTL: 3600, Data: []string{"www.example.com."}},
+		{Name: "sub.example.com.", Type: "NS", TTL: 3600, Data: []string{"ns1.sub.example.com."}},
+		{Name: "www.example.com.", Type: "A", TTL: 300, Data: []string{"192.0.2.1", "192.0.2.2"}},
+	}, records)
+}
+
+func TestParseZoneFileDefaultTTL(t *testing.T) {
+	records, err := ParseZoneFile("www A 192.0.2.1\napi 120 A 192.0.2.2\nftp A 192.0.2.3\n", "example.com.", 60)
+	require.NoError(t, err)
+
+	assert.Equal(t, []RecordSet{
+		{Name: "api.example.com.", Type: "A", TTL: 120, Data: []string{"192.0.2.2"}},
+		{Name: "ftp.example.com.", Type: "A", TTL: 120, Data: []string{"192.0.2.3"}},
+		{Name: "www.example.com.", Type: "A", TTL: 60, Data: []string{"192.0.2.1"}},
+	}, records)
+}
+
+func TestParseZoneFileErrors(t *testing.T) {
+	tests := map[string]struct {
+		zoneFile string
+		err      string
+	}{
+		"include":          {zoneFile: "$INCLUDE other.zone", err: "line 1: unsupported directive $INCLUDE"},
+		"no owner":         {zoneFile: "   A 192.0.2.1", err: "line 1: record has no owner name"},
+		"unsupported type": {zoneFile: "www A 192.0.2.1\nloc LOC 52 22 23.000 N 4 53 32.000 E -2.00m", err: "line 2: unsupported record type"},
+		"class":            {zoneFile: "www CH A 192.0.2.1", err: "unsupported class CH"},
+		"bad data":         {zoneFile: "www A 2001:db8::1", err: "line 1: record www.example.com. A: invalid IPv4"},
+		"parentheses":      {zoneFile: "www TXT ( \"a\"", err: "unbalanced parentheses"},
+		"quotes":           {zoneFile: "www TXT \"a\nftp A 192.0.2.1", err: "unterminated quoted string"},
+		"ttl":              {zoneFile: "$TTL 1x", err: "invalid TTL"},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := ParseZoneFile(tt.zoneFile, "example.com.", 60)
+			assert.ErrorContains(t, err, tt.err)
+		})
+	}
+}
+
+func TestParseTTL(t *testing.T) {
+	for value, expected := range map[string]int64{
+		"0":     0,
+		"3600":  3600,
+		"1h30m": 5400,
+		"1W2D":  777600,
+		"30s":   30,
+	} {
+		actual, err := parseTTL(value)
+		require.NoError(t, err, value)
+		assert.Equal(t, expected, actual, value)
+	}
+
+	for _, value := range []string{"", "h1", "1h30", "2147483648"} {
+		_, err := parseTTL(value)
+		assert.Error(t, err, value)
+	}
+}
@@ -0,0 +1,113 @@
+// Package healthwait implements waiting for the members of a group, e.g. instances of an instance group
+// or nodes of a Kubernetes node group, to become healthy after create or update.
+package healthwait
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	DefaultMinHealthyPercent = 100
+	DefaultTimeout           = "15m"
+	DefaultPollInterval      = 15 * time.Second
+)
+
+// Member is the health state of a single group member.
+type Member struct {
+	// Name identifies the member in diagnostics, e.g. the instance name.
+	Name    string
+	Healthy bool
+	// Reasons explain why the member is not healthy.
+	Reasons []string
+}
+
+// CheckFunc returns the current health state of the group members and the number of members
+// the group should have, e.g. the target size of an instance group.
+type CheckFunc func(ctx context.Context) ([]Member, int, error)
+
+// Required returns the number of healthy members required out of total for the given percent.
+func Required(total, minHealthyPercent int) int {
+	return (total*minHealthyPercent + 99) / 100
+}
+
+// Evaluate reports whether enough of the targetSize members are healthy and returns the unhealthy ones sorted by name.
+// The members that are not created yet are not healthy, so a group without members is healthy only if targetSize is 0.
+func Evaluate(members []Member, targetSize, minHealthyPercent int) (bool, []Member) {
+	healthy := 0
+	var unhealthy []Member
+	for _, m := range members {
+		if m.Healthy {
+			healthy++
+		} else {
+			unhealthy = append(unhealthy, m)
+		}
+	}
+	sort.Slice(unhealthy, func(i, j int) bool {
+		return unhealthy[i].Name < unhealthy[j].Name
+	})
+
+	return healthy >= Required(max(len(members), targetSize), minHealthyPercent), unhealthy
+}
+
+// Describe formats the unhealthy members for diagnostics, one per line.
+func Describe(unhealthy []Member) string {
+	lines := make([]string, 0, len(unhealthy))
+	for _, m := range unhealthy {
+		if len(m.Reasons) == 0 {
+			lines = append(lines, fmt.Sprintf("  - %s", m.Name))
+			continue
+		}
+		lines = append(lines, fmt.Sprintf("  - %s: %s", m.Name, strings.Join(m.Reasons, "; ")))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Wait polls check until at least minHealthyPercent of the members are healthy or the timeout expires.
+// On timeout the returned error lists the unhealthy members.
+func Wait(ctx context.Context, what string, minHealthyPercent int, timeout, interval time.Duration, check CheckFunc) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var (
+		members    []Member
+		targetSize int
+		unhealthy  []Member
+		lastErr    error
+	)
+	for {
+		current, target, err := check(ctx)
+		if err != nil {
+			// Health may be unavailable right after the operation, e.g. while a target group is being attached.
+			log.Printf("[WARN] Failed to check health of %s: %s", what, err)
+			lastErr = err
+		} else {
+			lastErr = nil
+			members, targetSize = current, target
+			var ok bool
+			ok, unhealthy = Evaluate(members, targetSize, minHealthyPercent)
+			if ok {
+				log.Printf("[DEBUG] %d of %d members of %s are healthy", len(members)-len(unhealthy), len(members), what)
+				return nil
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			if members == nil && lastErr != nil {
+				return fmt.Errorf("failed to check health of %s in %s: %w", what, timeout, lastErr)
+			}
+			description := Describe(unhealthy)
+			if missing := targetSize - len(members); missing > 0 {
+				description = strings.TrimPrefix(description+fmt.Sprintf("\n  - %d members are not created yet", missing), "\n")
+			}
+			return fmt.Errorf("%d of %d members of %s are healthy after %s, at least %d%% required; unhealthy members:\n%s",
+				len(members)-len(unhealthy), max(len(members), targetSize), what, timeout, minHealthyPercent, description)
+		case <-time.After(interval):
+		}
+	}
+}
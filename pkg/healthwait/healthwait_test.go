@@ -0,0 +1,93 @@
+package healthwait
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequired(t *testing.T) {
+	assert.Equal(t, 3, Required(3, 100))
+	assert.Equal(t, 2, Required(3, 50))
+	assert.Equal(t, 1, Required(3, 1))
+	assert.Equal(t, 0, Required(3, 0))
+	assert.Equal(t, 0, Required(0, 100))
+}
+
+func TestEvaluate(t *testing.T) {
+	members := []Member{
+		{Name: "b", Reasons: []string{"status RUNNING_OUTDATED"}},
+		{Name: "c", Healthy: true},
+		{Name: "a", Reasons: []string{"UNHEALTHY in load balancer nlb-1"}},
+		{Name: "d", Healthy: true},
+	}
+
+	ok, unhealthy := Evaluate(members, 4, 50)
+	assert.True(t, ok)
+	assert.Equal(t, []string{"a", "b"}, []string{unhealthy[0].Name, unhealthy[1].Name})
+
+	ok, _ = Evaluate(members, 4, 75)
+	assert.False(t, ok)
+
+	ok, _ = Evaluate(members, 8, 50)
+	assert.False(t, ok)
+
+	ok, unhealthy = Evaluate(nil, 0, 100)
+	assert.True(t, ok)
+	assert.Empty(t, unhealthy)
+
+	ok, _ = Evaluate(nil, 2, 100)
+	assert.False(t, ok)
+}
+
+func TestDescribe(t *testing.T) {
+	assert.Equal(t,
+		"  - a: status STARTING; DRAINING in load balancer alb-1\n  - b",
+		Describe([]Member{
+			{Name: "a", Reasons: []string{"status STARTING", "DRAINING in load balancer alb-1"}},
+			{Name: "b"},
+		}),
+	)
+}
+
+func TestWait(t *testing.T) {
+	calls := 0
+	err := Wait(context.Background(), "group", 100, time.Second, time.Millisecond, func(context.Context) ([]Member, int, error) {
+		calls++
+		switch calls {
+		case 1:
+			return nil, 0, errors.New("not ready")
+		case 2:
+			return nil, 2, nil
+		case 3:
+			return []Member{{Name: "a", Healthy: true}, {Name: "b"}}, 2, nil
+		default:
+			return []Member{{Name: "a", Healthy: true}, {Name: "b", Healthy: true}}, 2, nil
+		}
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 4, calls)
+}
+
+func TestWaitTimeout(t *testing.T) {
+	err := Wait(context.Background(), "instance group ig-1", 100, 20*time.Millisecond, time.Millisecond, func(context.Context) ([]Member, int, error) {
+		return []Member{{Name: "a", Healthy: true}, {Name: "b", Reasons: []string{"status CHECKING_HEALTH"}}}, 3, nil
+	})
+	assert.ErrorContains(t, err, "1 of 3 members of instance group ig-1 are healthy")
+	assert.ErrorContains(t, err, "  - b: status CHECKING_HEALTH\n  - 1 members are not created yet")
+
+	err = Wait(context.Background(), "group", 100, 20*time.Millisecond, time.Millisecond, func(context.Context) ([]Member, int, error) {
+		return nil, 2, nil
+	})
+	assert.ErrorContains(t, err, "0 of 2 members of group are healthy after 20ms, at least 100% required; unhealthy members:\n  - 2 members are not created yet")
+
+	err = Wait(context.Background(), "group", 100, 20*time.Millisecond, time.Millisecond, func(context.Context) ([]Member, int, error) {
+		return nil, 0, errors.New("permission denied")
+	})
+	assert.ErrorContains(t, err, "failed to check health of group")
+	assert.ErrorContains(t, err, "permission denied")
+}
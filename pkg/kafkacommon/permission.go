@@ -0,0 +1,15 @@
+package kafkacommon
+
+import "fmt"
+
+// UserMutexKey returns the key of the lock held while the permissions of the Kafka user are changed.
+// The lock is shared by `yandex_mdb_kafka_user` and `yandex_mdb_kafka_user_permission`, since the
+// update of the user replaces all of its permissions.
+func UserMutexKey(clusterID, userName string) string {
+	return fmt.Sprintf("mdb-kafka-user-%s-%s", clusterID, userName)
+}
+
+// PermissionKey identifies the binding of a topic and a role of a user, the allowed hosts are not part of it.
+func PermissionKey(topicName, role string) string {
+	return topicName + ":" + role
+}
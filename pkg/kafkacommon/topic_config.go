@@ -0,0 +1,134 @@
+// Package kafkacommon holds the Kafka settings rules shared by the resources.
+//
+// The package does not depend on the API bindings, so the same rules are used by the
+// SDKv2 resources at plan time and by the framework resources.
+package kafkacommon
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ConfigSuffix returns the suffix of the versioned config messages for the Kafka version,
+// e.g. "2_8" for KafkaConfig2_8 and TopicConfig2_8, "3" for any 3.x version and "4" for any 4.x version.
+func ConfigSuffix(version string) (string, error) {
+	switch {
+	case version == "":
+		return "", fmt.Errorf("you must specify version of Kafka")
+	case version == "2.8":
+		return "2_8", nil
+	case strings.HasPrefix(version, "3."):
+		return "3", nil
+	case strings.HasPrefix(version, "4."):
+		return "4", nil
+	}
+	return "", fmt.Errorf("version %q of Kafka is not supported by Terraform provider", version)
+}
+
+// SupportsZooKeeper reports whether the Kafka version can run with ZooKeeper.
+// ZooKeeper mode was removed in Kafka 4.0.
+func SupportsZooKeeper(version string) bool {
+	suffix, err := ConfigSuffix(version)
+	return err == nil && suffix != "4"
+}
+
+var (
+	CleanupPolicies = []string{
+		"CLEANUP_POLICY_DELETE",
+		"CLEANUP_POLICY_COMPACT",
+		"CLEANUP_POLICY_COMPACT_AND_DELETE",
+	}
+	CompressionTypes = []string{
+		"COMPRESSION_TYPE_UNCOMPRESSED",
+		"COMPRESSION_TYPE_ZSTD",
+		"COMPRESSION_TYPE_LZ4",
+		"COMPRESSION_TYPE_SNAPPY",
+		"COMPRESSION_TYPE_GZIP",
+		"COMPRESSION_TYPE_PRODUCER",
+	}
+	MessageTimestampTypes = []string{
+		"MESSAGE_TIMESTAMP_TYPE_CREATE_TIME",
+		"MESSAGE_TIMESTAMP_TYPE_LOG_APPEND_TIME",
+	}
+)
+
+var topicEnumSettings = map[string][]string{
+	"cleanup_policy":         CleanupPolicies,
+	"compression_type":       CompressionTypes,
+	"message_timestamp_type": MessageTimestampTypes,
+}
+
+// topicIntSettings holds the minimal values of the integer topic settings.
+var topicIntSettings = map[string]int64{
+	"delete_retention_ms":   0,
+	"file_delete_delay_ms":  0,
+	"flush_messages":        1,
+	"flush_ms":              0,
+	"min_compaction_lag_ms": 0,
+	"retention_bytes":       -1,
+	"retention_ms":          -1,
+	"max_message_bytes":     0,
+	"min_insync_replicas":   1,
+	"segment_bytes":         14,
+}
+
+// topicIntSettingsOverrides holds the minimal values changed by the specific Kafka versions.
+var topicIntSettingsOverrides = map[string]map[string]int64{
+	// KIP-1030 raised the minimal segment size to 1 MiB.
+	"4": {"segment_bytes": 1 << 20},
+}
+
+// ValidateTopicConfig checks the values of the topic settings. The settings are the same for all
+// supported Kafka versions, the only version-specific rule is the minimal segment size of Kafka 4.
+// Settings are passed the way they are set in the configuration: integers as strings,
+// enums by their names. Empty values are treated as unset.
+func ValidateTopicConfig(version string, settings map[string]string) error {
+	suffix, err := ConfigSuffix(version)
+	if err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(settings))
+	for name := range settings {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var errs []error
+	for _, name := range names {
+		value := settings[name]
+		if value == "" {
+			continue
+		}
+
+		if allowed, ok := topicEnumSettings[name]; ok {
+			if !slices.Contains(allowed, value) {
+				errs = append(errs, fmt.Errorf("value for %q must be one of %s, not %q", name, strings.Join(allowed, ", "), value))
+			}
+			continue
+		}
+
+		minValue, ok := topicIntSettings[name]
+		if !ok {
+			continue
+		}
+		if override, ok := topicIntSettingsOverrides[suffix][name]; ok {
+			minValue = override
+		}
+
+		i, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("value for %q must be an integer, not %q", name, value))
+			continue
+		}
+		if i < minValue {
+			errs = append(errs, fmt.Errorf("value for %q must be at least %d for Kafka %s, not %d", name, minValue, version, i))
+		}
+	}
+
+	return errors.Join(errs...)
+}
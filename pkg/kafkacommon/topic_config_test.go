@@ -0,0 +1,126 @@
+package kafkacommon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigSuffix(t *testing.T) {
+	tests := []struct {
+		version string
+		suffix  string
+		error   string
+	}{
+		{version: "2.8", suffix: "2_8"},
+		{version: "3.6", suffix: "3"},
+		{version: "3.9", suffix: "3"},
+		{version: "4.0", suffix: "4"},
+		{version: "", error: "must specify version"},
+		{version: "2.6", error: "not supported"},
+		{version: "30", error: "not supported"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.version, func(t *testing.T) {
+			suffix, err := ConfigSuffix(tt.version)
+			if tt.error != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.error)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.suffix, suffix)
+		})
+	}
+}
+
+func TestSupportsZooKeeper(t *testing.T) {
+	assert.True(t, SupportsZooKeeper("2.8"))
+	assert.True(t, SupportsZooKeeper("3.6"))
+	assert.False(t, SupportsZooKeeper("4.0"))
+	assert.False(t, SupportsZooKeeper("unknown"))
+}
+
+func TestValidateTopicConfig(t *testing.T) {
+	tests := []struct {
+		name     string
+		version  string
+		settings map[string]string
+		errors   []string
+	}{
+		{
+			name:    "valid settings",
+			version: "3.6",
+			settings: map[string]string{
+				"cleanup_policy":      "CLEANUP_POLICY_COMPACT",
+				"compression_type":    "COMPRESSION_TYPE_ZSTD",
+				"retention_ms":        "-1",
+				"retention_bytes":     "1073741824",
+				"min_insync_replicas": "2",
+				"segment_bytes":       "16384",
+			},
+		},
+		{
+			name:     "empty values are unset",
+			version:  "4.0",
+			settings: map[string]string{"segment_bytes": "", "cleanup_policy": ""},
+		},
+		{
+			name:     "unknown enum value",
+			version:  "3.6",
+			settings: map[string]string{"cleanup_policy": "CLEANUP_POLICY_UNSPECIFIED"},
+			errors:   []string{`"cleanup_policy" must be one of`},
+		},
+		{
+			name:     "not an integer",
+			version:  "3.6",
+			settings: map[string]string{"retention_ms": "1d"},
+			errors:   []string{`"retention_ms" must be an integer`},
+		},
+		{
+			name:    "values below minimum",
+			version: "2.8",
+			settings: map[string]string{
+				"retention_ms":        "-2",
+				"min_insync_replicas": "0",
+			},
+			errors: []string{
+				`"min_insync_replicas" must be at least 1`,
+				`"retention_ms" must be at least -1`,
+			},
+		},
+		{
+			name:     "segment size allowed before Kafka 4",
+			version:  "3.9",
+			settings: map[string]string{"segment_bytes": "16384"},
+		},
+		{
+			name:     "segment size raised in Kafka 4",
+			version:  "4.0",
+			settings: map[string]string{"segment_bytes": "16384"},
+			errors:   []string{`"segment_bytes" must be at least 1048576 for Kafka 4.0`},
+		},
+		{
+			name:     "unsupported version",
+			version:  "1.1",
+			settings: map[string]string{"retention_ms": "1000"},
+			errors:   []string{"not supported"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateTopicConfig(tt.version, tt.settings)
+			if len(tt.errors) == 0 {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			for _, e := range tt.errors {
+				assert.Contains(t, err.Error(), e)
+			}
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package kubeconfig
+
+import (
+	"encoding/base64"
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	EndpointTypeExternal = "external"
+	EndpointTypeInternal = "internal"
+)
+
+var EndpointTypeValues = []string{EndpointTypeExternal, EndpointTypeInternal}
+
+// Endpoints holds Kubernetes master endpoints of a Managed Kubernetes cluster.
+type Endpoints struct {
+	Internal string
+	External string
+}
+
+// Select returns the endpoint of the given type.
+func (e Endpoints) Select(endpointType string) (string, error) {
+	var endpoint string
+	switch endpointType {
+	case EndpointTypeExternal:
+		endpoint = e.External
+	case EndpointTypeInternal:
+		endpoint = e.Internal
+	default:
+		return "", fmt.Errorf("unknown endpoint type %q, expected one of %v", endpointType, EndpointTypeValues)
+	}
+
+	if endpoint == "" {
+		return "", fmt.Errorf("cluster has no %s endpoint", endpointType)
+	}
+	return endpoint, nil
+}
+
+// Params are the values rendered into a kubeconfig.
+type Params struct {
+	ClusterID            string
+	Server               string
+	ClusterCACertificate string
+	Token                string
+}
+
+type kubeconfig struct {
+	APIVersion     string         `yaml:"apiVersion"`
+	Kind           string         `yaml:"kind"`
+	Clusters       []namedCluster `yaml:"clusters"`
+	Contexts       []namedContext `yaml:"contexts"`
+	CurrentContext string         `yaml:"current-context"`
+	Users          []namedUser    `yaml:"users"`
+}
+
+type namedCluster struct {
+	Name    string  `yaml:"name"`
+	Cluster cluster `yaml:"cluster"`
+}
+
+type cluster struct {
+	Server                   string `yaml:"server"`
+	CertificateAuthorityData string `yaml:"certificate-authority-data"`
+}
+
+type namedContext struct {
+	Name    string  `yaml:"name"`
+	Context context `yaml:"context"`
+}
+
+type context struct {
+	Cluster string `yaml:"cluster"`
+	User    string `yaml:"user"`
+}
+
+type namedUser struct {
+	Name string `yaml:"name"`
+	User user   `yaml:"user"`
+}
+
+type user struct {
+	Token string `yaml:"token"`
+}
+
+// Render builds a kubeconfig with a single cluster, user and context authenticating with a bearer token.
+func Render(p Params) (string, error) {
+	name := "yc-managed-k8s-" + p.ClusterID
+
+	config := kubeconfig{
+		APIVersion: "v1",
+		Kind:       "Config",
+		Clusters: []namedCluster{{
+			Name: name,
+			Cluster: cluster{
+				Server:                   p.Server,
+				CertificateAuthorityData: base64.StdEncoding.EncodeToString([]byte(p.ClusterCACertificate)),
+			},
+		}},
+		Contexts: []namedContext{{
+			Name: name,
+			Context: context{
+				Cluster: name,
+				User:    name,
+			},
+		}},
+		CurrentContext: name,
+		Users: []namedUser{{
+			Name: name,
+			User: user{Token: p.Token},
+		}},
+	}
+
+	out, err := yaml.Marshal(config)
+	if err != nil {
+		return "", fmt.Errorf("failed to render kubeconfig: %w", err)
+	}
+	return string(out), nil
+}
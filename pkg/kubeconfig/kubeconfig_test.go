@@ -0,0 +1,56 @@
+package kubeconfig
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestEndpointsSelect(t *testing.T) {
+	endpoints := Endpoints{
+		Internal: "https://10.0.0.1",
+		External: "https://84.201.0.1",
+	}
+
+	endpoint, err := endpoints.Select(EndpointTypeExternal)
+	require.NoError(t, err)
+	assert.Equal(t, "https://84.201.0.1", endpoint)
+
+	endpoint, err = endpoints.Select(EndpointTypeInternal)
+	require.NoError(t, err)
+	assert.Equal(t, "https://10.0.0.1", endpoint)
+
+	_, err = endpoints.Select("ipv6")
+	assert.Error(t, err)
+
+	_, err = Endpoints{Internal: "https://10.0.0.1"}.Select(EndpointTypeExternal)
+	assert.ErrorContains(t, err, "no external endpoint")
+}
+
+func TestRender(t *testing.T) {
+	const caCert = "-----BEGIN CERTIFICATE-----\nMIIC\n-----END CERTIFICATE-----\n"
+
+	out, err := Render(Params{
+		ClusterID:            "cat123",
+		Server:               "https://10.0.0.1",
+		ClusterCACertificate: caCert,
+		Token:                "t1.token",
+	})
+	require.NoError(t, err)
+
+	var config map[string]interface{}
+	require.NoError(t, yaml.Unmarshal([]byte(out), &config))
+
+	assert.Equal(t, "Config", config["kind"])
+	assert.Equal(t, "yc-managed-k8s-cat123", config["current-context"])
+
+	cluster := config["clusters"].([]interface{})[0].(map[string]interface{})["cluster"].(map[string]interface{})
+	assert.Equal(t, "https://10.0.0.1", cluster["server"])
+	assert.Equal(t, base64.StdEncoding.EncodeToString([]byte(caCert)), cluster["certificate-authority-data"])
+
+	user := config["users"].([]interface{})[0].(map[string]interface{})["user"].(map[string]interface{})
+	assert.Equal(t, "t1.token", user["token"])
+}
@@ -0,0 +1,54 @@
+package mdbcommon
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/connectionmanager/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/lockbox/v1"
+	connectionmanagersdk "github.com/yandex-cloud/go-sdk/services/connectionmanager/v1"
+	lockboxsdk "github.com/yandex-cloud/go-sdk/services/lockbox/v1"
+	ycsdk "github.com/yandex-cloud/go-sdk/v2"
+)
+
+// passwordSecretKey is the key of the Lockbox secret entry holding the password, if the secret has several entries.
+const passwordSecretKey = "password"
+
+// ReadConnectionPassword reads the password of the Connection Manager connection from its Lockbox secret.
+func ReadConnectionPassword(ctx context.Context, sdk *ycsdk.SDK, connectionID string) (string, error) {
+	conn, err := connectionmanagersdk.NewConnectionClient(sdk).Get(ctx, &connectionmanager.GetConnectionRequest{ConnectionId: connectionID})
+	if err != nil {
+		return "", fmt.Errorf("failed to read connection %q: %w", connectionID, err)
+	}
+
+	secret := conn.GetLockboxSecret()
+	if secret.GetId() == "" {
+		return "", fmt.Errorf("connection %q has no Lockbox secret", connectionID)
+	}
+
+	payload, err := lockboxsdk.NewPayloadClient(sdk).Get(ctx, &lockbox.GetPayloadRequest{
+		SecretId:  secret.GetId(),
+		VersionId: secret.GetVersion(),
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to read Lockbox secret %q of connection %q: %w", secret.GetId(), connectionID, err)
+	}
+
+	return payloadPassword(payload.GetEntries())
+}
+
+// payloadPassword returns the only entry of the secret, or the entry with the passwordSecretKey key.
+func payloadPassword(entries []*lockbox.Payload_Entry) (string, error) {
+	if len(entries) == 1 {
+		return entries[0].GetTextValue(), nil
+	}
+
+	keys := make([]string, 0, len(entries))
+	for _, e := range entries {
+		if e.GetKey() == passwordSecretKey {
+			return e.GetTextValue(), nil
+		}
+		keys = append(keys, e.GetKey())
+	}
+	return "", fmt.Errorf("Lockbox secret has no %q entry, the entries are: %v", passwordSecretKey, keys)
+}
@@ -0,0 +1,250 @@
+package mdbcommon
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	ycsdk "github.com/yandex-cloud/go-sdk/v2"
+	utils "github.com/yandex-cloud/terraform-provider-yandex/pkg/wrappers"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// HostUpdateStrategy is the terraform model of the `host_update_strategy` attribute of the clusters.
+type HostUpdateStrategy struct {
+	MaxUnavailable           types.Int64 `tfsdk:"max_unavailable"`
+	Order                    types.List  `tfsdk:"order"`
+	PauseBetweenStepsSeconds types.Int64 `tfsdk:"pause_between_steps_seconds"`
+}
+
+var HostUpdateStrategyAttrTypes = map[string]attr.Type{
+	"max_unavailable":             types.Int64Type,
+	"order":                       types.ListType{ElemType: types.StringType},
+	"pause_between_steps_seconds": types.Int64Type,
+}
+
+// hostsHealthCheckInterval is the interval of polling the hosts health between the steps of a rolling update.
+const hostsHealthCheckInterval = 15 * time.Second
+
+// RollingHostUpdate replaces the hosts of a cluster zone by zone: the hosts of a zone are created,
+// then the hosts of a zone are deleted by batches of MaxUnavailable hosts. All hosts of the cluster
+// must become alive after each step before the next one is started.
+type RollingHostUpdate struct {
+	MaxUnavailable int
+	// Order is the order of the zones, the zones not listed go after them in alphabetical order.
+	Order []string
+	// PauseBetweenSteps is the fixed pause after the hosts became alive, so the replicas can catch up.
+	PauseBetweenSteps time.Duration
+}
+
+// ExpandHostUpdateStrategy returns nil if the strategy is not set, the hosts are changed all at once then.
+func ExpandHostUpdateStrategy(ctx context.Context, v types.Object, diags *diag.Diagnostics) *RollingHostUpdate {
+	if !utils.IsPresent(v) {
+		return nil
+	}
+
+	var strategy HostUpdateStrategy
+	diags.Append(v.As(ctx, &strategy, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return nil
+	}
+
+	result := &RollingHostUpdate{
+		MaxUnavailable:    1,
+		PauseBetweenSteps: time.Duration(strategy.PauseBetweenStepsSeconds.ValueInt64()) * time.Second,
+	}
+	if utils.IsPresent(strategy.MaxUnavailable) {
+		result.MaxUnavailable = int(strategy.MaxUnavailable.ValueInt64())
+	}
+	if utils.IsPresent(strategy.Order) {
+		diags.Append(strategy.Order.ElementsAs(ctx, &result.Order, false)...)
+	}
+	return result
+}
+
+// zoneOrder returns the zones in the order of the strategy.
+func (s *RollingHostUpdate) zoneOrder(zones map[string]struct{}) []string {
+	rest := maps.Clone(zones)
+	result := make([]string, 0, len(zones))
+	for _, zone := range s.Order {
+		if _, ok := rest[zone]; ok {
+			result = append(result, zone)
+			delete(rest, zone)
+		}
+	}
+	return append(result, slices.Sorted(maps.Keys(rest))...)
+}
+
+// applyHostChanges creates, updates and deletes the hosts all at once if the strategy is nil,
+// or zone by zone with the health checks between the steps otherwise.
+func applyHostChanges[H any, HS any, U any, O any](
+	ctx context.Context,
+	sdk *ycsdk.SDK,
+	diagnostics *diag.Diagnostics,
+	hostsApiService HostApiService[H, HS, U, O],
+	cid string,
+	opts O,
+	strategy *RollingHostUpdate,
+	toCreate []HS,
+	toUpdate []*U,
+	toDelete []string,
+	fqdnToZone map[string]string,
+) {
+	if strategy == nil {
+		hostsApiService.CreateHosts(ctx, sdk, diagnostics, cid, toCreate, opts)
+		if diagnostics.HasError() {
+			return
+		}
+		hostsApiService.UpdateHosts(ctx, sdk, diagnostics, cid, toUpdate)
+		if diagnostics.HasError() {
+			return
+		}
+		hostsApiService.DeleteHosts(ctx, sdk, diagnostics, cid, toDelete)
+		return
+	}
+
+	zones := make(map[string]struct{})
+	createByZone := make(map[string][]HS)
+	for _, spec := range toCreate {
+		zone := hostSpecZone(spec)
+		zones[zone] = struct{}{}
+		createByZone[zone] = append(createByZone[zone], spec)
+	}
+	deleteByZone := make(map[string][]string)
+	for _, fqdn := range toDelete {
+		zone := fqdnToZone[fqdn]
+		zones[zone] = struct{}{}
+		deleteByZone[zone] = append(deleteByZone[zone], fqdn)
+	}
+	order := strategy.zoneOrder(zones)
+
+	// New hosts go first, so the redundancy of the cluster is kept while the old hosts are deleted.
+	for _, zone := range order {
+		if len(createByZone[zone]) == 0 {
+			continue
+		}
+		tflog.Debug(ctx, "creating hosts of the zone", map[string]interface{}{"zone": zone, "count": len(createByZone[zone])})
+		hostsApiService.CreateHosts(ctx, sdk, diagnostics, cid, createByZone[zone], opts)
+		if diagnostics.HasError() {
+			return
+		}
+		waitHostsAlive(ctx, sdk, diagnostics, hostsApiService, cid, strategy.PauseBetweenSteps)
+		if diagnostics.HasError() {
+			return
+		}
+	}
+
+	hostsApiService.UpdateHosts(ctx, sdk, diagnostics, cid, toUpdate)
+	if diagnostics.HasError() {
+		return
+	}
+
+	for _, zone := range order {
+		for batch := range slices.Chunk(deleteByZone[zone], strategy.MaxUnavailable) {
+			tflog.Debug(ctx, "deleting hosts of the zone", map[string]interface{}{"zone": zone, "hosts": batch})
+			hostsApiService.DeleteHosts(ctx, sdk, diagnostics, cid, batch)
+			if diagnostics.HasError() {
+				return
+			}
+			waitHostsAlive(ctx, sdk, diagnostics, hostsApiService, cid, strategy.PauseBetweenSteps)
+			if diagnostics.HasError() {
+				return
+			}
+		}
+	}
+}
+
+// waitHostsAlive polls the hosts of the cluster until all of them are alive,
+// then pauses for pauseBetweenSteps.
+func waitHostsAlive[H any, HS any, U any, O any](
+	ctx context.Context,
+	sdk *ycsdk.SDK,
+	diagnostics *diag.Diagnostics,
+	hostsApiService HostApiService[H, HS, U, O],
+	cid string,
+	pauseBetweenSteps time.Duration,
+) {
+	for {
+		hosts := hostsApiService.ListHosts(ctx, sdk, diagnostics, cid)
+		if diagnostics.HasError() {
+			return
+		}
+
+		notAlive := notAliveHosts(hosts)
+		if len(notAlive) == 0 {
+			break
+		}
+		tflog.Debug(ctx, "waiting for hosts to become alive", map[string]interface{}{"hosts": notAlive})
+
+		if !sleepContext(ctx, hostsHealthCheckInterval) {
+			diagnostics.AddError(
+				"Failed to update hosts",
+				fmt.Sprintf("Hosts %s of cluster %q did not become alive: %s", strings.Join(notAlive, ", "), cid, ctx.Err()),
+			)
+			return
+		}
+	}
+
+	if pauseBetweenSteps > 0 && !sleepContext(ctx, pauseBetweenSteps) {
+		diagnostics.AddError(
+			"Failed to update hosts",
+			fmt.Sprintf("Waiting for replicas of cluster %q to catch up was interrupted: %s", cid, ctx.Err()),
+		)
+	}
+}
+
+func sleepContext(ctx context.Context, d time.Duration) bool {
+	select {
+	case <-ctx.Done():
+		return false
+	case <-time.After(d):
+		return true
+	}
+}
+
+// notAliveHosts returns the names of the hosts whose health is not ALIVE, including UNKNOWN:
+// a host that is just created reports UNKNOWN until it is checked. Hosts without the health field are skipped.
+// The services describe the health with their own enums, so it is read by reflection.
+func notAliveHosts[H any](hosts []H) []string {
+	var result []string
+	for _, host := range hosts {
+		m, ok := any(host).(proto.Message)
+		if !ok {
+			continue
+		}
+
+		r := m.ProtoReflect()
+		fd := r.Descriptor().Fields().ByName("health")
+		if fd == nil || fd.Kind() != protoreflect.EnumKind {
+			continue
+		}
+		v := fd.Enum().Values().ByNumber(r.Get(fd).Enum())
+		if v != nil && v.Name() == "ALIVE" {
+			continue
+		}
+
+		name := ""
+		if fd := r.Descriptor().Fields().ByName("name"); fd != nil && fd.Kind() == protoreflect.StringKind {
+			name = r.Get(fd).String()
+		}
+		result = append(result, name)
+	}
+	return result
+}
+
+// hostSpecZone returns the zone of the host spec, all the host specs of the services have zone_id.
+func hostSpecZone(spec any) string {
+	if s, ok := spec.(interface{ GetZoneId() string }); ok {
+		return s.GetZoneId()
+	}
+	return ""
+}
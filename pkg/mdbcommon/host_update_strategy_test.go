@@ -0,0 +1,142 @@
+package mdbcommon
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/stretchr/testify/assert"
+	ycsdk "github.com/yandex-cloud/go-sdk/v2"
+)
+
+type MockZonedHostSpec struct {
+	Name string
+	Zone string
+}
+
+func (m MockZonedHostSpec) GetZoneId() string {
+	return m.Zone
+}
+
+type MockHostApiService struct {
+	calls     []string
+	listCalls int
+}
+
+func (m *MockHostApiService) ListHosts(ctx context.Context, sdk *ycsdk.SDK, diag *diag.Diagnostics, cid string) []MockHost {
+	m.listCalls++
+	return []MockHost{{FQDN: "host.example.com"}}
+}
+
+func (m *MockHostApiService) CreateHosts(ctx context.Context, sdk *ycsdk.SDK, diag *diag.Diagnostics, cid string, specs []MockZonedHostSpec, opts struct{}) {
+	var names []string
+	for _, spec := range specs {
+		names = append(names, spec.Name)
+	}
+	m.calls = append(m.calls, fmt.Sprintf("create %v", names))
+}
+
+func (m *MockHostApiService) UpdateHosts(ctx context.Context, sdk *ycsdk.SDK, diag *diag.Diagnostics, cid string, specs []*MockUpdateSpec) {
+	m.calls = append(m.calls, fmt.Sprintf("update %d", len(specs)))
+}
+
+func (m *MockHostApiService) DeleteHosts(ctx context.Context, sdk *ycsdk.SDK, diag *diag.Diagnostics, cid string, fqdns []string) {
+	m.calls = append(m.calls, fmt.Sprintf("delete %v", fqdns))
+}
+
+func TestRollingHostUpdateZoneOrder(t *testing.T) {
+	t.Parallel()
+
+	zones := map[string]struct{}{
+		"ru-central1-a": {},
+		"ru-central1-b": {},
+		"ru-central1-d": {},
+	}
+
+	cases := []struct {
+		name     string
+		order    []string
+		expected []string
+	}{
+		{
+			name:     "no order",
+			expected: []string{"ru-central1-a", "ru-central1-b", "ru-central1-d"},
+		},
+		{
+			name:     "full order",
+			order:    []string{"ru-central1-d", "ru-central1-a", "ru-central1-b"},
+			expected: []string{"ru-central1-d", "ru-central1-a", "ru-central1-b"},
+		},
+		{
+			name:     "partial order with unknown zone",
+			order:    []string{"ru-central1-e", "ru-central1-b"},
+			expected: []string{"ru-central1-b", "ru-central1-a", "ru-central1-d"},
+		},
+	}
+
+	for _, c := range cases {
+		strategy := &RollingHostUpdate{MaxUnavailable: 1, Order: c.order}
+		assert.Equal(t, c.expected, strategy.zoneOrder(zones), c.name)
+	}
+}
+
+func TestApplyHostChanges(t *testing.T) {
+	t.Parallel()
+
+	toCreate := []MockZonedHostSpec{
+		{Name: "new-a", Zone: "ru-central1-a"},
+		{Name: "new-b1", Zone: "ru-central1-b"},
+		{Name: "new-b2", Zone: "ru-central1-b"},
+	}
+	toUpdate := []*MockUpdateSpec{{FQND: "host.example.com"}}
+	toDelete := []string{"old-a.example.com", "old-b1.example.com", "old-b2.example.com", "old-b3.example.com"}
+	fqdnToZone := map[string]string{
+		"old-a.example.com":  "ru-central1-a",
+		"old-b1.example.com": "ru-central1-b",
+		"old-b2.example.com": "ru-central1-b",
+		"old-b3.example.com": "ru-central1-b",
+	}
+
+	cases := []struct {
+		name          string
+		strategy      *RollingHostUpdate
+		expectedCalls []string
+		expectedLists int
+	}{
+		{
+			name: "without strategy",
+			expectedCalls: []string{
+				"create [new-a new-b1 new-b2]",
+				"update 1",
+				"delete [old-a.example.com old-b1.example.com old-b2.example.com old-b3.example.com]",
+			},
+		},
+		{
+			name:     "zone by zone",
+			strategy: &RollingHostUpdate{MaxUnavailable: 2, Order: []string{"ru-central1-b"}},
+			expectedCalls: []string{
+				"create [new-b1 new-b2]",
+				"create [new-a]",
+				"update 1",
+				"delete [old-b1.example.com old-b2.example.com]",
+				"delete [old-b3.example.com]",
+				"delete [old-a.example.com]",
+			},
+			expectedLists: 5,
+		},
+	}
+
+	for _, c := range cases {
+		api := &MockHostApiService{}
+		var diags diag.Diagnostics
+		applyHostChanges[MockHost, MockZonedHostSpec, MockUpdateSpec, struct{}](
+			context.Background(), nil, &diags, api, "cid", struct{}{}, c.strategy, toCreate, toUpdate, toDelete, fqdnToZone,
+		)
+		if diags.HasError() {
+			t.Errorf("%s: unexpected diagnostics: %v", c.name, diags)
+		}
+		assert.Equal(t, c.expectedCalls, api.calls, c.name)
+		assert.Equal(t, c.expectedLists, api.listCalls, c.name)
+	}
+}
@@ -127,6 +127,8 @@ func CreateClusterHosts[T Host, H any, HS any, U any](ctx context.Context,
 // 7) Update existing hosts
 // 8) Delete shards
 // 9) Delete remaining hosts
+//
+// If the strategy is set, the remaining hosts are created and deleted zone by zone, see RollingHostUpdate.
 func UpdateClusterHostsWithShards[T HostWithShard, H any, HS ProtoHostWithShard, U any, O any](
 	ctx context.Context,
 	sdk *ycsdk.SDK,
@@ -135,6 +137,7 @@ func UpdateClusterHostsWithShards[T HostWithShard, H any, HS ProtoHostWithShard,
 	hostsApiService HostApiServiceWithShards[H, HS, U, O],
 	cid string,
 	opts O,
+	strategy *RollingHostUpdate,
 	plan, state types.Map,
 ) {
 	entityIdToPlanHost := make(map[string]T)
@@ -201,12 +204,8 @@ func UpdateClusterHostsWithShards[T HostWithShard, H any, HS ProtoHostWithShard,
 		}
 	}
 
-	hostsApiService.CreateHosts(ctx, sdk, diagnostics, cid, toCreate, opts)
-	if diagnostics.HasError() {
-		return
-	}
-
-	hostsApiService.UpdateHosts(ctx, sdk, diagnostics, cid, toUpdate)
+	// Hosts of the deleted shards are deleted with the shards below.
+	applyHostChanges(ctx, sdk, diagnostics, hostsApiService, cid, opts, strategy, toCreate, toUpdate, nil, nil)
 	if diagnostics.HasError() {
 		return
 	}
@@ -234,7 +233,7 @@ func UpdateClusterHostsWithShards[T HostWithShard, H any, HS ProtoHostWithShard,
 		}
 	}
 
-	hostsApiService.DeleteHosts(ctx, sdk, diagnostics, cid, toDelete)
+	applyHostChanges(ctx, sdk, diagnostics, hostsApiService, cid, opts, strategy, nil, nil, toDelete, hostZones(utilsHostService, entityIdToApiHosts))
 	if diagnostics.HasError() {
 		return
 	}
@@ -251,6 +250,8 @@ func UpdateClusterHostsWithShards[T HostWithShard, H any, HS ProtoHostWithShard,
 // 3) Create remaining hosts
 // 4) Update existing hosts
 // 5) Delete remaining hosts
+//
+// If the strategy is set, the hosts are created and deleted zone by zone, see RollingHostUpdate.
 func UpdateClusterHosts[T Host, H any, HS any, U any, O any](
 	ctx context.Context,
 	sdk *ycsdk.SDK,
@@ -259,6 +260,7 @@ func UpdateClusterHosts[T Host, H any, HS any, U any, O any](
 	hostsApiService HostApiService[H, HS, U, O],
 	cid string,
 	opts O,
+	strategy *RollingHostUpdate,
 	plan, state types.Map,
 ) {
 	entityIdToPlanHost := make(map[string]T)
@@ -284,20 +286,19 @@ func UpdateClusterHosts[T Host, H any, HS any, U any, O any](
 		"deleted": len(toDelete),
 	})
 
-	hostsApiService.CreateHosts(ctx, sdk, diagnostics, cid, toCreate, opts)
-	if diagnostics.HasError() {
-		return
-	}
-
-	hostsApiService.UpdateHosts(ctx, sdk, diagnostics, cid, toUpdate)
+	applyHostChanges(ctx, sdk, diagnostics, hostsApiService, cid, opts, strategy, toCreate, toUpdate, toDelete, hostZones(utilsHostService, entityIdToApiHosts))
 	if diagnostics.HasError() {
 		return
 	}
+}
 
-	hostsApiService.DeleteHosts(ctx, sdk, diagnostics, cid, toDelete)
-	if diagnostics.HasError() {
-		return
+// hostZones returns the zones of the hosts by their FQDN.
+func hostZones[T Host, H any, HS any, U any](utilsHostService CmpHostService[T, H, HS, U], hosts map[string]T) map[string]string {
+	result := make(map[string]string, len(hosts))
+	for _, host := range hosts {
+		result[host.GetFQDN().ValueString()] = hostSpecZone(utilsHostService.ConvertToProto(host))
 	}
+	return result
 }
 
 // Processes the collected changes for hosts and shards.
@@ -0,0 +1,59 @@
+package mdbcommon
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// ImportBlock is an entity of the moved resource that is managed by a standalone resource after the move.
+type ImportBlock struct {
+	ResourceType string
+	// Name is the name of the entity, the resource name is derived from it.
+	Name string
+	ID   string
+}
+
+func (b ImportBlock) String() string {
+	return fmt.Sprintf("import {\n  to = %s.%s\n  id = %q\n}", b.ResourceType, resourceNameOf(b.Name), b.ID)
+}
+
+// AddNotMovedWarning reports the inline entities of the moved resource that are left out of the new state.
+// The entities are kept in the cluster, the warning lists the import blocks of the standalone resources for them.
+func AddNotMovedWarning(diags *diag.Diagnostics, sourceType, entities string, blocks []ImportBlock) {
+	if len(blocks) == 0 {
+		return
+	}
+
+	lines := make([]string, 0, len(blocks))
+	for _, b := range blocks {
+		lines = append(lines, b.String())
+	}
+	diags.AddWarning(
+		"Inline entities are not moved",
+		fmt.Sprintf("The %s of %s are not moved and are no longer managed by Terraform, they are kept in the cluster. "+
+			"Declare the standalone resources for them and import them, e.g.:\n\n%s", entities, sourceType, strings.Join(lines, "\n\n")),
+	)
+}
+
+// resourceNameOf returns a valid Terraform resource name for the entity name.
+func resourceNameOf(name string) string {
+	var sb strings.Builder
+	for i, r := range name {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r == '_':
+		case (r >= '0' && r <= '9') || r == '-':
+			if i == 0 {
+				sb.WriteRune('_')
+			}
+		default:
+			r = '_'
+		}
+		sb.WriteRune(r)
+	}
+	if sb.Len() == 0 {
+		return "_"
+	}
+	return sb.String()
+}
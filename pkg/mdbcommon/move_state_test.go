@@ -0,0 +1,49 @@
+package mdbcommon
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+func TestResourceNameOf(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]string{
+		"events":        "events",
+		"my-topic.v1":   "my-topic_v1",
+		"1st":           "_1st",
+		"-dash":         "_-dash",
+		"":              "_",
+		"user@example":  "user_example",
+		"Upper_Case_42": "Upper_Case_42",
+	}
+	for name, expected := range cases {
+		if actual := resourceNameOf(name); actual != expected {
+			t.Errorf("resourceNameOf(%q) = %q, expected %q", name, actual, expected)
+		}
+	}
+}
+
+func TestAddNotMovedWarning(t *testing.T) {
+	t.Parallel()
+
+	var diags diag.Diagnostics
+	AddNotMovedWarning(&diags, "yandex_mdb_kafka_cluster", "topics", nil)
+	if len(diags) != 0 {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	AddNotMovedWarning(&diags, "yandex_mdb_kafka_cluster", "topics", []ImportBlock{
+		{ResourceType: "yandex_mdb_kafka_topic", Name: "events.v1", ID: "cid:events.v1"},
+	})
+	if diags.WarningsCount() != 1 || diags.HasError() {
+		t.Fatalf("expected a single warning, got %v", diags)
+	}
+	detail := diags[0].Detail()
+	expected := "import {\n  to = yandex_mdb_kafka_topic.events_v1\n  id = \"cid:events.v1\"\n}"
+	if !strings.Contains(detail, expected) {
+		t.Errorf("warning detail %q does not contain %q", detail, expected)
+	}
+}
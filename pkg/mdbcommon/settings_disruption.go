@@ -0,0 +1,128 @@
+package mdbcommon
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// SettingDisruption describes how a cluster applies a change of a setting.
+type SettingDisruption int
+
+const (
+	// SettingOnline settings are applied without restarting the database.
+	SettingOnline SettingDisruption = iota
+	// SettingRequiresRestart settings are applied by restarting the database on every host.
+	SettingRequiresRestart
+	// SettingRequiresFailover settings are applied by restarting the hosts one by one,
+	// the primary is switched over to a replica before its restart.
+	SettingRequiresFailover
+)
+
+func (d SettingDisruption) String() string {
+	switch d {
+	case SettingRequiresRestart:
+		return "restart required"
+	case SettingRequiresFailover:
+		return "failover required"
+	default:
+		return "online"
+	}
+}
+
+// SettingsDisruptionInfoProvider is an optional interface that can be implemented alongside SettingsAttributeInfoProvider
+// to describe the settings whose change restarts the hosts or switches over the primary.
+// Settings missing in the map are considered to be applied online.
+type SettingsDisruptionInfoProvider interface {
+	GetSettingsDisruption() map[string]SettingDisruption
+}
+
+// DisruptiveChanges returns the changed settings of the plan which are not applied online.
+// Settings with unknown values are skipped, they are reported when the value becomes known.
+func (v SettingsMapValue) DisruptiveChanges(state SettingsMapValue) map[string]SettingDisruption {
+	dp, ok := v.p.(SettingsDisruptionInfoProvider)
+	if !ok || v.IsUnknown() {
+		return nil
+	}
+
+	return disruptiveChanges(v.Elements(), state.Elements(), dp.GetSettingsDisruption())
+}
+
+// ObjectDisruptiveChanges returns the changed attributes of the plan object which are not applied online.
+// It is used for the settings described by nested attributes instead of SettingsMapValue.
+func ObjectDisruptiveChanges(plan, state types.Object, disruption map[string]SettingDisruption) map[string]SettingDisruption {
+	if plan.IsUnknown() || state.IsUnknown() {
+		return nil
+	}
+
+	return disruptiveChanges(plan.Attributes(), state.Attributes(), disruption)
+}
+
+func disruptiveChanges(plan, state map[string]attr.Value, disruption map[string]SettingDisruption) map[string]SettingDisruption {
+	changes := make(map[string]SettingDisruption)
+	for name, d := range disruption {
+		if d == SettingOnline {
+			continue
+		}
+
+		planValue, inPlan := plan[name]
+		stateValue, inState := state[name]
+		if inPlan && planValue.IsUnknown() {
+			continue
+		}
+		// A removed setting is reset to its default value, so it is changed as well.
+		if isNullValue(planValue) && isNullValue(stateValue) {
+			continue
+		}
+		if inPlan && inState && planValue.Equal(stateValue) {
+			continue
+		}
+		changes[name] = d
+	}
+	return changes
+}
+
+func isNullValue(v attr.Value) bool {
+	return v == nil || v.IsNull()
+}
+
+// ReportDisruptiveChanges adds a warning listing the changed settings that restart the hosts or switch over the primary.
+// With ack the warning becomes an error, so the change can't be applied unnoticed.
+func ReportDisruptiveChanges(p path.Path, changes map[string]SettingDisruption, ack bool, diags *diag.Diagnostics) {
+	if len(changes) == 0 {
+		return
+	}
+
+	names := make([]string, 0, len(changes))
+	for name := range changes {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	lines := make([]string, 0, len(names))
+	for _, name := range names {
+		lines = append(lines, fmt.Sprintf("  - %s (%s)", name, changes[name]))
+	}
+
+	detail := fmt.Sprintf(
+		"Applying the changes of the following settings will restart the cluster hosts:\n%s",
+		strings.Join(lines, "\n"),
+	)
+
+	if ack {
+		diags.AddAttributeError(
+			p,
+			"Disruptive settings change",
+			detail+"\n\nThe plan is rejected because require_disruptive_change_ack is set. "+
+				"Set it to false to apply the change once the disruption is acknowledged.",
+		)
+		return
+	}
+
+	diags.AddAttributeWarning(p, "Disruptive settings change", detail)
+}
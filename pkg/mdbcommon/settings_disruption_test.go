@@ -0,0 +1,147 @@
+package mdbcommon
+
+import (
+	"maps"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type MockDisruptionAttrInfoProvider struct {
+	MockAttrInfoProvider
+}
+
+func (p *MockDisruptionAttrInfoProvider) GetSettingsDisruption() map[string]SettingDisruption {
+	return map[string]SettingDisruption{
+		"max_connections": SettingRequiresFailover,
+		"shared_buffers":  SettingRequiresRestart,
+		"work_mem":        SettingOnline,
+	}
+}
+
+func TestSettingsMapDisruptiveChanges(t *testing.T) {
+	t.Parallel()
+
+	p := &MockDisruptionAttrInfoProvider{}
+	state := NewSettingsMapValueMust(map[string]attr.Value{
+		"max_connections": types.Int64Value(100),
+		"shared_buffers":  types.Int64Value(1024),
+		"work_mem":        types.Int64Value(4),
+	}, p)
+
+	cases := []struct {
+		testname string
+		plan     SettingsMapValue
+		expected map[string]SettingDisruption
+	}{
+		{
+			testname: "NoChanges",
+			plan:     state,
+			expected: map[string]SettingDisruption{},
+		},
+		{
+			testname: "OnlineChange",
+			plan: NewSettingsMapValueMust(map[string]attr.Value{
+				"max_connections": types.Int64Value(100),
+				"shared_buffers":  types.Int64Value(1024),
+				"work_mem":        types.Int64Value(8),
+			}, p),
+			expected: map[string]SettingDisruption{},
+		},
+		{
+			testname: "ChangedAndRemoved",
+			plan: NewSettingsMapValueMust(map[string]attr.Value{
+				"max_connections": types.Int64Value(200),
+				"work_mem":        types.Int64Value(4),
+			}, p),
+			expected: map[string]SettingDisruption{
+				"max_connections": SettingRequiresFailover,
+				"shared_buffers":  SettingRequiresRestart,
+			},
+		},
+		{
+			testname: "UnknownValue",
+			plan: NewSettingsMapValueMust(map[string]attr.Value{
+				"max_connections": types.Int64Unknown(),
+				"shared_buffers":  types.Int64Value(1024),
+			}, p),
+			expected: map[string]SettingDisruption{},
+		},
+	}
+
+	for _, c := range cases {
+		changes := c.plan.DisruptiveChanges(state)
+		if !maps.Equal(changes, c.expected) {
+			t.Errorf("%s: unexpected disruptive changes: %v, want %v", c.testname, changes, c.expected)
+		}
+	}
+
+	// Providers without disruption metadata don't report any changes.
+	plain := NewSettingsMapValueMust(map[string]attr.Value{"max_connections": types.Int64Value(200)}, &mockProvider)
+	if changes := plain.DisruptiveChanges(state); len(changes) != 0 {
+		t.Errorf("unexpected disruptive changes without metadata: %v", changes)
+	}
+}
+
+func TestReportDisruptiveChanges(t *testing.T) {
+	t.Parallel()
+
+	changes := map[string]SettingDisruption{
+		"shared_buffers":  SettingRequiresRestart,
+		"max_connections": SettingRequiresFailover,
+	}
+
+	var diags diag.Diagnostics
+	ReportDisruptiveChanges(path.Root("config"), changes, false, &diags)
+	if diags.HasError() || diags.WarningsCount() != 1 {
+		t.Fatalf("expected a single warning, got %v", diags)
+	}
+	detail := diags[0].Detail()
+	if !strings.Contains(detail, "max_connections (failover required)") || strings.Index(detail, "max_connections") > strings.Index(detail, "shared_buffers") {
+		t.Errorf("unexpected warning detail: %s", detail)
+	}
+
+	diags = nil
+	ReportDisruptiveChanges(path.Root("config"), changes, true, &diags)
+	if diags.ErrorsCount() != 1 {
+		t.Errorf("expected an error with ack, got %v", diags)
+	}
+
+	diags = nil
+	ReportDisruptiveChanges(path.Root("config"), nil, true, &diags)
+	if len(diags) != 0 {
+		t.Errorf("expected no diagnostics without changes, got %v", diags)
+	}
+}
+
+func TestObjectDisruptiveChanges(t *testing.T) {
+	t.Parallel()
+
+	attrTypes := map[string]attr.Type{
+		"timezone":  types.StringType,
+		"log_level": types.StringType,
+	}
+	disruption := map[string]SettingDisruption{"timezone": SettingRequiresRestart}
+
+	state := types.ObjectValueMust(attrTypes, map[string]attr.Value{
+		"timezone":  types.StringValue("UTC"),
+		"log_level": types.StringValue("INFORMATION"),
+	})
+	plan := types.ObjectValueMust(attrTypes, map[string]attr.Value{
+		"timezone":  types.StringValue("Europe/Moscow"),
+		"log_level": types.StringValue("TRACE"),
+	})
+
+	changes := ObjectDisruptiveChanges(plan, state, disruption)
+	if !maps.Equal(changes, map[string]SettingDisruption{"timezone": SettingRequiresRestart}) {
+		t.Errorf("unexpected disruptive changes: %v", changes)
+	}
+
+	if changes := ObjectDisruptiveChanges(types.ObjectUnknown(attrTypes), state, disruption); len(changes) != 0 {
+		t.Errorf("unexpected disruptive changes for unknown plan: %v", changes)
+	}
+}
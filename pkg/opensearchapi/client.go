@@ -0,0 +1,220 @@
+// Package opensearchapi is a minimal client of the REST API of the OpenSearch clusters,
+// used by the resources managing the data plane of the cluster: index templates, ISM policies,
+// security roles and snapshot repositories.
+//
+// The package does not depend on the API bindings of the cloud, the hosts of the cluster
+// are resolved by the caller.
+package opensearchapi
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	// DefaultPort is the port of the REST API on the OpenSearch hosts of the cluster.
+	DefaultPort = 9200
+	// AdminUser is the user the cluster is created with.
+	AdminUser = "admin"
+
+	defaultTimeout = time.Minute
+)
+
+type Config struct {
+	// Hosts are the FQDNs of the OpenSearch hosts, the requests are sent to the first reachable one.
+	Hosts      []string
+	Port       int
+	Username   string
+	Password   string
+	HTTPClient *http.Client
+}
+
+type Client struct {
+	endpoints  []string
+	username   string
+	password   string
+	httpClient *http.Client
+}
+
+func NewClient(cfg Config) (*Client, error) {
+	if len(cfg.Hosts) == 0 {
+		return nil, fmt.Errorf("opensearch: no hosts to connect to")
+	}
+
+	port := cfg.Port
+	if port == 0 {
+		port = DefaultPort
+	}
+
+	endpoints := make([]string, 0, len(cfg.Hosts))
+	for _, host := range cfg.Hosts {
+		endpoints = append(endpoints, "https://"+net.JoinHostPort(host, strconv.Itoa(port)))
+	}
+
+	username := cfg.Username
+	if username == "" {
+		username = AdminUser
+	}
+
+	httpClient := cfg.HTTPClient
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: defaultTimeout}
+	}
+
+	return &Client{
+		endpoints:  endpoints,
+		username:   username,
+		password:   cfg.Password,
+		httpClient: httpClient,
+	}, nil
+}
+
+// NewHTTPClient returns an HTTP client trusting the system certificates and the PEM encoded caPEM, if any.
+func NewHTTPClient(caPEM string, insecure bool) (*http.Client, error) {
+	rootCAs, err := x509.SystemCertPool()
+	if err != nil {
+		rootCAs = x509.NewCertPool()
+	}
+	if caPEM != "" && !rootCAs.AppendCertsFromPEM([]byte(caPEM)) {
+		return nil, fmt.Errorf("opensearch: no certificates found in the CA certificate")
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = &tls.Config{
+		RootCAs:            rootCAs,
+		InsecureSkipVerify: insecure,
+	}
+
+	return &http.Client{Transport: transport, Timeout: defaultTimeout}, nil
+}
+
+// Do sends the request to the hosts of the cluster in turn until one of them responds.
+// The reqBody is marshaled to JSON unless it is nil, the response is unmarshaled into the result unless it is nil.
+func (c *Client) Do(ctx context.Context, method, path string, reqBody any, result any) error {
+	var body []byte
+	if reqBody != nil {
+		var err error
+		body, err = json.Marshal(reqBody)
+		if err != nil {
+			return fmt.Errorf("marshal request: %w", err)
+		}
+	}
+
+	var lastErr error
+	for _, endpoint := range c.endpoints {
+		respBody, err := c.do(ctx, method, endpoint+path, body)
+		if err != nil {
+			var apiErr *APIError
+			if errors.As(err, &apiErr) || ctx.Err() != nil {
+				return err
+			}
+			lastErr = err
+			continue
+		}
+
+		if result != nil && len(respBody) > 0 {
+			if err := json.Unmarshal(respBody, result); err != nil {
+				return fmt.Errorf("unmarshal response: %w", err)
+			}
+		}
+		return nil
+	}
+
+	return lastErr
+}
+
+func (c *Client) do(ctx context.Context, method, url string, body []byte) ([]byte, error) {
+	var reader io.Reader
+	if body != nil {
+		reader = bytes.NewReader(body)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, method, url, reader)
+	if err != nil {
+		return nil, fmt.Errorf("create request: %w", err)
+	}
+
+	httpReq.SetBasicAuth(c.username, c.password)
+	httpReq.Header.Set("Accept", "application/json")
+	if body != nil {
+		httpReq.Header.Set("Content-Type", "application/json")
+	}
+
+	httpResp, err := c.httpClient.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("http request: %w", err)
+	}
+	defer httpResp.Body.Close()
+
+	respBody, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read response: %w", err)
+	}
+
+	if httpResp.StatusCode < 200 || httpResp.StatusCode >= 300 {
+		return nil, &APIError{
+			StatusCode: httpResp.StatusCode,
+			Body:       string(respBody),
+			Method:     method,
+			Path:       httpReq.URL.Path,
+		}
+	}
+
+	return respBody, nil
+}
+
+type APIError struct {
+	StatusCode int
+	Body       string
+	Method     string
+	Path       string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("opensearch API %s %s returned status %d: %s", e.Method, e.Path, e.StatusCode, e.Body)
+}
+
+func IsNotFound(err error) bool {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode == http.StatusNotFound
+	}
+	return false
+}
+
+func IsUnauthorized(err error) bool {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode == http.StatusUnauthorized
+	}
+	return false
+}
+
+// getKeyedEntity reads the entity the API returns keyed by its name, e.g. a role or a snapshot repository.
+func getKeyedEntity[T any](ctx context.Context, c *Client, path, name string) (*T, error) {
+	var resp map[string]T
+	if err := c.Do(ctx, http.MethodGet, path, nil, &resp); err != nil {
+		return nil, err
+	}
+
+	entity, ok := resp[name]
+	if !ok {
+		return nil, &APIError{
+			StatusCode: http.StatusNotFound,
+			Body:       fmt.Sprintf("%q not found", name),
+			Method:     http.MethodGet,
+			Path:       path,
+		}
+	}
+	return &entity, nil
+}
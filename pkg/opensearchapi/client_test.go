@@ -0,0 +1,167 @@
+package opensearchapi
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"sync/atomic"
+	"testing"
+)
+
+func newTestClient(t *testing.T, handler http.HandlerFunc, hosts ...string) *Client {
+	t.Helper()
+
+	server := httptest.NewTLSServer(handler)
+	t.Cleanup(server.Close)
+
+	u, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	host, portStr, err := net.SplitHostPort(u.Host)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	port, err := strconv.Atoi(portStr)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	client, err := NewClient(Config{
+		Hosts:      append(hosts, host),
+		Port:       port,
+		Password:   "secret",
+		HTTPClient: server.Client(),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return client
+}
+
+func TestNewClient_RequiresHosts(t *testing.T) {
+	t.Parallel()
+
+	if _, err := NewClient(Config{}); err == nil {
+		t.Fatal("expected error for the empty hosts")
+	}
+}
+
+func TestClient_FailsOverToReachableHost(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		user, password, ok := r.BasicAuth()
+		if !ok || user != AdminUser || password != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(`{"acknowledged":true}`))
+	}, "127.0.0.2")
+
+	var resp struct {
+		Acknowledged bool `json:"acknowledged"`
+	}
+	if err := client.Do(context.Background(), http.MethodGet, "/", nil, &resp); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !resp.Acknowledged {
+		t.Fatal("expected acknowledged response")
+	}
+}
+
+func TestClient_APIErrorIsNotRetried(t *testing.T) {
+	t.Parallel()
+
+	var calls int64
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt64(&calls, 1)
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"status":404}`))
+	}
+
+	server := httptest.NewTLSServer(http.HandlerFunc(handler))
+	defer server.Close()
+	u, _ := url.Parse(server.URL)
+	host, portStr, _ := net.SplitHostPort(u.Host)
+	port, _ := strconv.Atoi(portStr)
+
+	client, err := NewClient(Config{
+		Hosts:      []string{host, host},
+		Port:       port,
+		HTTPClient: server.Client(),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	err = client.DeleteIndexTemplate(context.Background(), "logs")
+	if !IsNotFound(err) {
+		t.Fatalf("expected not found error, got %v", err)
+	}
+	if c := atomic.LoadInt64(&calls); c != 1 {
+		t.Fatalf("expected one request, got %d", c)
+	}
+}
+
+func TestClient_GetIndexTemplate(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/_index_template/logs" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(`{"index_templates":[{"name":"logs","index_template":{"index_patterns":["logs-*"]}}]}`))
+	})
+
+	body, err := client.GetIndexTemplate(context.Background(), "logs")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(body) != `{"index_patterns":["logs-*"]}` {
+		t.Fatalf("unexpected body: %s", body)
+	}
+
+	if _, err := client.GetIndexTemplate(context.Background(), "metrics"); !IsNotFound(err) {
+		t.Fatalf("expected not found error, got %v", err)
+	}
+}
+
+func TestClient_PutISMPolicyUsesSeqNo(t *testing.T) {
+	t.Parallel()
+
+	var query url.Values
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		_, _ = w.Write([]byte(`{}`))
+	})
+
+	err := client.PutISMPolicy(context.Background(), "hot-warm", []byte(`{"policy":{}}`), &ISMPolicy{SeqNo: 7, PrimaryTerm: 1})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if query.Get("if_seq_no") != "7" || query.Get("if_primary_term") != "1" {
+		t.Fatalf("unexpected query: %v", query)
+	}
+}
+
+func TestClient_GetRole(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"logs_reader":{"reserved":false,"cluster_permissions":["cluster_composite_ops_ro"],` +
+			`"index_permissions":[{"index_patterns":["logs-*"],"allowed_actions":["read"]}]}}`))
+	})
+
+	role, err := client.GetRole(context.Background(), "logs_reader")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(role.ClusterPermissions) != 1 || len(role.IndexPermissions) != 1 || role.IndexPermissions[0].IndexPatterns[0] != "logs-*" {
+		t.Fatalf("unexpected role: %+v", role)
+	}
+}
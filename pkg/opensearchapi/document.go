@@ -0,0 +1,99 @@
+package opensearchapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// ParseDocument decodes the JSON document keeping the numbers as json.Number,
+// so the large numbers are compared exactly.
+func ParseDocument(doc []byte) (any, error) {
+	dec := json.NewDecoder(bytes.NewReader(doc))
+	dec.UseNumber()
+
+	var v any
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+	if dec.More() {
+		return nil, fmt.Errorf("unexpected data after the JSON document")
+	}
+	return v, nil
+}
+
+// Contains reports whether the actual document returned by the API contains the expected one.
+// The API fills the defaults and converts the values of the settings to strings,
+// so only the fields of the expected document are compared and the scalars are compared by their string form.
+// The dotted keys of the expected document, e.g. `index.number_of_shards`, match the nested objects as well.
+func Contains(actual, expected any) bool {
+	switch e := expected.(type) {
+	case map[string]any:
+		a, ok := actual.(map[string]any)
+		if !ok {
+			return false
+		}
+		for k, ev := range e {
+			av, found := lookup(a, k)
+			if !found {
+				if ev == nil {
+					continue
+				}
+				return false
+			}
+			if !Contains(av, ev) {
+				return false
+			}
+		}
+		return true
+	case []any:
+		a, ok := actual.([]any)
+		if !ok || len(a) != len(e) {
+			return false
+		}
+		for i := range e {
+			if !Contains(a[i], e[i]) {
+				return false
+			}
+		}
+		return true
+	case nil:
+		return actual == nil
+	default:
+		switch actual.(type) {
+		case map[string]any, []any, nil:
+			return false
+		}
+		return fmt.Sprint(actual) == fmt.Sprint(expected)
+	}
+}
+
+func lookup(doc map[string]any, key string) (any, bool) {
+	if v, ok := doc[key]; ok {
+		return v, true
+	}
+
+	head, tail, dotted := strings.Cut(key, ".")
+	if !dotted {
+		return nil, false
+	}
+	nested, ok := doc[head].(map[string]any)
+	if !ok {
+		return nil, false
+	}
+	return lookup(nested, tail)
+}
+
+// DocumentMatches reports whether the actual document contains the expected one, see Contains.
+func DocumentMatches(actual json.RawMessage, expected string) (bool, error) {
+	a, err := ParseDocument(actual)
+	if err != nil {
+		return false, fmt.Errorf("invalid document returned by the API: %w", err)
+	}
+	e, err := ParseDocument([]byte(expected))
+	if err != nil {
+		return false, err
+	}
+	return Contains(a, e), nil
+}
@@ -0,0 +1,103 @@
+package opensearchapi
+
+import (
+	"testing"
+)
+
+func TestDocumentMatches(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		actual   string
+		expected string
+		matches  bool
+	}{
+		{
+			name:     "defaults filled by the API",
+			actual:   `{"index_patterns":["logs-*"],"priority":100,"composed_of":[]}`,
+			expected: `{"index_patterns":["logs-*"],"priority":100}`,
+			matches:  true,
+		},
+		{
+			name:     "numbers converted to strings",
+			actual:   `{"settings":{"index":{"number_of_shards":"1"}}}`,
+			expected: `{"settings":{"index.number_of_shards":1}}`,
+			matches:  true,
+		},
+		{
+			name:     "large numbers",
+			actual:   `{"min_size":"10000000000"}`,
+			expected: `{"min_size":10000000000}`,
+			matches:  true,
+		},
+		{
+			name:     "changed value",
+			actual:   `{"priority":100}`,
+			expected: `{"priority":200}`,
+		},
+		{
+			name:     "missing field",
+			actual:   `{}`,
+			expected: `{"priority":100}`,
+		},
+		{
+			name:     "null field",
+			actual:   `{}`,
+			expected: `{"priority":null}`,
+			matches:  true,
+		},
+		{
+			name:     "list order matters",
+			actual:   `{"index_patterns":["b-*","a-*"]}`,
+			expected: `{"index_patterns":["a-*","b-*"]}`,
+		},
+		{
+			name:     "object replaced by scalar",
+			actual:   `{"settings":"1"}`,
+			expected: `{"settings":{"index":"1"}}`,
+		},
+	}
+
+	for _, c := range cases {
+		matches, err := DocumentMatches([]byte(c.actual), c.expected)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", c.name, err)
+			continue
+		}
+		if matches != c.matches {
+			t.Errorf("%s: expected %t, got %t", c.name, c.matches, matches)
+		}
+	}
+}
+
+func TestDocumentMatches_InvalidJSON(t *testing.T) {
+	t.Parallel()
+
+	if _, err := DocumentMatches([]byte(`{}`), `{"priority":`); err == nil {
+		t.Fatal("expected error for the invalid document")
+	}
+	if _, err := DocumentMatches([]byte(`{}`), `{} {}`); err == nil {
+		t.Fatal("expected error for the trailing data")
+	}
+}
+
+func TestNormalizeIndexTemplate(t *testing.T) {
+	t.Parallel()
+
+	body, err := ParseDocument([]byte(`{"template":{"settings":{"number_of_shards":1,"index.refresh_interval":"5s","index":{"codec":"best_compression"}}}}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	actual, err := ParseDocument([]byte(`{"template":{"settings":{"index":{"number_of_shards":"1","refresh_interval":"5s","codec":"best_compression"}}}}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if Contains(actual, body) {
+		t.Fatal("expected the settings without the index prefix not to match before normalization")
+	}
+	if !Contains(actual, NormalizeIndexTemplate(body)) {
+		t.Fatal("expected the normalized settings to match")
+	}
+}
@@ -0,0 +1,77 @@
+package opensearchapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+type indexTemplatesResponse struct {
+	IndexTemplates []struct {
+		Name          string          `json:"name"`
+		IndexTemplate json.RawMessage `json:"index_template"`
+	} `json:"index_templates"`
+}
+
+func indexTemplatePath(name string) string {
+	return "/_index_template/" + url.PathEscape(name)
+}
+
+// PutIndexTemplate creates or replaces the composable index template.
+func (c *Client) PutIndexTemplate(ctx context.Context, name string, body json.RawMessage) error {
+	return c.Do(ctx, http.MethodPut, indexTemplatePath(name), body, nil)
+}
+
+// GetIndexTemplate returns the body of the composable index template.
+func (c *Client) GetIndexTemplate(ctx context.Context, name string) (json.RawMessage, error) {
+	var resp indexTemplatesResponse
+	if err := c.Do(ctx, http.MethodGet, indexTemplatePath(name), nil, &resp); err != nil {
+		return nil, err
+	}
+
+	for _, t := range resp.IndexTemplates {
+		if t.Name == name {
+			return t.IndexTemplate, nil
+		}
+	}
+	return nil, &APIError{
+		StatusCode: http.StatusNotFound,
+		Body:       fmt.Sprintf("index template %q not found", name),
+		Method:     http.MethodGet,
+		Path:       indexTemplatePath(name),
+	}
+}
+
+func (c *Client) DeleteIndexTemplate(ctx context.Context, name string) error {
+	return c.Do(ctx, http.MethodDelete, indexTemplatePath(name), nil, nil)
+}
+
+// NormalizeIndexTemplate adds the `index.` prefix to the keys of `template.settings` of the index template body,
+// as the API does, so the body can be compared with the one returned by the API.
+func NormalizeIndexTemplate(body any) any {
+	doc, ok := body.(map[string]any)
+	if !ok {
+		return body
+	}
+	template, ok := doc["template"].(map[string]any)
+	if !ok {
+		return body
+	}
+	settings, ok := template["settings"].(map[string]any)
+	if !ok {
+		return body
+	}
+
+	normalized := make(map[string]any, len(settings))
+	for k, v := range settings {
+		if k != "index" && !strings.HasPrefix(k, "index.") {
+			k = "index." + k
+		}
+		normalized[k] = v
+	}
+	template["settings"] = normalized
+	return body
+}
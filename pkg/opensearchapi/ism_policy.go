@@ -0,0 +1,46 @@
+package opensearchapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// ISMPolicy is the Index State Management policy with the version used for the optimistic concurrency control.
+type ISMPolicy struct {
+	ID          string          `json:"_id"`
+	SeqNo       int64           `json:"_seq_no"`
+	PrimaryTerm int64           `json:"_primary_term"`
+	Policy      json.RawMessage `json:"policy"`
+}
+
+func ismPolicyPath(id string) string {
+	return "/_plugins/_ism/policies/" + url.PathEscape(id)
+}
+
+func (c *Client) GetISMPolicy(ctx context.Context, id string) (*ISMPolicy, error) {
+	var policy ISMPolicy
+	if err := c.Do(ctx, http.MethodGet, ismPolicyPath(id), nil, &policy); err != nil {
+		return nil, err
+	}
+	return &policy, nil
+}
+
+// PutISMPolicy creates the policy, or replaces the current version of the policy if it is not nil.
+// The body is the document with the `policy` field.
+func (c *Client) PutISMPolicy(ctx context.Context, id string, body json.RawMessage, current *ISMPolicy) error {
+	path := ismPolicyPath(id)
+	if current != nil {
+		path += "?" + url.Values{
+			"if_seq_no":       {strconv.FormatInt(current.SeqNo, 10)},
+			"if_primary_term": {strconv.FormatInt(current.PrimaryTerm, 10)},
+		}.Encode()
+	}
+	return c.Do(ctx, http.MethodPut, path, body, nil)
+}
+
+func (c *Client) DeleteISMPolicy(ctx context.Context, id string) error {
+	return c.Do(ctx, http.MethodDelete, ismPolicyPath(id), nil, nil)
+}
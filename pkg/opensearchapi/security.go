@@ -0,0 +1,74 @@
+package opensearchapi
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+// Role is the role of the security plugin. The reserved, hidden and static flags returned by the API are ignored,
+// since the API rejects them in the requests.
+type Role struct {
+	Description        string             `json:"description,omitempty"`
+	ClusterPermissions []string           `json:"cluster_permissions,omitempty"`
+	IndexPermissions   []IndexPermission  `json:"index_permissions,omitempty"`
+	TenantPermissions  []TenantPermission `json:"tenant_permissions,omitempty"`
+}
+
+type IndexPermission struct {
+	IndexPatterns  []string `json:"index_patterns"`
+	DLS            string   `json:"dls,omitempty"`
+	FLS            []string `json:"fls,omitempty"`
+	MaskedFields   []string `json:"masked_fields,omitempty"`
+	AllowedActions []string `json:"allowed_actions"`
+}
+
+type TenantPermission struct {
+	TenantPatterns []string `json:"tenant_patterns"`
+	AllowedActions []string `json:"allowed_actions"`
+}
+
+// RoleMapping maps the users, the backend roles and the hosts to the role of the security plugin.
+type RoleMapping struct {
+	Description     string   `json:"description,omitempty"`
+	BackendRoles    []string `json:"backend_roles,omitempty"`
+	Hosts           []string `json:"hosts,omitempty"`
+	Users           []string `json:"users,omitempty"`
+	AndBackendRoles []string `json:"and_backend_roles,omitempty"`
+}
+
+const securityAPIPath = "/_plugins/_security/api/"
+
+func rolePath(name string) string {
+	return securityAPIPath + "roles/" + url.PathEscape(name)
+}
+
+func roleMappingPath(role string) string {
+	return securityAPIPath + "rolesmapping/" + url.PathEscape(role)
+}
+
+func (c *Client) GetRole(ctx context.Context, name string) (*Role, error) {
+	return getKeyedEntity[Role](ctx, c, rolePath(name), name)
+}
+
+// PutRole creates or replaces the role.
+func (c *Client) PutRole(ctx context.Context, name string, role *Role) error {
+	return c.Do(ctx, http.MethodPut, rolePath(name), role, nil)
+}
+
+func (c *Client) DeleteRole(ctx context.Context, name string) error {
+	return c.Do(ctx, http.MethodDelete, rolePath(name), nil, nil)
+}
+
+func (c *Client) GetRoleMapping(ctx context.Context, role string) (*RoleMapping, error) {
+	return getKeyedEntity[RoleMapping](ctx, c, roleMappingPath(role), role)
+}
+
+// PutRoleMapping creates or replaces the mapping of the role.
+func (c *Client) PutRoleMapping(ctx context.Context, role string, mapping *RoleMapping) error {
+	return c.Do(ctx, http.MethodPut, roleMappingPath(role), mapping, nil)
+}
+
+func (c *Client) DeleteRoleMapping(ctx context.Context, role string) error {
+	return c.Do(ctx, http.MethodDelete, roleMappingPath(role), nil, nil)
+}
@@ -0,0 +1,38 @@
+package opensearchapi
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+const (
+	// S3RepositoryType is the type of the snapshot repositories backed by Object Storage.
+	S3RepositoryType = "s3"
+	// StorageEndpoint is the endpoint of Object Storage the S3 repositories are created with.
+	StorageEndpoint = "storage.yandexcloud.net"
+)
+
+// SnapshotRepository is the repository of the snapshots. The API returns all the settings as strings.
+type SnapshotRepository struct {
+	Type     string            `json:"type"`
+	Settings map[string]string `json:"settings"`
+}
+
+func snapshotRepositoryPath(name string) string {
+	return "/_snapshot/" + url.PathEscape(name)
+}
+
+func (c *Client) GetSnapshotRepository(ctx context.Context, name string) (*SnapshotRepository, error) {
+	return getKeyedEntity[SnapshotRepository](ctx, c, snapshotRepositoryPath(name), name)
+}
+
+// PutSnapshotRepository registers or replaces the repository. The API verifies that
+// the repository is accessible by all the hosts of the cluster.
+func (c *Client) PutSnapshotRepository(ctx context.Context, name string, repo *SnapshotRepository) error {
+	return c.Do(ctx, http.MethodPut, snapshotRepositoryPath(name), repo, nil)
+}
+
+func (c *Client) DeleteSnapshotRepository(ctx context.Context, name string) error {
+	return c.Do(ctx, http.MethodDelete, snapshotRepositoryPath(name), nil, nil)
+}
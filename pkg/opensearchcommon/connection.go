@@ -0,0 +1,140 @@
+// Package opensearchcommon holds the parts shared by the resources managing the data plane of
+// the OpenSearch clusters through the REST API of the cluster.
+package opensearchcommon
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/opensearch/v1"
+	opensearchsdk "github.com/yandex-cloud/go-sdk/services/mdb/opensearch/v1"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/opensearchapi"
+	provider_config "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
+)
+
+const defaultMDBPageSize = 1000
+
+// ConnectionAttributes returns the attributes of the resource defining the cluster and the admin
+// credentials used to connect to its REST API.
+func ConnectionAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"cluster_id": schema.StringAttribute{
+			MarkdownDescription: "ID of the OpenSearch cluster.",
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"admin_password": schema.StringAttribute{
+			MarkdownDescription: "Password of the `admin` user of the cluster. It is stored in the state: the refresh and the deletion of the resource have no access to the write-only arguments, " +
+				"so `admin_password_wo` of `yandex_mdb_opensearch_cluster` can't be used here.",
+			Required:  true,
+			Sensitive: true,
+		},
+		"ca_certificate": schema.StringAttribute{
+			MarkdownDescription: "PEM encoded CA certificate the certificates of the cluster hosts are verified with, " +
+				"e.g. the content of `https://storage.yandexcloud.net/cloud-certs/CA.pem`. If not set, the system certificates are used.",
+			Optional: true,
+		},
+	}
+}
+
+// NewClient returns the client of the REST API of the cluster connecting to its OpenSearch hosts,
+// the alive ones first.
+func NewClient(ctx context.Context, providerConfig *provider_config.Config, cid, adminPassword, caCertificate string) (*opensearchapi.Client, error) {
+	if adminPassword == "" {
+		return nil, fmt.Errorf("the admin password of OpenSearch cluster %q is unknown, it is not known after import until `admin_password` is applied", cid)
+	}
+
+	hosts, err := listOpenSearchHosts(ctx, providerConfig, cid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list hosts of OpenSearch cluster %q: %w", cid, err)
+	}
+
+	httpClient, err := opensearchapi.NewHTTPClient(caCertificate, providerConfig.ProviderState.Insecure.ValueBool())
+	if err != nil {
+		return nil, err
+	}
+
+	return opensearchapi.NewClient(opensearchapi.Config{
+		Hosts:      hosts,
+		Password:   adminPassword,
+		HTTPClient: httpClient,
+	})
+}
+
+func listOpenSearchHosts(ctx context.Context, providerConfig *provider_config.Config, cid string) ([]string, error) {
+	var alive, other []string
+	pageToken := ""
+	for {
+		resp, err := opensearchsdk.NewClusterClient(providerConfig.SDKv2).ListHosts(ctx, &opensearch.ListClusterHostsRequest{
+			ClusterId: cid,
+			PageSize:  defaultMDBPageSize,
+			PageToken: pageToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, h := range resp.GetHosts() {
+			if h.GetType() != opensearch.Host_OPENSEARCH {
+				continue
+			}
+			if h.GetHealth() == opensearch.Host_ALIVE {
+				alive = append(alive, h.GetName())
+			} else {
+				other = append(other, h.GetName())
+			}
+		}
+
+		if resp.GetNextPageToken() == "" {
+			break
+		}
+		pageToken = resp.GetNextPageToken()
+	}
+
+	slices.Sort(alive)
+	slices.Sort(other)
+	return append(alive, other...), nil
+}
+
+// APIErrorDetail returns the detail of the diagnostic of the failed request to the REST API of the cluster.
+func APIErrorDetail(action string, err error) string {
+	detail := fmt.Sprintf("Error while requesting OpenSearch API to %s: %s", action, err.Error())
+	if opensearchapi.IsUnauthorized(err) {
+		detail += "\n\nIf the admin password of the cluster was changed, update `admin_password`."
+	}
+	return detail
+}
+
+// HandleReadError reports the failed read of the entity of the cluster. The missing entity is removed from the state,
+// the rejected password only produces a warning, so the new password can be applied.
+func HandleReadError(ctx context.Context, resp *resource.ReadResponse, entity string, err error) {
+	switch {
+	case opensearchapi.IsNotFound(err):
+		resp.State.RemoveResource(ctx)
+		resp.Diagnostics.AddWarning(
+			"Failed to Read resource",
+			fmt.Sprintf("OpenSearch %s not found, it is removed from the state", entity),
+		)
+	case opensearchapi.IsUnauthorized(err):
+		resp.Diagnostics.AddWarning("Failed to Read resource", APIErrorDetail("read "+entity, err))
+	default:
+		resp.Diagnostics.AddError("Failed to Read resource", APIErrorDetail("read "+entity, err))
+	}
+}
+
+// UnknownPasswordWarning is reported by the refresh of the imported resource, whose admin password
+// is not known until the next apply.
+func UnknownPasswordWarning(diags *diag.Diagnostics, entity string) {
+	diags.AddWarning(
+		"Failed to Read resource",
+		fmt.Sprintf("OpenSearch %s is not refreshed: the admin password is not known after import until `admin_password` is applied", entity),
+	)
+}
@@ -0,0 +1,31 @@
+package opensearchcommon
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// FlattenSetStringOrNull returns Null for an empty list, the API omits the empty lists
+// the same way as the omitted attributes.
+func FlattenSetStringOrNull(ctx context.Context, ss []string, diags *diag.Diagnostics) types.Set {
+	if len(ss) == 0 {
+		return types.SetNull(types.StringType)
+	}
+
+	set, d := types.SetValueFrom(ctx, types.StringType, ss)
+	diags.Append(d...)
+	return set
+}
+
+// ExpandSetString returns the elements of the set, nil for the null set.
+func ExpandSetString(ctx context.Context, set types.Set, diags *diag.Diagnostics) []string {
+	if set.IsNull() || set.IsUnknown() {
+		return nil
+	}
+
+	var ss []string
+	diags.Append(set.ElementsAs(ctx, &ss, false)...)
+	return ss
+}
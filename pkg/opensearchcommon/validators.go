@@ -0,0 +1,40 @@
+package opensearchcommon
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/opensearchapi"
+)
+
+var _ validator.String = jsonObjectValidator{}
+
+type jsonObjectValidator struct{}
+
+// NewJSONObjectValidator validates that the value is a JSON object, e.g. the body of an index template.
+func NewJSONObjectValidator() validator.String {
+	return jsonObjectValidator{}
+}
+
+func (v jsonObjectValidator) Description(_ context.Context) string {
+	return "value must be a JSON object"
+}
+
+func (v jsonObjectValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v jsonObjectValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	doc, err := opensearchapi.ParseDocument([]byte(req.ConfigValue.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid JSON document", "Error while parsing the JSON document: "+err.Error())
+		return
+	}
+	if _, ok := doc.(map[string]any); !ok {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid JSON document", v.Description(ctx))
+	}
+}
@@ -0,0 +1,54 @@
+// Package serialport contains helpers to process the serial port output of Compute instances.
+package serialport
+
+import (
+	"regexp"
+	"strings"
+)
+
+const (
+	MinPort = 1
+	MaxPort = 4
+)
+
+// ansiEscapeRe matches terminal control sequences that boot loaders and cloud-init write to the console.
+var ansiEscapeRe = regexp.MustCompile(`\x1b\[[0-9;?]*[A-Za-z]|\x1b[()][A-Za-z0-9]`)
+
+// Clean removes terminal control sequences and carriage returns from the output.
+func Clean(output string) string {
+	output = ansiEscapeRe.ReplaceAllString(output, "")
+	output = strings.ReplaceAll(output, "\r\n", "\n")
+	return strings.ReplaceAll(output, "\r", "")
+}
+
+// Tail returns the last n lines of the output. The whole output is returned if n is not positive.
+func Tail(output string, n int) string {
+	if n <= 0 {
+		return output
+	}
+
+	trimmed := strings.TrimSuffix(output, "\n")
+	idx := len(trimmed)
+	for i := 0; i < n; i++ {
+		idx = strings.LastIndexByte(trimmed[:idx], '\n')
+		if idx < 0 {
+			return output
+		}
+	}
+	return output[idx+1:]
+}
+
+// Extract returns all matches of re in the output. If re has capture groups, the first group
+// of each match is returned instead of the whole match.
+func Extract(output string, re *regexp.Regexp) []string {
+	matches := re.FindAllStringSubmatch(output, -1)
+	result := make([]string, 0, len(matches))
+	for _, match := range matches {
+		if len(match) > 1 {
+			result = append(result, match[1])
+		} else {
+			result = append(result, match[0])
+		}
+	}
+	return result
+}
@@ -0,0 +1,40 @@
+package serialport
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClean(t *testing.T) {
+	assert.Equal(t,
+		"[  OK  ] Started Initial cloud-init job.\nlogin: \n",
+		Clean("\x1b[0;32m[  OK  ]\x1b[0m Started Initial cloud-init job.\r\n\x1b(Blogin: \r\n"),
+	)
+}
+
+func TestTail(t *testing.T) {
+	const output = "one\ntwo\nthree\n"
+
+	assert.Equal(t, output, Tail(output, 0))
+	assert.Equal(t, "three\n", Tail(output, 1))
+	assert.Equal(t, "two\nthree\n", Tail(output, 2))
+	assert.Equal(t, output, Tail(output, 3))
+	assert.Equal(t, output, Tail(output, 10))
+	assert.Equal(t, "three", Tail("one\ntwo\nthree", 1))
+	assert.Equal(t, "", Tail("", 1))
+}
+
+func TestExtract(t *testing.T) {
+	const output = "Cloud-init v. 23.1 running 'modules:final'\n" +
+		"ci-info: no authorized SSH keys fingerprints found for user ubuntu.\n" +
+		"Cloud-init v. 23.1 finished at Mon, 19 Oct 2026 08:00:00 +0000. Up 42.10 seconds\n"
+
+	assert.Equal(t,
+		[]string{"Cloud-init v. 23.1 running", "Cloud-init v. 23.1 finished"},
+		Extract(output, regexp.MustCompile(`Cloud-init v\. \S+ (?:running|finished)`)),
+	)
+	assert.Equal(t, []string{"42.10"}, Extract(output, regexp.MustCompile(`Up ([0-9.]+) seconds`)))
+	assert.Empty(t, Extract(output, regexp.MustCompile(`kernel panic`)))
+}
@@ -0,0 +1,295 @@
+// Package sgrules analyzes security group rules for duplicates, shadowed rules and invalid targets.
+//
+// Rules are compared by their body only: description, labels and IDs are not taken into account.
+package sgrules
+
+import (
+	"fmt"
+	"net/netip"
+	"sort"
+	"strings"
+)
+
+const (
+	// ProtocolAny matches traffic of any protocol.
+	ProtocolAny = "ANY"
+	// AnyPort marks an unset bound of a port range.
+	AnyPort = -1
+)
+
+// PredefinedTargets lists the special-purpose targets supported by the cloud.
+var PredefinedTargets = []string{"self_security_group", "loadbalancer_healthchecks"}
+
+// Rule is the body of a security group rule.
+type Rule struct {
+	// ID of the rule in the cloud, empty for rules that are not created yet.
+	ID string
+	// Direction is either INGRESS or EGRESS, case-insensitive.
+	Direction string
+	// Protocol name, empty or ANY for any protocol.
+	Protocol string
+	// FromPort and ToPort are AnyPort for any port.
+	FromPort int64
+	ToPort   int64
+
+	V4CidrBlocks     []string
+	V6CidrBlocks     []string
+	SecurityGroupID  string
+	PredefinedTarget string
+}
+
+// HasTarget reports whether any target of the rule is set.
+func (r Rule) HasTarget() bool {
+	return len(r.V4CidrBlocks) > 0 || len(r.V6CidrBlocks) > 0 || r.SecurityGroupID != "" || r.PredefinedTarget != ""
+}
+
+// String describes the rule for diagnostics.
+func (r Rule) String() string {
+	var sb strings.Builder
+	sb.WriteString(strings.ToLower(r.Direction))
+	sb.WriteString(" ")
+	sb.WriteString(r.protocol())
+
+	from, to := r.ports()
+	switch {
+	case from == 0 && to == 65535:
+	case from == to:
+		fmt.Fprintf(&sb, " port %d", from)
+	default:
+		fmt.Fprintf(&sb, " ports %d-%d", from, to)
+	}
+
+	switch {
+	case r.SecurityGroupID != "":
+		fmt.Fprintf(&sb, " security group %s", r.SecurityGroupID)
+	case r.PredefinedTarget != "":
+		fmt.Fprintf(&sb, " %s", r.PredefinedTarget)
+	default:
+		fmt.Fprintf(&sb, " %s", strings.Join(append(append([]string{}, r.V4CidrBlocks...), r.V6CidrBlocks...), ", "))
+	}
+
+	if r.ID != "" {
+		fmt.Fprintf(&sb, " (%s)", r.ID)
+	}
+	return sb.String()
+}
+
+// Key returns a normalized representation of the rule body. Rules with equal keys are duplicates.
+func (r Rule) Key() string {
+	from, to := r.ports()
+	return strings.Join([]string{
+		strings.ToUpper(r.Direction),
+		r.protocol(),
+		fmt.Sprintf("%d-%d", from, to),
+		strings.Join(normalizeCidrs(r.V4CidrBlocks), ","),
+		strings.Join(normalizeCidrs(r.V6CidrBlocks), ","),
+		r.SecurityGroupID,
+		r.PredefinedTarget,
+	}, "|")
+}
+
+func (r Rule) protocol() string {
+	if r.Protocol == "" {
+		return ProtocolAny
+	}
+	return strings.ToUpper(r.Protocol)
+}
+
+// ports returns the port range of the rule, any port is 0-65535.
+func (r Rule) ports() (int64, int64) {
+	if r.FromPort == AnyPort && r.ToPort == AnyPort {
+		return 0, 65535
+	}
+	if r.FromPort == AnyPort {
+		return r.ToPort, r.ToPort
+	}
+	if r.ToPort == AnyPort {
+		return r.FromPort, r.FromPort
+	}
+	return r.FromPort, r.ToPort
+}
+
+// Validate checks that the rule has a consistent set of targets and ports.
+// A rule without targets passes: its targets may not be known yet.
+func Validate(r Rule) error {
+	targets := 0
+	if len(r.V4CidrBlocks) > 0 || len(r.V6CidrBlocks) > 0 {
+		targets++
+	}
+	if r.SecurityGroupID != "" {
+		targets++
+	}
+	if r.PredefinedTarget != "" {
+		targets++
+	}
+	if targets > 1 {
+		return fmt.Errorf("rule %s: only one of v4_cidr_blocks/v6_cidr_blocks, security_group_id and predefined_target can be specified", r)
+	}
+
+	if r.PredefinedTarget != "" && !isPredefinedTarget(r.PredefinedTarget) {
+		return fmt.Errorf("rule %s: unknown predefined_target %q, expected one of %s", r, r.PredefinedTarget, strings.Join(PredefinedTargets, ", "))
+	}
+
+	for _, c := range append(append([]string{}, r.V4CidrBlocks...), r.V6CidrBlocks...) {
+		if _, err := netip.ParsePrefix(c); err != nil {
+			return fmt.Errorf("rule %s: invalid CIDR block %q", r, c)
+		}
+	}
+
+	if p := r.protocol(); (p == "ICMP" || p == "IPV6_ICMP") && (r.FromPort != AnyPort || r.ToPort != AnyPort) {
+		return fmt.Errorf("rule %s: ports can't be specified for protocol %s", r, p)
+	}
+
+	return nil
+}
+
+func isPredefinedTarget(t string) bool {
+	for _, known := range PredefinedTargets {
+		if t == known {
+			return true
+		}
+	}
+	return false
+}
+
+// Covers reports whether every packet matched by rule b is matched by rule a.
+func Covers(a, b Rule) bool {
+	if !strings.EqualFold(a.Direction, b.Direction) {
+		return false
+	}
+	if a.protocol() != ProtocolAny && a.protocol() != b.protocol() {
+		return false
+	}
+
+	aFrom, aTo := a.ports()
+	bFrom, bTo := b.ports()
+	if aFrom > bFrom || aTo < bTo {
+		return false
+	}
+
+	switch {
+	case b.SecurityGroupID != "":
+		return a.SecurityGroupID == b.SecurityGroupID
+	case b.PredefinedTarget != "":
+		return a.PredefinedTarget == b.PredefinedTarget
+	case len(b.V4CidrBlocks) > 0 || len(b.V6CidrBlocks) > 0:
+		return cidrsCover(append(append([]string{}, a.V4CidrBlocks...), a.V6CidrBlocks...),
+			append(append([]string{}, b.V4CidrBlocks...), b.V6CidrBlocks...))
+	}
+	return false
+}
+
+// cidrsCover reports whether every block of inner is inside one of the blocks of outer.
+func cidrsCover(outer, inner []string) bool {
+	if len(outer) == 0 {
+		return false
+	}
+	for _, c := range inner {
+		block, err := netip.ParsePrefix(c)
+		if err != nil {
+			return false
+		}
+		covered := false
+		for _, o := range outer {
+			p, err := netip.ParsePrefix(o)
+			if err != nil {
+				continue
+			}
+			if p.Bits() <= block.Bits() && p.Contains(block.Masked().Addr()) {
+				covered = true
+				break
+			}
+		}
+		if !covered {
+			return false
+		}
+	}
+	return true
+}
+
+func normalizeCidrs(cidrs []string) []string {
+	result := make([]string, len(cidrs))
+	for i, c := range cidrs {
+		if p, err := netip.ParsePrefix(c); err == nil {
+			c = p.Masked().String()
+		}
+		result[i] = c
+	}
+	sort.Strings(result)
+	return result
+}
+
+// Kind is the kind of a conflict between two rules.
+type Kind int
+
+const (
+	// Duplicate rules have the same body.
+	Duplicate Kind = iota
+	// Shadowed rule matches only the traffic that is already matched by another rule.
+	Shadowed
+)
+
+// Conflict describes a rule that has no effect because of another rule.
+type Conflict struct {
+	Kind Kind
+	Rule Rule
+	By   Rule
+}
+
+func (c Conflict) String() string {
+	if c.Kind == Duplicate {
+		return fmt.Sprintf("rule %s duplicates rule %s", c.Rule, c.By)
+	}
+	return fmt.Sprintf("rule %s is shadowed by rule %s", c.Rule, c.By)
+}
+
+// Analyze returns conflicts between the rules. Rules without targets are skipped.
+func Analyze(rules []Rule) []Conflict {
+	var conflicts []Conflict
+	for i := range rules {
+		if !rules[i].HasTarget() {
+			continue
+		}
+		for j := i + 1; j < len(rules); j++ {
+			if !rules[j].HasTarget() {
+				continue
+			}
+			if c, ok := conflict(rules[j], rules[i]); ok {
+				conflicts = append(conflicts, c)
+			}
+		}
+	}
+	return conflicts
+}
+
+// AnalyzeAgainst returns conflicts of the rule with the existing rules. Existing rules with the same ID
+// as the rule are skipped.
+func AnalyzeAgainst(rule Rule, existing []Rule) []Conflict {
+	if !rule.HasTarget() {
+		return nil
+	}
+
+	var conflicts []Conflict
+	for _, e := range existing {
+		if rule.ID != "" && e.ID == rule.ID {
+			continue
+		}
+		if c, ok := conflict(rule, e); ok {
+			conflicts = append(conflicts, c)
+		}
+	}
+	return conflicts
+}
+
+// conflict checks rule r against rule o, reporting r as the rule without effect if possible.
+func conflict(r, o Rule) (Conflict, bool) {
+	switch {
+	case r.Key() == o.Key():
+		return Conflict{Kind: Duplicate, Rule: r, By: o}, true
+	case Covers(o, r):
+		return Conflict{Kind: Shadowed, Rule: r, By: o}, true
+	case Covers(r, o):
+		return Conflict{Kind: Shadowed, Rule: o, By: r}, true
+	}
+	return Conflict{}, false
+}
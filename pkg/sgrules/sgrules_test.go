@@ -0,0 +1,171 @@
+package sgrules
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func tcp(port int64, cidrs ...string) Rule {
+	return Rule{Direction: "INGRESS", Protocol: "TCP", FromPort: port, ToPort: port, V4CidrBlocks: cidrs}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name  string
+		rule  Rule
+		error string
+	}{
+		{
+			name: "cidr target",
+			rule: tcp(443, "10.0.0.0/8"),
+		},
+		{
+			name: "no target",
+			rule: Rule{Direction: "INGRESS", Protocol: "ANY", FromPort: AnyPort, ToPort: AnyPort},
+		},
+		{
+			name:  "cidr and security group",
+			rule:  Rule{Direction: "INGRESS", Protocol: "TCP", FromPort: AnyPort, ToPort: AnyPort, V4CidrBlocks: []string{"10.0.0.0/8"}, SecurityGroupID: "sg"},
+			error: "only one of",
+		},
+		{
+			name:  "security group and predefined target",
+			rule:  Rule{Direction: "EGRESS", FromPort: AnyPort, ToPort: AnyPort, SecurityGroupID: "sg", PredefinedTarget: "self_security_group"},
+			error: "only one of",
+		},
+		{
+			name:  "unknown predefined target",
+			rule:  Rule{Direction: "INGRESS", FromPort: AnyPort, ToPort: AnyPort, PredefinedTarget: "self"},
+			error: `unknown predefined_target "self"`,
+		},
+		{
+			name:  "invalid cidr",
+			rule:  tcp(22, "10.0.0.0/33"),
+			error: "invalid CIDR block",
+		},
+		{
+			name:  "icmp with port",
+			rule:  Rule{Direction: "INGRESS", Protocol: "icmp", FromPort: 8, ToPort: 8, V4CidrBlocks: []string{"0.0.0.0/0"}},
+			error: "ports can't be specified for protocol ICMP",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Validate(tt.rule)
+			if tt.error == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.error)
+		})
+	}
+}
+
+func TestCovers(t *testing.T) {
+	tests := []struct {
+		name     string
+		a, b     Rule
+		expected bool
+	}{
+		{
+			name:     "wider cidr",
+			a:        tcp(443, "10.0.0.0/8"),
+			b:        tcp(443, "10.1.0.0/16"),
+			expected: true,
+		},
+		{
+			name:     "narrower cidr",
+			a:        tcp(443, "10.1.0.0/16"),
+			b:        tcp(443, "10.0.0.0/8"),
+			expected: false,
+		},
+		{
+			name:     "any protocol and port",
+			a:        Rule{Direction: "ingress", Protocol: "ANY", FromPort: AnyPort, ToPort: AnyPort, V4CidrBlocks: []string{"0.0.0.0/0"}},
+			b:        tcp(22, "192.168.0.0/24"),
+			expected: true,
+		},
+		{
+			name:     "port range",
+			a:        Rule{Direction: "INGRESS", Protocol: "TCP", FromPort: 8000, ToPort: 9000, V4CidrBlocks: []string{"10.0.0.0/8"}},
+			b:        tcp(8080, "10.0.0.0/8"),
+			expected: true,
+		},
+		{
+			name:     "other protocol",
+			a:        Rule{Direction: "INGRESS", Protocol: "UDP", FromPort: AnyPort, ToPort: AnyPort, V4CidrBlocks: []string{"0.0.0.0/0"}},
+			b:        tcp(53, "10.0.0.0/8"),
+			expected: false,
+		},
+		{
+			name:     "other direction",
+			a:        Rule{Direction: "EGRESS", Protocol: "TCP", FromPort: 443, ToPort: 443, V4CidrBlocks: []string{"0.0.0.0/0"}},
+			b:        tcp(443, "10.0.0.0/8"),
+			expected: false,
+		},
+		{
+			name:     "same security group",
+			a:        Rule{Direction: "INGRESS", Protocol: "ANY", FromPort: AnyPort, ToPort: AnyPort, SecurityGroupID: "sg1"},
+			b:        Rule{Direction: "INGRESS", Protocol: "TCP", FromPort: 22, ToPort: 22, SecurityGroupID: "sg1"},
+			expected: true,
+		},
+		{
+			name:     "cidr does not cover security group",
+			a:        Rule{Direction: "INGRESS", Protocol: "ANY", FromPort: AnyPort, ToPort: AnyPort, V4CidrBlocks: []string{"0.0.0.0/0"}},
+			b:        Rule{Direction: "INGRESS", Protocol: "TCP", FromPort: 22, ToPort: 22, SecurityGroupID: "sg1"},
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, Covers(tt.a, tt.b))
+		})
+	}
+}
+
+func TestAnalyze(t *testing.T) {
+	rules := []Rule{
+		tcp(443, "10.0.0.0/8", "192.168.0.0/16"),
+		tcp(22, "10.0.0.0/8"),
+		{Direction: "INGRESS", Protocol: "tcp", FromPort: 443, ToPort: 443, V4CidrBlocks: []string{"192.168.0.0/16", "10.0.0.0/8"}},
+		tcp(443, "10.10.0.0/16"),
+		{Direction: "INGRESS", Protocol: "TCP", FromPort: 22, ToPort: 22},
+	}
+
+	conflicts := Analyze(rules)
+	require.Len(t, conflicts, 3)
+
+	assert.Equal(t, Duplicate, conflicts[0].Kind)
+	assert.Equal(t, rules[2], conflicts[0].Rule)
+	assert.Equal(t, rules[0], conflicts[0].By)
+
+	assert.Equal(t, Shadowed, conflicts[1].Kind)
+	assert.Equal(t, rules[3], conflicts[1].Rule)
+	assert.Equal(t, rules[0], conflicts[1].By)
+
+	assert.Equal(t, Shadowed, conflicts[2].Kind)
+	assert.Equal(t, rules[3], conflicts[2].Rule)
+	assert.Equal(t, rules[2], conflicts[2].By)
+}
+
+func TestAnalyzeAgainst(t *testing.T) {
+	rule := tcp(443, "10.0.0.0/8")
+	rule.ID = "rule1"
+
+	existing := []Rule{
+		rule,
+		{ID: "rule2", Direction: "INGRESS", Protocol: "TCP", FromPort: 443, ToPort: 443, V4CidrBlocks: []string{"10.0.0.0/8"}},
+		{ID: "rule3", Direction: "INGRESS", Protocol: "TCP", FromPort: 443, ToPort: 443, V4CidrBlocks: []string{"10.1.0.0/16"}},
+		{ID: "rule4", Direction: "INGRESS", Protocol: "TCP", FromPort: 80, ToPort: 80, V4CidrBlocks: []string{"10.0.0.0/8"}},
+	}
+
+	conflicts := AnalyzeAgainst(rule, existing)
+	require.Len(t, conflicts, 2)
+	assert.Equal(t, "rule ingress TCP port 443 10.0.0.0/8 (rule1) duplicates rule ingress TCP port 443 10.0.0.0/8 (rule2)", conflicts[0].String())
+	assert.Equal(t, "rule ingress TCP port 443 10.1.0.0/16 (rule3) is shadowed by rule ingress TCP port 443 10.0.0.0/8 (rule1)", conflicts[1].String())
+}
@@ -161,3 +161,317 @@ func normalizeJsonString(jsonString interface{}) (string, error) {
 	bytes, _ := json.Marshal(j)
 	return string(bytes[:]), nil
 }
+
+func (c *Client) GetBucketCORS(ctx context.Context, bucket string) ([]*s3.CORSRule, error) {
+	output, err := RetryLongTermOperations[*s3.GetBucketCorsOutput](
+		ctx,
+		func() (*s3.GetBucketCorsOutput, error) {
+			return c.s3.GetBucketCorsWithContext(ctx, &s3.GetBucketCorsInput{
+				Bucket: aws.String(bucket),
+			})
+		},
+	)
+	if err != nil {
+		if IsErr(err, NoSuchCORSConfiguration) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error getting Storage Bucket (%s) CORS configuration: %w", bucket, err)
+	}
+	tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Storage Bucket: %s, read CORS: %v", bucket, output))
+
+	return output.CORSRules, nil
+}
+
+func (c *Client) UpdateBucketCORS(ctx context.Context, bucket string, rules []*s3.CORSRule) error {
+	if len(rules) == 0 {
+		tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Storage Bucket: %s, delete CORS", bucket))
+
+		_, err := RetryLongTermOperations(ctx, func() (any, error) {
+			return c.s3.DeleteBucketCorsWithContext(ctx, &s3.DeleteBucketCorsInput{
+				Bucket: aws.String(bucket),
+			})
+		})
+		if err != nil {
+			return fmt.Errorf("error deleting Storage Bucket (%s) CORS configuration: %w", bucket, err)
+		}
+		return nil
+	}
+
+	input := &s3.PutBucketCorsInput{
+		Bucket: aws.String(bucket),
+		CORSConfiguration: &s3.CORSConfiguration{
+			CORSRules: rules,
+		},
+	}
+	tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Storage Bucket: %s, put CORS: %#v", bucket, input))
+
+	_, err := RetryLongTermOperations(ctx, func() (any, error) {
+		return c.s3.PutBucketCorsWithContext(ctx, input)
+	})
+	if err != nil {
+		return fmt.Errorf("error putting Storage Bucket (%s) CORS configuration: %w", bucket, err)
+	}
+	return nil
+}
+
+func (c *Client) GetBucketWebsite(ctx context.Context, bucket string) (*s3.WebsiteConfiguration, error) {
+	output, err := RetryLongTermOperations[*s3.GetBucketWebsiteOutput](
+		ctx,
+		func() (*s3.GetBucketWebsiteOutput, error) {
+			return c.s3.GetBucketWebsiteWithContext(ctx, &s3.GetBucketWebsiteInput{
+				Bucket: aws.String(bucket),
+			})
+		},
+	)
+	if err != nil {
+		if IsErr(err, NoSuchWebsiteConfiguration) || IsErr(err, NotImplemented) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error getting Storage Bucket (%s) website configuration: %w", bucket, err)
+	}
+	tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Storage Bucket: %s, read website: %v", bucket, output))
+
+	return &s3.WebsiteConfiguration{
+		ErrorDocument:         output.ErrorDocument,
+		IndexDocument:         output.IndexDocument,
+		RedirectAllRequestsTo: output.RedirectAllRequestsTo,
+		RoutingRules:          output.RoutingRules,
+	}, nil
+}
+
+func (c *Client) UpdateBucketWebsite(ctx context.Context, bucket string, website *s3.WebsiteConfiguration) error {
+	if website == nil {
+		tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Storage Bucket: %s, delete website", bucket))
+
+		_, err := RetryLongTermOperations(ctx, func() (any, error) {
+			return c.s3.DeleteBucketWebsiteWithContext(ctx, &s3.DeleteBucketWebsiteInput{
+				Bucket: aws.String(bucket),
+			})
+		})
+		if err != nil {
+			return fmt.Errorf("error deleting Storage Bucket (%s) website configuration: %w", bucket, err)
+		}
+		return nil
+	}
+
+	input := &s3.PutBucketWebsiteInput{
+		Bucket:               aws.String(bucket),
+		WebsiteConfiguration: website,
+	}
+	tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Storage Bucket: %s, put website: %#v", bucket, input))
+
+	_, err := RetryLongTermOperations(ctx, func() (any, error) {
+		return c.s3.PutBucketWebsiteWithContext(ctx, input)
+	})
+	if err != nil {
+		return fmt.Errorf("error putting Storage Bucket (%s) website configuration: %w", bucket, err)
+	}
+	return nil
+}
+
+func (c *Client) GetBucketVersioning(ctx context.Context, bucket string) (string, error) {
+	output, err := RetryLongTermOperations[*s3.GetBucketVersioningOutput](
+		ctx,
+		func() (*s3.GetBucketVersioningOutput, error) {
+			return c.s3.GetBucketVersioningWithContext(ctx, &s3.GetBucketVersioningInput{
+				Bucket: aws.String(bucket),
+			})
+		},
+	)
+	if err != nil {
+		return "", fmt.Errorf("error getting Storage Bucket (%s) versioning: %w", bucket, err)
+	}
+	tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Storage Bucket: %s, read versioning: %v", bucket, output))
+
+	return aws.StringValue(output.Status), nil
+}
+
+func (c *Client) UpdateBucketVersioning(ctx context.Context, bucket, status string) error {
+	input := &s3.PutBucketVersioningInput{
+		Bucket:                  aws.String(bucket),
+		VersioningConfiguration: &s3.VersioningConfiguration{Status: aws.String(status)},
+	}
+	tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Storage Bucket: %s, put versioning: %#v", bucket, input))
+
+	_, err := RetryLongTermOperations(ctx, func() (any, error) {
+		return c.s3.PutBucketVersioningWithContext(ctx, input)
+	})
+	if err != nil {
+		return fmt.Errorf("error putting Storage Bucket (%s) versioning: %w", bucket, err)
+	}
+	return nil
+}
+
+func (c *Client) GetBucketLifecycle(ctx context.Context, bucket string) ([]*s3.LifecycleRule, error) {
+	output, err := RetryLongTermOperations[*s3.GetBucketLifecycleConfigurationOutput](
+		ctx,
+		func() (*s3.GetBucketLifecycleConfigurationOutput, error) {
+			return c.s3.GetBucketLifecycleConfigurationWithContext(ctx, &s3.GetBucketLifecycleConfigurationInput{
+				Bucket: aws.String(bucket),
+			})
+		},
+	)
+	if err != nil {
+		if IsErr(err, NoSuchLifecycleConfiguration) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error getting Storage Bucket (%s) lifecycle configuration: %w", bucket, err)
+	}
+	tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Storage Bucket: %s, read lifecycle: %v", bucket, output))
+
+	return output.Rules, nil
+}
+
+func (c *Client) UpdateBucketLifecycle(ctx context.Context, bucket string, rules []*s3.LifecycleRule) error {
+	if len(rules) == 0 {
+		tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Storage Bucket: %s, delete lifecycle", bucket))
+
+		_, err := RetryLongTermOperations(ctx, func() (any, error) {
+			return c.s3.DeleteBucketLifecycleWithContext(ctx, &s3.DeleteBucketLifecycleInput{
+				Bucket: aws.String(bucket),
+			})
+		})
+		if err != nil {
+			return fmt.Errorf("error deleting Storage Bucket (%s) lifecycle configuration: %w", bucket, err)
+		}
+		return nil
+	}
+
+	input := &s3.PutBucketLifecycleConfigurationInput{
+		Bucket: aws.String(bucket),
+		LifecycleConfiguration: &s3.BucketLifecycleConfiguration{
+			Rules: rules,
+		},
+	}
+	tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Storage Bucket: %s, put lifecycle: %#v", bucket, input))
+
+	_, err := RetryLongTermOperations(ctx, func() (any, error) {
+		return c.s3.PutBucketLifecycleConfigurationWithContext(ctx, input)
+	})
+	if err != nil {
+		return fmt.Errorf("error putting Storage Bucket (%s) lifecycle configuration: %w", bucket, err)
+	}
+	return nil
+}
+
+func (c *Client) GetBucketEncryption(ctx context.Context, bucket string) (*s3.ServerSideEncryptionConfiguration, error) {
+	output, err := RetryLongTermOperations[*s3.GetBucketEncryptionOutput](
+		ctx,
+		func() (*s3.GetBucketEncryptionOutput, error) {
+			return c.s3.GetBucketEncryptionWithContext(ctx, &s3.GetBucketEncryptionInput{
+				Bucket: aws.String(bucket),
+			})
+		},
+	)
+	if err != nil {
+		if IsErr(err, ServerSideEncryptionConfigurationNotFoundError) || IsErr(err, NoSuchEncryptionConfiguration) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error getting Storage Bucket (%s) encryption: %w", bucket, err)
+	}
+	tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Storage Bucket: %s, read encryption: %v", bucket, output))
+
+	return output.ServerSideEncryptionConfiguration, nil
+}
+
+func (c *Client) UpdateBucketEncryption(ctx context.Context, bucket string, configuration *s3.ServerSideEncryptionConfiguration) error {
+	if configuration == nil || len(configuration.Rules) == 0 {
+		tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Storage Bucket: %s, delete encryption", bucket))
+
+		_, err := RetryLongTermOperations(ctx, func() (any, error) {
+			return c.s3.DeleteBucketEncryptionWithContext(ctx, &s3.DeleteBucketEncryptionInput{
+				Bucket: aws.String(bucket),
+			})
+		})
+		if err != nil {
+			return fmt.Errorf("error deleting Storage Bucket (%s) encryption: %w", bucket, err)
+		}
+		return nil
+	}
+
+	input := &s3.PutBucketEncryptionInput{
+		Bucket:                            aws.String(bucket),
+		ServerSideEncryptionConfiguration: configuration,
+	}
+	tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Storage Bucket: %s, put encryption: %#v", bucket, input))
+
+	_, err := RetryLongTermOperations(ctx, func() (any, error) {
+		return c.s3.PutBucketEncryptionWithContext(ctx, input)
+	})
+	if err != nil {
+		return fmt.Errorf("error putting Storage Bucket (%s) encryption: %w", bucket, err)
+	}
+	return nil
+}
+
+func (c *Client) GetBucketLogging(ctx context.Context, bucket string) (*s3.LoggingEnabled, error) {
+	output, err := RetryLongTermOperations[*s3.GetBucketLoggingOutput](
+		ctx,
+		func() (*s3.GetBucketLoggingOutput, error) {
+			return c.s3.GetBucketLoggingWithContext(ctx, &s3.GetBucketLoggingInput{
+				Bucket: aws.String(bucket),
+			})
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error getting Storage Bucket (%s) logging: %w", bucket, err)
+	}
+	tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Storage Bucket: %s, read logging: %v", bucket, output))
+
+	return output.LoggingEnabled, nil
+}
+
+// UpdateBucketLogging enables logging of the bucket to the target or disables it, when logging is nil.
+func (c *Client) UpdateBucketLogging(ctx context.Context, bucket string, logging *s3.LoggingEnabled) error {
+	input := &s3.PutBucketLoggingInput{
+		Bucket: aws.String(bucket),
+		BucketLoggingStatus: &s3.BucketLoggingStatus{
+			LoggingEnabled: logging,
+		},
+	}
+	tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Storage Bucket: %s, put logging: %#v", bucket, input))
+
+	_, err := RetryLongTermOperations(ctx, func() (any, error) {
+		return c.s3.PutBucketLoggingWithContext(ctx, input)
+	})
+	if err != nil {
+		return fmt.Errorf("error putting Storage Bucket (%s) logging: %w", bucket, err)
+	}
+	return nil
+}
+
+func (c *Client) GetBucketObjectLock(ctx context.Context, bucket string) (*s3.ObjectLockConfiguration, error) {
+	output, err := RetryLongTermOperations[*s3.GetObjectLockConfigurationOutput](
+		ctx,
+		func() (*s3.GetObjectLockConfigurationOutput, error) {
+			return c.s3.GetObjectLockConfigurationWithContext(ctx, &s3.GetObjectLockConfigurationInput{
+				Bucket: aws.String(bucket),
+			})
+		},
+	)
+	if err != nil {
+		if IsErr(err, ObjectLockConfigurationNotFoundError) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error getting Storage Bucket (%s) object lock configuration: %w", bucket, err)
+	}
+	tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Storage Bucket: %s, read object lock configuration: %v", bucket, output))
+
+	return output.ObjectLockConfiguration, nil
+}
+
+func (c *Client) UpdateBucketObjectLock(ctx context.Context, bucket string, configuration *s3.ObjectLockConfiguration) error {
+	input := &s3.PutObjectLockConfigurationInput{
+		Bucket:                  aws.String(bucket),
+		ObjectLockConfiguration: configuration,
+	}
+	tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Storage Bucket: %s, put object lock configuration: %#v", bucket, input))
+
+	_, err := RetryLongTermOperations(ctx, func() (any, error) {
+		return c.s3.PutObjectLockConfigurationWithContext(ctx, input)
+	})
+	if err != nil {
+		return fmt.Errorf("error putting Storage Bucket (%s) object lock configuration: %w", bucket, err)
+	}
+	return nil
+}
@@ -19,6 +19,10 @@ const (
 
 type Client struct {
 	s3 *s3.S3
+
+	// staticCredentials is set when the client signs requests with static access keys
+	// and thus is able to presign URLs.
+	staticCredentials bool
 }
 
 func NewClient(ctx context.Context, accessKey, secretKey, iamToken, url string) (*Client, error) {
@@ -52,7 +56,8 @@ func NewClient(ctx context.Context, accessKey, secretKey, iamToken, url string)
 	}
 
 	return &Client{
-		s3: s3.New(ssn, config),
+		s3:                s3.New(ssn, config),
+		staticCredentials: accessKey != "" && secretKey != "",
 	}, nil
 }
 
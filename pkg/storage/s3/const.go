@@ -26,3 +26,33 @@ const (
 	PermissionRead        = s3.PermissionRead
 	PermissionWrite       = s3.PermissionWrite
 )
+
+const (
+	VersioningStatusEnabled   = s3.BucketVersioningStatusEnabled
+	VersioningStatusSuspended = s3.BucketVersioningStatusSuspended
+)
+
+const ServerSideEncryptionAwsKms = s3.ServerSideEncryptionAwsKms
+
+const ObjectLockEnabled = s3.ObjectLockEnabledEnabled
+
+var ObjectLockRetentionModeValues = s3.ObjectLockRetentionMode_Values()
+
+const (
+	ExpirationStatusEnabled  = s3.ExpirationStatusEnabled
+	ExpirationStatusDisabled = s3.ExpirationStatusDisabled
+)
+
+const (
+	StorageClassStandardIA         = s3.StorageClassStandardIa
+	StorageClassCold               = "COLD"
+	StorageClassIce                = "ICE"
+	StorageClassIntelligentTiering = s3.StorageClassIntelligentTiering
+)
+
+var StorageClassValues = []string{
+	StorageClassStandardIA,
+	StorageClassCold,
+	StorageClassIce,
+	StorageClassIntelligentTiering,
+}
@@ -0,0 +1,98 @@
+package s3
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	PresignMethodGet = http.MethodGet
+	PresignMethodPut = http.MethodPut
+
+	// PresignMaxExpiration is the longest validity period of a presigned URL allowed by Signature Version 4.
+	PresignMaxExpiration = 7 * 24 * time.Hour
+)
+
+var PresignMethodValues = []string{PresignMethodGet, PresignMethodPut}
+
+var ErrPresignRequiresStaticKeys = errors.New("presigned URLs can only be generated with static access keys")
+
+// PresignedURL is a URL that grants temporary access to an object without further authentication.
+type PresignedURL struct {
+	URL string
+	// SignedHeaders are the headers that were signed along with the URL
+	// and must be sent unchanged by the client using it.
+	SignedHeaders map[string]string
+	ExpiresAt     time.Time
+}
+
+// PresignObjectURL generates a presigned URL for a GET or PUT request to the object.
+// For PUT requests a non-empty contentType is signed, so the upload has to be made with the same Content-Type header.
+func (c *Client) PresignObjectURL(
+	ctx context.Context,
+	method, bucket, key, contentType string,
+	expires time.Duration,
+) (*PresignedURL, error) {
+	if !c.staticCredentials {
+		return nil, ErrPresignRequiresStaticKeys
+	}
+	if expires <= 0 || expires > PresignMaxExpiration {
+		return nil, fmt.Errorf("presigned URL expiration must be between 1 second and %s, got %s", PresignMaxExpiration, expires)
+	}
+
+	var req *request.Request
+	switch method {
+	case PresignMethodGet:
+		if contentType != "" {
+			return nil, fmt.Errorf("content type can only be constrained for %s requests", PresignMethodPut)
+		}
+		req, _ = c.s3.GetObjectRequest(&s3.GetObjectInput{
+			Bucket: aws.String(bucket),
+			Key:    aws.String(key),
+		})
+	case PresignMethodPut:
+		input := &s3.PutObjectInput{
+			Bucket: aws.String(bucket),
+			Key:    aws.String(key),
+		}
+		if contentType != "" {
+			input.ContentType = aws.String(contentType)
+		}
+		req, _ = c.s3.PutObjectRequest(input)
+	default:
+		return nil, fmt.Errorf("unsupported presign method %q", method)
+	}
+	req.SetContext(ctx)
+
+	signedAt := time.Now()
+	url, headers, err := req.PresignRequest(expires)
+	if err != nil {
+		return nil, fmt.Errorf("error presigning %s request for object %q in bucket %q: %w", method, key, bucket, err)
+	}
+	tflog.Debug(ctx, "Presigned storage object URL", map[string]any{
+		"method":  method,
+		"bucket":  bucket,
+		"key":     key,
+		"expires": expires.String(),
+	})
+
+	signedHeaders := make(map[string]string, len(headers))
+	for name, values := range headers {
+		signedHeaders[http.CanonicalHeaderKey(name)] = strings.Join(values, ",")
+	}
+
+	return &PresignedURL{
+		URL:           url,
+		SignedHeaders: signedHeaders,
+		ExpiresAt:     signedAt.Add(expires).UTC(),
+	}, nil
+}
@@ -0,0 +1,59 @@
+package s3
+
+import (
+	"context"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPresignObjectURL(t *testing.T) {
+	ctx := context.Background()
+
+	client, err := NewClient(ctx, "access", "secret", "", "https://storage.example.net")
+	require.NoError(t, err)
+
+	get, err := client.PresignObjectURL(ctx, PresignMethodGet, "bucket", "dir/file.txt", "", time.Hour)
+	require.NoError(t, err)
+
+	u, err := url.Parse(get.URL)
+	require.NoError(t, err)
+	assert.Equal(t, "bucket.storage.example.net", u.Host)
+	assert.Equal(t, "/dir/file.txt", u.Path)
+	assert.Equal(t, "3600", u.Query().Get("X-Amz-Expires"))
+	assert.Contains(t, u.Query().Get("X-Amz-Credential"), "access/")
+	assert.NotEmpty(t, u.Query().Get("X-Amz-Signature"))
+	assert.Empty(t, get.SignedHeaders)
+	assert.WithinDuration(t, time.Now().Add(time.Hour), get.ExpiresAt, time.Minute)
+
+	put, err := client.PresignObjectURL(ctx, PresignMethodPut, "bucket", "file.json", "application/json", time.Minute)
+	require.NoError(t, err)
+
+	u, err = url.Parse(put.URL)
+	require.NoError(t, err)
+	assert.Contains(t, u.Query().Get("X-Amz-SignedHeaders"), "content-type")
+	assert.Equal(t, "application/json", put.SignedHeaders["Content-Type"])
+
+	_, err = client.PresignObjectURL(ctx, PresignMethodGet, "bucket", "file.json", "application/json", time.Minute)
+	assert.Error(t, err)
+
+	_, err = client.PresignObjectURL(ctx, "DELETE", "bucket", "file.json", "", time.Minute)
+	assert.Error(t, err)
+
+	_, err = client.PresignObjectURL(ctx, PresignMethodGet, "bucket", "file.json", "", PresignMaxExpiration+time.Second)
+	assert.Error(t, err)
+}
+
+func TestPresignObjectURLRequiresStaticKeys(t *testing.T) {
+	ctx := context.Background()
+
+	client, err := NewClient(ctx, "access", "secret", "", "https://storage.example.net")
+	require.NoError(t, err)
+	client.staticCredentials = false
+
+	_, err = client.PresignObjectURL(ctx, PresignMethodGet, "bucket", "file.txt", "", time.Hour)
+	assert.ErrorIs(t, err, ErrPresignRequiresStaticKeys)
+}
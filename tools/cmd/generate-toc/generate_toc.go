@@ -52,6 +52,15 @@ func main() {
 		return
 	}
 
+	ephemeralResourcesDir := filepath.Join(docsDir, "ephemeral-resources")
+	if _, err := os.Stat(ephemeralResourcesDir); err == nil {
+		err = processDirectory(ephemeralResourcesDir, "Ephemeral Resources", &toc)
+		if err != nil {
+			log.Fatalf("Error while processing ephemeral-resources dir: %s\n", err)
+			return
+		}
+	}
+
 	sortTocItems(&toc.Items)
 
 	tocFile, err := os.Create(filepath.Join(docsDir, "toc.yaml"))
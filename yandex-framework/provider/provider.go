@@ -11,6 +11,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/providervalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -30,19 +31,34 @@ import (
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/datasphere_community"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/datasphere_project"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/gitlab_instance"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/kubernetes_cluster_credentials"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/kubernetes_marketplace_helm_release"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/mdb_backups"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/mdb_clickhouse_cluster_v2"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/mdb_clickhouse_database"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/mdb_clickhouse_user"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/mdb_connection_string"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/mdb_greenplum_cluster_v2"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/mdb_greenplum_resource_group"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/mdb_greenplum_user"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/mdb_kafka_cluster_v2"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/mdb_kafka_user_permission"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/mdb_mongodb_cluster_v2"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/mdb_mongodb_database"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/mdb_mongodb_user"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/mdb_mysql_cluster_v2"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/mdb_mysql_database_v2"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/mdb_opensearch_cluster"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/mdb_opensearch_index_template"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/mdb_opensearch_ism_policy"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/mdb_opensearch_role"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/mdb_opensearch_role_mapping"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/mdb_opensearch_snapshot_repository"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/mdb_postgresql_cluster_v2"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/mdb_postgresql_database_v2"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/mdb_postgresql_user_grant"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/mdb_postgresql_user_permission"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/mdb_postgresql_user_v2"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/mdb_redis_cluster_v2"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/mdb_redis_user"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/mdb_sharded_postgresql_cluster"
@@ -55,9 +71,17 @@ import (
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/organizationmanager_mfa_enforcement_audience"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/organizationmanager_mfa_enforcement_excluded_audience"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/spark_cluster"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/storage_bucket_cors_configuration"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/storage_bucket_grant"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/storage_bucket_iam_binding"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/storage_bucket_lifecycle_configuration"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/storage_bucket_logging"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/storage_bucket_object_lock_configuration"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/storage_bucket_policy"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/storage_bucket_server_side_encryption"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/storage_bucket_versioning"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/storage_bucket_website_configuration"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/storage_object_presigned_url"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/trino_access_control"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/trino_catalog"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/trino_cluster"
@@ -99,6 +123,8 @@ func (v saKeyValidator) ValidateString(ctx context.Context, req validator.String
 	}
 }
 
+var _ provider.ProviderWithEphemeralResources = &Provider{}
+
 type Provider struct {
 	emptyFolder bool
 	config      *provider_config.Config
@@ -295,6 +321,7 @@ func (p *Provider) Configure(ctx context.Context, req provider.ConfigureRequest,
 
 	resp.ResourceData = p.config
 	resp.DataSourceData = p.config
+	resp.EphemeralResourceData = p.config
 }
 
 func (p *Provider) Resources(_ context.Context) []func() resource.Resource {
@@ -314,15 +341,27 @@ func (p *Provider) Resources(_ context.Context) []func() resource.Resource {
 		mdb_mongodb_database.NewResource,
 		mdb_mongodb_user.NewResource,
 		mdb_opensearch_cluster.NewResource,
+		mdb_opensearch_index_template.NewResource,
+		mdb_opensearch_ism_policy.NewResource,
+		mdb_opensearch_role.NewResource,
+		mdb_opensearch_role_mapping.NewResource,
+		mdb_opensearch_snapshot_repository.NewResource,
 		airflow_cluster.NewResource,
 		metastore_cluster.NewResource,
 		vpc_security_group_rule.NewResource,
 		mdb_postgresql_cluster_v2.NewPostgreSQLClusterResourceV2,
+		mdb_postgresql_database_v2.NewResource,
+		mdb_postgresql_user_v2.NewResource,
+		mdb_postgresql_user_grant.NewResource,
+		mdb_postgresql_user_permission.NewResource,
 		mdb_redis_cluster_v2.NewResource,
 		mdb_redis_user.NewResource,
 		mdb_mysql_cluster_v2.NewMySQLClusterResourceV2,
 		mdb_mysql_database_v2.NewResource,
 		mdb_mysql_user_v2.NewResource,
+		mdb_kafka_cluster_v2.NewKafkaClusterResourceV2,
+		mdb_kafka_user_permission.NewResource,
+		mdb_mongodb_cluster_v2.NewMongoDBClusterResourceV2,
 		kubernetes_marketplace_helm_release.NewResource,
 		organizationmanager_idp_application_oauth_application_assignment.NewResource,
 		organizationmanager_idp_application_saml_application_assignment.NewResource,
@@ -342,6 +381,13 @@ func (p *Provider) Resources(_ context.Context) []func() resource.Resource {
 		storage_bucket_grant.NewResource,
 		storage_bucket_iam_binding.NewIamBinding,
 		storage_bucket_policy.NewResource,
+		storage_bucket_lifecycle_configuration.NewResource,
+		storage_bucket_cors_configuration.NewResource,
+		storage_bucket_website_configuration.NewResource,
+		storage_bucket_versioning.NewResource,
+		storage_bucket_server_side_encryption.NewResource,
+		storage_bucket_logging.NewResource,
+		storage_bucket_object_lock_configuration.NewResource,
 		mdb_sharded_postgresql_cluster.NewShardedPostgreSQLClusterResource,
 		mdb_sharded_postgresql_user.NewShardedPostgreSQLUserResource,
 		mdb_sharded_postgresql_database.NewShardedPostgreSQLDatabaseResource,
@@ -388,10 +434,19 @@ func (p *Provider) DataSources(_ context.Context) []func() datasource.DataSource
 		cloud_desktops_desktop_group.NewDatasource,
 		cloud_desktops_desktop.NewDatasource,
 		mdb_clickhouse_cluster_v2.NewDataSource,
+		mdb_backups.NewDataSource,
+		mdb_connection_string.NewDataSource,
 		datalens_connection.NewDataSource,
 	}, yandex_gen.GetProviderDataSources()...)
 }
 
+func (p *Provider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		kubernetes_cluster_credentials.NewEphemeralResource,
+		storage_object_presigned_url.NewEphemeralResource,
+	}
+}
+
 func (p *Provider) GetConfig() provider_config.Config {
 	if p.config == nil {
 		return provider_config.Config{}
@@ -0,0 +1,130 @@
+package kubernetes_cluster_credentials
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/iam/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/k8s/v1"
+	iamsdk "github.com/yandex-cloud/go-sdk/services/iam/v1"
+	k8ssdk "github.com/yandex-cloud/go-sdk/services/k8s/v1"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/kubeconfig"
+	provider_config "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
+)
+
+var (
+	_ ephemeral.EphemeralResource              = &kubernetesClusterCredentialsEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &kubernetesClusterCredentialsEphemeralResource{}
+)
+
+type kubernetesClusterCredentialsEphemeralResource struct {
+	providerConfig *provider_config.Config
+}
+
+func NewEphemeralResource() ephemeral.EphemeralResource {
+	return &kubernetesClusterCredentialsEphemeralResource{}
+}
+
+func (r *kubernetesClusterCredentialsEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kubernetes_cluster_credentials"
+}
+
+func (r *kubernetesClusterCredentialsEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = EphemeralResourceSchema(ctx)
+}
+
+func (r *kubernetesClusterCredentialsEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerConfig, ok := req.ProviderData.(*provider_config.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *provider_config.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.providerConfig = providerConfig
+}
+
+func (r *kubernetesClusterCredentialsEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data KubernetesClusterCredentialsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.EndpointType.IsNull() {
+		data.EndpointType = types.StringValue(kubeconfig.EndpointTypeExternal)
+	}
+
+	clusterID := data.ClusterID.ValueString()
+	cluster, err := k8ssdk.NewClusterClient(r.providerConfig.SDKv2).Get(ctx, &k8s.GetClusterRequest{
+		ClusterId: clusterID,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get Kubernetes cluster", fmt.Sprintf("Error while requesting API to get Kubernetes cluster %q: %s", clusterID, err))
+		return
+	}
+
+	endpoints := kubeconfig.Endpoints{
+		Internal: cluster.GetMaster().GetEndpoints().GetInternalV4Endpoint(),
+		External: cluster.GetMaster().GetEndpoints().GetExternalV4Endpoint(),
+	}
+	endpoint, err := endpoints.Select(data.EndpointType.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to select Kubernetes cluster endpoint", err.Error())
+		return
+	}
+
+	token, expiresAt, err := r.createIAMToken(ctx, data.ServiceAccountID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to issue IAM token", err.Error())
+		return
+	}
+
+	caCertificate := cluster.GetMaster().GetMasterAuth().GetClusterCaCertificate()
+	rendered, err := kubeconfig.Render(kubeconfig.Params{
+		ClusterID:            clusterID,
+		Server:               endpoint,
+		ClusterCACertificate: caCertificate,
+		Token:                token,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to render kubeconfig", err.Error())
+		return
+	}
+
+	data.Endpoint = types.StringValue(endpoint)
+	data.ClusterCACertificate = types.StringValue(caCertificate)
+	data.Token = types.StringValue(token)
+	data.ExpiresAt = types.StringValue(expiresAt.Format(time.RFC3339))
+	data.Kubeconfig = types.StringValue(rendered)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+func (r *kubernetesClusterCredentialsEphemeralResource) createIAMToken(ctx context.Context, serviceAccountID string) (string, time.Time, error) {
+	if serviceAccountID == "" {
+		resp, err := r.providerConfig.SDKv2.CreateIAMToken(ctx)
+		if err != nil {
+			return "", time.Time{}, fmt.Errorf("failed to get IAM token: %w", err)
+		}
+		return resp.GetIamToken(), resp.GetExpiresAt(), nil
+	}
+
+	resp, err := iamsdk.NewIamTokenClient(r.providerConfig.SDKv2).CreateForServiceAccount(ctx, &iam.CreateIamTokenForServiceAccountRequest{
+		ServiceAccountId: serviceAccountID,
+	})
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to create IAM token for service account %q: %w", serviceAccountID, err)
+	}
+	return resp.GetIamToken(), resp.GetExpiresAt().AsTime(), nil
+}
@@ -0,0 +1,141 @@
+package kubernetes_cluster_credentials_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/compare"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+
+	test "github.com/yandex-cloud/terraform-provider-yandex/pkg/testhelpers"
+)
+
+const defaultZone = "ru-central1-a"
+
+// TestMain - add sweepers flag to the go test command
+// important for sweepers run.
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
+
+func TestAccKubernetesClusterCredentialsEphemeral(t *testing.T) {
+	name := test.ResourceName(40)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { test.AccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV6ProviderFactories: testAccProviderFactoriesWithEcho(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesClusterCredentialsConfig(name, test.GetExampleFolderID(), ""),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.CompareValuePairs(
+						"echo.test", tfjsonpath.New("data").AtMapKey("endpoint"),
+						"yandex_kubernetes_cluster.test", tfjsonpath.New("master").AtSliceIndex(0).AtMapKey("external_v4_endpoint"),
+						compare.ValuesSame(),
+					),
+					statecheck.CompareValuePairs(
+						"echo.test", tfjsonpath.New("data").AtMapKey("cluster_ca_certificate"),
+						"yandex_kubernetes_cluster.test", tfjsonpath.New("master").AtSliceIndex(0).AtMapKey("cluster_ca_certificate"),
+						compare.ValuesSame(),
+					),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("token"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("expires_at"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("kubeconfig"),
+						knownvalue.StringRegexp(regexp.MustCompile("current-context: yc-managed-k8s-"))),
+				},
+			},
+			{
+				Config: testAccKubernetesClusterCredentialsConfig(name, test.GetExampleFolderID(), `
+  endpoint_type      = "internal"
+  service_account_id = yandex_iam_service_account.test.id`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.CompareValuePairs(
+						"echo.test", tfjsonpath.New("data").AtMapKey("endpoint"),
+						"yandex_kubernetes_cluster.test", tfjsonpath.New("master").AtSliceIndex(0).AtMapKey("internal_v4_endpoint"),
+						compare.ValuesSame(),
+					),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("token"), knownvalue.NotNull()),
+				},
+			},
+		},
+	})
+}
+
+func testAccProviderFactoriesWithEcho() map[string]func() (tfprotov6.ProviderServer, error) {
+	factories := map[string]func() (tfprotov6.ProviderServer, error){
+		"echo": echoprovider.NewProviderServer(),
+	}
+	for name, factory := range test.AccProviderFactories {
+		factories[name] = factory
+	}
+	return factories
+}
+
+func testAccKubernetesClusterCredentialsConfig(name, folderID, arguments string) string {
+	return fmt.Sprintf(`
+resource "yandex_iam_service_account" "test" {
+  name      = "%[1]s"
+  folder_id = "%[2]s"
+}
+
+resource "yandex_resourcemanager_folder_iam_member" "test" {
+  folder_id = "%[2]s"
+  role      = "editor"
+  member    = "serviceAccount:${yandex_iam_service_account.test.id}"
+}
+
+resource "yandex_vpc_network" "test" {
+  name      = "%[1]s"
+  folder_id = "%[2]s"
+}
+
+resource "yandex_vpc_subnet" "test" {
+  name           = "%[1]s"
+  folder_id      = "%[2]s"
+  zone           = "%[3]s"
+  network_id     = yandex_vpc_network.test.id
+  v4_cidr_blocks = ["10.1.0.0/16"]
+}
+
+resource "yandex_kubernetes_cluster" "test" {
+  name       = "%[1]s"
+  folder_id  = "%[2]s"
+  network_id = yandex_vpc_network.test.id
+
+  master {
+    zonal {
+      zone      = yandex_vpc_subnet.test.zone
+      subnet_id = yandex_vpc_subnet.test.id
+    }
+
+    public_ip = true
+  }
+
+  service_account_id      = yandex_iam_service_account.test.id
+  node_service_account_id = yandex_iam_service_account.test.id
+
+  depends_on = [yandex_resourcemanager_folder_iam_member.test]
+}
+
+ephemeral "yandex_kubernetes_cluster_credentials" "test" {
+  cluster_id = yandex_kubernetes_cluster.test.id
+%[4]s
+}
+
+provider "echo" {
+  data = ephemeral.yandex_kubernetes_cluster_credentials.test
+}
+
+resource "echo" "test" {}
+`, name, folderID, defaultZone, arguments)
+}
@@ -0,0 +1,14 @@
+package kubernetes_cluster_credentials
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+type KubernetesClusterCredentialsModel struct {
+	ClusterID            types.String `tfsdk:"cluster_id"`
+	EndpointType         types.String `tfsdk:"endpoint_type"`
+	ServiceAccountID     types.String `tfsdk:"service_account_id"`
+	Endpoint             types.String `tfsdk:"endpoint"`
+	ClusterCACertificate types.String `tfsdk:"cluster_ca_certificate"`
+	Token                types.String `tfsdk:"token"`
+	ExpiresAt            types.String `tfsdk:"expires_at"`
+	Kubeconfig           types.String `tfsdk:"kubeconfig"`
+}
@@ -0,0 +1,56 @@
+package kubernetes_cluster_credentials
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/kubeconfig"
+)
+
+func EphemeralResourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Issues short-lived credentials for a Yandex Cloud Managed Kubernetes Cluster to configure `kubernetes` or `helm` providers without the `yc` CLI. The credentials are an IAM token of the provider's identity or of a given service account and are not stored in the Terraform state or plan. For more information, see [the official documentation](https://yandex.cloud/docs/managed-kubernetes/operations/connect/create-static-conf).\n\n~> IAM tokens are valid for no more than 12 hours, new credentials are issued on every Terraform run.",
+		Attributes: map[string]schema.Attribute{
+			"cluster_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "ID of the Kubernetes cluster.",
+			},
+			"endpoint_type": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Type of the Kubernetes master endpoint to connect to: `external` or `internal`. Default is `external`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(kubeconfig.EndpointTypeValues...),
+				},
+			},
+			"service_account_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "ID of a service account to issue the IAM token for. The provider's identity must have the `iam.serviceAccounts.tokenCreator` role for it. If not set, the IAM token of the provider's identity is used.",
+			},
+			"endpoint": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The selected Kubernetes master endpoint.",
+			},
+			"cluster_ca_certificate": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "PEM-encoded public certificate that is the root of trust for the Kubernetes cluster.",
+			},
+			"token": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "IAM token to authenticate in the Kubernetes cluster with.",
+			},
+			"expires_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The time the IAM token expires at, in RFC3339 format.",
+			},
+			"kubeconfig": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The kubeconfig in YAML format.",
+			},
+		},
+	}
+}
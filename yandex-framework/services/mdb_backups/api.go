@@ -0,0 +1,129 @@
+package mdb_backups
+
+import (
+	"context"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/clickhouse/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/greenplum/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/mongodb/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/mysql/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/opensearch/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/postgresql/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/redis/v1"
+	clickhousesdk "github.com/yandex-cloud/go-sdk/services/mdb/clickhouse/v1"
+	greenplumsdk "github.com/yandex-cloud/go-sdk/services/mdb/greenplum/v1"
+	mongodbsdk "github.com/yandex-cloud/go-sdk/services/mdb/mongodb/v1"
+	mysqlsdk "github.com/yandex-cloud/go-sdk/services/mdb/mysql/v1"
+	opensearchsdk "github.com/yandex-cloud/go-sdk/services/mdb/opensearch/v1"
+	postgresqlsdk "github.com/yandex-cloud/go-sdk/services/mdb/postgresql/v1"
+	redissdk "github.com/yandex-cloud/go-sdk/services/mdb/redis/v1"
+	ycsdk "github.com/yandex-cloud/go-sdk/v2"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const defaultMDBPageSize = 1000
+
+// backup is implemented by the backup messages of all the managed database services.
+type backup interface {
+	proto.Message
+	GetId() string
+	GetSourceClusterId() string
+	GetStartedAt() *timestamppb.Timestamp
+	GetCreatedAt() *timestamppb.Timestamp
+}
+
+type backupsLister func(ctx context.Context, sdk *ycsdk.SDK, folderID string) ([]backup, error)
+
+// backupsListers lists the backups of the folder, including the backups of the deleted clusters.
+var backupsListers = map[string]backupsLister{
+	"postgresql": func(ctx context.Context, sdk *ycsdk.SDK, folderID string) ([]backup, error) {
+		return listAllBackups(func(pageToken string) ([]*postgresql.Backup, string, error) {
+			resp, err := postgresqlsdk.NewBackupClient(sdk).List(ctx, &postgresql.ListBackupsRequest{
+				FolderId:  folderID,
+				PageSize:  defaultMDBPageSize,
+				PageToken: pageToken,
+			})
+			return resp.GetBackups(), resp.GetNextPageToken(), err
+		})
+	},
+	"mysql": func(ctx context.Context, sdk *ycsdk.SDK, folderID string) ([]backup, error) {
+		return listAllBackups(func(pageToken string) ([]*mysql.Backup, string, error) {
+			resp, err := mysqlsdk.NewBackupClient(sdk).List(ctx, &mysql.ListBackupsRequest{
+				FolderId:  folderID,
+				PageSize:  defaultMDBPageSize,
+				PageToken: pageToken,
+			})
+			return resp.GetBackups(), resp.GetNextPageToken(), err
+		})
+	},
+	"mongodb": func(ctx context.Context, sdk *ycsdk.SDK, folderID string) ([]backup, error) {
+		return listAllBackups(func(pageToken string) ([]*mongodb.Backup, string, error) {
+			resp, err := mongodbsdk.NewBackupClient(sdk).List(ctx, &mongodb.ListBackupsRequest{
+				FolderId:  folderID,
+				PageSize:  defaultMDBPageSize,
+				PageToken: pageToken,
+			})
+			return resp.GetBackups(), resp.GetNextPageToken(), err
+		})
+	},
+	"redis": func(ctx context.Context, sdk *ycsdk.SDK, folderID string) ([]backup, error) {
+		return listAllBackups(func(pageToken string) ([]*redis.Backup, string, error) {
+			resp, err := redissdk.NewBackupClient(sdk).List(ctx, &redis.ListBackupsRequest{
+				FolderId:  folderID,
+				PageSize:  defaultMDBPageSize,
+				PageToken: pageToken,
+			})
+			return resp.GetBackups(), resp.GetNextPageToken(), err
+		})
+	},
+	"clickhouse": func(ctx context.Context, sdk *ycsdk.SDK, folderID string) ([]backup, error) {
+		return listAllBackups(func(pageToken string) ([]*clickhouse.Backup, string, error) {
+			resp, err := clickhousesdk.NewBackupClient(sdk).List(ctx, &clickhouse.ListBackupsRequest{
+				FolderId:  folderID,
+				PageSize:  defaultMDBPageSize,
+				PageToken: pageToken,
+			})
+			return resp.GetBackups(), resp.GetNextPageToken(), err
+		})
+	},
+	"greenplum": func(ctx context.Context, sdk *ycsdk.SDK, folderID string) ([]backup, error) {
+		return listAllBackups(func(pageToken string) ([]*greenplum.Backup, string, error) {
+			resp, err := greenplumsdk.NewBackupClient(sdk).List(ctx, &greenplum.ListBackupsRequest{
+				FolderId:  folderID,
+				PageSize:  defaultMDBPageSize,
+				PageToken: pageToken,
+			})
+			return resp.GetBackups(), resp.GetNextPageToken(), err
+		})
+	},
+	"opensearch": func(ctx context.Context, sdk *ycsdk.SDK, folderID string) ([]backup, error) {
+		return listAllBackups(func(pageToken string) ([]*opensearch.Backup, string, error) {
+			resp, err := opensearchsdk.NewBackupClient(sdk).List(ctx, &opensearch.ListBackupsRequest{
+				FolderId:  folderID,
+				PageSize:  defaultMDBPageSize,
+				PageToken: pageToken,
+			})
+			return resp.GetBackups(), resp.GetNextPageToken(), err
+		})
+	},
+}
+
+func listAllBackups[T backup](listPage func(pageToken string) ([]T, string, error)) ([]backup, error) {
+	var result []backup
+	pageToken := ""
+
+	for {
+		page, nextPageToken, err := listPage(pageToken)
+		if err != nil {
+			return nil, err
+		}
+		for _, b := range page {
+			result = append(result, b)
+		}
+		if nextPageToken == "" {
+			return result, nil
+		}
+		pageToken = nextPageToken
+	}
+}
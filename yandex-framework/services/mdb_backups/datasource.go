@@ -0,0 +1,275 @@
+package mdb_backups
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yandex-cloud/terraform-provider-yandex/common"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/mdbcommon"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/resourceid"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/timestamp"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/validate"
+	provider_config "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
+	"golang.org/x/exp/maps"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+type backupsDataSource struct {
+	providerConfig *provider_config.Config
+}
+
+func NewDataSource() datasource.DataSource {
+	return &backupsDataSource{}
+}
+
+func (d *backupsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mdb_backups"
+}
+
+func (d *backupsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerConfig, ok := req.ProviderData.(*provider_config.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected DataSource Configure Type",
+			fmt.Sprintf("Expected *provider_config.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.providerConfig = providerConfig
+}
+
+func (d *backupsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	engines := maps.Keys(backupsListers)
+	slices.Sort(engines)
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Get the backups of Managed Service for databases within the Yandex Cloud. " +
+			"The backups are sorted by creation time, the newest backup goes first. " +
+			"The backups of the deleted clusters are listed as well until their retention period expires.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: common.ResourceDescriptions["id"],
+				Computed:            true,
+			},
+			"engine": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("Database engine of the backups. One of `%s`.", strings.Join(engines, "`, `")),
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(engines...),
+				},
+			},
+			"folder_id": schema.StringAttribute{
+				MarkdownDescription: common.ResourceDescriptions["folder_id"],
+				Optional:            true,
+				Computed:            true,
+			},
+			"cluster_id": schema.StringAttribute{
+				MarkdownDescription: "Return only the backups of the specified cluster. The cluster may already be deleted.",
+				Optional:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Return only the backups of the specified creation type: `AUTOMATED` or `MANUAL`. The backups of the engines which don't report the creation type are not returned then.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("AUTOMATED", "MANUAL"),
+				},
+			},
+			"created_after": schema.StringAttribute{
+				MarkdownDescription: "Return only the backups created after the specified moment. (Format: `2006-01-02T15:04:05` - UTC).",
+				Optional:            true,
+				Validators: []validator.String{
+					mdbcommon.NewStringToTimeValidator(),
+				},
+			},
+			"created_before": schema.StringAttribute{
+				MarkdownDescription: "Return only the backups created before the specified moment. (Format: `2006-01-02T15:04:05` - UTC). Use the first backup of the result to restore a cluster to the latest backup before the moment.",
+				Optional:            true,
+				Validators: []validator.String{
+					mdbcommon.NewStringToTimeValidator(),
+				},
+			},
+			"backups": schema.ListNestedAttribute{
+				MarkdownDescription: "The backups matching the filters, the newest backup goes first.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "ID of the backup. Use it in the `restore` block of the cluster.",
+							Computed:            true,
+						},
+						"source_cluster_id": schema.StringAttribute{
+							MarkdownDescription: "ID of the cluster the backup was created for.",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Creation type of the backup: `AUTOMATED` or `MANUAL`. Empty for the engines which don't report it.",
+							Computed:            true,
+						},
+						"size": schema.Int64Attribute{
+							MarkdownDescription: "Size of the backup in bytes.",
+							Computed:            true,
+						},
+						"started_at": schema.StringAttribute{
+							MarkdownDescription: "Time when the backup operation was started.",
+							Computed:            true,
+						},
+						"created_at": schema.StringAttribute{
+							MarkdownDescription: "Time when the backup operation was completed.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *backupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state Backups
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	folderID, dg := validate.FolderID(state.FolderId, &d.providerConfig.ProviderState)
+	resp.Diagnostics.Append(dg)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	f := backupsFilter{
+		ClusterID: state.ClusterId.ValueString(),
+		Type:      state.Type.ValueString(),
+	}
+	f.CreatedAfter = parseFilterTime(state.CreatedAfter, "created_after", &resp.Diagnostics)
+	f.CreatedBefore = parseFilterTime(state.CreatedBefore, "created_before", &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	engine := state.Engine.ValueString()
+	backups, err := backupsListers[engine](ctx, d.providerConfig.SDKv2, folderID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to read data source",
+			fmt.Sprintf("Error while requesting API to list %s backups in folder %q: %s", engine, folderID, err.Error()),
+		)
+		return
+	}
+
+	state.Id = types.StringValue(resourceid.Construct(folderID, engine))
+	state.FolderId = types.StringValue(folderID)
+	state.Backups = flattenBackups(ctx, f.apply(backups), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+type backupsFilter struct {
+	ClusterID     string
+	Type          string
+	CreatedAfter  *time.Time
+	CreatedBefore *time.Time
+}
+
+// apply returns the backups matching the filter sorted by creation time, the newest backup goes first.
+func (f backupsFilter) apply(backups []backup) []backup {
+	result := make([]backup, 0, len(backups))
+	for _, b := range backups {
+		if f.ClusterID != "" && b.GetSourceClusterId() != f.ClusterID {
+			continue
+		}
+		if f.Type != "" && backupTypeName(b) != f.Type {
+			continue
+		}
+		createdAt := b.GetCreatedAt().AsTime()
+		if f.CreatedAfter != nil && !createdAt.After(*f.CreatedAfter) {
+			continue
+		}
+		if f.CreatedBefore != nil && !createdAt.Before(*f.CreatedBefore) {
+			continue
+		}
+		result = append(result, b)
+	}
+
+	slices.SortStableFunc(result, func(a, b backup) int {
+		return b.GetCreatedAt().AsTime().Compare(a.GetCreatedAt().AsTime())
+	})
+	return result
+}
+
+func parseFilterTime(v types.String, attrName string, diags *diag.Diagnostics) *time.Time {
+	if v.IsNull() || v.IsUnknown() {
+		return nil
+	}
+
+	t, err := mdbcommon.ParseStringToTime(v.ValueString())
+	if err != nil {
+		diags.AddError(
+			"Failed to read data source",
+			fmt.Sprintf("Error while parsing %s value %q: %s", attrName, v.ValueString(), err.Error()),
+		)
+		return nil
+	}
+	return &t
+}
+
+func flattenBackups(ctx context.Context, backups []backup, diags *diag.Diagnostics) types.List {
+	result := make([]Backup, 0, len(backups))
+	for _, b := range backups {
+		result = append(result, Backup{
+			Id:              types.StringValue(b.GetId()),
+			SourceClusterId: types.StringValue(b.GetSourceClusterId()),
+			Type:            types.StringValue(backupTypeName(b)),
+			Size:            types.Int64Value(backupSize(b)),
+			StartedAt:       types.StringValue(timestamp.Get(b.GetStartedAt())),
+			CreatedAt:       types.StringValue(timestamp.Get(b.GetCreatedAt())),
+		})
+	}
+
+	list, d := types.ListValueFrom(ctx, backupType, result)
+	diags.Append(d...)
+	return list
+}
+
+// backupTypeName returns the creation type of the backup, the engines describe it with their own enums.
+func backupTypeName(b backup) string {
+	m := b.ProtoReflect()
+	fd := m.Descriptor().Fields().ByName("type")
+	if fd == nil || fd.Kind() != protoreflect.EnumKind {
+		return ""
+	}
+
+	v := fd.Enum().Values().ByNumber(m.Get(fd).Enum())
+	if v == nil || v.Number() == 0 {
+		return ""
+	}
+	return string(v.Name())
+}
+
+// backupSize returns the size of the backup in bytes, the engines name the field differently.
+func backupSize(b backup) int64 {
+	m := b.ProtoReflect()
+	for _, name := range []protoreflect.Name{"size", "size_bytes"} {
+		if fd := m.Descriptor().Fields().ByName(name); fd != nil && fd.Kind() == protoreflect.Int64Kind {
+			return m.Get(fd).Int()
+		}
+	}
+	return 0
+}
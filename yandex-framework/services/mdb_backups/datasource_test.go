@@ -0,0 +1,102 @@
+package mdb_backups
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/opensearch/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/postgresql/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func testPostgresqlBackup(id, cid string, createdAt time.Time, backupType postgresql.Backup_BackupCreationType) *postgresql.Backup {
+	return &postgresql.Backup{
+		Id:              id,
+		SourceClusterId: cid,
+		CreatedAt:       timestamppb.New(createdAt),
+		Type:            backupType,
+		Size:            1024,
+	}
+}
+
+func backupIDs(backups []backup) []string {
+	ids := make([]string, 0, len(backups))
+	for _, b := range backups {
+		ids = append(ids, b.GetId())
+	}
+	return ids
+}
+
+func TestBackupsFilter(t *testing.T) {
+	t.Parallel()
+
+	day := func(d int) time.Time { return time.Date(2024, 1, d, 0, 0, 0, 0, time.UTC) }
+	before := day(3)
+	after := day(1)
+
+	backups := []backup{
+		testPostgresqlBackup("cid1:1", "cid1", day(1), postgresql.Backup_AUTOMATED),
+		testPostgresqlBackup("cid1:2", "cid1", day(2), postgresql.Backup_MANUAL),
+		testPostgresqlBackup("cid1:4", "cid1", day(4), postgresql.Backup_AUTOMATED),
+		testPostgresqlBackup("cid2:2", "cid2", day(2), postgresql.Backup_AUTOMATED),
+	}
+
+	cases := []struct {
+		testname string
+		filter   backupsFilter
+		expected []string
+	}{
+		{
+			testname: "NoFilters",
+			expected: []string{"cid1:4", "cid1:2", "cid2:2", "cid1:1"},
+		},
+		{
+			testname: "Cluster",
+			filter:   backupsFilter{ClusterID: "cid1"},
+			expected: []string{"cid1:4", "cid1:2", "cid1:1"},
+		},
+		{
+			testname: "LatestBefore",
+			filter:   backupsFilter{ClusterID: "cid1", CreatedBefore: &before},
+			expected: []string{"cid1:2", "cid1:1"},
+		},
+		{
+			testname: "TimeWindowAndType",
+			filter:   backupsFilter{Type: "AUTOMATED", CreatedAfter: &after, CreatedBefore: &before},
+			expected: []string{"cid2:2"},
+		},
+	}
+
+	for _, c := range cases {
+		if ids := backupIDs(c.filter.apply(backups)); !slices.Equal(ids, c.expected) {
+			t.Errorf("%s: unexpected backups: %v, want %v", c.testname, ids, c.expected)
+		}
+	}
+}
+
+func TestBackupTypeAndSize(t *testing.T) {
+	t.Parallel()
+
+	pg := testPostgresqlBackup("cid:1", "cid", time.Now(), postgresql.Backup_MANUAL)
+	if backupTypeName(pg) != "MANUAL" || backupSize(pg) != 1024 {
+		t.Errorf("unexpected postgresql backup type and size: %q, %d", backupTypeName(pg), backupSize(pg))
+	}
+
+	// OpenSearch backups have no creation type, so they don't match any type filter.
+	os := &opensearch.Backup{Id: "cid:1", SourceClusterId: "cid", SizeBytes: 2048}
+	if backupTypeName(os) != "" || backupSize(os) != 2048 {
+		t.Errorf("unexpected opensearch backup type and size: %q, %d", backupTypeName(os), backupSize(os))
+	}
+	if ids := backupIDs(backupsFilter{Type: "MANUAL"}.apply([]backup{os})); len(ids) != 0 {
+		t.Errorf("unexpected opensearch backups filtered by type: %v", ids)
+	}
+	if ids := backupIDs(backupsFilter{}.apply([]backup{os})); len(ids) != 1 {
+		t.Errorf("unexpected opensearch backups without filter: %v", ids)
+	}
+
+	untyped := testPostgresqlBackup("cid:2", "cid", time.Now(), postgresql.Backup_BACKUP_CREATION_TYPE_UNSPECIFIED)
+	if ids := backupIDs(backupsFilter{Type: "AUTOMATED"}.apply([]backup{pg, untyped})); len(ids) != 0 {
+		t.Errorf("unexpected postgresql backups filtered by type: %v", ids)
+	}
+}
@@ -0,0 +1,37 @@
+package mdb_backups
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type Backups struct {
+	Id            types.String `tfsdk:"id"`
+	Engine        types.String `tfsdk:"engine"`
+	FolderId      types.String `tfsdk:"folder_id"`
+	ClusterId     types.String `tfsdk:"cluster_id"`
+	Type          types.String `tfsdk:"type"`
+	CreatedAfter  types.String `tfsdk:"created_after"`
+	CreatedBefore types.String `tfsdk:"created_before"`
+	Backups       types.List   `tfsdk:"backups"`
+}
+
+type Backup struct {
+	Id              types.String `tfsdk:"id"`
+	SourceClusterId types.String `tfsdk:"source_cluster_id"`
+	Type            types.String `tfsdk:"type"`
+	Size            types.Int64  `tfsdk:"size"`
+	StartedAt       types.String `tfsdk:"started_at"`
+	CreatedAt       types.String `tfsdk:"created_at"`
+}
+
+var backupType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"id":                types.StringType,
+		"source_cluster_id": types.StringType,
+		"type":              types.StringType,
+		"size":              types.Int64Type,
+		"started_at":        types.StringType,
+		"created_at":        types.StringType,
+	},
+}
@@ -133,6 +133,31 @@ func (c *ClickHouseAPI) DeleteCluster(ctx context.Context, sdk *ycsdk.SDK, diags
 	}
 }
 
+func (c *ClickHouseAPI) BackupCluster(ctx context.Context, sdk *ycsdk.SDK, diags *diag.Diagnostics, cid string) {
+	tflog.Debug(ctx, "Creating final backup of ClickHouse Cluster", map[string]any{"cluster_id": cid})
+
+	op, err := retry.ConflictingOperationV2(ctx, sdk, func() (*clickhousesdk.ClusterBackupOperation, error) {
+		return clickhousesdk.NewClusterClient(sdk).Backup(ctx, &clickhouse.BackupClusterRequest{
+			ClusterId: cid,
+		})
+	})
+
+	if err != nil {
+		diags.AddError(
+			"Failed to backup resource",
+			fmt.Sprintf("Error while requesting API to backup ClickHouse cluster %q: %s", cid, err.Error()),
+		)
+		return
+	}
+
+	if _, err = op.Wait(ctx); err != nil {
+		diags.AddError(
+			"Failed to backup resource",
+			fmt.Sprintf("Error while waiting for operation %q to backup ClickHouse cluster %q: %s", op.ID(), cid, err.Error()),
+		)
+	}
+}
+
 func (c *ClickHouseAPI) CreateCluster(ctx context.Context, sdk *ycsdk.SDK, diags *diag.Diagnostics, req *clickhouse.CreateClusterRequest) string {
 	tflog.Debug(ctx, "Creating ClickHouse Cluster", map[string]any{"request": redactClickHouseCreateClusterRequest(req)})
 
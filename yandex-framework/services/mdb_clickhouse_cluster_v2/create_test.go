@@ -70,6 +70,7 @@ var (
 			"embedded_keeper":           types.BoolNull(),
 			"backup_retain_period_days": types.Int64Null(),
 			"deletion_protection":       types.BoolNull(),
+			"final_backup":              types.BoolNull(),
 			"service_account_id":        types.StringNull(),
 			"disk_encryption_key_id":    types.StringNull(),
 			"ml_model":                  types.SetNull(types.ObjectType{AttrTypes: models.MLModelAttrTypes}),
@@ -86,6 +87,8 @@ var (
 			"copy_schema_on_new_hosts":       types.BoolNull(),
 			"allow_host_recreation":          types.BoolNull(),
 			"allow_degradation_to_read_only": types.BoolNull(),
+			"host_update_strategy":           types.ObjectNull(mdbcommon.HostUpdateStrategyAttrTypes),
+			"endpoints":                      types.ObjectNull(mdbcommon.EndpointsAttrTypes),
 			"restore":                        types.ObjectNull(models.RestoreAttrTypes),
 			"performance_diagnostics":        types.ObjectNull(models.PerformanceDiagnosticsAttrTypes),
 			"monitoring":                     types.ListNull(types.ObjectType{AttrTypes: models.MonitoringAttrTypes}),
@@ -682,6 +685,7 @@ var (
 			"embedded_keeper":           types.BoolValue(false),
 			"backup_retain_period_days": types.Int64Value(14),
 			"deletion_protection":       types.BoolValue(true),
+			"final_backup":              types.BoolNull(),
 			"service_account_id":        types.StringValue("sa-id"),
 			"disk_encryption_key_id":    types.StringValue("test-key"),
 			"hosts": types.MapValueMust(types.StringType, map[string]attr.Value{
@@ -693,6 +697,8 @@ var (
 			"copy_schema_on_new_hosts":       types.BoolNull(),
 			"allow_host_recreation":          types.BoolNull(),
 			"allow_degradation_to_read_only": types.BoolNull(),
+			"host_update_strategy":           types.ObjectNull(mdbcommon.HostUpdateStrategyAttrTypes),
+			"endpoints":                      types.ObjectNull(mdbcommon.EndpointsAttrTypes),
 			"restore":                        types.ObjectNull(models.RestoreAttrTypes),
 			"performance_diagnostics": types.ObjectValueMust(
 				models.PerformanceDiagnosticsAttrTypes,
@@ -963,24 +969,24 @@ func TestYandexProvider_MDBClickHouseClusterPrepareCreateRequests(t *testing.T)
 								SelectFromSystemDbRequiresGrant:          wrapperspb.Bool(true),
 								SelectFromInformationSchemaRequiresGrant: wrapperspb.Bool(false),
 							},
-							MaxConnections:                              wrapperspb.Int64(1024),
-							MaxConcurrentQueries:                        wrapperspb.Int64(512),
-							MaxTableSizeToDrop:                          wrapperspb.Int64(256),
-							MaxPartitionSizeToDrop:                      wrapperspb.Int64(128),
-							KeepAliveTimeout:                            wrapperspb.Int64(64),
-							UncompressedCacheSize:                       wrapperspb.Int64(32),
-							Timezone:                                    "MSK",
-							GeobaseEnabled:                              wrapperspb.Bool(false),
-							GeobaseUri:                                  "geobase_uri",
-							DefaultDatabase:                             wrapperspb.String("default_database"),
-							TotalMemoryProfilerStep:                     wrapperspb.Int64(16),
-							TotalMemoryTrackerSampleProbability:         wrapperspb.Double(10),
-							AsyncInsertThreads:                          wrapperspb.Int64(128),
-							BackupThreads:                               wrapperspb.Int64(512),
-							RestoreThreads:                              wrapperspb.Int64(64),
-							MarkCacheSize:                               wrapperspb.Int64(5368709120),
-							VectorSimilarityIndexCacheSize:              wrapperspb.Int64(1073741824),
-							VectorSimilarityIndexCacheMaxEntries:        wrapperspb.Int64(10000),
+							MaxConnections:                       wrapperspb.Int64(1024),
+							MaxConcurrentQueries:                 wrapperspb.Int64(512),
+							MaxTableSizeToDrop:                   wrapperspb.Int64(256),
+							MaxPartitionSizeToDrop:               wrapperspb.Int64(128),
+							KeepAliveTimeout:                     wrapperspb.Int64(64),
+							UncompressedCacheSize:                wrapperspb.Int64(32),
+							Timezone:                             "MSK",
+							GeobaseEnabled:                       wrapperspb.Bool(false),
+							GeobaseUri:                           "geobase_uri",
+							DefaultDatabase:                      wrapperspb.String("default_database"),
+							TotalMemoryProfilerStep:              wrapperspb.Int64(16),
+							TotalMemoryTrackerSampleProbability:  wrapperspb.Double(10),
+							AsyncInsertThreads:                   wrapperspb.Int64(128),
+							BackupThreads:                        wrapperspb.Int64(512),
+							RestoreThreads:                       wrapperspb.Int64(64),
+							MarkCacheSize:                        wrapperspb.Int64(5368709120),
+							VectorSimilarityIndexCacheSize:       wrapperspb.Int64(1073741824),
+							VectorSimilarityIndexCacheMaxEntries: wrapperspb.Int64(10000),
 							MaxBuildVectorSimilarityIndexThreadPoolSize: wrapperspb.Int64(4),
 							Tls: &clickhouseConfig.ClickhouseConfig_Tls{
 								TrustedCertificates: []string{"-----BEGIN CERTIFICATE-----\n-----END CERTIFICATE-----\n"},
@@ -186,6 +186,7 @@ func refreshDataSourceState(ctx context.Context, prevState, state *models.Cluste
 
 	currentShards := clickhouseApi.ListShards(ctx, sdk, diags, cid)
 	state.Shards = models.FlattenListShard(ctx, currentShards, diags)
+	state.Endpoints = models.FlattenEndpoints(ctx, cid, currentShards, diags)
 
 	currentShardGroups := clickhouseApi.ListShardGroups(ctx, sdk, diags, cid)
 	state.ShardGroup = models.FlattenListShardGroup(ctx, currentShardGroups, types.ListNull(types.ObjectType{AttrTypes: models.ShardGroupAttrTypes}), diags)
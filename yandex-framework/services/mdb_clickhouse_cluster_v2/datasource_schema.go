@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/yandex-cloud/terraform-provider-yandex/common"
 	"github.com/yandex-cloud/terraform-provider-yandex/pkg/chcommon/usersettings"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/mdbcommon"
 )
 
 func DataSourceClusterSchema(ctx context.Context) schema.Schema {
@@ -117,6 +118,7 @@ func DataSourceClusterSchema(ctx context.Context) schema.Schema {
 			"shards":                  DataSourceShardsSchema(),
 			"performance_diagnostics": DataSourcePerformanceDiagnosticsSchema(),
 			"external_dictionary":     DataSourceExternalDictionarySchema(),
+			"endpoints":               mdbcommon.EndpointsDataSourceSchema(),
 			"full_version": schema.StringAttribute{
 				MarkdownDescription: "Full version of the ClickHouse server software.",
 				Computed:            true,
@@ -190,6 +190,45 @@ var ClickhouseConfigAttrTypes = map[string]attr.Type{
 	"tls": types.ObjectType{AttrTypes: ClickhouseTlsAttrTypes},
 }
 
+// ClickhouseConfigDisruption lists the server settings which are applied on restart of ClickHouse.
+var ClickhouseConfigDisruption = map[string]mdbcommon.SettingDisruption{
+	"timezone":                                     mdbcommon.SettingRequiresRestart,
+	"geobase_enabled":                              mdbcommon.SettingRequiresRestart,
+	"geobase_uri":                                  mdbcommon.SettingRequiresRestart,
+	"mysql_protocol":                               mdbcommon.SettingRequiresRestart,
+	"max_connections":                              mdbcommon.SettingRequiresRestart,
+	"keep_alive_timeout":                           mdbcommon.SettingRequiresRestart,
+	"uncompressed_cache_size":                      mdbcommon.SettingRequiresRestart,
+	"mark_cache_size":                              mdbcommon.SettingRequiresRestart,
+	"async_insert_threads":                         mdbcommon.SettingRequiresRestart,
+	"backup_threads":                               mdbcommon.SettingRequiresRestart,
+	"restore_threads":                              mdbcommon.SettingRequiresRestart,
+	"background_schedule_pool_size":                mdbcommon.SettingRequiresRestart,
+	"background_distributed_schedule_pool_size":    mdbcommon.SettingRequiresRestart,
+	"background_buffer_flush_schedule_pool_size":   mdbcommon.SettingRequiresRestart,
+	"background_message_broker_schedule_pool_size": mdbcommon.SettingRequiresRestart,
+	"background_common_pool_size":                  mdbcommon.SettingRequiresRestart,
+}
+
+// GetClickHouseConfig returns the server settings of the ClickHouse subcluster.
+func GetClickHouseConfig(ctx context.Context, cluster ClusterResource, diags *diag.Diagnostics) (types.Object, bool) {
+	if cluster.ClickHouse.IsNull() || cluster.ClickHouse.IsUnknown() {
+		return types.ObjectNull(ClickhouseConfigAttrTypes), false
+	}
+
+	var ch Clickhouse
+	diags.Append(cluster.ClickHouse.As(ctx, &ch, datasize.DefaultOpts)...)
+	if diags.HasError() {
+		return types.ObjectNull(ClickhouseConfigAttrTypes), false
+	}
+
+	if ch.Config.IsNull() || ch.Config.IsUnknown() {
+		return ch.Config, false
+	}
+
+	return ch.Config, true
+}
+
 func FlattenClickHouseConfig(ctx context.Context, prevClickHouse types.Object, config *clickhouseConfig.ClickhouseConfig, tlsOverride *clickhouseConfig.ClickhouseConfig_Tls, diags *diag.Diagnostics) types.Object {
 	if config == nil {
 		return types.ObjectNull(ClickhouseConfigAttrTypes)
@@ -4,6 +4,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/mdbcommon"
 )
 
 type ClusterResource struct {
@@ -20,6 +21,7 @@ type ClusterResource struct {
 	MaintenanceWindow   types.Object `tfsdk:"maintenance_window"`
 	SecurityGroupIds    types.Set    `tfsdk:"security_group_ids"`
 	DeletionProtection  types.Bool   `tfsdk:"deletion_protection"`
+	FinalBackup         types.Bool   `tfsdk:"final_backup"`
 	DiskEncryptionKeyId types.String `tfsdk:"disk_encryption_key_id"`
 
 	Version                types.String `tfsdk:"version"`
@@ -48,6 +50,9 @@ type ClusterResource struct {
 	CopySchemaOnNewHosts       types.Bool     `tfsdk:"copy_schema_on_new_hosts"`
 	AllowHostRecreation        types.Bool     `tfsdk:"allow_host_recreation"`
 	AllowDegradationToReadOnly types.Bool     `tfsdk:"allow_degradation_to_read_only"`
+	RequireDisruptiveChangeAck types.Bool     `tfsdk:"require_disruptive_change_ack"`
+	HostUpdateStrategy         types.Object   `tfsdk:"host_update_strategy"`
+	Endpoints                  types.Object   `tfsdk:"endpoints"`
 	Restore                    types.Object   `tfsdk:"restore"`
 	PerformanceDiagnostics     types.Object   `tfsdk:"performance_diagnostics"`
 	Monitoring                 types.List     `tfsdk:"monitoring"`
@@ -68,6 +73,7 @@ var ClusterResourceAttrTypes = map[string]attr.Type{
 	"maintenance_window":        types.ObjectType{AttrTypes: MaintenanceWindowAttrTypes},
 	"security_group_ids":        types.SetType{ElemType: types.StringType},
 	"deletion_protection":       types.BoolType,
+	"final_backup":              types.BoolType,
 	"disk_encryption_key_id":    types.StringType,
 	"version":                   types.StringType,
 	"clickhouse":                types.ObjectType{AttrTypes: ClickhouseAttrTypes},
@@ -95,6 +101,9 @@ var ClusterResourceAttrTypes = map[string]attr.Type{
 	"copy_schema_on_new_hosts":       types.BoolType,
 	"allow_host_recreation":          types.BoolType,
 	"allow_degradation_to_read_only": types.BoolType,
+	"require_disruptive_change_ack":  types.BoolType,
+	"host_update_strategy":           types.ObjectType{AttrTypes: mdbcommon.HostUpdateStrategyAttrTypes},
+	"endpoints":                      types.ObjectType{AttrTypes: mdbcommon.EndpointsAttrTypes},
 	"restore":                        types.ObjectType{AttrTypes: RestoreAttrTypes},
 	"performance_diagnostics":        types.ObjectType{AttrTypes: PerformanceDiagnosticsAttrTypes},
 	"monitoring":                     types.ListType{ElemType: types.ObjectType{AttrTypes: MonitoringAttrTypes}},
@@ -143,6 +152,7 @@ type ClusterDataSource struct {
 	AllowHostRecreation  types.Bool     `tfsdk:"allow_host_recreation"`
 	Monitoring           types.List     `tfsdk:"monitoring"`
 	FullVersion          types.String   `tfsdk:"full_version"`
+	Endpoints            types.Object   `tfsdk:"endpoints"`
 }
 
 var ClusterDataSourceTypes = map[string]attr.Type{
@@ -186,4 +196,5 @@ var ClusterDataSourceTypes = map[string]attr.Type{
 	"allow_host_recreation":    types.BoolType,
 	"monitoring":               types.ListType{ElemType: types.ObjectType{AttrTypes: MonitoringAttrTypes}},
 	"full_version":             types.StringType,
+	"endpoints":                types.ObjectType{AttrTypes: mdbcommon.EndpointsAttrTypes},
 }
@@ -56,6 +56,15 @@ func FlattenListShard(ctx context.Context, shards []*clickhouse.Shard, diags *di
 	return m
 }
 
+// FlattenEndpoints returns the endpoints of the cluster, the shards are reached by their special FQDNs.
+func FlattenEndpoints(ctx context.Context, cid string, shards []*clickhouse.Shard, diags *diag.Diagnostics) types.Object {
+	shardEndpoints := make(map[string][]string, len(shards))
+	for _, s := range shards {
+		shardEndpoints[s.Name] = []string{mdbcommon.HostPort(mdbcommon.ShardReadWriteHost(cid, s.Name), mdbcommon.ClickHousePort)}
+	}
+	return mdbcommon.FlattenEndpoints(ctx, mdbcommon.HostPort(mdbcommon.ClusterReadWriteHost(cid), mdbcommon.ClickHousePort), "", shardEndpoints, diags)
+}
+
 func ExpandListShard(ctx context.Context, m types.Map, cid string, diags *diag.Diagnostics) []*clickhouse.ShardSpec {
 	if m.IsNull() || m.IsUnknown() {
 		return nil
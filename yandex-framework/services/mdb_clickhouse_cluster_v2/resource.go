@@ -355,6 +355,11 @@ func (r *clusterResource) Update(ctx context.Context, req resource.UpdateRequest
 		PlanShardSpecByShardName: mapShardNameShardSpec,
 	}
 
+	hostUpdateStrategy := mdbcommon.ExpandHostUpdateStrategy(ctx, plan.HostUpdateStrategy, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if migrateToKeeper {
 		migrationRequest := prepareMigrateToKeeperRequest(
 			ctx,
@@ -373,6 +378,11 @@ func (r *clusterResource) Update(ctx context.Context, req resource.UpdateRequest
 		// Migration creates Keeper hosts and removes ZooKeeper hosts atomically, so the generic
 		// host reconciler must not manage coordinator hosts in the same apply.
 		tflog.Debug(ctx, "Updating ZooKeeper/Keeper hosts")
+		keeperHostUpdateStrategy := hostUpdateStrategy
+		if !opts.HasCoordinator {
+			// The coordinator is added with all of its hosts at once.
+			keeperHostUpdateStrategy = nil
+		}
 		mdbcommon.UpdateClusterHosts(
 			ctx,
 			r.providerConfig.SDKv2,
@@ -381,6 +391,7 @@ func (r *clusterResource) Update(ctx context.Context, req resource.UpdateRequest
 			&clickhouseApi,
 			plan.Id.ValueString(),
 			opts,
+			keeperHostUpdateStrategy,
 			planKeeperHostSpecs,
 			stateKeeperHostSpecs,
 		)
@@ -399,6 +410,7 @@ func (r *clusterResource) Update(ctx context.Context, req resource.UpdateRequest
 		&clickhouseApi,
 		plan.Id.ValueString(),
 		opts,
+		hostUpdateStrategy,
 		planChHostSpecs,
 		stateChHostSpecs,
 	)
@@ -484,6 +496,12 @@ func (r *clusterResource) Delete(ctx context.Context, req resource.DeleteRequest
 	defer cancel()
 
 	cid := state.Id.ValueString()
+	if state.FinalBackup.ValueBool() {
+		clickhouseApi.BackupCluster(ctx, r.providerConfig.SDKv2, &resp.Diagnostics, cid)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 	clickhouseApi.DeleteCluster(ctx, r.providerConfig.SDKv2, &resp.Diagnostics, cid)
 	if resp.Diagnostics.HasError() {
 		return
@@ -508,6 +526,21 @@ func (r *clusterResource) ModifyPlan(ctx context.Context, req resource.ModifyPla
 		return
 	}
 
+	// server settings applied on restart
+	planCHConfig, planCHConfigSet := models.GetClickHouseConfig(ctx, plan, &resp.Diagnostics)
+	stateCHConfig, stateCHConfigSet := models.GetClickHouseConfig(ctx, state, &resp.Diagnostics)
+	if planCHConfigSet && stateCHConfigSet {
+		mdbcommon.ReportDisruptiveChanges(
+			path.Root("clickhouse").AtName("config"),
+			mdbcommon.ObjectDisruptiveChanges(planCHConfig, stateCHConfig, models.ClickhouseConfigDisruption),
+			plan.RequireDisruptiveChangeAck.ValueBool(),
+			&resp.Diagnostics,
+		)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// zookeeper resources
 	_, planKeeperHosts := splitHostSpecsByType(ctx, plan.HostSpecs, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
@@ -717,6 +750,7 @@ func refreshState(ctx context.Context, prevState, state *models.ClusterResource,
 
 	currentShards := clickhouseApi.ListShards(ctx, sdk, diags, cid)
 	state.Shards = models.FlattenListShard(ctx, currentShards, diags)
+	state.Endpoints = models.FlattenEndpoints(ctx, cid, currentShards, diags)
 
 	currentShardGroups := clickhouseApi.ListShardGroups(ctx, sdk, diags, cid)
 	state.ShardGroup = models.FlattenListShardGroup(ctx, currentShardGroups, prevState.ShardGroup, diags)
@@ -80,6 +80,7 @@ func (r *clusterResource) Schema(ctx context.Context, _ resource.SchemaRequest,
 			},
 			"labels":              defaultschema.Labels(),
 			"deletion_protection": defaultschema.DeletionProtection(),
+			"final_backup":        defaultschema.FinalBackup(),
 			"disk_encryption_key_id": schema.StringAttribute{
 				Description: "ID of the KMS key for cluster disk encryption.",
 				Optional:    true,
@@ -174,16 +175,19 @@ func (r *clusterResource) Schema(ctx context.Context, _ resource.SchemaRequest,
 				Description: "Allows the cluster to become read-only during migration from ZooKeeper to ClickHouse Keeper. Must be enabled when changing coordinator host types from `ZOOKEEPER` to `KEEPER`.",
 				Optional:    true,
 			},
-			"clickhouse":              ClickHouseSchema(),
-			"zookeeper":               ZooKeeperSchema(),
-			"cloud_storage":           CloudStorageSchema(),
-			"backup_window_start":     BackupWindowStart(),
-			"access":                  AccessSchema(),
-			"hosts":                   HostsSchema(),
-			"shards":                  ShardsSchema(),
-			"restore":                 RestoreSchema(),
-			"performance_diagnostics": PerformanceDiagnosticsSchema(),
-			"external_dictionary":     ExternalDictionarySchema(),
+			"require_disruptive_change_ack": defaultschema.RequireDisruptiveChangeAck(),
+			"host_update_strategy":          defaultschema.HostUpdateStrategy(),
+			"clickhouse":                    ClickHouseSchema(),
+			"zookeeper":                     ZooKeeperSchema(),
+			"cloud_storage":                 CloudStorageSchema(),
+			"backup_window_start":           BackupWindowStart(),
+			"access":                        AccessSchema(),
+			"hosts":                         HostsSchema(),
+			"shards":                        ShardsSchema(),
+			"restore":                       RestoreSchema(),
+			"endpoints":                     mdbcommon.EndpointsSchema(),
+			"performance_diagnostics":       PerformanceDiagnosticsSchema(),
+			"external_dictionary":           ExternalDictionarySchema(),
 			"full_version": schema.StringAttribute{
 				Description: "Full version of the ClickHouse server software.",
 				Computed:    true,
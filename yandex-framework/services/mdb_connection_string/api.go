@@ -0,0 +1,178 @@
+package mdb_connection_string
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/clickhouse/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/mongodb/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/mysql/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/postgresql/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/redis/v1"
+	clickhousesdk "github.com/yandex-cloud/go-sdk/services/mdb/clickhouse/v1"
+	mongodbsdk "github.com/yandex-cloud/go-sdk/services/mdb/mongodb/v1"
+	mysqlsdk "github.com/yandex-cloud/go-sdk/services/mdb/mysql/v1"
+	postgresqlsdk "github.com/yandex-cloud/go-sdk/services/mdb/postgresql/v1"
+	redissdk "github.com/yandex-cloud/go-sdk/services/mdb/redis/v1"
+	ycsdk "github.com/yandex-cloud/go-sdk/v2"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/mdbcommon"
+)
+
+const defaultMDBPageSize = 1000
+
+// clusterConnection is the part of the connection string defined by the cluster.
+type clusterConnection struct {
+	Scheme    string
+	Endpoints []string
+	Query     url.Values
+}
+
+type engine struct {
+	// connection resolves the endpoints of the cluster.
+	connection func(ctx context.Context, sdk *ycsdk.SDK, cid, databaseName string, readOnly bool) (*clusterConnection, error)
+	// connectionID returns the Connection Manager connection of the user, nil for the engines
+	// whose users are not integrated with Connection Manager.
+	connectionID func(ctx context.Context, sdk *ycsdk.SDK, cid, userName string) (string, error)
+}
+
+var engines = map[string]engine{
+	"postgresql": {
+		connection: func(ctx context.Context, sdk *ycsdk.SDK, cid, _ string, readOnly bool) (*clusterConnection, error) {
+			if _, err := postgresqlsdk.NewClusterClient(sdk).Get(ctx, &postgresql.GetClusterRequest{ClusterId: cid}); err != nil {
+				return nil, err
+			}
+			return &clusterConnection{
+				Scheme:    "postgresql",
+				Endpoints: []string{mdbcommon.HostPort(specialHost(cid, readOnly), mdbcommon.PostgreSQLPort)},
+				Query:     url.Values{"sslmode": {"verify-full"}},
+			}, nil
+		},
+		connectionID: func(ctx context.Context, sdk *ycsdk.SDK, cid, userName string) (string, error) {
+			user, err := postgresqlsdk.NewUserClient(sdk).Get(ctx, &postgresql.GetUserRequest{ClusterId: cid, UserName: userName})
+			return user.GetConnectionManager().GetConnectionId(), err
+		},
+	},
+	"mysql": {
+		connection: func(ctx context.Context, sdk *ycsdk.SDK, cid, _ string, readOnly bool) (*clusterConnection, error) {
+			if _, err := mysqlsdk.NewClusterClient(sdk).Get(ctx, &mysql.GetClusterRequest{ClusterId: cid}); err != nil {
+				return nil, err
+			}
+			return &clusterConnection{
+				Scheme:    "mysql",
+				Endpoints: []string{mdbcommon.HostPort(specialHost(cid, readOnly), mdbcommon.MySQLPort)},
+				Query:     url.Values{"ssl-mode": {"VERIFY_IDENTITY"}},
+			}, nil
+		},
+		connectionID: func(ctx context.Context, sdk *ycsdk.SDK, cid, userName string) (string, error) {
+			user, err := mysqlsdk.NewUserClient(sdk).Get(ctx, &mysql.GetUserRequest{ClusterId: cid, UserName: userName})
+			return user.GetConnectionManager().GetConnectionId(), err
+		},
+	},
+	"clickhouse": {
+		connection: func(ctx context.Context, sdk *ycsdk.SDK, cid, _ string, readOnly bool) (*clusterConnection, error) {
+			if readOnly {
+				return nil, fmt.Errorf("ClickHouse clusters have no read-only endpoint")
+			}
+			if _, err := clickhousesdk.NewClusterClient(sdk).Get(ctx, &clickhouse.GetClusterRequest{ClusterId: cid}); err != nil {
+				return nil, err
+			}
+			return &clusterConnection{
+				Scheme:    "clickhouse",
+				Endpoints: []string{mdbcommon.HostPort(mdbcommon.ClusterReadWriteHost(cid), mdbcommon.ClickHousePort)},
+				Query:     url.Values{"secure": {"true"}},
+			}, nil
+		},
+		connectionID: func(ctx context.Context, sdk *ycsdk.SDK, cid, userName string) (string, error) {
+			user, err := clickhousesdk.NewUserClient(sdk).Get(ctx, &clickhouse.GetUserRequest{ClusterId: cid, UserName: userName})
+			return user.GetConnectionManager().GetConnectionId(), err
+		},
+	},
+	"redis": {
+		connection: func(ctx context.Context, sdk *ycsdk.SDK, cid, _ string, readOnly bool) (*clusterConnection, error) {
+			if readOnly {
+				return nil, fmt.Errorf("Redis clusters have no read-only endpoint")
+			}
+			cluster, err := redissdk.NewClusterClient(sdk).Get(ctx, &redis.GetClusterRequest{ClusterId: cid})
+			if err != nil {
+				return nil, err
+			}
+			if cluster.GetSharded() {
+				return nil, fmt.Errorf("sharded Redis clusters have no single endpoint, use `endpoints.shards` of the cluster instead")
+			}
+
+			if cluster.GetTlsEnabled() {
+				return &clusterConnection{
+					Scheme:    "rediss",
+					Endpoints: []string{mdbcommon.HostPort(mdbcommon.ClusterReadWriteHost(cid), mdbcommon.RedisTLSPort)},
+				}, nil
+			}
+			return &clusterConnection{
+				Scheme:    "redis",
+				Endpoints: []string{mdbcommon.HostPort(mdbcommon.ClusterReadWriteHost(cid), mdbcommon.RedisPort)},
+			}, nil
+		},
+	},
+	"mongodb": {
+		connection: func(ctx context.Context, sdk *ycsdk.SDK, cid, databaseName string, readOnly bool) (*clusterConnection, error) {
+			cluster, err := mongodbsdk.NewClusterClient(sdk).Get(ctx, &mongodb.GetClusterRequest{ClusterId: cid})
+			if err != nil {
+				return nil, err
+			}
+			hosts, err := listMongoDBHosts(ctx, sdk, cid)
+			if err != nil {
+				return nil, err
+			}
+
+			conn := &clusterConnection{Scheme: "mongodb", Query: url.Values{}}
+			for _, host := range hosts {
+				switch {
+				case cluster.GetSharded() && (host.GetType() == mongodb.Host_MONGOS || host.GetType() == mongodb.Host_MONGOINFRA):
+					conn.Endpoints = append(conn.Endpoints, mdbcommon.HostPort(host.GetName(), mdbcommon.MongoDBMongosPort))
+				case !cluster.GetSharded() && host.GetType() == mongodb.Host_MONGOD:
+					conn.Endpoints = append(conn.Endpoints, mdbcommon.HostPort(host.GetName(), mdbcommon.MongoDBPort))
+					conn.Query.Set("replicaSet", host.GetShardName())
+				}
+			}
+			slices.Sort(conn.Endpoints)
+
+			if databaseName != "" {
+				conn.Query.Set("authSource", databaseName)
+			}
+			if readOnly {
+				conn.Query.Set("readPreference", "secondaryPreferred")
+			}
+			return conn, nil
+		},
+	},
+}
+
+// specialHost returns the special FQDN of the master, or of the least lagging replica for the read-only connections.
+func specialHost(cid string, readOnly bool) string {
+	if readOnly {
+		return mdbcommon.ClusterReadOnlyHost(cid)
+	}
+	return mdbcommon.ClusterReadWriteHost(cid)
+}
+
+func listMongoDBHosts(ctx context.Context, sdk *ycsdk.SDK, cid string) ([]*mongodb.Host, error) {
+	var hosts []*mongodb.Host
+	pageToken := ""
+	for {
+		resp, err := mongodbsdk.NewClusterClient(sdk).ListHosts(ctx, &mongodb.ListClusterHostsRequest{
+			ClusterId: cid,
+			PageSize:  defaultMDBPageSize,
+			PageToken: pageToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+		hosts = append(hosts, resp.GetHosts()...)
+
+		if resp.GetNextPageToken() == "" {
+			return hosts, nil
+		}
+		pageToken = resp.GetNextPageToken()
+	}
+}
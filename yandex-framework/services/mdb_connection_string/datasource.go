@@ -0,0 +1,162 @@
+package mdb_connection_string
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yandex-cloud/terraform-provider-yandex/common"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/mdbcommon"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/resourceid"
+	provider_config "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
+	"golang.org/x/exp/maps"
+)
+
+type connectionStringDataSource struct {
+	providerConfig *provider_config.Config
+}
+
+func NewDataSource() datasource.DataSource {
+	return &connectionStringDataSource{}
+}
+
+func (d *connectionStringDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mdb_connection_string"
+}
+
+func (d *connectionStringDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerConfig, ok := req.ProviderData.(*provider_config.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected DataSource Configure Type",
+			fmt.Sprintf("Expected *provider_config.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.providerConfig = providerConfig
+}
+
+func (d *connectionStringDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	engineNames := maps.Keys(engines)
+	slices.Sort(engineNames)
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Get the connection string of a database of Managed Service for databases within the Yandex Cloud. " +
+			"The connection string combines the endpoints of the cluster, the user and the database into a URI. " +
+			"If the password is not set explicitly, it is read from the Lockbox secret of the user's Connection Manager connection, " +
+			"for the engines integrated with Connection Manager.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: common.ResourceDescriptions["id"],
+				Computed:            true,
+			},
+			"engine": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("Database engine of the cluster. One of `%s`.", strings.Join(engineNames, "`, `")),
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(engineNames...),
+				},
+			},
+			"cluster_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the cluster.",
+				Required:            true,
+			},
+			"user_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the user. Omitted from the URI if not set.",
+				Optional:            true,
+			},
+			"database_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the database. Used as the authentication database for MongoDB.",
+				Optional:            true,
+			},
+			"read_only": schema.BoolAttribute{
+				MarkdownDescription: "Connect to the least lagging replica instead of the master. Supported for PostgreSQL and MySQL; for MongoDB the secondary hosts are preferred for reads. The default is `false`.",
+				Optional:            true,
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "Password of the user. If not set, the password is read from the Lockbox secret of the user's Connection Manager connection. The URI contains no password if the user has no connection.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"endpoints": schema.ListAttribute{
+				MarkdownDescription: "The endpoints of the connection in the `host:port` form.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"uri": schema.StringAttribute{
+				MarkdownDescription: "The connection string, e.g. `postgresql://user:password@c-<cluster_id>.rw.mdb.yandexcloud.net:6432/db?sslmode=verify-full`.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+		},
+	}
+}
+
+func (d *connectionStringDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state ConnectionString
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sdk := d.providerConfig.SDKv2
+	engineName := state.Engine.ValueString()
+	e := engines[engineName]
+	cid := state.ClusterId.ValueString()
+	userName := state.UserName.ValueString()
+	databaseName := state.DatabaseName.ValueString()
+
+	conn, err := e.connection(ctx, sdk, cid, databaseName, state.ReadOnly.ValueBool())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to read data source",
+			fmt.Sprintf("Error while resolving endpoints of %s cluster %q: %s", engineName, cid, err.Error()),
+		)
+		return
+	}
+
+	password := state.Password.ValueString()
+	if password == "" && userName != "" && e.connectionID != nil {
+		connectionID, err := e.connectionID(ctx, sdk, cid, userName)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to read data source",
+				fmt.Sprintf("Error while requesting API to get user %q of %s cluster %q: %s", userName, engineName, cid, err.Error()),
+			)
+			return
+		}
+
+		if connectionID != "" {
+			password, err = mdbcommon.ReadConnectionPassword(ctx, sdk, connectionID)
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Failed to read data source",
+					fmt.Sprintf("Error while reading password of user %q: %s", userName, err.Error()),
+				)
+				return
+			}
+		}
+	}
+
+	state.Id = types.StringValue(resourceid.Construct(cid, engineName))
+	endpoints, dg := types.ListValueFrom(ctx, types.StringType, conn.Endpoints)
+	resp.Diagnostics.Append(dg...)
+	state.Endpoints = endpoints
+	state.Uri = types.StringValue(mdbcommon.ConnectionURI(conn.Scheme, userName, password, conn.Endpoints, databaseName, conn.Query))
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,17 @@
+package mdb_connection_string
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type ConnectionString struct {
+	Id           types.String `tfsdk:"id"`
+	Engine       types.String `tfsdk:"engine"`
+	ClusterId    types.String `tfsdk:"cluster_id"`
+	UserName     types.String `tfsdk:"user_name"`
+	DatabaseName types.String `tfsdk:"database_name"`
+	ReadOnly     types.Bool   `tfsdk:"read_only"`
+	Password     types.String `tfsdk:"password"`
+	Endpoints    types.List   `tfsdk:"endpoints"`
+	Uri          types.String `tfsdk:"uri"`
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/greenplum/v1"
 	"github.com/yandex-cloud/terraform-provider-yandex/pkg/converter"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/mdbcommon"
 )
 
 type yandexMdbGreenplumClusterV2DatasourceModel struct {
@@ -20,6 +21,7 @@ type yandexMdbGreenplumClusterV2DatasourceModel struct {
 	CreatedAt           types.String   `tfsdk:"created_at"`
 	DeletionProtection  types.Bool     `tfsdk:"deletion_protection"`
 	Description         types.String   `tfsdk:"description"`
+	Endpoints           types.Object   `tfsdk:"endpoints"`
 	Environment         types.String   `tfsdk:"environment"`
 	FolderId            types.String   `tfsdk:"folder_id"`
 	HostGroupIds        types.Set      `tfsdk:"host_group_ids"`
@@ -64,6 +66,9 @@ func (m *yandexMdbGreenplumClusterV2DatasourceModel) GetDeletionProtection() typ
 func (m *yandexMdbGreenplumClusterV2DatasourceModel) GetDescription() types.String {
 	return m.Description
 }
+func (m *yandexMdbGreenplumClusterV2DatasourceModel) GetEndpoints() types.Object {
+	return m.Endpoints
+}
 func (m *yandexMdbGreenplumClusterV2DatasourceModel) GetEnvironment() types.String {
 	return m.Environment
 }
@@ -134,6 +139,7 @@ func NewYandexMdbGreenplumClusterV2DatasourceModel() yandexMdbGreenplumClusterV2
 		CreatedAt:           types.StringNull(),
 		DeletionProtection:  types.BoolNull(),
 		Description:         types.StringNull(),
+		Endpoints:           types.ObjectNull(mdbcommon.EndpointsAttrTypes),
 		Environment:         types.StringNull(),
 		FolderId:            types.StringNull(),
 		HostGroupIds:        types.SetNull(types.StringType),
@@ -179,6 +185,9 @@ func yandexMdbGreenplumClusterV2DatasourceModelFillUnknown(target yandexMdbGreen
 	if target.Description.IsUnknown() || target.Description.IsNull() {
 		target.Description = types.StringNull()
 	}
+	if target.Endpoints.IsUnknown() || target.Endpoints.IsNull() {
+		target.Endpoints = types.ObjectNull(mdbcommon.EndpointsAttrTypes)
+	}
 	if target.Environment.IsUnknown() || target.Environment.IsNull() {
 		target.Environment = types.StringNull()
 	}
@@ -252,6 +261,7 @@ var yandexMdbGreenplumClusterV2DatasourceModelType = types.ObjectType{
 		"created_at":             types.StringType,
 		"deletion_protection":    types.BoolType,
 		"description":            types.StringType,
+		"endpoints":              types.ObjectType{AttrTypes: mdbcommon.EndpointsAttrTypes},
 		"environment":            types.StringType,
 		"folder_id":              types.StringType,
 		"host_group_ids":         types.SetType{ElemType: types.StringType},
@@ -292,6 +302,7 @@ func flattenYandexMdbGreenplumClusterV2Datasource(ctx context.Context,
 		CreatedAt:           types.StringValue(yandexMdbGreenplumClusterV2Datasource.GetCreatedAt().AsTime().Format(time.RFC3339)),
 		DeletionProtection:  types.BoolValue(yandexMdbGreenplumClusterV2Datasource.GetDeletionProtection()),
 		Description:         types.StringValue(yandexMdbGreenplumClusterV2Datasource.GetDescription()),
+		Endpoints:           flattenEndpoints(ctx, yandexMdbGreenplumClusterV2Datasource.GetId(), diags),
 		Environment:         types.StringValue(yandexMdbGreenplumClusterV2Datasource.GetEnvironment().String()),
 		FolderId:            types.StringValue(yandexMdbGreenplumClusterV2Datasource.GetFolderId()),
 		HostGroupIds:        flattenYandexMdbGreenplumClusterV2HostGroupIds(ctx, yandexMdbGreenplumClusterV2Datasource.GetHostGroupIds(), state.HostGroupIds, diags),
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/mdbcommon"
 )
 
 func YandexMdbGreenplumClusterV2DatasourceSchema(ctx context.Context) schema.Schema {
@@ -887,6 +888,8 @@ func YandexMdbGreenplumClusterV2DatasourceSchema(ctx context.Context) schema.Sch
 				Computed: true,
 			},
 
+			"endpoints": mdbcommon.EndpointsDataSourceSchema(),
+
 			"description": schema.StringAttribute{
 				MarkdownDescription: "Description of the Greenplum® cluster.",
 				Description: "Description of the Greenplum® cluster." +
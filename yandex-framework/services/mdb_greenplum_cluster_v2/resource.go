@@ -22,6 +22,7 @@ import (
 	"github.com/yandex-cloud/terraform-provider-yandex/pkg/converter"
 	"github.com/yandex-cloud/terraform-provider-yandex/pkg/datasize"
 	"github.com/yandex-cloud/terraform-provider-yandex/pkg/mdbcommon"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/retry"
 	"github.com/yandex-cloud/terraform-provider-yandex/pkg/validate"
 	utils "github.com/yandex-cloud/terraform-provider-yandex/pkg/wrappers"
 	providerconfig "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
@@ -422,6 +423,12 @@ func (r *clusterResource) Delete(ctx context.Context, req resource.DeleteRequest
 	if !state.ID.IsUnknown() && !state.ID.IsNull() {
 		id = state.ID.ValueString()
 	}
+	if state.FinalBackup.ValueBool() {
+		r.backupCluster(ctx, &resp.Diagnostics, id)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 	reqApi.SetClusterId(id)
 	tflog.Debug(ctx, fmt.Sprintf("Delete cluster request: %s", validate.ProtoDump(reqApi)))
 
@@ -454,6 +461,35 @@ func (r *clusterResource) Delete(ctx context.Context, req resource.DeleteRequest
 	tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Delete cluster response: %s", validate.ProtoDump(deleteRes)))
 }
 
+func (r *clusterResource) backupCluster(ctx context.Context, diags *diag.Diagnostics, cid string) {
+	reqApi := &greenplum.BackupClusterRequest{}
+	reqApi.SetClusterId(cid)
+	tflog.Debug(ctx, fmt.Sprintf("Backup cluster request: %s", validate.ProtoDump(reqApi)))
+
+	// The cluster may still run the operation of the previous update.
+	op, err := retry.ConflictingOperationV2(ctx, r.providerConfig.SDKv2, func() (*greenplumsdk.ClusterBackupOperation, error) {
+		return greenplumsdk.NewClusterClient(r.providerConfig.SDKv2).Backup(ctx, reqApi)
+	})
+	if err != nil {
+		diags.AddError(
+			"Failed to Backup resource",
+			"Error while requesting API to backup cluster:"+err.Error(),
+		)
+		return
+	}
+	backupRes, err := op.Wait(ctx)
+	if err != nil {
+		diags.AddError(
+			"Unable to Backup Resource",
+			fmt.Sprintf("An unexpected error occurred while waiting longrunning response. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"Error: %s", err),
+		)
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("[DEBUG] Backup cluster response: %s", validate.ProtoDump(backupRes)))
+}
+
 func (r *clusterResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
 		return
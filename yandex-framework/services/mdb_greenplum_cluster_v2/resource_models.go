@@ -179,7 +179,9 @@ type yandexMdbGreenplumClusterV2Model struct {
 	Config                types.Object   `tfsdk:"config"`
 	CreatedAt             types.String   `tfsdk:"created_at"`
 	DeletionProtection    types.Bool     `tfsdk:"deletion_protection"`
+	FinalBackup           types.Bool     `tfsdk:"final_backup"`
 	Description           types.String   `tfsdk:"description"`
+	Endpoints             types.Object   `tfsdk:"endpoints"`
 	Environment           types.String   `tfsdk:"environment"`
 	FolderId              types.String   `tfsdk:"folder_id"`
 	HostGroupIds          types.Set      `tfsdk:"host_group_ids"`
@@ -227,9 +229,15 @@ func (m *yandexMdbGreenplumClusterV2Model) GetCreatedAt() types.String {
 func (m *yandexMdbGreenplumClusterV2Model) GetDeletionProtection() types.Bool {
 	return m.DeletionProtection
 }
+func (m *yandexMdbGreenplumClusterV2Model) GetFinalBackup() types.Bool {
+	return m.FinalBackup
+}
 func (m *yandexMdbGreenplumClusterV2Model) GetDescription() types.String {
 	return m.Description
 }
+func (m *yandexMdbGreenplumClusterV2Model) GetEndpoints() types.Object {
+	return m.Endpoints
+}
 func (m *yandexMdbGreenplumClusterV2Model) GetEnvironment() types.String {
 	return m.Environment
 }
@@ -309,7 +317,9 @@ func NewYandexMdbGreenplumClusterV2Model() yandexMdbGreenplumClusterV2Model {
 		Config:                types.ObjectNull(yandexMdbGreenplumClusterV2ConfigModelType.AttrTypes),
 		CreatedAt:             types.StringNull(),
 		DeletionProtection:    types.BoolNull(),
+		FinalBackup:           types.BoolNull(),
 		Description:           types.StringNull(),
+		Endpoints:             types.ObjectNull(mdbcommon.EndpointsAttrTypes),
 		Environment:           types.StringNull(),
 		FolderId:              types.StringNull(),
 		HostGroupIds:          types.SetNull(types.StringType),
@@ -358,9 +368,15 @@ func yandexMdbGreenplumClusterV2ModelFillUnknown(target yandexMdbGreenplumCluste
 	if target.DeletionProtection.IsUnknown() || target.DeletionProtection.IsNull() {
 		target.DeletionProtection = types.BoolNull()
 	}
+	if target.FinalBackup.IsUnknown() || target.FinalBackup.IsNull() {
+		target.FinalBackup = types.BoolNull()
+	}
 	if target.Description.IsUnknown() || target.Description.IsNull() {
 		target.Description = types.StringNull()
 	}
+	if target.Endpoints.IsUnknown() || target.Endpoints.IsNull() {
+		target.Endpoints = types.ObjectNull(mdbcommon.EndpointsAttrTypes)
+	}
 	if target.Environment.IsUnknown() || target.Environment.IsNull() {
 		target.Environment = types.StringNull()
 	}
@@ -443,7 +459,9 @@ var yandexMdbGreenplumClusterV2ModelType = types.ObjectType{
 		"config":                   yandexMdbGreenplumClusterV2ConfigModelType,
 		"created_at":               types.StringType,
 		"deletion_protection":      types.BoolType,
+		"final_backup":             types.BoolType,
 		"description":              types.StringType,
+		"endpoints":                types.ObjectType{AttrTypes: mdbcommon.EndpointsAttrTypes},
 		"environment":              types.StringType,
 		"folder_id":                types.StringType,
 		"host_group_ids":           types.SetType{ElemType: types.StringType},
@@ -487,7 +505,9 @@ func flattenYandexMdbGreenplumClusterV2(ctx context.Context,
 		Config:                flattenYandexMdbGreenplumClusterV2Config(ctx, yandexMdbGreenplumClusterV2.GetConfig(), converter.ExpandObject(ctx, state.Config, yandexMdbGreenplumClusterV2ConfigModel{}, diags).(yandexMdbGreenplumClusterV2ConfigModel), diags),
 		CreatedAt:             types.StringValue(yandexMdbGreenplumClusterV2.GetCreatedAt().AsTime().Format(time.RFC3339)),
 		DeletionProtection:    types.BoolValue(yandexMdbGreenplumClusterV2.GetDeletionProtection()),
+		FinalBackup:           state.FinalBackup,
 		Description:           types.StringValue(yandexMdbGreenplumClusterV2.GetDescription()),
+		Endpoints:             flattenEndpoints(ctx, yandexMdbGreenplumClusterV2.GetId(), diags),
 		Environment:           flattenEnum(yandexMdbGreenplumClusterV2.GetEnvironment()),
 		FolderId:              types.StringValue(yandexMdbGreenplumClusterV2.GetFolderId()),
 		HostGroupIds:          flattenYandexMdbGreenplumClusterV2HostGroupIds(ctx, yandexMdbGreenplumClusterV2.GetHostGroupIds(), state.HostGroupIds, diags),
@@ -515,6 +535,11 @@ func flattenYandexMdbGreenplumClusterV2(ctx context.Context,
 	}
 }
 
+// flattenEndpoints returns the endpoints of the cluster, the special FQDN points to the primary master.
+func flattenEndpoints(ctx context.Context, cid string, diags *diag.Diagnostics) types.Object {
+	return mdbcommon.FlattenEndpoints(ctx, mdbcommon.HostPort(mdbcommon.ClusterReadWriteHost(cid), mdbcommon.GreenplumPort), "", nil, diags)
+}
+
 type yandexMdbGreenplumClusterV2RestoreModel struct {
 	BackupId      types.String `tfsdk:"backup_id"`
 	TimeInclusive types.Bool   `tfsdk:"time_inclusive"`
@@ -26,6 +26,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/greenplum/v1"
+	"github.com/yandex-cloud/terraform-provider-yandex/common/defaultschema"
 	"github.com/yandex-cloud/terraform-provider-yandex/pkg/converter"
 	"github.com/yandex-cloud/terraform-provider-yandex/pkg/mdbcommon"
 	"github.com/yandex-cloud/terraform-provider-yandex/pkg/planmodifiers"
@@ -1605,6 +1606,10 @@ func YandexMdbGreenplumClusterV2ResourceSchema(ctx context.Context) schema.Schem
 				},
 			},
 
+			"final_backup": defaultschema.FinalBackup(),
+
+			"endpoints": mdbcommon.EndpointsSchema(),
+
 			"description": schema.StringAttribute{
 				MarkdownDescription: "Description of the Greenplum® cluster.",
 				Description: "Description of the Greenplum® cluster." +
@@ -0,0 +1,175 @@
+package mdb_kafka_cluster_v2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/kafka/v1"
+	kafkasdk "github.com/yandex-cloud/go-sdk/services/mdb/kafka/v1"
+	ycsdk "github.com/yandex-cloud/go-sdk/v2"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/retry"
+)
+
+const defaultMDBPageSize = 1000
+
+var kafkaApi = KafkaAPI{}
+
+type KafkaAPI struct{}
+
+func (r *KafkaAPI) ListHosts(ctx context.Context, sdk *ycsdk.SDK, diags *diag.Diagnostics, cid string) []*kafka.Host {
+	hosts := []*kafka.Host{}
+	pageToken := ""
+
+	for {
+		resp, err := kafkasdk.NewClusterClient(sdk).ListHosts(ctx, &kafka.ListClusterHostsRequest{
+			ClusterId: cid,
+			PageSize:  defaultMDBPageSize,
+			PageToken: pageToken,
+		})
+		if err != nil {
+			diags.AddError(
+				"Failed to List Kafka Hosts",
+				fmt.Sprintf("Error while requesting API to list hosts of Kafka cluster %q: %s", cid, err.Error()),
+			)
+			return nil
+		}
+
+		hosts = append(hosts, resp.Hosts...)
+
+		if resp.NextPageToken == "" {
+			break
+		}
+		pageToken = resp.NextPageToken
+	}
+
+	return hosts
+}
+
+func (r *KafkaAPI) GetCluster(ctx context.Context, sdk *ycsdk.SDK, diags *diag.Diagnostics, cid string) *kafka.Cluster {
+	cluster, err := kafkasdk.NewClusterClient(sdk).Get(ctx, &kafka.GetClusterRequest{
+		ClusterId: cid,
+	})
+	if err != nil {
+		diags.AddError(
+			"Failed to read resource",
+			fmt.Sprintf("Error while requesting API to read Kafka cluster %q: %s", cid, err.Error()),
+		)
+		return nil
+	}
+	return cluster
+}
+
+func (r *KafkaAPI) CreateCluster(ctx context.Context, sdk *ycsdk.SDK, diags *diag.Diagnostics, req *kafka.CreateClusterRequest) string {
+	op, err := kafkasdk.NewClusterClient(sdk).Create(ctx, req)
+	if err != nil {
+		diags.AddError(
+			"Failed to create resource",
+			fmt.Sprintf("Error while requesting API to create Kafka cluster: %s", err.Error()),
+		)
+		return ""
+	}
+
+	md := op.Metadata()
+
+	tflog.Debug(ctx, "Creating Kafka Cluster", map[string]any{"request_body": req})
+
+	if _, err = op.Wait(ctx); err != nil {
+		diags.AddError(
+			"Failed to create resource",
+			fmt.Sprintf("Error while waiting for operation %q to create Kafka cluster: %s", op.ID(), err.Error()),
+		)
+		return ""
+	}
+
+	return md.ClusterId
+}
+
+func (r *KafkaAPI) UpdateCluster(ctx context.Context, sdk *ycsdk.SDK, diags *diag.Diagnostics, req *kafka.UpdateClusterRequest) {
+	if req == nil || len(req.UpdateMask.GetPaths()) == 0 {
+		return
+	}
+
+	op, err := retry.ConflictingOperationV2(ctx, sdk, func() (*kafkasdk.ClusterUpdateOperation, error) {
+		tflog.Debug(ctx, "Updating Kafka Cluster", map[string]any{"request_body": req})
+		return kafkasdk.NewClusterClient(sdk).Update(ctx, req)
+	})
+	if err != nil {
+		diags.AddError(
+			"Failed to update resource",
+			fmt.Sprintf("Error while requesting API to update Kafka cluster %q: %s", req.ClusterId, err.Error()),
+		)
+		return
+	}
+
+	if _, err = op.Wait(ctx); err != nil {
+		diags.AddError(
+			"Failed to update resource",
+			fmt.Sprintf("Error while waiting for operation %q to update Kafka cluster %q: %s", op.ID(), req.ClusterId, err.Error()),
+		)
+	}
+}
+
+func (r *KafkaAPI) DeleteCluster(ctx context.Context, sdk *ycsdk.SDK, diags *diag.Diagnostics, cid string) {
+	op, err := kafkasdk.NewClusterClient(sdk).Delete(ctx, &kafka.DeleteClusterRequest{
+		ClusterId: cid,
+	})
+	if err != nil {
+		diags.AddError(
+			"Failed to delete resource",
+			fmt.Sprintf("Error while requesting API to delete Kafka cluster %q: %s", cid, err.Error()),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting Kafka Cluster", map[string]any{"cluster_id": cid})
+
+	if _, err = op.Wait(ctx); err != nil {
+		diags.AddError(
+			"Failed to delete resource",
+			fmt.Sprintf("Error while waiting for operation %q to delete Kafka cluster %q: %s", op.ID(), cid, err.Error()),
+		)
+	}
+}
+
+// MigrateToKRaft replaces the ZooKeeper subcluster with the KRaft-controller one.
+// The live configuration is checked first, so an interrupted apply can be retried.
+func (r *KafkaAPI) MigrateToKRaft(ctx context.Context, sdk *ycsdk.SDK, diags *diag.Diagnostics, req *kafka.UpdateClusterRequest) {
+	cluster := r.GetCluster(ctx, sdk, diags, req.GetClusterId())
+	if diags.HasError() {
+		return
+	}
+
+	live := getAPICoordinatorTypes(cluster.GetConfig())
+	if live.hasKRaft && !live.hasZooKeeper {
+		tflog.Debug(ctx, "Kafka cluster is already migrated to KRaft", map[string]any{"cluster_id": req.GetClusterId()})
+		return
+	}
+	if !live.hasZooKeeper {
+		diags.AddError(
+			"Unable to migrate Kafka cluster to KRaft",
+			fmt.Sprintf("Kafka cluster %q has no ZooKeeper subcluster to migrate.", req.GetClusterId()),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Migrating Kafka cluster to KRaft", map[string]any{"request": req})
+	op, err := retry.ConflictingOperationV2(ctx, sdk, func() (*kafkasdk.ClusterUpdateOperation, error) {
+		return kafkasdk.NewClusterClient(sdk).Update(ctx, req)
+	})
+	if err != nil {
+		diags.AddError(
+			"Failed to migrate Kafka cluster to KRaft",
+			fmt.Sprintf("Error while requesting API to migrate Kafka cluster %q to KRaft: %s", req.GetClusterId(), err.Error()),
+		)
+		return
+	}
+
+	if _, err = op.Wait(ctx); err != nil {
+		diags.AddError(
+			"Failed to migrate Kafka cluster to KRaft",
+			fmt.Sprintf("Error while waiting for operation %q to migrate Kafka cluster %q to KRaft: %s", op.ID(), req.GetClusterId(), err.Error()),
+		)
+	}
+}
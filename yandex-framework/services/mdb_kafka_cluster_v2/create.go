@@ -0,0 +1,37 @@
+package mdb_kafka_cluster_v2
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/kafka/v1"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/mdbcommon"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
+)
+
+func prepareCreateRequest(ctx context.Context, plan *Cluster, providerConfig *config.State) (*kafka.CreateClusterRequest, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+
+	request := &kafka.CreateClusterRequest{
+		FolderId:           mdbcommon.ExpandFolderId(ctx, plan.FolderId, providerConfig, &diags),
+		Name:               plan.Name.ValueString(),
+		Description:        plan.Description.ValueString(),
+		NetworkId:          plan.NetworkId.ValueString(),
+		Environment:        mdbcommon.ExpandEnvironment[kafka.Cluster_Environment](ctx, plan.Environment, &diags),
+		Labels:             mdbcommon.ExpandLabels(ctx, plan.Labels, &diags),
+		ConfigSpec:         expandConfigSpec(ctx, plan, &diags),
+		SubnetId:           expandStringList(ctx, plan.SubnetIds, &diags),
+		SecurityGroupIds:   mdbcommon.ExpandSecurityGroupIds(ctx, plan.SecurityGroupIds, &diags),
+		HostGroupIds:       expandStringSet(ctx, plan.HostGroupIds, &diags),
+		DeletionProtection: plan.DeletionProtection.ValueBool(),
+		MaintenanceWindow: mdbcommon.ExpandClusterMaintenanceWindow[
+			kafka.MaintenanceWindow,
+			kafka.WeeklyMaintenanceWindow,
+			kafka.AnytimeMaintenanceWindow,
+			kafka.WeeklyMaintenanceWindow_WeekDay,
+		](ctx, plan.MaintenanceWindow, &diags),
+		DiskEncryptionKeyId: mdbcommon.ExpandStringWrapper(ctx, plan.DiskEncryptionKeyId, &diags),
+	}
+
+	return request, diags
+}
@@ -0,0 +1,160 @@
+package mdb_kafka_cluster_v2
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/kafka/v1"
+	protobuf_adapter "github.com/yandex-cloud/terraform-provider-yandex/pkg/adapters/protobuf"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/datasize"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/kafkacommon"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/mdbcommon"
+	utils "github.com/yandex-cloud/terraform-provider-yandex/pkg/wrappers"
+)
+
+var unhandledAsEmpty = basetypes.ObjectAsOptions{
+	UnhandledNullAsEmpty:    true,
+	UnhandledUnknownAsEmpty: true,
+}
+
+func expandConfigSpec(ctx context.Context, plan *Cluster, diags *diag.Diagnostics) *kafka.ConfigSpec {
+	spec := &kafka.ConfigSpec{
+		Version:        plan.Version.ValueString(),
+		BrokersCount:   mdbcommon.ExpandInt64Wrapper(ctx, plan.BrokersCount, diags),
+		AssignPublicIp: plan.AssignPublicIp.ValueBool(),
+		SchemaRegistry: plan.SchemaRegistry.ValueBool(),
+		ZoneId:         expandStringList(ctx, plan.Zones, diags),
+		Kafka: &kafka.ConfigSpec_Kafka{
+			Resources: mdbcommon.ExpandResources[kafka.Resources](ctx, plan.Resources, diags),
+		},
+		Zookeeper:           expandZooKeeper(ctx, plan.ZooKeeper, diags),
+		Kraft:               expandKRaft(ctx, plan.KRaft, diags),
+		DiskSizeAutoscaling: expandDiskSizeAutoscaling(ctx, plan.DiskSizeAutoscaling, diags),
+	}
+
+	setKafkaConfig(ctx, spec.Kafka, plan.Version.ValueString(), plan.KafkaConfig, diags)
+	spec.SetAccess(expandAccess(ctx, plan.Access, diags))
+	spec.SetRestApiConfig(&kafka.ConfigSpec_RestAPIConfig{Enabled: expandEnabled(ctx, plan.RestAPI, diags)})
+	spec.SetKafkaUiConfig(&kafka.ConfigSpec_KafkaUIConfig{Enabled: expandEnabled(ctx, plan.KafkaUI, diags)})
+
+	return spec
+}
+
+func expandStringList(ctx context.Context, l types.List, diags *diag.Diagnostics) []string {
+	if !utils.IsPresent(l) {
+		return nil
+	}
+
+	var result []string
+	diags.Append(l.ElementsAs(ctx, &result, false)...)
+	return result
+}
+
+func expandStringSet(ctx context.Context, s types.Set, diags *diag.Diagnostics) []string {
+	if !utils.IsPresent(s) {
+		return nil
+	}
+
+	var result []string
+	diags.Append(s.ElementsAs(ctx, &result, false)...)
+	return result
+}
+
+func expandCoordinatorResources(ctx context.Context, o types.Object, diags *diag.Diagnostics) *kafka.Resources {
+	if !utils.IsPresent(o) {
+		return nil
+	}
+
+	var c Coordinator
+	diags.Append(o.As(ctx, &c, datasize.DefaultOpts)...)
+	if diags.HasError() {
+		return nil
+	}
+
+	return mdbcommon.ExpandResources[kafka.Resources](ctx, c.Resources, diags)
+}
+
+func expandZooKeeper(ctx context.Context, o types.Object, diags *diag.Diagnostics) *kafka.ConfigSpec_Zookeeper {
+	if !utils.IsPresent(o) {
+		return nil
+	}
+	return &kafka.ConfigSpec_Zookeeper{Resources: expandCoordinatorResources(ctx, o, diags)}
+}
+
+func expandKRaft(ctx context.Context, o types.Object, diags *diag.Diagnostics) *kafka.ConfigSpec_KRaft {
+	if !utils.IsPresent(o) {
+		return nil
+	}
+	return &kafka.ConfigSpec_KRaft{Resources: expandCoordinatorResources(ctx, o, diags)}
+}
+
+// Set access to default if null
+func expandAccess(ctx context.Context, o types.Object, diags *diag.Diagnostics) *kafka.Access {
+	var access Access
+	diags.Append(o.As(ctx, &access, unhandledAsEmpty)...)
+	if diags.HasError() {
+		return nil
+	}
+	return &kafka.Access{DataTransfer: access.DataTransfer.ValueBool()}
+}
+
+func expandEnabled(ctx context.Context, o types.Object, diags *diag.Diagnostics) bool {
+	var e Enabled
+	diags.Append(o.As(ctx, &e, unhandledAsEmpty)...)
+	return e.Enabled.ValueBool()
+}
+
+func expandDiskSizeAutoscaling(ctx context.Context, o types.Object, diags *diag.Diagnostics) *kafka.DiskSizeAutoscaling {
+	if !utils.IsPresent(o) {
+		return nil
+	}
+
+	var dsa DiskSizeAutoscaling
+	diags.Append(o.As(ctx, &dsa, datasize.DefaultOpts)...)
+	if diags.HasError() {
+		return nil
+	}
+
+	return &kafka.DiskSizeAutoscaling{
+		DiskSizeLimit:           datasize.ToBytes(dsa.DiskSizeLimit.ValueInt64()),
+		PlannedUsageThreshold:   dsa.PlannedUsageThreshold.ValueInt64(),
+		EmergencyUsageThreshold: dsa.EmergencyUsageThreshold.ValueInt64(),
+	}
+}
+
+// setKafkaConfig fills the versioned kafka_config_* message of the Kafka subcluster.
+func setKafkaConfig(
+	ctx context.Context,
+	spec *kafka.ConfigSpec_Kafka,
+	version string, config mdbcommon.SettingsMapValue,
+	diags *diag.Diagnostics,
+) {
+	suffix, err := kafkacommon.ConfigSuffix(version)
+	if err != nil {
+		diags.AddError("Failed to expand Kafka config.", err.Error())
+		return
+	}
+
+	a := protobuf_adapter.NewProtobufMapDataAdapter()
+	attrs := config.PrimitiveElements(ctx, diags)
+	if diags.HasError() {
+		return
+	}
+
+	switch suffix {
+	case "4":
+		cfg := &kafka.KafkaConfig4{}
+		a.Fill(ctx, cfg, attrs, diags)
+		spec.SetKafkaConfig_4(cfg)
+	case "3":
+		cfg := &kafka.KafkaConfig3{}
+		a.Fill(ctx, cfg, attrs, diags)
+		spec.SetKafkaConfig_3(cfg)
+	case "2_8":
+		cfg := &kafka.KafkaConfig2_8{}
+		a.Fill(ctx, cfg, attrs, diags)
+		spec.SetKafkaConfig_2_8(cfg)
+	}
+}
@@ -0,0 +1,145 @@
+package mdb_kafka_cluster_v2
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/kafka/v1"
+	protobuf_adapter "github.com/yandex-cloud/terraform-provider-yandex/pkg/adapters/protobuf"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/datasize"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/mdbcommon"
+)
+
+func flattenCoordinator(ctx context.Context, r *kafka.Resources, diags *diag.Diagnostics) types.Object {
+	obj, d := types.ObjectValueFrom(ctx, CoordinatorAttrTypes, Coordinator{
+		Resources: mdbcommon.FlattenResources(ctx, r, diags),
+	})
+	diags.Append(d...)
+	return obj
+}
+
+func flattenZooKeeper(ctx context.Context, zk *kafka.ConfigSpec_Zookeeper, diags *diag.Diagnostics) types.Object {
+	if zk == nil {
+		return types.ObjectNull(CoordinatorAttrTypes)
+	}
+	return flattenCoordinator(ctx, zk.GetResources(), diags)
+}
+
+func flattenKRaft(ctx context.Context, kraft *kafka.ConfigSpec_KRaft, diags *diag.Diagnostics) types.Object {
+	if kraft == nil {
+		return types.ObjectNull(CoordinatorAttrTypes)
+	}
+	return flattenCoordinator(ctx, kraft.GetResources(), diags)
+}
+
+func flattenAccess(ctx context.Context, access *kafka.Access, diags *diag.Diagnostics) types.Object {
+	if access == nil {
+		return types.ObjectNull(AccessAttrTypes)
+	}
+
+	obj, d := types.ObjectValueFrom(ctx, AccessAttrTypes, Access{
+		DataTransfer: types.BoolValue(access.DataTransfer),
+	})
+	diags.Append(d...)
+	return obj
+}
+
+func flattenEnabled(ctx context.Context, present, enabled bool, diags *diag.Diagnostics) types.Object {
+	if !present {
+		return types.ObjectNull(EnabledAttrTypes)
+	}
+
+	obj, d := types.ObjectValueFrom(ctx, EnabledAttrTypes, Enabled{
+		Enabled: types.BoolValue(enabled),
+	})
+	diags.Append(d...)
+	return obj
+}
+
+func flattenDiskSizeAutoscaling(ctx context.Context, dsa *kafka.DiskSizeAutoscaling, diags *diag.Diagnostics) types.Object {
+	if dsa == nil {
+		return types.ObjectNull(DiskSizeAutoscalingAttrTypes)
+	}
+
+	obj, d := types.ObjectValueFrom(ctx, DiskSizeAutoscalingAttrTypes, DiskSizeAutoscaling{
+		DiskSizeLimit:           types.Int64Value(datasize.ToGigabytes(dsa.GetDiskSizeLimit())),
+		PlannedUsageThreshold:   types.Int64Value(dsa.PlannedUsageThreshold),
+		EmergencyUsageThreshold: types.Int64Value(dsa.EmergencyUsageThreshold),
+	})
+	diags.Append(d...)
+	return obj
+}
+
+func flattenHosts(ctx context.Context, hosts []*kafka.Host, diags *diag.Diagnostics) types.Set {
+	result := make([]Host, 0, len(hosts))
+	for _, h := range hosts {
+		result = append(result, Host{
+			Name:           types.StringValue(h.Name),
+			ZoneId:         types.StringValue(h.ZoneId),
+			Role:           types.StringValue(h.Role.String()),
+			SubnetId:       types.StringValue(h.SubnetId),
+			AssignPublicIp: types.BoolValue(h.AssignPublicIp),
+		})
+	}
+
+	obj, d := types.SetValueFrom(ctx, hostType, result)
+	diags.Append(d...)
+	return obj
+}
+
+// flattenEndpoints returns the brokers of the cluster as the rw endpoint.
+// Kafka clusters have no special FQDNs, so the hosts are listed.
+func flattenEndpoints(ctx context.Context, hosts []*kafka.Host, diags *diag.Diagnostics) types.Object {
+	var brokers []string
+	for _, h := range hosts {
+		if h.Role == kafka.Host_KAFKA {
+			brokers = append(brokers, mdbcommon.HostPort(h.Name, mdbcommon.KafkaPort))
+		}
+	}
+	slices.Sort(brokers)
+	return mdbcommon.FlattenEndpoints(ctx, strings.Join(brokers, ","), "", nil, diags)
+}
+
+// flattenKafkaConfig extracts the versioned kafka_config_* message of the Kafka subcluster.
+// KafkaConfig messages hold the settings directly, so there is no user_config to unwrap.
+func flattenKafkaConfig(ctx context.Context, k *kafka.ConfigSpec_Kafka, diags *diag.Diagnostics) mdbcommon.SettingsMapValue {
+	var src any
+	switch {
+	case k.GetKafkaConfig_4() != nil:
+		src = k.GetKafkaConfig_4()
+	case k.GetKafkaConfig_3() != nil:
+		src = k.GetKafkaConfig_3()
+	case k.GetKafkaConfig_2_8() != nil:
+		src = k.GetKafkaConfig_2_8()
+	default:
+		mv, d := NewKafkaSettingsMapValue(map[string]attr.Value{})
+		diags.Append(d...)
+		return mv
+	}
+
+	a := protobuf_adapter.NewProtobufMapDataAdapter()
+	attrs := a.Extract(ctx, src, diags)
+	if diags.HasError() {
+		return NewKafkaSettingsMapNull()
+	}
+
+	attrsPresent := make(map[string]attr.Value)
+	for attr, val := range attrs {
+		if ok := mdbcommon.IsAttrZeroValue(val, diags); !ok {
+			attrsPresent[attr] = val
+		}
+
+		if diags.HasError() {
+			diags.AddError("Flatten Kafka Config Error", fmt.Sprintf("Can't check zero attribute %s", attr))
+		}
+	}
+
+	mv, d := NewKafkaSettingsMapValue(attrsPresent)
+	diags.Append(d...)
+	return mv
+}
@@ -0,0 +1,126 @@
+package mdb_kafka_cluster_v2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/kafka/v1"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/datasize"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/kafkacommon"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/mdbcommon"
+	utils "github.com/yandex-cloud/terraform-provider-yandex/pkg/wrappers"
+	"google.golang.org/genproto/protobuf/field_mask"
+)
+
+type coordinatorTypes struct {
+	hasZooKeeper bool
+	hasKRaft     bool
+	hasUnknown   bool
+}
+
+func getCoordinatorTypes(zookeeper, kraft types.Object) coordinatorTypes {
+	return coordinatorTypes{
+		hasZooKeeper: utils.IsPresent(zookeeper),
+		hasKRaft:     utils.IsPresent(kraft),
+		hasUnknown:   zookeeper.IsUnknown() || kraft.IsUnknown(),
+	}
+}
+
+// detectKRaftMigration reports whether the cluster has to be migrated from ZooKeeper to KRaft.
+// The coordinator blocks are computed, so the configuration is compared with the state instead of the plan.
+func detectKRaftMigration(stateTypes, configTypes coordinatorTypes, stateVersion, planVersion types.String, diags *diag.Diagnostics) bool {
+	if configTypes.hasUnknown || stateTypes.hasUnknown {
+		return false
+	}
+
+	if configTypes.hasZooKeeper && configTypes.hasKRaft {
+		diags.AddError(
+			"Invalid coordinator configuration",
+			"The zookeeper and kraft blocks cannot be configured at the same time. Replace the zookeeper block with the kraft block to migrate the cluster to KRaft.",
+		)
+		return false
+	}
+
+	if stateTypes.hasKRaft && configTypes.hasZooKeeper {
+		diags.AddError(
+			"Unsupported coordinator transition",
+			"Migration of a Kafka cluster from KRaft back to ZooKeeper is not supported.",
+		)
+		return false
+	}
+
+	version := planVersion.ValueString()
+	if planVersion.IsUnknown() || version == "" {
+		return false
+	}
+
+	if configTypes.hasZooKeeper && !kafkacommon.SupportsZooKeeper(version) {
+		diags.AddError(
+			"Unsupported coordinator configuration",
+			fmt.Sprintf("Kafka %s does not support ZooKeeper. Use the kraft block instead.", version),
+		)
+		return false
+	}
+
+	migrate := stateTypes.hasZooKeeper && configTypes.hasKRaft
+	if stateTypes.hasZooKeeper && !migrate && !planVersion.Equal(stateVersion) && !kafkacommon.SupportsZooKeeper(version) {
+		diags.AddError(
+			"Unsupported version upgrade",
+			fmt.Sprintf("Kafka %s does not support ZooKeeper. Migrate the cluster to KRaft by replacing the zookeeper block with the kraft block before the upgrade.", version),
+		)
+		return false
+	}
+
+	return migrate
+}
+
+func getAPICoordinatorTypes(cfg *kafka.ConfigSpec) coordinatorTypes {
+	return coordinatorTypes{
+		hasZooKeeper: cfg.GetZookeeper() != nil,
+		hasKRaft:     cfg.GetKraft() != nil,
+	}
+}
+
+func prepareMigrateToKRaftRequest(ctx context.Context, clusterID string, kraft types.Object, diags *diag.Diagnostics) *kafka.UpdateClusterRequest {
+	request := &kafka.UpdateClusterRequest{
+		ClusterId: clusterID,
+		ConfigSpec: &kafka.ConfigSpec{
+			Kraft: expandKRaft(ctx, kraft, diags),
+		},
+		UpdateMask: &field_mask.FieldMask{},
+	}
+	if diags.HasError() {
+		return nil
+	}
+
+	var c Coordinator
+	diags.Append(kraft.As(ctx, &c, datasize.DefaultOpts)...)
+	if diags.HasError() {
+		return nil
+	}
+
+	var r mdbcommon.Resource
+	if utils.IsPresent(c.Resources) {
+		diags.Append(c.Resources.As(ctx, &r, datasize.DefaultOpts)...)
+		if diags.HasError() {
+			return nil
+		}
+	}
+
+	if utils.IsPresent(r.ResourcePresetId) {
+		request.UpdateMask.Paths = append(request.UpdateMask.Paths, "config_spec.kraft.resources.resource_preset_id")
+	}
+	if utils.IsPresent(r.DiskSize) {
+		request.UpdateMask.Paths = append(request.UpdateMask.Paths, "config_spec.kraft.resources.disk_size")
+	}
+	if utils.IsPresent(r.DiskTypeId) {
+		request.UpdateMask.Paths = append(request.UpdateMask.Paths, "config_spec.kraft.resources.disk_type_id")
+	}
+	if len(request.UpdateMask.Paths) == 0 {
+		request.UpdateMask.Paths = append(request.UpdateMask.Paths, "config_spec.kraft")
+	}
+
+	return request
+}
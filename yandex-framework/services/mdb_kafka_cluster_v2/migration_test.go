@@ -0,0 +1,126 @@
+package mdb_kafka_cluster_v2
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/kafka/v1"
+)
+
+func TestDetectKRaftMigration(t *testing.T) {
+	t.Parallel()
+
+	zookeeper := coordinatorTypes{hasZooKeeper: true}
+	kraft := coordinatorTypes{hasKRaft: true}
+
+	tests := []struct {
+		name          string
+		stateTypes    coordinatorTypes
+		configTypes   coordinatorTypes
+		stateVersion  string
+		planVersion   string
+		wantMigration bool
+		wantError     bool
+	}{
+		{
+			name:          "ZooKeeper to KRaft",
+			stateTypes:    zookeeper,
+			configTypes:   kraft,
+			stateVersion:  "3.6",
+			planVersion:   "3.6",
+			wantMigration: true,
+		},
+		{
+			name:          "ZooKeeper to KRaft with upgrade to Kafka 4",
+			stateTypes:    zookeeper,
+			configTypes:   kraft,
+			stateVersion:  "3.9",
+			planVersion:   "4.0",
+			wantMigration: true,
+		},
+		{
+			name:         "ZooKeeper block removed from configuration",
+			stateTypes:   zookeeper,
+			stateVersion: "3.6",
+			planVersion:  "3.6",
+		},
+		{
+			name:         "KRaft topology update",
+			stateTypes:   kraft,
+			configTypes:  kraft,
+			stateVersion: "3.9",
+			planVersion:  "4.0",
+		},
+		{
+			name:        "new cluster with ZooKeeper on Kafka 4",
+			configTypes: zookeeper,
+			planVersion: "4.0",
+			wantError:   true,
+		},
+		{
+			name:         "upgrade to Kafka 4 without migration",
+			stateTypes:   zookeeper,
+			stateVersion: "3.9",
+			planVersion:  "4.0",
+			wantError:    true,
+		},
+		{
+			name:         "both coordinators configured",
+			stateTypes:   zookeeper,
+			configTypes:  coordinatorTypes{hasZooKeeper: true, hasKRaft: true},
+			stateVersion: "3.6",
+			planVersion:  "3.6",
+			wantError:    true,
+		},
+		{
+			name:         "KRaft to ZooKeeper",
+			stateTypes:   kraft,
+			configTypes:  zookeeper,
+			stateVersion: "3.6",
+			planVersion:  "3.6",
+			wantError:    true,
+		},
+		{
+			name:         "unknown configuration",
+			stateTypes:   zookeeper,
+			configTypes:  coordinatorTypes{hasUnknown: true},
+			stateVersion: "3.6",
+			planVersion:  "4.0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			stateVersion := types.StringNull()
+			if tt.stateVersion != "" {
+				stateVersion = types.StringValue(tt.stateVersion)
+			}
+
+			var diags diag.Diagnostics
+			got := detectKRaftMigration(tt.stateTypes, tt.configTypes, stateVersion, types.StringValue(tt.planVersion), &diags)
+			if diags.HasError() != tt.wantError {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if got != tt.wantMigration {
+				t.Errorf("detectKRaftMigration() = %v, want %v", got, tt.wantMigration)
+			}
+		})
+	}
+}
+
+func TestGetAPICoordinatorTypes(t *testing.T) {
+	t.Parallel()
+
+	got := getAPICoordinatorTypes(&kafka.ConfigSpec{Zookeeper: &kafka.ConfigSpec_Zookeeper{}})
+	if !got.hasZooKeeper || got.hasKRaft {
+		t.Errorf("unexpected coordinator types for ZooKeeper cluster: %+v", got)
+	}
+
+	got = getAPICoordinatorTypes(&kafka.ConfigSpec{Kraft: &kafka.ConfigSpec_KRaft{}})
+	if got.hasZooKeeper || !got.hasKRaft {
+		t.Errorf("unexpected coordinator types for KRaft cluster: %+v", got)
+	}
+}
@@ -0,0 +1,103 @@
+package mdb_kafka_cluster_v2
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/mdbcommon"
+)
+
+type Cluster struct {
+	Id                  types.String               `tfsdk:"id"`
+	FolderId            types.String               `tfsdk:"folder_id"`
+	NetworkId           types.String               `tfsdk:"network_id"`
+	Name                types.String               `tfsdk:"name"`
+	Description         types.String               `tfsdk:"description"`
+	Environment         types.String               `tfsdk:"environment"`
+	Labels              types.Map                  `tfsdk:"labels"`
+	SubnetIds           types.List                 `tfsdk:"subnet_ids"`
+	SecurityGroupIds    types.Set                  `tfsdk:"security_group_ids"`
+	HostGroupIds        types.Set                  `tfsdk:"host_group_ids"`
+	DeletionProtection  types.Bool                 `tfsdk:"deletion_protection"`
+	MaintenanceWindow   types.Object               `tfsdk:"maintenance_window"`
+	DiskEncryptionKeyId types.String               `tfsdk:"disk_encryption_key_id"`
+	Version             types.String               `tfsdk:"version"`
+	PatchVersion        types.String               `tfsdk:"patch_version"`
+	Zones               types.List                 `tfsdk:"zones"`
+	BrokersCount        types.Int64                `tfsdk:"brokers_count"`
+	AssignPublicIp      types.Bool                 `tfsdk:"assign_public_ip"`
+	SchemaRegistry      types.Bool                 `tfsdk:"schema_registry"`
+	Resources           types.Object               `tfsdk:"resources"`
+	KafkaConfig         mdbcommon.SettingsMapValue `tfsdk:"kafka_config"`
+	ZooKeeper           types.Object               `tfsdk:"zookeeper"`
+	KRaft               types.Object               `tfsdk:"kraft"`
+	Access              types.Object               `tfsdk:"access"`
+	RestAPI             types.Object               `tfsdk:"rest_api"`
+	KafkaUI             types.Object               `tfsdk:"kafka_ui"`
+	DiskSizeAutoscaling types.Object               `tfsdk:"disk_size_autoscaling"`
+	Hosts               types.Set                  `tfsdk:"hosts"`
+	Endpoints           types.Object               `tfsdk:"endpoints"`
+	Timeouts            timeouts.Value             `tfsdk:"timeouts"`
+}
+
+type Host struct {
+	Name           types.String `tfsdk:"name"`
+	ZoneId         types.String `tfsdk:"zone_id"`
+	Role           types.String `tfsdk:"role"`
+	SubnetId       types.String `tfsdk:"subnet_id"`
+	AssignPublicIp types.Bool   `tfsdk:"assign_public_ip"`
+}
+
+var hostType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"name":             types.StringType,
+		"zone_id":          types.StringType,
+		"role":             types.StringType,
+		"subnet_id":        types.StringType,
+		"assign_public_ip": types.BoolType,
+	},
+}
+
+// Coordinator is the configuration of the ZooKeeper or KRaft-controller subcluster.
+type Coordinator struct {
+	Resources types.Object `tfsdk:"resources"`
+}
+
+var CoordinatorAttrTypes = map[string]attr.Type{
+	"resources": mdbcommon.ResourceType,
+}
+
+type Access struct {
+	DataTransfer types.Bool `tfsdk:"data_transfer"`
+}
+
+var AccessAttrTypes = map[string]attr.Type{
+	"data_transfer": types.BoolType,
+}
+
+// Enabled is the model of the rest_api and kafka_ui blocks.
+type Enabled struct {
+	Enabled types.Bool `tfsdk:"enabled"`
+}
+
+var EnabledAttrTypes = map[string]attr.Type{
+	"enabled": types.BoolType,
+}
+
+type DiskSizeAutoscaling struct {
+	DiskSizeLimit           types.Int64 `tfsdk:"disk_size_limit"`
+	PlannedUsageThreshold   types.Int64 `tfsdk:"planned_usage_threshold"`
+	EmergencyUsageThreshold types.Int64 `tfsdk:"emergency_usage_threshold"`
+}
+
+var DiskSizeAutoscalingAttrTypes = map[string]attr.Type{
+	"disk_size_limit":           types.Int64Type,
+	"planned_usage_threshold":   types.Int64Type,
+	"emergency_usage_threshold": types.Int64Type,
+}
+
+var MaintenanceWindowAttrTypes = map[string]attr.Type{
+	"type": types.StringType,
+	"day":  types.StringType,
+	"hour": types.Int64Type,
+}
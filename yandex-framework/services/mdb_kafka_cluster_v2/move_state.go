@@ -0,0 +1,330 @@
+package mdb_kafka_cluster_v2
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/mdbcommon"
+)
+
+const legacyClusterTypeName = "yandex_mdb_kafka_cluster"
+
+// legacyCluster is the yandex_mdb_kafka_cluster state moved to this resource.
+// Inline topics and users are not moved: they are managed by the standalone resources,
+// the move reports their import blocks.
+type legacyCluster struct {
+	ID                  string                    `json:"id"`
+	FolderID            string                    `json:"folder_id"`
+	NetworkID           string                    `json:"network_id"`
+	Name                string                    `json:"name"`
+	Description         string                    `json:"description"`
+	Environment         string                    `json:"environment"`
+	Labels              map[string]string         `json:"labels"`
+	SubnetIDs           []string                  `json:"subnet_ids"`
+	SecurityGroupIDs    []string                  `json:"security_group_ids"`
+	HostGroupIDs        []string                  `json:"host_group_ids"`
+	DeletionProtection  bool                      `json:"deletion_protection"`
+	DiskEncryptionKeyID string                    `json:"disk_encryption_key_id"`
+	MaintenanceWindow   []legacyMaintenanceWindow `json:"maintenance_window"`
+	Hosts               []legacyHost              `json:"host"`
+	Config              []legacyConfig            `json:"config"`
+	Topics              []legacyNamed             `json:"topic"`
+	Users               []legacyNamed             `json:"user"`
+}
+
+type legacyNamed struct {
+	Name string `json:"name"`
+}
+
+type legacyMaintenanceWindow struct {
+	Type string `json:"type"`
+	Day  string `json:"day"`
+	Hour int64  `json:"hour"`
+}
+
+type legacyHost struct {
+	Name           string `json:"name"`
+	ZoneID         string `json:"zone_id"`
+	Role           string `json:"role"`
+	SubnetID       string `json:"subnet_id"`
+	AssignPublicIP bool   `json:"assign_public_ip"`
+}
+
+type legacyResources struct {
+	ResourcePresetID string `json:"resource_preset_id"`
+	DiskSize         int64  `json:"disk_size"`
+	DiskTypeID       string `json:"disk_type_id"`
+}
+
+type legacyCoordinator struct {
+	Resources []legacyResources `json:"resources"`
+}
+
+type legacyEnabled struct {
+	Enabled bool `json:"enabled"`
+}
+
+type legacyConfig struct {
+	Version        string   `json:"version"`
+	PatchVersion   string   `json:"patch_version"`
+	Zones          []string `json:"zones"`
+	BrokersCount   int64    `json:"brokers_count"`
+	AssignPublicIP bool     `json:"assign_public_ip"`
+	SchemaRegistry bool     `json:"schema_registry"`
+	Kafka          []struct {
+		Resources   []legacyResources        `json:"resources"`
+		KafkaConfig []map[string]interface{} `json:"kafka_config"`
+	} `json:"kafka"`
+	Zookeeper []legacyCoordinator `json:"zookeeper"`
+	Kraft     []legacyCoordinator `json:"kraft"`
+	Access    []struct {
+		DataTransfer bool `json:"data_transfer"`
+	} `json:"access"`
+	RestAPI             []legacyEnabled `json:"rest_api"`
+	KafkaUI             []legacyEnabled `json:"kafka_ui"`
+	DiskSizeAutoscaling []struct {
+		DiskSizeLimit           int64 `json:"disk_size_limit"`
+		PlannedUsageThreshold   int64 `json:"planned_usage_threshold"`
+		EmergencyUsageThreshold int64 `json:"emergency_usage_threshold"`
+	} `json:"disk_size_autoscaling"`
+}
+
+func (r *clusterResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		{
+			StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+				if req.SourceTypeName != legacyClusterTypeName || req.SourceRawState == nil {
+					return
+				}
+
+				var legacy legacyCluster
+				if err := json.Unmarshal(req.SourceRawState.JSON, &legacy); err != nil {
+					resp.Diagnostics.AddError(
+						"Unable to Move Resource State",
+						fmt.Sprintf("Error while decoding the state of %s: %s", legacyClusterTypeName, err.Error()),
+					)
+					return
+				}
+
+				state := legacyClusterToState(ctx, &legacy, &resp.Diagnostics)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				resp.Diagnostics.Append(resp.TargetState.Set(ctx, state)...)
+			},
+		},
+	}
+}
+
+func legacyClusterToState(ctx context.Context, legacy *legacyCluster, diags *diag.Diagnostics) *Cluster {
+	if len(legacy.Config) == 0 {
+		diags.AddError(
+			"Unable to Move Resource State",
+			fmt.Sprintf("The state of %s %q has no config block", legacyClusterTypeName, legacy.ID),
+		)
+		return nil
+	}
+	cfg := legacy.Config[0]
+
+	state := &Cluster{
+		Id:                  types.StringValue(legacy.ID),
+		FolderId:            types.StringValue(legacy.FolderID),
+		NetworkId:           types.StringValue(legacy.NetworkID),
+		Name:                types.StringValue(legacy.Name),
+		Description:         types.StringValue(legacy.Description),
+		Environment:         types.StringValue(legacy.Environment),
+		Labels:              mdbcommon.FlattenMapString(ctx, legacy.Labels, diags),
+		SubnetIds:           types.ListNull(types.StringType),
+		SecurityGroupIds:    mdbcommon.FlattenSetString(ctx, legacy.SecurityGroupIDs, diags),
+		HostGroupIds:        mdbcommon.FlattenSetString(ctx, legacy.HostGroupIDs, diags),
+		DeletionProtection:  types.BoolValue(legacy.DeletionProtection),
+		MaintenanceWindow:   legacyMaintenanceWindowToState(ctx, legacy.MaintenanceWindow, diags),
+		DiskEncryptionKeyId: mdbcommon.FlattenStringOrNull(legacy.DiskEncryptionKeyID),
+		Version:             types.StringValue(cfg.Version),
+		PatchVersion:        types.StringValue(cfg.PatchVersion),
+		Zones:               mdbcommon.FlattenListString(ctx, cfg.Zones, diags),
+		BrokersCount:        types.Int64Value(cfg.BrokersCount),
+		AssignPublicIp:      types.BoolValue(cfg.AssignPublicIP),
+		SchemaRegistry:      types.BoolValue(cfg.SchemaRegistry),
+		Resources:           types.ObjectNull(mdbcommon.ResourceType.AttrTypes),
+		KafkaConfig:         NewKafkaSettingsMapNull(),
+		ZooKeeper:           legacyCoordinatorToState(ctx, cfg.Zookeeper, diags),
+		KRaft:               legacyCoordinatorToState(ctx, cfg.Kraft, diags),
+		Access:              types.ObjectNull(AccessAttrTypes),
+		RestAPI:             legacyEnabledToState(ctx, cfg.RestAPI, diags),
+		KafkaUI:             legacyEnabledToState(ctx, cfg.KafkaUI, diags),
+		DiskSizeAutoscaling: types.ObjectNull(DiskSizeAutoscalingAttrTypes),
+		Hosts:               legacyHostsToState(ctx, legacy.Hosts, diags),
+		Endpoints:           types.ObjectNull(mdbcommon.EndpointsAttrTypes),
+		Timeouts: timeouts.Value{
+			Object: types.ObjectNull(map[string]attr.Type{
+				"create": types.StringType,
+				"update": types.StringType,
+				"delete": types.StringType,
+			}),
+		},
+	}
+
+	if len(legacy.SubnetIDs) > 0 {
+		state.SubnetIds = mdbcommon.FlattenListString(ctx, legacy.SubnetIDs, diags)
+	}
+
+	if len(cfg.Kafka) > 0 {
+		state.Resources = legacyResourcesToState(ctx, cfg.Kafka[0].Resources, diags)
+		if len(cfg.Kafka[0].KafkaConfig) > 0 {
+			state.KafkaConfig = legacyKafkaConfigToState(cfg.Kafka[0].KafkaConfig[0], diags)
+		}
+	}
+
+	if len(cfg.Access) > 0 {
+		obj, d := types.ObjectValueFrom(ctx, AccessAttrTypes, Access{
+			DataTransfer: types.BoolValue(cfg.Access[0].DataTransfer),
+		})
+		diags.Append(d...)
+		state.Access = obj
+	}
+
+	blocks := make([]mdbcommon.ImportBlock, 0, len(legacy.Topics)+len(legacy.Users))
+	for _, t := range legacy.Topics {
+		blocks = append(blocks, mdbcommon.ImportBlock{ResourceType: "yandex_mdb_kafka_topic", Name: t.Name, ID: legacy.ID + ":" + t.Name})
+	}
+	for _, u := range legacy.Users {
+		blocks = append(blocks, mdbcommon.ImportBlock{ResourceType: "yandex_mdb_kafka_user", Name: u.Name, ID: legacy.ID + ":" + u.Name})
+	}
+	mdbcommon.AddNotMovedWarning(diags, fmt.Sprintf("%s %q", legacyClusterTypeName, legacy.ID), "topic and user blocks", blocks)
+
+	if len(cfg.DiskSizeAutoscaling) > 0 {
+		dsa := cfg.DiskSizeAutoscaling[0]
+		obj, d := types.ObjectValueFrom(ctx, DiskSizeAutoscalingAttrTypes, DiskSizeAutoscaling{
+			DiskSizeLimit:           types.Int64Value(dsa.DiskSizeLimit),
+			PlannedUsageThreshold:   types.Int64Value(dsa.PlannedUsageThreshold),
+			EmergencyUsageThreshold: types.Int64Value(dsa.EmergencyUsageThreshold),
+		})
+		diags.Append(d...)
+		state.DiskSizeAutoscaling = obj
+	}
+
+	return state
+}
+
+func legacyResourcesToState(ctx context.Context, resources []legacyResources, diags *diag.Diagnostics) types.Object {
+	if len(resources) == 0 {
+		return types.ObjectNull(mdbcommon.ResourceType.AttrTypes)
+	}
+
+	obj, d := types.ObjectValueFrom(ctx, mdbcommon.ResourceType.AttrTypes, mdbcommon.Resource{
+		ResourcePresetId: types.StringValue(resources[0].ResourcePresetID),
+		DiskSize:         types.Int64Value(resources[0].DiskSize),
+		DiskTypeId:       types.StringValue(resources[0].DiskTypeID),
+	})
+	diags.Append(d...)
+	return obj
+}
+
+func legacyCoordinatorToState(ctx context.Context, coordinator []legacyCoordinator, diags *diag.Diagnostics) types.Object {
+	if len(coordinator) == 0 {
+		return types.ObjectNull(CoordinatorAttrTypes)
+	}
+
+	obj, d := types.ObjectValueFrom(ctx, CoordinatorAttrTypes, Coordinator{
+		Resources: legacyResourcesToState(ctx, coordinator[0].Resources, diags),
+	})
+	diags.Append(d...)
+	return obj
+}
+
+func legacyEnabledToState(ctx context.Context, e []legacyEnabled, diags *diag.Diagnostics) types.Object {
+	if len(e) == 0 {
+		return types.ObjectNull(EnabledAttrTypes)
+	}
+
+	obj, d := types.ObjectValueFrom(ctx, EnabledAttrTypes, Enabled{
+		Enabled: types.BoolValue(e[0].Enabled),
+	})
+	diags.Append(d...)
+	return obj
+}
+
+func legacyMaintenanceWindowToState(ctx context.Context, mw []legacyMaintenanceWindow, diags *diag.Diagnostics) types.Object {
+	if len(mw) == 0 {
+		return types.ObjectNull(MaintenanceWindowAttrTypes)
+	}
+
+	day, hour := types.StringNull(), types.Int64Null()
+	if mw[0].Type == "WEEKLY" {
+		day, hour = types.StringValue(mw[0].Day), types.Int64Value(mw[0].Hour)
+	}
+
+	obj, d := types.ObjectValue(MaintenanceWindowAttrTypes, map[string]attr.Value{
+		"type": types.StringValue(mw[0].Type),
+		"day":  day,
+		"hour": hour,
+	})
+	diags.Append(d...)
+	return obj
+}
+
+func legacyHostsToState(ctx context.Context, hosts []legacyHost, diags *diag.Diagnostics) types.Set {
+	result := make([]Host, 0, len(hosts))
+	for _, h := range hosts {
+		result = append(result, Host{
+			Name:           types.StringValue(h.Name),
+			ZoneId:         types.StringValue(h.ZoneID),
+			Role:           types.StringValue(h.Role),
+			SubnetId:       types.StringValue(h.SubnetID),
+			AssignPublicIp: types.BoolValue(h.AssignPublicIP),
+		})
+	}
+
+	obj, d := types.SetValueFrom(ctx, hostType, result)
+	diags.Append(d...)
+	return obj
+}
+
+// legacyKafkaConfigToState converts the kafka_config block to the settings map.
+// Unset values are stored by SDKv2 as empty strings, false or empty lists, so they are skipped.
+func legacyKafkaConfigToState(config map[string]interface{}, diags *diag.Diagnostics) mdbcommon.SettingsMapValue {
+	elements := make(map[string]attr.Value)
+	for name, value := range config {
+		if name == "log_preallocate" {
+			// Deprecated and not supported by the API anymore.
+			continue
+		}
+
+		switch v := value.(type) {
+		case string:
+			if v != "" {
+				elements[name] = types.StringValue(v)
+			}
+		case bool:
+			if v {
+				elements[name] = types.StringValue(strconv.FormatBool(v))
+			}
+		case []interface{}:
+			values := make([]string, 0, len(v))
+			for _, el := range v {
+				if s, ok := el.(string); ok && s != "" {
+					values = append(values, s)
+				}
+			}
+			if len(values) > 0 {
+				sort.Strings(values)
+				elements[name] = types.StringValue(strings.Join(values, ","))
+			}
+		}
+	}
+
+	mv, d := NewKafkaSettingsMapValue(elements)
+	diags.Append(d...)
+	return mv
+}
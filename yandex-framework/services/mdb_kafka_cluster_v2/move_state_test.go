@@ -0,0 +1,131 @@
+package mdb_kafka_cluster_v2
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const legacyClusterJSON = `{
+	"id": "cid",
+	"folder_id": "folder",
+	"network_id": "network",
+	"name": "kafka",
+	"description": "",
+	"environment": "PRODUCTION",
+	"labels": {"env": "test"},
+	"subnet_ids": [],
+	"security_group_ids": ["sg"],
+	"host_group_ids": [],
+	"deletion_protection": true,
+	"disk_encryption_key_id": "",
+	"maintenance_window": [{"type": "WEEKLY", "day": "MON", "hour": 5}],
+	"host": [
+		{"name": "kafka-a", "zone_id": "ru-central1-a", "role": "KAFKA", "health": "ALIVE", "subnet_id": "subnet", "assign_public_ip": false}
+	],
+	"topic": [{"name": "inline"}],
+	"user": [{"name": "inline"}],
+	"config": [{
+		"version": "3.6",
+		"patch_version": "3.6.2",
+		"zones": ["ru-central1-a"],
+		"brokers_count": 1,
+		"assign_public_ip": false,
+		"schema_registry": true,
+		"kafka": [{
+			"resources": [{"resource_preset_id": "s2.micro", "disk_size": 16, "disk_type_id": "network-ssd"}],
+			"kafka_config": [{
+				"compression_type": "COMPRESSION_TYPE_ZSTD",
+				"log_retention_ms": "86400000",
+				"log_segment_bytes": "",
+				"log_preallocate": true,
+				"auto_create_topics_enable": true,
+				"ssl_cipher_suites": [],
+				"sasl_enabled_mechanisms": ["SASL_MECHANISM_SCRAM_SHA_512", "SASL_MECHANISM_SCRAM_SHA_256"]
+			}]
+		}],
+		"zookeeper": [{"resources": [{"resource_preset_id": "s2.micro", "disk_size": 20, "disk_type_id": "network-ssd"}]}],
+		"kraft": [],
+		"access": [{"data_transfer": true}],
+		"rest_api": [{"enabled": false}],
+		"kafka_ui": [],
+		"disk_size_autoscaling": []
+	}]
+}`
+
+func TestLegacyClusterToState(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	var legacy legacyCluster
+	if err := json.Unmarshal([]byte(legacyClusterJSON), &legacy); err != nil {
+		t.Fatalf("failed to decode legacy state: %v", err)
+	}
+
+	var diags diag.Diagnostics
+	state := legacyClusterToState(ctx, &legacy, &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if state.Version.ValueString() != "3.6" || state.PatchVersion.ValueString() != "3.6.2" {
+		t.Errorf("unexpected version: %s (%s)", state.Version, state.PatchVersion)
+	}
+	if !state.SubnetIds.IsNull() {
+		t.Errorf("empty subnet_ids should be moved as null, got %s", state.SubnetIds)
+	}
+	if !state.DiskEncryptionKeyId.IsNull() {
+		t.Errorf("empty disk_encryption_key_id should be moved as null, got %s", state.DiskEncryptionKeyId)
+	}
+	if state.ZooKeeper.IsNull() || !state.KRaft.IsNull() {
+		t.Errorf("unexpected coordinators: zookeeper %s, kraft %s", state.ZooKeeper, state.KRaft)
+	}
+	if !state.KafkaUI.IsNull() || !state.DiskSizeAutoscaling.IsNull() {
+		t.Errorf("absent blocks should be moved as null: kafka_ui %s, disk_size_autoscaling %s", state.KafkaUI, state.DiskSizeAutoscaling)
+	}
+	if len(state.Hosts.Elements()) != 1 {
+		t.Errorf("unexpected hosts: %s", state.Hosts)
+	}
+	if diags.WarningsCount() != 1 {
+		t.Fatalf("expected a warning about inline topics and users, got %v", diags)
+	}
+	for _, block := range []string{
+		"to = yandex_mdb_kafka_topic.inline\n  id = \"cid:inline\"",
+		"to = yandex_mdb_kafka_user.inline\n  id = \"cid:inline\"",
+	} {
+		if !strings.Contains(diags[0].Detail(), block) {
+			t.Errorf("warning %q does not contain %q", diags[0].Detail(), block)
+		}
+	}
+
+	expectedConfig := map[string]string{
+		"compression_type":          "COMPRESSION_TYPE_ZSTD",
+		"log_retention_ms":          "86400000",
+		"auto_create_topics_enable": "true",
+		"sasl_enabled_mechanisms":   "SASL_MECHANISM_SCRAM_SHA_256,SASL_MECHANISM_SCRAM_SHA_512",
+	}
+	elements := state.KafkaConfig.Elements()
+	if len(elements) != len(expectedConfig) {
+		t.Fatalf("unexpected kafka_config: %s", state.KafkaConfig)
+	}
+	for name, value := range expectedConfig {
+		if !elements[name].Equal(types.StringValue(value)) {
+			t.Errorf("unexpected kafka_config.%s: %s, want %q", name, elements[name], value)
+		}
+	}
+}
+
+func TestLegacyClusterToStateWithoutConfig(t *testing.T) {
+	t.Parallel()
+
+	var diags diag.Diagnostics
+	legacyClusterToState(context.Background(), &legacyCluster{ID: "cid"}, &diags)
+	if !diags.HasError() {
+		t.Error("expected error for the state without config block")
+	}
+}
@@ -0,0 +1,663 @@
+package mdb_kafka_cluster_v2
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/kafka/v1"
+	"github.com/yandex-cloud/terraform-provider-yandex/common"
+	"github.com/yandex-cloud/terraform-provider-yandex/common/defaultschema"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/mdbcommon"
+	utils "github.com/yandex-cloud/terraform-provider-yandex/pkg/wrappers"
+	provider_config "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
+)
+
+const (
+	yandexMDBKafkaClusterDefaultTimeout = 30 * time.Minute
+	yandexMDBKafkaClusterUpdateTimeout  = 60 * time.Minute
+)
+
+var (
+	_ resource.Resource                   = &clusterResource{}
+	_ resource.ResourceWithImportState    = &clusterResource{}
+	_ resource.ResourceWithModifyPlan     = &clusterResource{}
+	_ resource.ResourceWithMoveState      = &clusterResource{}
+	_ resource.ResourceWithValidateConfig = &clusterResource{}
+)
+
+type clusterResource struct {
+	providerConfig *provider_config.Config
+}
+
+func NewKafkaClusterResourceV2() resource.Resource {
+	return &clusterResource{}
+}
+
+func (r *clusterResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mdb_kafka_cluster_v2"
+}
+
+func (r *clusterResource) Configure(_ context.Context,
+	req resource.ConfigureRequest, resp *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerConfig, ok := req.ProviderData.(*provider_config.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider_config.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.providerConfig = providerConfig
+}
+
+func coordinatorSchema(description, hosts string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: description,
+		Optional:    true,
+		Computed:    true,
+		PlanModifiers: []planmodifier.Object{
+			objectplanmodifier.UseStateForUnknown(),
+		},
+		Attributes: map[string]schema.Attribute{
+			"resources": schema.SingleNestedAttribute{
+				Description: fmt.Sprintf("Resources allocated to hosts of the %s subcluster.", hosts),
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"resource_preset_id": schema.StringAttribute{
+						Description: fmt.Sprintf("The ID of the preset for computational resources available to a %s host (CPU, memory etc.). For more information, see [the official documentation](https://yandex.cloud/docs/managed-kafka/concepts).", hosts),
+						Optional:    true,
+						Computed:    true,
+					},
+					"disk_size": schema.Int64Attribute{
+						Description: fmt.Sprintf("Volume of the storage available to a %s host, in gigabytes.", hosts),
+						Optional:    true,
+						Computed:    true,
+					},
+					"disk_type_id": schema.StringAttribute{
+						Description: fmt.Sprintf("Type of the storage of %s hosts. For more information see [the official documentation](https://yandex.cloud/docs/managed-kafka/concepts/storage).", hosts),
+						Optional:    true,
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func enabledSchema(description, enabledDescription string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: description,
+		Optional:    true,
+		Computed:    true,
+		PlanModifiers: []planmodifier.Object{
+			objectplanmodifier.UseStateForUnknown(),
+		},
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				Description: enabledDescription,
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+func (r *clusterResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Kafka cluster within the Yandex Cloud. For more information, see [the official documentation](https://yandex.cloud/docs/managed-kafka/concepts).\n\n" +
+			"~> Topics and users are not managed by this resource. Use `yandex_mdb_kafka_topic` and `yandex_mdb_kafka_user` instead.\n\n" +
+			"~> Managed Service for Apache Kafka® has no cluster backups, so unlike the other `_v2` cluster resources this resource has no `final_backup` attribute.\n",
+		Attributes: map[string]schema.Attribute{
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+			"id": schema.StringAttribute{
+				Description: common.ResourceDescriptions["id"],
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the Kafka cluster. Provided by the client when the cluster is created.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the Kafka cluster.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+			},
+			"folder_id":  defaultschema.FolderId(),
+			"network_id": defaultschema.NetworkId(),
+			"environment": schema.StringAttribute{
+				Description: "Deployment environment of the Kafka cluster. Can be either `PRESTABLE` or `PRODUCTION`. The default is `PRODUCTION`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("PRODUCTION"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("PRODUCTION", "PRESTABLE"),
+				},
+			},
+			"labels": defaultschema.Labels(),
+			"subnet_ids": schema.ListAttribute{
+				Description: "IDs of the subnets, to which the Kafka cluster belongs.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"security_group_ids": defaultschema.SecurityGroupIds(),
+			"host_group_ids": schema.SetAttribute{
+				Description: "A list of IDs of the host groups to place VMs of the cluster on.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			"deletion_protection": defaultschema.DeletionProtection(),
+			"disk_encryption_key_id": schema.StringAttribute{
+				Description: "ID of the KMS key to encrypt cluster disks.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"version": schema.StringAttribute{
+				Description: "Version of the Kafka server software.",
+				Required:    true,
+				Validators: []validator.String{
+					NewVersionValidator(),
+				},
+			},
+			"patch_version": schema.StringAttribute{
+				Description: "Patch version of the Kafka server software.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"zones": schema.ListAttribute{
+				Description: "List of availability zones.",
+				ElementType: types.StringType,
+				Required:    true,
+			},
+			"brokers_count": schema.Int64Attribute{
+				Description: "Count of brokers per availability zone. The default is `1`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(1),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"assign_public_ip": schema.BoolAttribute{
+				Description: "Determines whether each broker will be assigned a public IP address. The default is `false`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"schema_registry": schema.BoolAttribute{
+				Description: "Enables managed schema registry on cluster. The default is `false`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"kafka_config": schema.MapAttribute{
+				CustomType:  NewKafkaSettingsMapType(),
+				Optional:    true,
+				Computed:    true,
+				Description: "User-defined settings for the Kafka cluster. For detailed information specific to your Kafka version, please refer to the [API proto specifications](https://github.com/yandex-cloud/cloudapi/tree/master/yandex/cloud/mdb/kafka/v1/cluster.proto). Sets are passed as comma-separated strings, e.g. `sasl_enabled_mechanisms = \"SASL_MECHANISM_SCRAM_SHA_256,SASL_MECHANISM_SCRAM_SHA_512\"`.",
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"zookeeper": coordinatorSchema("Configuration of the ZooKeeper subcluster. Not supported since Kafka 4.0. Replace it with the `kraft` block to migrate the cluster to KRaft.", "ZooKeeper"),
+			"kraft":     coordinatorSchema("Configuration of the KRaft-controller subcluster.", "KRaft-controller"),
+			"access": schema.SingleNestedAttribute{
+				Description: "Access policy to the Kafka cluster.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"data_transfer": schema.BoolAttribute{
+						Description: "Allow access for [DataTransfer](https://yandex.cloud/services/data-transfer).",
+						Optional:    true,
+						Computed:    true,
+						Default:     booldefault.StaticBool(false),
+					},
+				},
+			},
+			"rest_api": enabledSchema("REST API settings of the Kafka cluster.", "Enables REST API on cluster. The default is `false`."),
+			"kafka_ui": enabledSchema("KAFKA UI settings of the Kafka cluster.", "Enables KAFKA UI on cluster. The default is `false`."),
+			"disk_size_autoscaling": schema.SingleNestedAttribute{
+				Description: "Disk autoscaling settings of the Kafka cluster.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"disk_size_limit": schema.Int64Attribute{
+						Description: "The overall maximum for disk size (GB) that limits all autoscaling iterations.",
+						Required:    true,
+						Validators: []validator.Int64{
+							mdbcommon.Int64GreaterValidator(path.MatchRoot("resources").AtName("disk_size")),
+						},
+					},
+					"planned_usage_threshold": schema.Int64Attribute{
+						Description: "Percent of disk utilization. During maintenance disk will autoscale, if this threshold reached. Value is between 0 and 100. Default value is 0 (autoscaling disabled).",
+						Optional:    true,
+						Computed:    true,
+						Default:     int64default.StaticInt64(0),
+						Validators: []validator.Int64{
+							int64validator.Between(0, 100),
+						},
+					},
+					"emergency_usage_threshold": schema.Int64Attribute{
+						Description: "Percent of disk utilization. Disk will autoscale immediately, if this threshold reached. Value is between 0 and 100. Default value is 0 (autoscaling disabled). Must be not less then `planned_usage_threshold` value.",
+						Optional:    true,
+						Computed:    true,
+						Default:     int64default.StaticInt64(0),
+						Validators: []validator.Int64{
+							int64validator.Between(0, 100),
+						},
+					},
+				},
+			},
+			// Optional nested attribute maintenance_window required all optional nested attributes
+			// But if the block is specified explicitly, then the type attribute is required
+			"maintenance_window": schema.SingleNestedAttribute{
+				Description: "Maintenance policy of the Kafka cluster.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Object{
+					NewMaintenanceWindowStructValidator(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						Description: "Type of maintenance window. Can be either ANYTIME or WEEKLY. A day and hour of window need to be specified with weekly window.",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("ANYTIME", "WEEKLY"),
+						},
+					},
+					"day": schema.StringAttribute{
+						Description: "Day of the week (in DDD format). Allowed values: \"MON\", \"TUE\", \"WED\", \"THU\", \"FRI\", \"SAT\",\"SUN\"",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(
+								"MON", "TUE",
+								"WED", "THU",
+								"FRI", "SAT",
+								"SUN",
+							),
+						},
+					},
+					"hour": schema.Int64Attribute{
+						Description: "Hour of the day in UTC (in HH format). Allowed value is between 1 and 24.",
+						Optional:    true,
+						Validators: []validator.Int64{
+							int64validator.Between(1, 24),
+						},
+					},
+				},
+			},
+			"endpoints": mdbcommon.EndpointsSchema(),
+			"hosts": schema.SetNestedAttribute{
+				Description: "A host of the Kafka cluster.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "The fully qualified domain name of the host.",
+							Computed:    true,
+						},
+						"zone_id": schema.StringAttribute{
+							Description: "The availability zone where the Kafka host was created.",
+							Computed:    true,
+						},
+						"role": schema.StringAttribute{
+							Description: "Role of the host in the cluster. Can be either `KAFKA`, `ZOOKEEPER` or `KRAFT`.",
+							Computed:    true,
+						},
+						"subnet_id": schema.StringAttribute{
+							Description: "The ID of the subnet, to which the host belongs.",
+							Computed:    true,
+						},
+						"assign_public_ip": schema.BoolAttribute{
+							Description: "The flag that defines whether a public IP address is assigned to the node.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"resources": schema.SingleNestedBlock{
+				Description: "Resources allocated to hosts of the Kafka subcluster.",
+				Attributes: map[string]schema.Attribute{
+					"resource_preset_id": schema.StringAttribute{
+						Description: "The ID of the preset for computational resources available to a Kafka host (CPU, memory etc.). For more information, see [the official documentation](https://yandex.cloud/docs/managed-kafka/concepts).",
+						Required:    true,
+					},
+					"disk_type_id": schema.StringAttribute{
+						Description: "Type of the storage of Kafka hosts. For more information see [the official documentation](https://yandex.cloud/docs/managed-kafka/concepts/storage).",
+						Required:    true,
+					},
+					"disk_size": schema.Int64Attribute{
+						Description: "Volume of the storage available to a Kafka host, in gigabytes.",
+						Required:    true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *clusterResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var version types.String
+	var zookeeper, kraft types.Object
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("version"), &version)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("zookeeper"), &zookeeper)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("kraft"), &kraft)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	detectKRaftMigration(coordinatorTypes{}, getCoordinatorTypes(zookeeper, kraft), types.StringNull(), version, &resp.Diagnostics)
+}
+
+func (r *clusterResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+	var plan Cluster
+	var state Cluster
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Modifying plan for cluster", map[string]interface{}{"id": plan.Id.ValueString()})
+
+	migrate := r.detectKRaftMigration(ctx, req.Config, &state, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if migrate {
+		plan.ZooKeeper = types.ObjectNull(CoordinatorAttrTypes)
+	}
+
+	// The host topology is known only after apply.
+	if migrate ||
+		!plan.Zones.Equal(state.Zones) ||
+		!plan.BrokersCount.Equal(state.BrokersCount) ||
+		!plan.AssignPublicIp.Equal(state.AssignPublicIp) ||
+		!plan.SubnetIds.Equal(state.SubnetIds) {
+		plan.Hosts = types.SetUnknown(hostType)
+	}
+
+	if !plan.Version.Equal(state.Version) {
+		plan.PatchVersion = types.StringUnknown()
+	}
+
+	autoscalingOn := utils.IsPresent(attr.Value(state.DiskSizeAutoscaling))
+
+	// remove changes on disk_size from plan if enabled autoscaling
+	plan.Resources = mdbcommon.FixDiskSizeOnAutoscalingChanges(ctx, plan.Resources, state.Resources, autoscalingOn, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, plan)...)
+}
+
+// detectKRaftMigration compares the coordinator blocks of the configuration with the state.
+func (r *clusterResource) detectKRaftMigration(ctx context.Context, config tfsdk.Config, state, plan *Cluster, diags *diag.Diagnostics) bool {
+	var zookeeper, kraft types.Object
+	diags.Append(config.GetAttribute(ctx, path.Root("zookeeper"), &zookeeper)...)
+	diags.Append(config.GetAttribute(ctx, path.Root("kraft"), &kraft)...)
+	if diags.HasError() {
+		return false
+	}
+
+	return detectKRaftMigration(
+		getCoordinatorTypes(state.ZooKeeper, state.KRaft),
+		getCoordinatorTypes(zookeeper, kraft),
+		state.Version, plan.Version,
+		diags,
+	)
+}
+
+func (r *clusterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan Cluster
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, yandexMDBKafkaClusterDefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	tflog.Debug(ctx, "Creating Kafka Cluster")
+
+	request, diags := prepareCreateRequest(ctx, &plan, &r.providerConfig.ProviderState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cid := kafkaApi.CreateCluster(ctx, r.providerConfig.SDKv2, &resp.Diagnostics, request)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.Id = types.StringValue(cid)
+
+	r.refreshResourceState(ctx, &plan, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *clusterResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state Cluster
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.refreshResourceState(ctx, &state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *clusterResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan Cluster
+	var state Cluster
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, yandexMDBKafkaClusterUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	tflog.Debug(ctx, "Updating Kafka Cluster", map[string]any{"id": plan.Id.ValueString()})
+
+	cid := state.Id.ValueString()
+
+	// ZooKeeper is not supported by Kafka 4.x, so the migration goes before the version upgrade.
+	migrate := r.detectKRaftMigration(ctx, req.Config, &state, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if migrate {
+		migrateRequest := prepareMigrateToKRaftRequest(ctx, cid, plan.KRaft, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		kafkaApi.MigrateToKRaft(ctx, r.providerConfig.SDKv2, &resp.Diagnostics, migrateRequest)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	kafkaApi.UpdateCluster(ctx, r.providerConfig.SDKv2, &resp.Diagnostics, prepareVersionUpdateRequest(&state, &plan))
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateRequest, diags := prepareUpdateRequest(ctx, &state, &plan, migrate)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	kafkaApi.UpdateCluster(ctx, r.providerConfig.SDKv2, &resp.Diagnostics, updateRequest)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.refreshResourceState(ctx, &plan, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *clusterResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state Cluster
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, yandexMDBKafkaClusterDefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	kafkaApi.DeleteCluster(ctx, r.providerConfig.SDKv2, &resp.Diagnostics, state.Id.ValueString())
+}
+
+func (r *clusterResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *clusterResource) refreshResourceState(ctx context.Context, state *Cluster, respDiagnostics *diag.Diagnostics) {
+	cid := state.Id.ValueString()
+	cluster := kafkaApi.GetCluster(ctx, r.providerConfig.SDKv2, respDiagnostics, cid)
+	if respDiagnostics.HasError() {
+		return
+	}
+
+	hosts := kafkaApi.ListHosts(ctx, r.providerConfig.SDKv2, respDiagnostics, cid)
+	if respDiagnostics.HasError() {
+		return
+	}
+
+	cfg := cluster.GetConfig()
+
+	state.Id = types.StringValue(cluster.Id)
+	state.FolderId = types.StringValue(cluster.FolderId)
+	state.NetworkId = types.StringValue(cluster.NetworkId)
+	state.Name = types.StringValue(cluster.Name)
+	state.Description = types.StringValue(cluster.Description)
+	state.Environment = types.StringValue(cluster.Environment.String())
+	state.Labels = mdbcommon.FlattenMapString(ctx, cluster.Labels, respDiagnostics)
+	state.SecurityGroupIds = mdbcommon.FlattenSetString(ctx, cluster.SecurityGroupIds, respDiagnostics)
+	state.HostGroupIds = mdbcommon.FlattenSetString(ctx, cluster.HostGroupIds, respDiagnostics)
+	state.DeletionProtection = types.BoolValue(cluster.GetDeletionProtection())
+	state.MaintenanceWindow = mdbcommon.FlattenMaintenanceWindow[
+		kafka.MaintenanceWindow,
+		kafka.WeeklyMaintenanceWindow,
+		kafka.AnytimeMaintenanceWindow,
+		kafka.WeeklyMaintenanceWindow_WeekDay,
+	](ctx, cluster.MaintenanceWindow, respDiagnostics)
+	state.DiskEncryptionKeyId = mdbcommon.FlattenStringWrapper(ctx, cluster.DiskEncryptionKeyId, respDiagnostics)
+
+	state.Version = types.StringValue(cfg.GetVersion())
+	state.PatchVersion = types.StringValue(cfg.GetPatchVersion())
+	state.Zones = mdbcommon.FlattenListString(ctx, cfg.GetZoneId(), respDiagnostics)
+	state.BrokersCount = types.Int64Value(cfg.GetBrokersCount().GetValue())
+	state.AssignPublicIp = types.BoolValue(cfg.GetAssignPublicIp())
+	state.SchemaRegistry = types.BoolValue(cfg.GetSchemaRegistry())
+	state.Resources = mdbcommon.FlattenResources(ctx, cfg.GetKafka().GetResources(), respDiagnostics)
+	if state.KafkaConfig.IsNull() || state.KafkaConfig.IsUnknown() {
+		state.KafkaConfig = flattenKafkaConfig(ctx, cfg.GetKafka(), respDiagnostics)
+	}
+	state.ZooKeeper = flattenZooKeeper(ctx, cfg.GetZookeeper(), respDiagnostics)
+	state.KRaft = flattenKRaft(ctx, cfg.GetKraft(), respDiagnostics)
+	state.Access = flattenAccess(ctx, cfg.GetAccess(), respDiagnostics)
+	state.RestAPI = flattenEnabled(ctx, cfg.GetRestApiConfig() != nil, cfg.GetRestApiConfig().GetEnabled(), respDiagnostics)
+	state.KafkaUI = flattenEnabled(ctx, cfg.GetKafkaUiConfig() != nil, cfg.GetKafkaUiConfig().GetEnabled(), respDiagnostics)
+	state.DiskSizeAutoscaling = flattenDiskSizeAutoscaling(ctx, cfg.GetDiskSizeAutoscaling(), respDiagnostics)
+	state.Hosts = flattenHosts(ctx, hosts, respDiagnostics)
+	state.Endpoints = flattenEndpoints(ctx, hosts, respDiagnostics)
+
+	// subnet_ids are not returned by the API.
+	if state.SubnetIds.IsUnknown() {
+		state.SubnetIds = types.ListNull(types.StringType)
+	}
+}
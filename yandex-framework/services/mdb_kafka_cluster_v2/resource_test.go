@@ -0,0 +1,240 @@
+package mdb_kafka_cluster_v2_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/kafka/v1"
+	kafkasdk "github.com/yandex-cloud/go-sdk/services/mdb/kafka/v1"
+	test "github.com/yandex-cloud/terraform-provider-yandex/pkg/testhelpers"
+	yandex_framework "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider"
+)
+
+const (
+	kfV2ResourceName = "yandex_mdb_kafka_cluster_v2.foo"
+	kfResourceName   = "yandex_mdb_kafka_cluster.foo"
+)
+
+const kfV2Dependencies = `
+resource "yandex_vpc_network" "foo" {}
+
+resource "yandex_vpc_subnet" "foo" {
+  zone           = "ru-central1-a"
+  network_id     = yandex_vpc_network.foo.id
+  v4_cidr_blocks = ["10.1.0.0/24"]
+}
+`
+
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
+
+func TestAccMDBKafkaClusterV2_basic(t *testing.T) {
+	t.Parallel()
+
+	clusterName := acctest.RandomWithPrefix("tf-kafka-cluster-v2")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.AccPreCheck(t) },
+		ProtoV6ProviderFactories: test.AccProviderFactories,
+		CheckDestroy:             testAccCheckMDBKafkaClusterV2Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMDBKafkaClusterV2Config(clusterName, "3.9", `
+  kafka_config = {
+    compression_type        = "COMPRESSION_TYPE_ZSTD"
+    log_retention_ms        = "86400000"
+    sasl_enabled_mechanisms = "SASL_MECHANISM_SCRAM_SHA_256,SASL_MECHANISM_SCRAM_SHA_512"
+  }
+`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMDBKafkaClusterV2Exists(kfV2ResourceName),
+					resource.TestCheckResourceAttr(kfV2ResourceName, "version", "3.9"),
+					resource.TestCheckResourceAttr(kfV2ResourceName, "kafka_config.compression_type", "COMPRESSION_TYPE_ZSTD"),
+					resource.TestCheckResourceAttr(kfV2ResourceName, "hosts.#", "1"),
+					resource.TestCheckResourceAttrSet(kfV2ResourceName, "patch_version"),
+				),
+			},
+			{
+				ResourceName:            kfV2ResourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"subnet_ids", "timeouts"},
+			},
+			{
+				Config: testAccMDBKafkaClusterV2Config(clusterName, "3.9", `
+  description = "updated"
+
+  kafka_config = {
+    compression_type = "COMPRESSION_TYPE_LZ4"
+  }
+`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(kfV2ResourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMDBKafkaClusterV2Exists(kfV2ResourceName),
+					resource.TestCheckResourceAttr(kfV2ResourceName, "description", "updated"),
+					resource.TestCheckResourceAttr(kfV2ResourceName, "kafka_config.compression_type", "COMPRESSION_TYPE_LZ4"),
+					resource.TestCheckNoResourceAttr(kfV2ResourceName, "kafka_config.log_retention_ms"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMDBKafkaClusterV2_topicConfigValidation(t *testing.T) {
+	t.Parallel()
+
+	clusterName := acctest.RandomWithPrefix("tf-kafka-cluster-v2")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.AccPreCheck(t) },
+		ProtoV6ProviderFactories: test.AccProviderFactories,
+		CheckDestroy:             testAccCheckMDBKafkaClusterV2Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMDBKafkaClusterV2Config(clusterName, "4.0", ""),
+				Check:  testAccCheckMDBKafkaClusterV2Exists(kfV2ResourceName),
+			},
+			{
+				Config: testAccMDBKafkaClusterV2Config(clusterName, "4.0", "") + `
+resource "yandex_mdb_kafka_topic" "foo" {
+  cluster_id         = yandex_mdb_kafka_cluster_v2.foo.id
+  name               = "events"
+  partitions         = 1
+  replication_factor = 1
+
+  topic_config {
+    segment_bytes = "16384"
+  }
+}
+`,
+				ExpectError: regexp.MustCompile(`"segment_bytes" must be at least 1048576 for Kafka 4.0`),
+			},
+		},
+	})
+}
+
+func TestAccMDBKafkaClusterV2_moveState(t *testing.T) {
+	t.Parallel()
+
+	clusterName := acctest.RandomWithPrefix("tf-kafka-cluster-v2")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.AccPreCheck(t) },
+		ProtoV6ProviderFactories: test.AccProviderFactories,
+		CheckDestroy:             testAccCheckMDBKafkaClusterV2Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: kfV2Dependencies + fmt.Sprintf(`
+resource "yandex_mdb_kafka_cluster" "foo" {
+  name        = "%s"
+  environment = "PRESTABLE"
+  network_id  = yandex_vpc_network.foo.id
+  subnet_ids  = [yandex_vpc_subnet.foo.id]
+
+  config {
+    version       = "3.9"
+    zones         = ["ru-central1-a"]
+    brokers_count = 1
+
+    kafka {
+      resources {
+        resource_preset_id = "s2.micro"
+        disk_size          = 16
+        disk_type_id       = "network-ssd"
+      }
+    }
+  }
+}
+`, clusterName),
+				Check: resource.TestCheckResourceAttrSet(kfResourceName, "id"),
+			},
+			{
+				Config: testAccMDBKafkaClusterV2Config(clusterName, "3.9", "") + `
+moved {
+  from = yandex_mdb_kafka_cluster.foo
+  to   = yandex_mdb_kafka_cluster_v2.foo
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(kfV2ResourceName, plancheck.ResourceActionNoop),
+					},
+				},
+				Check: testAccCheckMDBKafkaClusterV2Exists(kfV2ResourceName),
+			},
+		},
+	})
+}
+
+func testAccCheckMDBKafkaClusterV2Exists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+
+		config := test.AccProvider.(*yandex_framework.Provider).GetConfig()
+		cluster, err := kafkasdk.NewClusterClient(config.SDKv2).Get(context.Background(), &kafka.GetClusterRequest{
+			ClusterId: rs.Primary.ID,
+		})
+		if err != nil {
+			return fmt.Errorf("Kafka cluster not found: %v", err)
+		}
+
+		if cluster.GetConfig().GetZookeeper() != nil {
+			return fmt.Errorf("expected Kafka cluster %q without ZooKeeper", cluster.Id)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckMDBKafkaClusterV2Destroy(s *terraform.State) error {
+	config := test.AccProvider.(*yandex_framework.Provider).GetConfig()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "yandex_mdb_kafka_cluster_v2" {
+			continue
+		}
+
+		_, err := kafkasdk.NewClusterClient(config.SDKv2).Get(context.Background(), &kafka.GetClusterRequest{
+			ClusterId: rs.Primary.ID,
+		})
+		if err == nil {
+			return fmt.Errorf("Kafka cluster %q still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccMDBKafkaClusterV2Config(clusterName, version, body string) string {
+	return kfV2Dependencies + fmt.Sprintf(`
+resource "yandex_mdb_kafka_cluster_v2" "foo" {
+  name        = "%s"
+  environment = "PRESTABLE"
+  network_id  = yandex_vpc_network.foo.id
+  subnet_ids  = [yandex_vpc_subnet.foo.id]
+  version     = "%s"
+  zones       = ["ru-central1-a"]
+
+  resources {
+    resource_preset_id = "s2.micro"
+    disk_size          = 16
+    disk_type_id       = "network-ssd"
+  }
+%s
+}
+`, clusterName, version, body)
+}
@@ -0,0 +1,222 @@
+package mdb_kafka_cluster_v2
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/kafka/v1"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/datasize"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/kafkacommon"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/mdbcommon"
+	"google.golang.org/genproto/protobuf/field_mask"
+)
+
+func prepareVersionUpdateRequest(state, plan *Cluster) *kafka.UpdateClusterRequest {
+	if plan.Version.Equal(state.Version) {
+		return nil
+	}
+
+	return &kafka.UpdateClusterRequest{
+		ClusterId: state.Id.ValueString(),
+		ConfigSpec: &kafka.ConfigSpec{
+			Version: plan.Version.ValueString(),
+		},
+		UpdateMask: &field_mask.FieldMask{Paths: []string{"config_spec.version"}},
+	}
+}
+
+// prepareUpdateRequest builds the request for all the changes except the version upgrade.
+// Changes of the coordinator subclusters are skipped while the cluster is migrated to KRaft.
+func prepareUpdateRequest(ctx context.Context, state, plan *Cluster, migrating bool) (*kafka.UpdateClusterRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	request := &kafka.UpdateClusterRequest{
+		ClusterId:  state.Id.ValueString(),
+		UpdateMask: &field_mask.FieldMask{},
+	}
+
+	if !plan.Name.Equal(state.Name) {
+		request.SetName(plan.Name.ValueString())
+		request.UpdateMask.Paths = append(request.UpdateMask.Paths, "name")
+	}
+
+	if !plan.Description.Equal(state.Description) {
+		request.SetDescription(plan.Description.ValueString())
+		request.UpdateMask.Paths = append(request.UpdateMask.Paths, "description")
+	}
+
+	if !plan.Labels.Equal(state.Labels) {
+		request.SetLabels(mdbcommon.ExpandLabels(ctx, plan.Labels, &diags))
+		request.UpdateMask.Paths = append(request.UpdateMask.Paths, "labels")
+	}
+
+	if !plan.SubnetIds.Equal(state.SubnetIds) {
+		request.SetSubnetIds(expandStringList(ctx, plan.SubnetIds, &diags))
+		request.UpdateMask.Paths = append(request.UpdateMask.Paths, "subnet_ids")
+	}
+
+	config := &kafka.ConfigSpec{Kafka: &kafka.ConfigSpec_Kafka{}}
+	updConf := false
+
+	if !plan.Zones.Equal(state.Zones) {
+		updConf = true
+		config.ZoneId = expandStringList(ctx, plan.Zones, &diags)
+		request.UpdateMask.Paths = append(request.UpdateMask.Paths, "config_spec.zone_id")
+	}
+
+	if !plan.BrokersCount.Equal(state.BrokersCount) {
+		updConf = true
+		config.BrokersCount = mdbcommon.ExpandInt64Wrapper(ctx, plan.BrokersCount, &diags)
+		request.UpdateMask.Paths = append(request.UpdateMask.Paths, "config_spec.brokers_count")
+	}
+
+	if !plan.AssignPublicIp.Equal(state.AssignPublicIp) {
+		updConf = true
+		config.AssignPublicIp = plan.AssignPublicIp.ValueBool()
+		request.UpdateMask.Paths = append(request.UpdateMask.Paths, "config_spec.assign_public_ip")
+	}
+
+	if !plan.SchemaRegistry.Equal(state.SchemaRegistry) {
+		updConf = true
+		config.SchemaRegistry = plan.SchemaRegistry.ValueBool()
+		request.UpdateMask.Paths = append(request.UpdateMask.Paths, "config_spec.schema_registry")
+	}
+
+	if !plan.Resources.Equal(state.Resources) {
+		updConf = true
+		config.Kafka.Resources = mdbcommon.ExpandResources[kafka.Resources](ctx, plan.Resources, &diags)
+		request.UpdateMask.Paths = append(request.UpdateMask.Paths, resourcesUpdatePaths(ctx, "config_spec.kafka.resources", state.Resources, plan.Resources, &diags)...)
+	}
+
+	if !plan.KafkaConfig.Equal(state.KafkaConfig) {
+		updConf = true
+		setKafkaConfig(ctx, config.Kafka, plan.Version.ValueString(), plan.KafkaConfig, &diags)
+
+		suffix, err := kafkacommon.ConfigSuffix(plan.Version.ValueString())
+		if err != nil {
+			diags.AddError("Invalid version", fmt.Sprintf("Details: %v", err))
+			return nil, diags
+		}
+
+		attrsState := mdbcommon.GetAttrNamesSetFromMap(state.KafkaConfig.MapValue, &diags)
+		attrsPlan := mdbcommon.GetAttrNamesSetFromMap(plan.KafkaConfig.MapValue, &diags)
+
+		maps.Copy(attrsPlan, attrsState)
+		for attr := range attrsPlan {
+			request.UpdateMask.Paths = append(request.UpdateMask.Paths, fmt.Sprintf("config_spec.kafka.kafka_config_%s.%s", suffix, attr))
+		}
+	}
+
+	if !migrating && !plan.ZooKeeper.Equal(state.ZooKeeper) && !plan.ZooKeeper.IsNull() {
+		updConf = true
+		config.Zookeeper = expandZooKeeper(ctx, plan.ZooKeeper, &diags)
+		request.UpdateMask.Paths = append(request.UpdateMask.Paths, coordinatorUpdatePaths(ctx, "config_spec.zookeeper.resources", state.ZooKeeper, plan.ZooKeeper, &diags)...)
+	}
+
+	if !migrating && !plan.KRaft.Equal(state.KRaft) && !plan.KRaft.IsNull() {
+		updConf = true
+		config.Kraft = expandKRaft(ctx, plan.KRaft, &diags)
+		request.UpdateMask.Paths = append(request.UpdateMask.Paths, coordinatorUpdatePaths(ctx, "config_spec.kraft.resources", state.KRaft, plan.KRaft, &diags)...)
+	}
+
+	if !plan.Access.Equal(state.Access) {
+		updConf = true
+		config.SetAccess(expandAccess(ctx, plan.Access, &diags))
+		request.UpdateMask.Paths = append(request.UpdateMask.Paths, "config_spec.access")
+	}
+
+	if !plan.RestAPI.Equal(state.RestAPI) {
+		updConf = true
+		config.SetRestApiConfig(&kafka.ConfigSpec_RestAPIConfig{Enabled: expandEnabled(ctx, plan.RestAPI, &diags)})
+		request.UpdateMask.Paths = append(request.UpdateMask.Paths, "config_spec.rest_api_config.enabled")
+	}
+
+	if !plan.KafkaUI.Equal(state.KafkaUI) {
+		updConf = true
+		config.SetKafkaUiConfig(&kafka.ConfigSpec_KafkaUIConfig{Enabled: expandEnabled(ctx, plan.KafkaUI, &diags)})
+		request.UpdateMask.Paths = append(request.UpdateMask.Paths, "config_spec.kafka_ui_config.enabled")
+	}
+
+	if !plan.DiskSizeAutoscaling.Equal(state.DiskSizeAutoscaling) {
+		updConf = true
+		config.SetDiskSizeAutoscaling(expandDiskSizeAutoscaling(ctx, plan.DiskSizeAutoscaling, &diags))
+
+		var psda, sdsa DiskSizeAutoscaling
+		diags.Append(state.DiskSizeAutoscaling.As(ctx, &sdsa, datasize.UnhandledOpts)...)
+		diags.Append(plan.DiskSizeAutoscaling.As(ctx, &psda, datasize.UnhandledOpts)...)
+
+		if !psda.DiskSizeLimit.Equal(sdsa.DiskSizeLimit) {
+			request.UpdateMask.Paths = append(request.UpdateMask.Paths, "config_spec.disk_size_autoscaling.disk_size_limit")
+		}
+		if !psda.PlannedUsageThreshold.Equal(sdsa.PlannedUsageThreshold) {
+			request.UpdateMask.Paths = append(request.UpdateMask.Paths, "config_spec.disk_size_autoscaling.planned_usage_threshold")
+		}
+		if !psda.EmergencyUsageThreshold.Equal(sdsa.EmergencyUsageThreshold) {
+			request.UpdateMask.Paths = append(request.UpdateMask.Paths, "config_spec.disk_size_autoscaling.emergency_usage_threshold")
+		}
+	}
+
+	if updConf {
+		request.SetConfigSpec(config)
+	}
+
+	if !plan.DeletionProtection.Equal(state.DeletionProtection) {
+		request.SetDeletionProtection(plan.DeletionProtection.ValueBool())
+		request.UpdateMask.Paths = append(request.UpdateMask.Paths, "deletion_protection")
+	}
+
+	if !plan.SecurityGroupIds.Equal(state.SecurityGroupIds) {
+		request.SetSecurityGroupIds(mdbcommon.ExpandSecurityGroupIds(ctx, plan.SecurityGroupIds, &diags))
+		request.UpdateMask.Paths = append(request.UpdateMask.Paths, "security_group_ids")
+	}
+
+	if !plan.MaintenanceWindow.Equal(state.MaintenanceWindow) {
+		request.SetMaintenanceWindow(mdbcommon.ExpandClusterMaintenanceWindow[
+			kafka.MaintenanceWindow,
+			kafka.WeeklyMaintenanceWindow,
+			kafka.AnytimeMaintenanceWindow,
+			kafka.WeeklyMaintenanceWindow_WeekDay,
+		](ctx, plan.MaintenanceWindow, &diags))
+		request.UpdateMask.Paths = append(request.UpdateMask.Paths, "maintenance_window")
+	}
+
+	return request, diags
+}
+
+func resourcesUpdatePaths(ctx context.Context, prefix string, state, plan types.Object, diags *diag.Diagnostics) []string {
+	var sr, pr mdbcommon.Resource
+	diags.Append(state.As(ctx, &sr, datasize.UnhandledOpts)...)
+	diags.Append(plan.As(ctx, &pr, datasize.UnhandledOpts)...)
+
+	var paths []string
+	if !pr.ResourcePresetId.Equal(sr.ResourcePresetId) {
+		paths = append(paths, prefix+".resource_preset_id")
+	}
+	if !pr.DiskSize.Equal(sr.DiskSize) {
+		paths = append(paths, prefix+".disk_size")
+	}
+	if !pr.DiskTypeId.Equal(sr.DiskTypeId) {
+		paths = append(paths, prefix+".disk_type_id")
+	}
+	return paths
+}
+
+func coordinatorUpdatePaths(ctx context.Context, prefix string, state, plan types.Object, diags *diag.Diagnostics) []string {
+	var sc, pc Coordinator
+	diags.Append(state.As(ctx, &sc, datasize.UnhandledOpts)...)
+	diags.Append(plan.As(ctx, &pc, datasize.UnhandledOpts)...)
+	if diags.HasError() {
+		return nil
+	}
+
+	if sc.Resources.IsNull() || sc.Resources.IsUnknown() {
+		sc.Resources = types.ObjectNull(mdbcommon.ResourceType.AttrTypes)
+	}
+	if pc.Resources.IsNull() || pc.Resources.IsUnknown() {
+		pc.Resources = types.ObjectNull(mdbcommon.ResourceType.AttrTypes)
+	}
+	return resourcesUpdatePaths(ctx, prefix, sc.Resources, pc.Resources, diags)
+}
@@ -0,0 +1,137 @@
+package mdb_kafka_cluster_v2
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/mdbcommon"
+)
+
+func testCoordinator(t *testing.T, preset string) types.Object {
+	t.Helper()
+
+	resources, d := types.ObjectValue(mdbcommon.ResourceType.AttrTypes, map[string]attr.Value{
+		"resource_preset_id": types.StringValue(preset),
+		"disk_size":          types.Int64Value(20),
+		"disk_type_id":       types.StringValue("network-ssd"),
+	})
+	if d.HasError() {
+		t.Fatalf("failed to build resources: %v", d)
+	}
+
+	obj, d := types.ObjectValue(CoordinatorAttrTypes, map[string]attr.Value{"resources": resources})
+	if d.HasError() {
+		t.Fatalf("failed to build coordinator: %v", d)
+	}
+	return obj
+}
+
+func testCluster(t *testing.T, version string, config map[string]attr.Value) *Cluster {
+	t.Helper()
+
+	kafkaConfig, d := NewKafkaSettingsMapValue(config)
+	if d.HasError() {
+		t.Fatalf("failed to build kafka_config: %v", d)
+	}
+
+	return &Cluster{
+		Id:                  types.StringValue("cid"),
+		Name:                types.StringValue("kafka"),
+		Description:         types.StringValue(""),
+		Labels:              types.MapNull(types.StringType),
+		SubnetIds:           types.ListNull(types.StringType),
+		SecurityGroupIds:    types.SetNull(types.StringType),
+		DeletionProtection:  types.BoolValue(false),
+		MaintenanceWindow:   types.ObjectNull(MaintenanceWindowAttrTypes),
+		Version:             types.StringValue(version),
+		Zones:               types.ListNull(types.StringType),
+		BrokersCount:        types.Int64Value(1),
+		AssignPublicIp:      types.BoolValue(false),
+		SchemaRegistry:      types.BoolValue(false),
+		Resources:           types.ObjectNull(mdbcommon.ResourceType.AttrTypes),
+		KafkaConfig:         kafkaConfig,
+		ZooKeeper:           testCoordinator(t, "s2.micro"),
+		KRaft:               types.ObjectNull(CoordinatorAttrTypes),
+		Access:              types.ObjectNull(AccessAttrTypes),
+		RestAPI:             types.ObjectNull(EnabledAttrTypes),
+		KafkaUI:             types.ObjectNull(EnabledAttrTypes),
+		DiskSizeAutoscaling: types.ObjectNull(DiskSizeAutoscalingAttrTypes),
+		Endpoints:           types.ObjectNull(mdbcommon.EndpointsAttrTypes),
+	}
+}
+
+func TestPrepareVersionUpdateRequest(t *testing.T) {
+	t.Parallel()
+
+	state := testCluster(t, "3.9", nil)
+	if req := prepareVersionUpdateRequest(state, testCluster(t, "3.9", nil)); req != nil {
+		t.Errorf("expected no request for the same version, got %v", req)
+	}
+
+	req := prepareVersionUpdateRequest(state, testCluster(t, "4.0", nil))
+	if req == nil || req.GetConfigSpec().GetVersion() != "4.0" || !slices.Equal(req.GetUpdateMask().GetPaths(), []string{"config_spec.version"}) {
+		t.Errorf("unexpected version update request: %v", req)
+	}
+}
+
+func TestPrepareUpdateRequestKafkaConfig(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	state := testCluster(t, "3.6", map[string]attr.Value{
+		"log_retention_ms": types.StringValue("1000"),
+	})
+	plan := testCluster(t, "3.6", map[string]attr.Value{
+		"compression_type": types.StringValue("COMPRESSION_TYPE_ZSTD"),
+	})
+
+	req, diags := prepareUpdateRequest(ctx, state, plan, false)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	paths := req.GetUpdateMask().GetPaths()
+	slices.Sort(paths)
+	expected := []string{
+		"config_spec.kafka.kafka_config_3.compression_type",
+		"config_spec.kafka.kafka_config_3.log_retention_ms",
+	}
+	if !slices.Equal(paths, expected) {
+		t.Errorf("unexpected update mask: %v, want %v", paths, expected)
+	}
+	if req.GetConfigSpec().GetKafka().GetKafkaConfig_3() == nil {
+		t.Error("expected kafka_config_3 in the request")
+	}
+}
+
+func TestPrepareUpdateRequestSkipsCoordinatorsWhileMigrating(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	state := testCluster(t, "3.9", nil)
+	plan := testCluster(t, "3.9", nil)
+	plan.ZooKeeper = types.ObjectNull(CoordinatorAttrTypes)
+	plan.KRaft = testCoordinator(t, "s2.small")
+
+	req, diags := prepareUpdateRequest(ctx, state, plan, true)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if paths := req.GetUpdateMask().GetPaths(); len(paths) != 0 {
+		t.Errorf("expected empty update mask while migrating, got %v", paths)
+	}
+
+	state.ZooKeeper = types.ObjectNull(CoordinatorAttrTypes)
+	state.KRaft = testCoordinator(t, "s2.micro")
+	req, diags = prepareUpdateRequest(ctx, state, plan, false)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	expected := []string{"config_spec.kraft.resources.resource_preset_id"}
+	if paths := req.GetUpdateMask().GetPaths(); !slices.Equal(paths, expected) {
+		t.Errorf("unexpected update mask: %v, want %v", paths, expected)
+	}
+}
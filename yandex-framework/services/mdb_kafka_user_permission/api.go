@@ -0,0 +1,90 @@
+package mdb_kafka_user_permission
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/kafka/v1"
+	kafkasdk "github.com/yandex-cloud/go-sdk/services/mdb/kafka/v1"
+	ycsdk "github.com/yandex-cloud/go-sdk/v2"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/retry"
+)
+
+func readUser(ctx context.Context, sdk *ycsdk.SDK, cid, userName string) (*kafka.User, error) {
+	return kafkasdk.NewUserClient(sdk).Get(ctx, &kafka.GetUserRequest{
+		ClusterId: cid,
+		UserName:  userName,
+	})
+}
+
+func grantPermission(ctx context.Context, sdk *ycsdk.SDK, diags *diag.Diagnostics, cid, userName string, permission *kafka.Permission) {
+	op, err := retry.ConflictingOperationV2(ctx, sdk, func() (*kafkasdk.UserGrantPermissionOperation, error) {
+		return kafkasdk.NewUserClient(sdk).GrantPermission(ctx, &kafka.GrantUserPermissionRequest{
+			ClusterId:  cid,
+			UserName:   userName,
+			Permission: permission,
+		})
+	})
+	if err != nil {
+		diags.AddError(
+			"Failed to Create resource",
+			fmt.Sprintf("Error while requesting API to grant role %s on topic %q to Kafka user %q in cluster %q: %s", permission.GetRole(), permission.GetTopicName(), userName, cid, err.Error()),
+		)
+		return
+	}
+
+	if _, err = op.Wait(ctx); err != nil {
+		diags.AddError(
+			"Failed to Create resource",
+			fmt.Sprintf("Error while waiting for operation to grant role %s on topic %q to Kafka user %q in cluster %q: %s", permission.GetRole(), permission.GetTopicName(), userName, cid, err.Error()),
+		)
+	}
+}
+
+func revokePermission(ctx context.Context, sdk *ycsdk.SDK, diags *diag.Diagnostics, cid, userName string, permission *kafka.Permission) {
+	op, err := retry.ConflictingOperationV2(ctx, sdk, func() (*kafkasdk.UserRevokePermissionOperation, error) {
+		return kafkasdk.NewUserClient(sdk).RevokePermission(ctx, &kafka.RevokeUserPermissionRequest{
+			ClusterId:  cid,
+			UserName:   userName,
+			Permission: permission,
+		})
+	})
+	if err != nil {
+		diags.AddError(
+			"Failed to Delete resource",
+			fmt.Sprintf("Error while requesting API to revoke role %s on topic %q from Kafka user %q in cluster %q: %s", permission.GetRole(), permission.GetTopicName(), userName, cid, err.Error()),
+		)
+		return
+	}
+
+	if _, err = op.Wait(ctx); err != nil {
+		diags.AddError(
+			"Failed to Delete resource",
+			fmt.Sprintf("Error while waiting for operation to revoke role %s on topic %q from Kafka user %q in cluster %q: %s", permission.GetRole(), permission.GetTopicName(), userName, cid, err.Error()),
+		)
+	}
+}
+
+// findPermission returns the binding of the topic and the role granted to the user, nil if there is none.
+func findPermission(user *kafka.User, topicName, role string) *kafka.Permission {
+	for _, p := range user.GetPermissions() {
+		if p.GetTopicName() == topicName && p.GetRole().String() == role {
+			return p
+		}
+	}
+	return nil
+}
+
+// accessRoles returns the roles that can be granted on a topic.
+func accessRoles() []string {
+	roles := make([]string, 0, len(kafka.Permission_AccessRole_value))
+	for name, v := range kafka.Permission_AccessRole_value {
+		if v != int32(kafka.Permission_ACCESS_ROLE_UNSPECIFIED) {
+			roles = append(roles, name)
+		}
+	}
+	slices.Sort(roles)
+	return roles
+}
@@ -0,0 +1,31 @@
+package mdb_kafka_user_permission
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type Permission struct {
+	Id         types.String   `tfsdk:"id"`
+	ClusterID  types.String   `tfsdk:"cluster_id"`
+	UserName   types.String   `tfsdk:"user_name"`
+	TopicName  types.String   `tfsdk:"topic_name"`
+	Role       types.String   `tfsdk:"role"`
+	AllowHosts types.Set      `tfsdk:"allow_hosts"`
+	Timeouts   timeouts.Value `tfsdk:"timeouts"`
+}
+
+func constructId(cid, userName, topicName, role string) string {
+	return fmt.Sprintf("%s:%s:%s:%s", cid, userName, topicName, role)
+}
+
+func deconstructId(id string) (string, string, string, string, error) {
+	parts := strings.SplitN(id, ":", 4)
+	if len(parts) != 4 {
+		return "", "", "", "", fmt.Errorf("Invalid resource id format: %q", id)
+	}
+	return parts[0], parts[1], parts[2], parts[3], nil
+}
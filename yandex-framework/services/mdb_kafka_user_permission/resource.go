@@ -0,0 +1,366 @@
+package mdb_kafka_user_permission
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/kafka/v1"
+	"github.com/yandex-cloud/terraform-provider-yandex/common"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/globallock"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/kafkacommon"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/validate"
+	provider_config "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	yandexMDBKafkaUserPermissionDefaultTimeout = 10 * time.Minute
+)
+
+var (
+	_ resource.Resource                = &permissionResource{}
+	_ resource.ResourceWithImportState = &permissionResource{}
+	_ resource.ResourceWithModifyPlan  = &permissionResource{}
+)
+
+type permissionResource struct {
+	providerConfig *provider_config.Config
+}
+
+func NewResource() resource.Resource {
+	return &permissionResource{}
+}
+
+func (r *permissionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mdb_kafka_user_permission"
+}
+
+func (r *permissionResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerConfig, ok := req.ProviderData.(*provider_config.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider_config.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.providerConfig = providerConfig
+}
+
+func (r *permissionResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Grants a Kafka user a role on a topic within the Yandex Cloud. For more information, see [the official documentation](https://yandex.cloud/docs/managed-kafka/operations/cluster-accounts).\n\n" +
+			"~> The other permissions of the user are left untouched, so the topic and the user can be managed by the different modules. " +
+			"The `yandex_mdb_kafka_user` resource of the user must set `ignore_external_permissions = true`, otherwise it revokes the permission on the next apply. " +
+			"The same role on the topic must not be declared by a `permission` block of `yandex_mdb_kafka_user`: the conflict is rejected at plan time.\n",
+		Attributes: map[string]schema.Attribute{
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+			"id": schema.StringAttribute{
+				MarkdownDescription: common.ResourceDescriptions["id"],
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"cluster_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the Kafka cluster.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the user the role is granted to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"topic_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the topic the role is granted on.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"role": schema.StringAttribute{
+				MarkdownDescription: "The role to grant on the topic, e.g. `ACCESS_ROLE_CONSUMER` or `ACCESS_ROLE_PRODUCER`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(accessRoles()...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"allow_hosts": schema.SetAttribute{
+				MarkdownDescription: "Set of hosts the role is granted to. Only IP addresses are allowed as values. By default, the role is granted to any host.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+// ModifyPlan implements resource.ResourceWithModifyPlan. It rejects the permission that is already
+// granted to the user, e.g. by a `permission` block of `yandex_mdb_kafka_user`.
+func (r *permissionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || !req.State.Raw.IsNull() || r.providerConfig == nil {
+		return
+	}
+
+	var plan Permission
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.ClusterID.IsUnknown() || plan.UserName.IsUnknown() || plan.TopicName.IsUnknown() || plan.Role.IsUnknown() {
+		return
+	}
+
+	cid := plan.ClusterID.ValueString()
+	userName := plan.UserName.ValueString()
+	topicName := plan.TopicName.ValueString()
+	role := plan.Role.ValueString()
+
+	// Failures to read the user must not fail the plan: the permission is checked on apply anyway.
+	user, err := readUser(ctx, r.providerConfig.SDKv2, cid, userName)
+	if err != nil {
+		return
+	}
+
+	if findPermission(user, topicName, role) != nil {
+		resp.Diagnostics.AddError("Conflicting Kafka user permission", conflictDetail(cid, userName, topicName, role))
+	}
+}
+
+func (r *permissionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan Permission
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, yandexMDBKafkaUserPermissionDefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	cid := plan.ClusterID.ValueString()
+	userName := plan.UserName.ValueString()
+	topicName := plan.TopicName.ValueString()
+	role := plan.Role.ValueString()
+
+	var allowHosts []string
+	resp.Diagnostics.Append(plan.AllowHosts.ElementsAs(ctx, &allowHosts, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	mutexKV := globallock.GetMutexKV()
+	mutexKV.Lock(kafkacommon.UserMutexKey(cid, userName))
+	defer mutexKV.Unlock(kafkacommon.UserMutexKey(cid, userName))
+
+	user, err := readUser(ctx, r.providerConfig.SDKv2, cid, userName)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Create resource",
+			fmt.Sprintf("Error while requesting API to read Kafka user %q in cluster %q: %s", userName, cid, err.Error()),
+		)
+		return
+	}
+
+	// The permission declared in the same apply by the user resource is not visible at plan time.
+	if findPermission(user, topicName, role) != nil {
+		resp.Diagnostics.AddError("Conflicting Kafka user permission", conflictDetail(cid, userName, topicName, role))
+		return
+	}
+
+	grantPermission(ctx, r.providerConfig.SDKv2, &resp.Diagnostics, cid, userName, &kafka.Permission{
+		TopicName:  topicName,
+		Role:       kafka.Permission_AccessRole(kafka.Permission_AccessRole_value[role]),
+		AllowHosts: allowHosts,
+	})
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Id = types.StringValue(constructId(cid, userName, topicName, role))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *permissionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state Permission
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cid, userName, topicName, role, err := deconstructId(state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to parse resource ID",
+			fmt.Sprintf("Error parsing resource ID %q: %s", state.Id.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	user, err := readUser(ctx, r.providerConfig.SDKv2, cid, userName)
+	if err != nil {
+		f := resp.Diagnostics.AddError
+		if validate.IsStatusWithCode(err, codes.NotFound) {
+			resp.State.RemoveResource(ctx)
+			f = resp.Diagnostics.AddWarning
+		}
+
+		f(
+			"Failed to Read resource",
+			fmt.Sprintf("Error while requesting API to read Kafka user %q in cluster %q: %s", userName, cid, err.Error()),
+		)
+		return
+	}
+
+	permission := findPermission(user, topicName, role)
+	if permission == nil {
+		resp.State.RemoveResource(ctx)
+		resp.Diagnostics.AddWarning(
+			"Failed to Read resource",
+			fmt.Sprintf("Kafka user %q in cluster %q has no role %s on topic %q", userName, cid, role, topicName),
+		)
+		return
+	}
+
+	state.ClusterID = types.StringValue(cid)
+	state.UserName = types.StringValue(userName)
+	state.TopicName = types.StringValue(topicName)
+	state.Role = types.StringValue(role)
+	state.AllowHosts = types.SetNull(types.StringType)
+	if len(permission.GetAllowHosts()) > 0 {
+		allowHosts, diags := types.SetValueFrom(ctx, types.StringType, permission.GetAllowHosts())
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		state.AllowHosts = allowHosts
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *permissionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan Permission
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// All the attributes except timeouts require replacement.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *permissionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state Permission
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, yandexMDBKafkaUserPermissionDefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	cid, userName, topicName, role, err := deconstructId(state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to parse resource ID",
+			fmt.Sprintf("Error parsing resource ID %q: %s", state.Id.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	mutexKV := globallock.GetMutexKV()
+	mutexKV.Lock(kafkacommon.UserMutexKey(cid, userName))
+	defer mutexKV.Unlock(kafkacommon.UserMutexKey(cid, userName))
+
+	user, err := readUser(ctx, r.providerConfig.SDKv2, cid, userName)
+	if err != nil {
+		if validate.IsStatusWithCode(err, codes.NotFound) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failed to Delete resource",
+			fmt.Sprintf("Error while requesting API to read Kafka user %q in cluster %q: %s", userName, cid, err.Error()),
+		)
+		return
+	}
+
+	permission := findPermission(user, topicName, role)
+	if permission == nil {
+		return
+	}
+
+	revokePermission(ctx, r.providerConfig.SDKv2, &resp.Diagnostics, cid, userName, permission)
+}
+
+func (r *permissionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	cid, userName, topicName, role, err := deconstructId(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <cluster_id>:<user_name>:<topic_name>:<role>. Got: %q. Error: %s", req.ID, err.Error()),
+		)
+		return
+	}
+
+	state := Permission{
+		Id:         types.StringValue(req.ID),
+		ClusterID:  types.StringValue(cid),
+		UserName:   types.StringValue(userName),
+		TopicName:  types.StringValue(topicName),
+		Role:       types.StringValue(role),
+		AllowHosts: types.SetNull(types.StringType),
+		Timeouts: timeouts.Value{
+			Object: types.ObjectNull(map[string]attr.Type{
+				"create": types.StringType,
+				"delete": types.StringType,
+			}),
+		},
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func conflictDetail(cid, userName, topicName, role string) string {
+	return fmt.Sprintf("Role %s on topic %q is already granted to Kafka user %q in cluster %q, e.g. by a `permission` block of `yandex_mdb_kafka_user` "+
+		"or by another `yandex_mdb_kafka_user_permission`. Remove the other declaration or import the permission with ID %q.",
+		role, topicName, userName, cid, constructId(cid, userName, topicName, role))
+}
@@ -0,0 +1,177 @@
+package mdb_kafka_user_permission_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/kafka/v1"
+	kafkasdk "github.com/yandex-cloud/go-sdk/services/mdb/kafka/v1"
+	test "github.com/yandex-cloud/terraform-provider-yandex/pkg/testhelpers"
+	yandex_framework "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider"
+)
+
+const kafkaUserPermissionResourceName = "yandex_mdb_kafka_user_permission.events_consumer"
+
+const kafkaUserPermissionConfig = `
+resource "yandex_vpc_network" "foo" {}
+
+resource "yandex_vpc_subnet" "foo" {
+  zone           = "ru-central1-a"
+  network_id     = yandex_vpc_network.foo.id
+  v4_cidr_blocks = ["10.1.0.0/24"]
+}
+
+resource "yandex_mdb_kafka_cluster" "foo" {
+  name        = "%s"
+  environment = "PRESTABLE"
+  network_id  = yandex_vpc_network.foo.id
+  subnet_ids  = [yandex_vpc_subnet.foo.id]
+
+  config {
+    version       = "3.9"
+    zones         = ["ru-central1-a"]
+    brokers_count = 1
+
+    kafka {
+      resources {
+        resource_preset_id = "s2.micro"
+        disk_size          = 16
+        disk_type_id       = "network-ssd"
+      }
+    }
+  }
+}
+
+resource "yandex_mdb_kafka_topic" "events" {
+  cluster_id         = yandex_mdb_kafka_cluster.foo.id
+  name               = "events"
+  partitions         = 1
+  replication_factor = 1
+}
+
+resource "yandex_mdb_kafka_user" "events_user" {
+  cluster_id = yandex_mdb_kafka_cluster.foo.id
+  name       = "events-user"
+  password   = "test-password-123"
+
+  ignore_external_permissions = true
+
+  permission {
+    topic_name = yandex_mdb_kafka_topic.events.name
+    role       = "ACCESS_ROLE_PRODUCER"
+  }
+  %s
+}
+
+resource "yandex_mdb_kafka_user_permission" "events_consumer" {
+  cluster_id  = yandex_mdb_kafka_cluster.foo.id
+  user_name   = yandex_mdb_kafka_user.events_user.name
+  topic_name  = yandex_mdb_kafka_topic.events.name
+  role        = "ACCESS_ROLE_CONSUMER"
+  allow_hosts = ["10.1.0.10"]
+}
+`
+
+const kafkaUserInlineConsumerPermission = `
+  permission {
+    topic_name = yandex_mdb_kafka_topic.events.name
+    role       = "ACCESS_ROLE_CONSUMER"
+  }
+`
+
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
+
+func TestAccMDBKafkaUserPermission_basic(t *testing.T) {
+	t.Parallel()
+
+	clusterName := acctest.RandomWithPrefix("tf-kafka-user-permission")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.AccPreCheck(t) },
+		ProtoV6ProviderFactories: test.AccProviderFactories,
+		CheckDestroy:             testAccCheckMDBKafkaUserPermissionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(kafkaUserPermissionConfig, clusterName, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMDBKafkaUserPermissionExists(kafkaUserPermissionResourceName),
+					resource.TestCheckResourceAttr("yandex_mdb_kafka_user.events_user", "permission.#", "1"),
+					resource.TestCheckResourceAttr(kafkaUserPermissionResourceName, "allow_hosts.#", "1"),
+				),
+			},
+			{
+				ResourceName:      kafkaUserPermissionResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config:      fmt.Sprintf(kafkaUserPermissionConfig, clusterName, kafkaUserInlineConsumerPermission),
+				ExpectError: regexp.MustCompile(`already granted outside of permission blocks`),
+			},
+		},
+	})
+}
+
+func testAccHasMDBKafkaUserPermission(id string) (bool, error) {
+	parts := strings.SplitN(id, ":", 4)
+	if len(parts) != 4 {
+		return false, fmt.Errorf("invalid resource id format: %q", id)
+	}
+
+	config := test.AccProvider.(*yandex_framework.Provider).GetConfig()
+	user, err := kafkasdk.NewUserClient(config.SDKv2).Get(context.Background(), &kafka.GetUserRequest{
+		ClusterId: parts[0],
+		UserName:  parts[1],
+	})
+	if err != nil {
+		return false, err
+	}
+
+	for _, p := range user.Permissions {
+		if p.TopicName == parts[2] && p.Role.String() == parts[3] {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func testAccCheckMDBKafkaUserPermissionExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+
+		ok, err := testAccHasMDBKafkaUserPermission(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("Kafka user not found: %v", err)
+		}
+		if !ok {
+			return fmt.Errorf("Kafka user permission %q not found", rs.Primary.ID)
+		}
+		return nil
+	}
+}
+
+func testAccCheckMDBKafkaUserPermissionDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "yandex_mdb_kafka_user_permission" {
+			continue
+		}
+
+		ok, err := testAccHasMDBKafkaUserPermission(rs.Primary.ID)
+		if err == nil && ok {
+			return fmt.Errorf("Kafka user permission %q still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,331 @@
+package mdb_mongodb_cluster_v2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/mongodb/v1"
+	mongodbsdk "github.com/yandex-cloud/go-sdk/services/mdb/mongodb/v1"
+	ycsdk "github.com/yandex-cloud/go-sdk/v2"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/retry"
+)
+
+const defaultMDBPageSize = 1000
+
+var mongodbApi = MongoDBAPI{}
+
+type MongoDBAPI struct{}
+
+func (r *MongoDBAPI) GetCluster(ctx context.Context, sdk *ycsdk.SDK, diags *diag.Diagnostics, cid string) *mongodb.Cluster {
+	cluster, err := mongodbsdk.NewClusterClient(sdk).Get(ctx, &mongodb.GetClusterRequest{
+		ClusterId: cid,
+	})
+	if err != nil {
+		diags.AddError(
+			"Failed to read resource",
+			fmt.Sprintf("Error while requesting API to read MongoDB cluster %q: %s", cid, err.Error()),
+		)
+		return nil
+	}
+	return cluster
+}
+
+func (r *MongoDBAPI) CreateCluster(ctx context.Context, sdk *ycsdk.SDK, diags *diag.Diagnostics, req *mongodb.CreateClusterRequest) string {
+	op, err := mongodbsdk.NewClusterClient(sdk).Create(ctx, req)
+	if err != nil {
+		diags.AddError(
+			"Failed to create resource",
+			fmt.Sprintf("Error while requesting API to create MongoDB cluster: %s", err.Error()),
+		)
+		return ""
+	}
+
+	md := op.Metadata()
+
+	tflog.Debug(ctx, "Creating MongoDB Cluster", map[string]any{"cluster_id": md.ClusterId})
+
+	if _, err = op.Wait(ctx); err != nil {
+		diags.AddError(
+			"Failed to create resource",
+			fmt.Sprintf("Error while waiting for operation %q to create MongoDB cluster: %s", op.ID(), err.Error()),
+		)
+		return ""
+	}
+
+	return md.ClusterId
+}
+
+func (r *MongoDBAPI) RestoreCluster(ctx context.Context, sdk *ycsdk.SDK, diags *diag.Diagnostics, req *mongodb.RestoreClusterRequest) string {
+	op, err := mongodbsdk.NewClusterClient(sdk).Restore(ctx, req)
+	if err != nil {
+		diags.AddError(
+			"Failed to restore resource from backup",
+			fmt.Sprintf("Error while requesting API to restore MongoDB cluster from backup %q: %s", req.BackupId, err.Error()),
+		)
+		return ""
+	}
+
+	md := op.Metadata()
+
+	tflog.Debug(ctx, "Restoring MongoDB Cluster from backup", map[string]any{"cluster_id": md.ClusterId, "backup_id": req.BackupId})
+
+	if _, err = op.Wait(ctx); err != nil {
+		diags.AddError(
+			"Failed to restore resource from backup",
+			fmt.Sprintf("Error while waiting for operation %q to restore MongoDB cluster from backup %q: %s", op.ID(), req.BackupId, err.Error()),
+		)
+	}
+
+	return md.ClusterId
+}
+
+func (r *MongoDBAPI) UpdateCluster(ctx context.Context, sdk *ycsdk.SDK, diags *diag.Diagnostics, req *mongodb.UpdateClusterRequest) {
+	if req == nil || len(req.UpdateMask.GetPaths()) == 0 {
+		return
+	}
+
+	op, err := retry.ConflictingOperationV2(ctx, sdk, func() (*mongodbsdk.ClusterUpdateOperation, error) {
+		tflog.Debug(ctx, "Updating MongoDB Cluster", map[string]any{"request_body": req})
+		return mongodbsdk.NewClusterClient(sdk).Update(ctx, req)
+	})
+	if err != nil {
+		diags.AddError(
+			"Failed to update resource",
+			fmt.Sprintf("Error while requesting API to update MongoDB cluster %q: %s", req.ClusterId, err.Error()),
+		)
+		return
+	}
+
+	if _, err = op.Wait(ctx); err != nil {
+		diags.AddError(
+			"Failed to update resource",
+			fmt.Sprintf("Error while waiting for operation %q to update MongoDB cluster %q: %s", op.ID(), req.ClusterId, err.Error()),
+		)
+	}
+}
+
+func (r *MongoDBAPI) DeleteCluster(ctx context.Context, sdk *ycsdk.SDK, diags *diag.Diagnostics, cid string) {
+	op, err := mongodbsdk.NewClusterClient(sdk).Delete(ctx, &mongodb.DeleteClusterRequest{
+		ClusterId: cid,
+	})
+	if err != nil {
+		diags.AddError(
+			"Failed to delete resource",
+			fmt.Sprintf("Error while requesting API to delete MongoDB cluster %q: %s", cid, err.Error()),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting MongoDB Cluster", map[string]any{"cluster_id": cid})
+
+	if _, err = op.Wait(ctx); err != nil {
+		diags.AddError(
+			"Failed to delete resource",
+			fmt.Sprintf("Error while waiting for operation %q to delete MongoDB cluster %q: %s", op.ID(), cid, err.Error()),
+		)
+	}
+}
+
+func (r *MongoDBAPI) BackupCluster(ctx context.Context, sdk *ycsdk.SDK, diags *diag.Diagnostics, cid string) {
+	op, err := retry.ConflictingOperationV2(ctx, sdk, func() (*mongodbsdk.ClusterBackupOperation, error) {
+		return mongodbsdk.NewClusterClient(sdk).Backup(ctx, &mongodb.BackupClusterRequest{
+			ClusterId: cid,
+		})
+	})
+
+	if err != nil {
+		diags.AddError(
+			"Failed to backup resource",
+			fmt.Sprintf("Error while requesting API to backup MongoDB cluster %q: %s", cid, err.Error()),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Creating final backup of MongoDB Cluster", map[string]any{"cluster_id": cid})
+
+	if _, err = op.Wait(ctx); err != nil {
+		diags.AddError(
+			"Failed to backup resource",
+			fmt.Sprintf("Error while waiting for operation %q to backup MongoDB cluster %q: %s", op.ID(), cid, err.Error()),
+		)
+	}
+}
+
+// EnableSharding turns the replica set into a sharded cluster.
+// The existing mongod hosts become the first shard, the request brings in the infrastructure hosts.
+func (r *MongoDBAPI) EnableSharding(ctx context.Context, sdk *ycsdk.SDK, diags *diag.Diagnostics, req *mongodb.EnableClusterShardingRequest) {
+	op, err := retry.ConflictingOperationV2(ctx, sdk, func() (*mongodbsdk.ClusterEnableShardingOperation, error) {
+		tflog.Debug(ctx, "Enabling sharding on MongoDB Cluster", map[string]any{"request_body": req})
+		return mongodbsdk.NewClusterClient(sdk).EnableSharding(ctx, req)
+	})
+	if err != nil {
+		diags.AddError(
+			"Failed to enable sharding",
+			fmt.Sprintf("Error while requesting API to enable sharding on MongoDB cluster %q: %s", req.ClusterId, err.Error()),
+		)
+		return
+	}
+
+	if _, err = op.Wait(ctx); err != nil {
+		diags.AddError(
+			"Failed to enable sharding",
+			fmt.Sprintf("Error while waiting for operation %q to enable sharding on MongoDB cluster %q: %s", op.ID(), req.ClusterId, err.Error()),
+		)
+	}
+}
+
+func (r *MongoDBAPI) ListHosts(ctx context.Context, sdk *ycsdk.SDK, diags *diag.Diagnostics, cid string) []*mongodb.Host {
+	hosts := []*mongodb.Host{}
+	pageToken := ""
+
+	for {
+		resp, err := mongodbsdk.NewClusterClient(sdk).ListHosts(ctx, &mongodb.ListClusterHostsRequest{
+			ClusterId: cid,
+			PageSize:  defaultMDBPageSize,
+			PageToken: pageToken,
+		})
+		if err != nil {
+			diags.AddError(
+				"Failed to List MongoDB Hosts",
+				fmt.Sprintf("Error while requesting API to list hosts of MongoDB cluster %q: %s", cid, err.Error()),
+			)
+			return nil
+		}
+
+		hosts = append(hosts, resp.Hosts...)
+
+		if resp.NextPageToken == "" {
+			break
+		}
+		pageToken = resp.NextPageToken
+	}
+
+	return hosts
+}
+
+func (r *MongoDBAPI) CreateHosts(ctx context.Context, sdk *ycsdk.SDK, diags *diag.Diagnostics, cid string, specs []*mongodb.HostSpec, _ struct{}) {
+	for _, spec := range specs {
+		op, err := retry.ConflictingOperationV2(ctx, sdk, func() (*mongodbsdk.ClusterAddHostsOperation, error) {
+			return mongodbsdk.NewClusterClient(sdk).AddHosts(ctx, &mongodb.AddClusterHostsRequest{
+				ClusterId: cid,
+				HostSpecs: []*mongodb.HostSpec{spec},
+			})
+		})
+		if err != nil {
+			diags.AddError(
+				"Failed to create host",
+				fmt.Sprintf("Error while requesting API to add host to MongoDB cluster %q: %s", cid, err.Error()),
+			)
+			return
+		}
+
+		if _, err = op.Wait(ctx); err != nil {
+			diags.AddError(
+				"Failed to create host",
+				fmt.Sprintf("Error while waiting for operation %q to add host to MongoDB cluster %q: %s", op.ID(), cid, err.Error()),
+			)
+			return
+		}
+	}
+}
+
+func (r *MongoDBAPI) UpdateHosts(ctx context.Context, sdk *ycsdk.SDK, diags *diag.Diagnostics, cid string, specs []*mongodb.UpdateHostSpec) {
+	for _, spec := range specs {
+		op, err := retry.ConflictingOperationV2(ctx, sdk, func() (*mongodbsdk.ClusterUpdateHostsOperation, error) {
+			return mongodbsdk.NewClusterClient(sdk).UpdateHosts(ctx, &mongodb.UpdateClusterHostsRequest{
+				ClusterId:       cid,
+				UpdateHostSpecs: []*mongodb.UpdateHostSpec{spec},
+			})
+		})
+		if err != nil {
+			diags.AddError(
+				"Failed to update host",
+				fmt.Sprintf("Error while requesting API to update host %q of MongoDB cluster %q: %s", spec.HostName, cid, err.Error()),
+			)
+			return
+		}
+
+		if _, err = op.Wait(ctx); err != nil {
+			diags.AddError(
+				"Failed to update host",
+				fmt.Sprintf("Error while waiting for operation %q to update host %q of MongoDB cluster %q: %s", op.ID(), spec.HostName, cid, err.Error()),
+			)
+			return
+		}
+	}
+}
+
+func (r *MongoDBAPI) DeleteHosts(ctx context.Context, sdk *ycsdk.SDK, diags *diag.Diagnostics, cid string, fqdns []string) {
+	for _, fqdn := range fqdns {
+		op, err := retry.ConflictingOperationV2(ctx, sdk, func() (*mongodbsdk.ClusterDeleteHostsOperation, error) {
+			return mongodbsdk.NewClusterClient(sdk).DeleteHosts(ctx, &mongodb.DeleteClusterHostsRequest{
+				ClusterId: cid,
+				HostNames: []string{fqdn},
+			})
+		})
+		if err != nil {
+			diags.AddError(
+				"Failed to delete host",
+				fmt.Sprintf("Error while requesting API to delete host %q from MongoDB cluster %q: %s", fqdn, cid, err.Error()),
+			)
+			return
+		}
+
+		if _, err = op.Wait(ctx); err != nil {
+			diags.AddError(
+				"Failed to delete host",
+				fmt.Sprintf("Error while waiting for operation %q to delete host %q from MongoDB cluster %q: %s", op.ID(), fqdn, cid, err.Error()),
+			)
+			return
+		}
+	}
+}
+
+func (r *MongoDBAPI) CreateShard(ctx context.Context, sdk *ycsdk.SDK, diags *diag.Diagnostics, cid, shardName string, hostSpecs []*mongodb.HostSpec, _ struct{}) {
+	op, err := retry.ConflictingOperationV2(ctx, sdk, func() (*mongodbsdk.ClusterAddShardOperation, error) {
+		return mongodbsdk.NewClusterClient(sdk).AddShard(ctx, &mongodb.AddClusterShardRequest{
+			ClusterId: cid,
+			ShardName: shardName,
+			HostSpecs: hostSpecs,
+		})
+	})
+	if err != nil {
+		diags.AddError(
+			"Failed to create shard",
+			fmt.Sprintf("Error while requesting API to add shard %q to MongoDB cluster %q: %s", shardName, cid, err.Error()),
+		)
+		return
+	}
+
+	if _, err = op.Wait(ctx); err != nil {
+		diags.AddError(
+			"Failed to create shard",
+			fmt.Sprintf("Error while waiting for operation %q to add shard %q to MongoDB cluster %q: %s", op.ID(), shardName, cid, err.Error()),
+		)
+	}
+}
+
+func (r *MongoDBAPI) DeleteShard(ctx context.Context, sdk *ycsdk.SDK, diags *diag.Diagnostics, cid, shardName string) {
+	op, err := retry.ConflictingOperationV2(ctx, sdk, func() (*mongodbsdk.ClusterDeleteShardOperation, error) {
+		return mongodbsdk.NewClusterClient(sdk).DeleteShard(ctx, &mongodb.DeleteClusterShardRequest{
+			ClusterId: cid,
+			ShardName: shardName,
+		})
+	})
+	if err != nil {
+		diags.AddError(
+			"Failed to delete shard",
+			fmt.Sprintf("Error while requesting API to delete shard %q from MongoDB cluster %q: %s", shardName, cid, err.Error()),
+		)
+		return
+	}
+
+	if _, err = op.Wait(ctx); err != nil {
+		diags.AddError(
+			"Failed to delete shard",
+			fmt.Sprintf("Error while waiting for operation %q to delete shard %q from MongoDB cluster %q: %s", op.ID(), shardName, cid, err.Error()),
+		)
+	}
+}
@@ -0,0 +1,92 @@
+package mdb_mongodb_cluster_v2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/mongodb/v1"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/mdbcommon"
+	utils "github.com/yandex-cloud/terraform-provider-yandex/pkg/wrappers"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func prepareCreateRequest(ctx context.Context, plan *Cluster, providerConfig *config.State) (*mongodb.CreateClusterRequest, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+
+	hostSpecs, d := mdbcommon.CreateClusterHosts(ctx, mongodbHostService, plan.HostSpecs)
+	diags.Append(d...)
+
+	request := &mongodb.CreateClusterRequest{
+		FolderId:            mdbcommon.ExpandFolderId(ctx, plan.FolderId, providerConfig, &diags),
+		Name:                plan.Name.ValueString(),
+		Description:         plan.Description.ValueString(),
+		NetworkId:           plan.NetworkId.ValueString(),
+		Environment:         mdbcommon.ExpandEnvironment[mongodb.Cluster_Environment](ctx, plan.Environment, &diags),
+		Labels:              mdbcommon.ExpandLabels(ctx, plan.Labels, &diags),
+		ConfigSpec:          expandConfigSpec(ctx, plan, &diags),
+		HostSpecs:           hostSpecs,
+		SecurityGroupIds:    mdbcommon.ExpandSecurityGroupIds(ctx, plan.SecurityGroupIds, &diags),
+		DeletionProtection:  plan.DeletionProtection.ValueBool(),
+		MaintenanceWindow:   expandMaintenanceWindow(ctx, plan.MaintenanceWindow, &diags),
+		DiskEncryptionKeyId: mdbcommon.ExpandStringWrapper(ctx, plan.DiskEncryptionKeyId, &diags),
+	}
+
+	return request, diags
+}
+
+func prepareRestoreRequest(ctx context.Context, plan *Cluster, providerConfig *config.State) (*mongodb.RestoreClusterRequest, diag.Diagnostics) {
+	createRequest, diags := prepareCreateRequest(ctx, plan, providerConfig)
+
+	var restoreConf Restore
+	diags.Append(plan.Restore.As(ctx, &restoreConf, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	var recoveryTarget *mongodb.RestoreClusterRequest_RecoveryTargetSpec
+	if utils.IsPresent(restoreConf.Time) {
+		t, err := mdbcommon.ParseStringToTime(restoreConf.Time.ValueString())
+		if err != nil {
+			diags.AddError(
+				"Failed to create MongoDB cluster from backup",
+				fmt.Sprintf(
+					"Error while parsing restore time to create MongoDB Cluster from backup %v, value: %v error: %s",
+					restoreConf.BackupId, restoreConf.Time, err.Error(),
+				),
+			)
+			return nil, diags
+		}
+		recoveryTarget = &mongodb.RestoreClusterRequest_RecoveryTargetSpec{
+			Timestamp: t.Unix(),
+		}
+	}
+
+	request := &mongodb.RestoreClusterRequest{
+		BackupId:            restoreConf.BackupId.ValueString(),
+		RecoveryTargetSpec:  recoveryTarget,
+		FolderId:            createRequest.FolderId,
+		Name:                createRequest.Name,
+		Description:         createRequest.Description,
+		NetworkId:           createRequest.NetworkId,
+		Environment:         createRequest.Environment,
+		Labels:              createRequest.Labels,
+		ConfigSpec:          createRequest.ConfigSpec,
+		HostSpecs:           createRequest.HostSpecs,
+		SecurityGroupIds:    createRequest.SecurityGroupIds,
+		DeletionProtection:  createRequest.DeletionProtection,
+		MaintenanceWindow:   createRequest.MaintenanceWindow,
+		DiskEncryptionKeyId: createRequest.DiskEncryptionKeyId,
+	}
+
+	// Empty string will remove encryption when restoring
+	if request.DiskEncryptionKeyId == nil {
+		tflog.Warn(ctx, "Disk encryption key ID is not set. Encryption will be disabled if present in source cluster.")
+		request.DiskEncryptionKeyId = wrapperspb.String("")
+	}
+
+	return request, diags
+}
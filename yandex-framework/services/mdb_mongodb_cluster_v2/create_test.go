@@ -0,0 +1,70 @@
+package mdb_mongodb_cluster_v2
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
+)
+
+func TestPrepareRestoreRequest(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	cases := []struct {
+		testname      string
+		time          types.String
+		expectedTime  int64
+		expectedError bool
+	}{
+		{
+			testname: "LatestState",
+			time:     types.StringNull(),
+		},
+		{
+			testname:     "PointInTime",
+			time:         types.StringValue("2024-01-02T03:04:05"),
+			expectedTime: 1704164645,
+		},
+		{
+			testname:      "InvalidTime",
+			time:          types.StringValue("yesterday"),
+			expectedError: true,
+		},
+	}
+
+	for _, c := range cases {
+		plan := testCluster(t, false, map[string]Host{"a": testHost("MONGOD", "rs01", "")})
+		plan.FolderId = types.StringValue("folder")
+		plan.NetworkId = types.StringValue("network")
+		plan.Environment = types.StringValue("PRODUCTION")
+		plan.DiskEncryptionKeyId = types.StringNull()
+		plan.Restore = types.ObjectValueMust(RestoreAttrTypes, map[string]attr.Value{
+			"backup_id": types.StringValue("cid:backup"),
+			"time":      c.time,
+		})
+
+		req, diags := prepareRestoreRequest(ctx, plan, &config.State{})
+		if diags.HasError() != c.expectedError {
+			t.Errorf("%s: unexpected diagnostics: %v", c.testname, diags)
+			continue
+		}
+		if c.expectedError {
+			continue
+		}
+
+		if req.GetBackupId() != "cid:backup" || req.GetName() != "mongo" || len(req.GetHostSpecs()) != 1 {
+			t.Errorf("%s: unexpected restore request: %v", c.testname, req)
+		}
+		if req.GetRecoveryTargetSpec().GetTimestamp() != c.expectedTime {
+			t.Errorf("%s: unexpected recovery target: %v", c.testname, req.GetRecoveryTargetSpec())
+		}
+		// Encryption of the source cluster is removed unless a key is set explicitly.
+		if req.GetDiskEncryptionKeyId() == nil || req.GetDiskEncryptionKeyId().GetValue() != "" {
+			t.Errorf("%s: unexpected disk encryption key: %v", c.testname, req.GetDiskEncryptionKeyId())
+		}
+	}
+}
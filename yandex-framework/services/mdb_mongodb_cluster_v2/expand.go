@@ -0,0 +1,155 @@
+package mdb_mongodb_cluster_v2
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/mongodb/v1"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/datasize"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/mdbcommon"
+	utils "github.com/yandex-cloud/terraform-provider-yandex/pkg/wrappers"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var unhandledAsEmpty = basetypes.ObjectAsOptions{
+	UnhandledNullAsEmpty:    true,
+	UnhandledUnknownAsEmpty: true,
+}
+
+func expandConfigSpec(ctx context.Context, plan *Cluster, diags *diag.Diagnostics) *mongodb.ConfigSpec {
+	spec := &mongodb.ConfigSpec{
+		Version:                     plan.Version.ValueString(),
+		FeatureCompatibilityVersion: expandFeatureCompatibilityVersion(plan),
+		Mongodb:                     expandMongodbSpec(ctx, plan, diags),
+		BackupRetainPeriodDays:      mdbcommon.ExpandInt64Wrapper(ctx, plan.BackupRetainPeriodDays, diags),
+		PerformanceDiagnostics:      expandPerformanceDiagnostics(ctx, plan.PerformanceDiagnostics, diags),
+	}
+
+	if utils.IsPresent(plan.BackupWindowStart) {
+		spec.BackupWindowStart = mdbcommon.ExpandBackupWindow(ctx, plan.BackupWindowStart, diags)
+	}
+	if utils.IsPresent(plan.Access) {
+		spec.Access = mdbcommon.ExpandAccess[*mongodb.Access](ctx, plan.Access, diags)
+	}
+
+	return spec
+}
+
+// The feature compatibility version follows the server version unless it is set explicitly.
+func expandFeatureCompatibilityVersion(plan *Cluster) string {
+	if utils.IsPresent(plan.FeatureCompatibilityVersion) {
+		return plan.FeatureCompatibilityVersion.ValueString()
+	}
+	return plan.Version.ValueString()
+}
+
+// expandMongodbSpec builds the subcluster specs. Infrastructure subclusters are
+// only sent when the hosts of the matching type are planned.
+func expandMongodbSpec(ctx context.Context, plan *Cluster, diags *diag.Diagnostics) *mongodb.MongodbSpec {
+	hostTypes := planHostTypes(ctx, plan.HostSpecs, diags)
+
+	spec := &mongodb.MongodbSpec{
+		Mongod: &mongodb.MongodbSpec_Mongod{
+			Resources:           mdbcommon.ExpandResources[mongodb.Resources](ctx, plan.ResourcesMongod, diags),
+			DiskSizeAutoscaling: expandDiskSizeAutoscaling(ctx, plan.DiskSizeAutoscalingMongod, diags),
+		},
+	}
+
+	if _, ok := hostTypes[mongodb.Host_MONGOS.String()]; ok {
+		spec.Mongos = &mongodb.MongodbSpec_Mongos{
+			Resources:           mdbcommon.ExpandResources[mongodb.Resources](ctx, plan.ResourcesMongos, diags),
+			DiskSizeAutoscaling: expandDiskSizeAutoscaling(ctx, plan.DiskSizeAutoscalingMongos, diags),
+		}
+	}
+	if _, ok := hostTypes[mongodb.Host_MONGOCFG.String()]; ok {
+		spec.Mongocfg = &mongodb.MongodbSpec_MongoCfg{
+			Resources:           mdbcommon.ExpandResources[mongodb.Resources](ctx, plan.ResourcesMongocfg, diags),
+			DiskSizeAutoscaling: expandDiskSizeAutoscaling(ctx, plan.DiskSizeAutoscalingMongocfg, diags),
+		}
+	}
+	if _, ok := hostTypes[mongodb.Host_MONGOINFRA.String()]; ok {
+		spec.Mongoinfra = &mongodb.MongodbSpec_MongoInfra{
+			Resources:           mdbcommon.ExpandResources[mongodb.Resources](ctx, plan.ResourcesMongoinfra, diags),
+			DiskSizeAutoscaling: expandDiskSizeAutoscaling(ctx, plan.DiskSizeAutoscalingMongoinfra, diags),
+		}
+	}
+
+	return spec
+}
+
+func expandPerformanceDiagnostics(ctx context.Context, o types.Object, diags *diag.Diagnostics) *mongodb.PerformanceDiagnosticsConfig {
+	if !utils.IsPresent(o) {
+		return nil
+	}
+
+	var pd PerformanceDiagnostics
+	diags.Append(o.As(ctx, &pd, unhandledAsEmpty)...)
+	if diags.HasError() {
+		return nil
+	}
+	return &mongodb.PerformanceDiagnosticsConfig{ProfilingEnabled: pd.Enabled.ValueBool()}
+}
+
+func expandDiskSizeAutoscaling(ctx context.Context, o types.Object, diags *diag.Diagnostics) *mongodb.DiskSizeAutoscaling {
+	if !utils.IsPresent(o) {
+		return nil
+	}
+
+	var dsa DiskSizeAutoscaling
+	diags.Append(o.As(ctx, &dsa, datasize.DefaultOpts)...)
+	if diags.HasError() {
+		return nil
+	}
+
+	result := &mongodb.DiskSizeAutoscaling{
+		DiskSizeLimit: wrapperspb.Int64(datasize.ToBytes(dsa.DiskSizeLimit.ValueInt64())),
+	}
+	if utils.IsPresent(dsa.PlannedUsageThreshold) {
+		result.PlannedUsageThreshold = wrapperspb.Int64(dsa.PlannedUsageThreshold.ValueInt64())
+	}
+	if utils.IsPresent(dsa.EmergencyUsageThreshold) {
+		result.EmergencyUsageThreshold = wrapperspb.Int64(dsa.EmergencyUsageThreshold.ValueInt64())
+	}
+	return result
+}
+
+func expandMaintenanceWindow(ctx context.Context, o types.Object, diags *diag.Diagnostics) *mongodb.MaintenanceWindow {
+	return mdbcommon.ExpandClusterMaintenanceWindow[
+		mongodb.MaintenanceWindow,
+		mongodb.WeeklyMaintenanceWindow,
+		mongodb.AnytimeMaintenanceWindow,
+		mongodb.WeeklyMaintenanceWindow_WeekDay,
+	](ctx, o, diags)
+}
+
+// planHostTypes returns the set of host types present in the hosts map.
+func planHostTypes(ctx context.Context, hosts types.Map, diags *diag.Diagnostics) map[string]struct{} {
+	result := make(map[string]struct{})
+	if !utils.IsPresent(hosts) {
+		return result
+	}
+
+	hostsMap := make(map[string]Host)
+	diags.Append(hosts.ElementsAs(ctx, &hostsMap, false)...)
+	for _, h := range hostsMap {
+		// The type is not known yet or is left to the default.
+		if !utils.IsPresent(h.Type) {
+			continue
+		}
+		result[h.Type.ValueString()] = struct{}{}
+	}
+	return result
+}
+
+// hasInfraHosts reports whether the hosts map contains mongos, mongocfg or mongoinfra hosts,
+// i.e. whether the planned cluster is sharded.
+func hasInfraHosts(ctx context.Context, hosts types.Map, diags *diag.Diagnostics) bool {
+	for t := range planHostTypes(ctx, hosts, diags) {
+		if t != mongodb.Host_MONGOD.String() {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,90 @@
+package mdb_mongodb_cluster_v2
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/mongodb/v1"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/datasize"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/mdbcommon"
+)
+
+// flattenRoleResources returns null for the subclusters the cluster doesn't have.
+func flattenRoleResources(ctx context.Context, r *mongodb.Resources, diags *diag.Diagnostics) types.Object {
+	if r == nil {
+		return types.ObjectNull(mdbcommon.ResourceType.AttrTypes)
+	}
+	return mdbcommon.FlattenResources(ctx, r, diags)
+}
+
+func flattenPerformanceDiagnostics(ctx context.Context, pd *mongodb.PerformanceDiagnosticsConfig, diags *diag.Diagnostics) types.Object {
+	if pd == nil {
+		return types.ObjectNull(PerformanceDiagnosticsAttrTypes)
+	}
+
+	obj, d := types.ObjectValueFrom(ctx, PerformanceDiagnosticsAttrTypes, PerformanceDiagnostics{
+		Enabled: types.BoolValue(pd.ProfilingEnabled),
+	})
+	diags.Append(d...)
+	return obj
+}
+
+// The API returns an empty message for subclusters without autoscaling.
+func flattenDiskSizeAutoscaling(ctx context.Context, dsa *mongodb.DiskSizeAutoscaling, diags *diag.Diagnostics) types.Object {
+	if dsa.GetDiskSizeLimit().GetValue() == 0 {
+		return types.ObjectNull(DiskSizeAutoscalingAttrTypes)
+	}
+
+	obj, d := types.ObjectValueFrom(ctx, DiskSizeAutoscalingAttrTypes, DiskSizeAutoscaling{
+		DiskSizeLimit:           types.Int64Value(datasize.ToGigabytes(dsa.GetDiskSizeLimit().GetValue())),
+		PlannedUsageThreshold:   types.Int64Value(dsa.GetPlannedUsageThreshold().GetValue()),
+		EmergencyUsageThreshold: types.Int64Value(dsa.GetEmergencyUsageThreshold().GetValue()),
+	})
+	diags.Append(d...)
+	return obj
+}
+
+func flattenMaintenanceWindow(ctx context.Context, mw *mongodb.MaintenanceWindow, diags *diag.Diagnostics) types.Object {
+	return mdbcommon.FlattenMaintenanceWindow[
+		mongodb.MaintenanceWindow,
+		mongodb.WeeklyMaintenanceWindow,
+		mongodb.AnytimeMaintenanceWindow,
+		mongodb.WeeklyMaintenanceWindow_WeekDay,
+	](ctx, mw, diags)
+}
+
+// flattenMongodbConfig fills the per-subcluster resources and disk autoscaling of the state.
+func flattenMongodbConfig(ctx context.Context, state *Cluster, cfg *mongodb.ClusterConfig, diags *diag.Diagnostics) {
+	mc := cfg.GetMongodbConfig()
+
+	state.ResourcesMongod = flattenRoleResources(ctx, mc.GetMongod().GetResources(), diags)
+	state.ResourcesMongos = flattenRoleResources(ctx, mc.GetMongos().GetResources(), diags)
+	state.ResourcesMongocfg = flattenRoleResources(ctx, mc.GetMongocfg().GetResources(), diags)
+	state.ResourcesMongoinfra = flattenRoleResources(ctx, mc.GetMongoinfra().GetResources(), diags)
+
+	state.DiskSizeAutoscalingMongod = flattenDiskSizeAutoscaling(ctx, mc.GetMongod().GetDiskSizeAutoscaling(), diags)
+	state.DiskSizeAutoscalingMongos = flattenDiskSizeAutoscaling(ctx, mc.GetMongos().GetDiskSizeAutoscaling(), diags)
+	state.DiskSizeAutoscalingMongocfg = flattenDiskSizeAutoscaling(ctx, mc.GetMongocfg().GetDiskSizeAutoscaling(), diags)
+	state.DiskSizeAutoscalingMongoinfra = flattenDiskSizeAutoscaling(ctx, mc.GetMongoinfra().GetDiskSizeAutoscaling(), diags)
+}
+
+// flattenEndpoints returns the replica sets of the cluster by the shard name, and the routers of a sharded
+// cluster as the rw endpoint. MongoDB clusters have no special FQDNs, so the hosts are listed.
+func flattenEndpoints(ctx context.Context, hosts map[string]Host, diags *diag.Diagnostics) types.Object {
+	var routers []string
+	shards := make(map[string][]string)
+	for _, host := range hosts {
+		switch host.Type.ValueString() {
+		case mongodb.Host_MONGOS.String(), mongodb.Host_MONGOINFRA.String():
+			routers = append(routers, mdbcommon.HostPort(host.FQDN.ValueString(), mdbcommon.MongoDBMongosPort))
+		case "", mongodb.Host_MONGOD.String():
+			shardName := host.ShardName.ValueString()
+			shards[shardName] = append(shards[shardName], mdbcommon.HostPort(host.FQDN.ValueString(), mdbcommon.MongoDBPort))
+		}
+	}
+	slices.Sort(routers)
+	return mdbcommon.FlattenEndpoints(ctx, strings.Join(routers, ","), "", shards, diags)
+}
@@ -0,0 +1,152 @@
+package mdb_mongodb_cluster_v2
+
+import (
+	"context"
+	"maps"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/mongodb/v1"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+const (
+	defaultHostPriority           = 1.0
+	defaultHostVotes              = 1
+	defaultHostSecondaryDelaySecs = 0
+)
+
+var mongodbHostService = &MongoDBHostService{}
+
+type MongoDBHostService struct {
+}
+
+func (r MongoDBHostService) FullyMatch(planHost Host, stateHost Host) bool {
+	return r.PartialMatch(planHost, stateHost) &&
+		planHost.AssignPublicIp.ValueBool() == stateHost.AssignPublicIp.ValueBool() &&
+		planHost.Hidden.ValueBool() == stateHost.Hidden.ValueBool() &&
+		planHost.Priority.ValueFloat64() == stateHost.Priority.ValueFloat64() &&
+		planHost.Votes.ValueInt64() == stateHost.Votes.ValueInt64() &&
+		planHost.SecondaryDelaySecs.ValueInt64() == stateHost.SecondaryDelaySecs.ValueInt64() &&
+		maps.Equal(hostTags(planHost), hostTags(stateHost))
+}
+
+func (r MongoDBHostService) PartialMatch(planHost Host, stateHost Host) bool {
+	return planHost.Zone.Equal(stateHost.Zone) &&
+		planHost.Type.Equal(stateHost.Type) &&
+		(planHost.FQDN.IsUnknown() || planHost.FQDN.Equal(stateHost.FQDN)) &&
+		(planHost.SubnetId.IsUnknown() || planHost.SubnetId.Equal(stateHost.SubnetId)) &&
+		(planHost.ShardName.IsUnknown() || planHost.ShardName.Equal(stateHost.ShardName))
+}
+
+func (r MongoDBHostService) GetChanges(plan Host, state Host) (*mongodb.UpdateHostSpec, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if !r.PartialMatch(plan, state) {
+		diags.AddError(
+			"Wrong changes for host",
+			"Attributes type, shard_name, zone, subnet_id can't be changed. Try to replace this host to new one",
+		)
+		return nil, diags
+	}
+
+	spec := &mongodb.UpdateHostSpec{
+		HostName:   state.FQDN.ValueString(),
+		UpdateMask: &fieldmaskpb.FieldMask{},
+	}
+	if !plan.AssignPublicIp.Equal(state.AssignPublicIp) {
+		spec.AssignPublicIp = plan.AssignPublicIp.ValueBool()
+		spec.UpdateMask.Paths = append(spec.UpdateMask.Paths, "assign_public_ip")
+	}
+
+	// Replica set parameters are applicable to mongod hosts only.
+	if isMongod(plan) {
+		if !plan.Hidden.Equal(state.Hidden) {
+			spec.Hidden = wrapperspb.Bool(plan.Hidden.ValueBool())
+			spec.UpdateMask.Paths = append(spec.UpdateMask.Paths, "hidden")
+		}
+		if !plan.Priority.Equal(state.Priority) {
+			spec.Priority = wrapperspb.Double(plan.Priority.ValueFloat64())
+			spec.UpdateMask.Paths = append(spec.UpdateMask.Paths, "priority")
+		}
+		if !plan.Votes.Equal(state.Votes) {
+			spec.Votes = wrapperspb.Int64(plan.Votes.ValueInt64())
+			spec.UpdateMask.Paths = append(spec.UpdateMask.Paths, "votes")
+		}
+		if !plan.SecondaryDelaySecs.Equal(state.SecondaryDelaySecs) {
+			spec.SecondaryDelaySecs = wrapperspb.Int64(plan.SecondaryDelaySecs.ValueInt64())
+			spec.UpdateMask.Paths = append(spec.UpdateMask.Paths, "secondary_delay_secs")
+		}
+		if tags := hostTags(plan); !maps.Equal(tags, hostTags(state)) {
+			spec.Tags = tags
+			spec.UpdateMask.Paths = append(spec.UpdateMask.Paths, "tags")
+		}
+	}
+
+	if len(spec.UpdateMask.Paths) == 0 {
+		return nil, nil
+	}
+	return spec, diags
+}
+
+func (r MongoDBHostService) ConvertToProto(h Host) *mongodb.HostSpec {
+	spec := &mongodb.HostSpec{
+		ZoneId:         h.Zone.ValueString(),
+		SubnetId:       h.SubnetId.ValueString(),
+		AssignPublicIp: h.AssignPublicIp.ValueBool(),
+		ShardName:      h.ShardName.ValueString(),
+		Type:           mongodb.Host_Type(mongodb.Host_Type_value[h.Type.ValueString()]),
+	}
+
+	if isMongod(h) {
+		spec.SetHidden(wrapperspb.Bool(h.Hidden.ValueBool()))
+		spec.SetPriority(wrapperspb.Double(h.Priority.ValueFloat64()))
+		spec.SetVotes(wrapperspb.Int64(h.Votes.ValueInt64()))
+		spec.SetSecondaryDelaySecs(wrapperspb.Int64(h.SecondaryDelaySecs.ValueInt64()))
+		spec.Tags = hostTags(h)
+	}
+
+	return spec
+}
+
+func (r MongoDBHostService) ConvertFromProto(apiHost *mongodb.Host) Host {
+	host := Host{
+		Zone:               types.StringValue(apiHost.ZoneId),
+		SubnetId:           types.StringValue(apiHost.SubnetId),
+		AssignPublicIp:     types.BoolValue(apiHost.AssignPublicIp),
+		ShardName:          types.StringValue(apiHost.ShardName),
+		Type:               types.StringValue(apiHost.Type.String()),
+		FQDN:               types.StringValue(apiHost.Name),
+		Hidden:             types.BoolValue(false),
+		Priority:           types.Float64Value(defaultHostPriority),
+		Votes:              types.Int64Value(defaultHostVotes),
+		SecondaryDelaySecs: types.Int64Value(defaultHostSecondaryDelaySecs),
+		Tags:               types.MapNull(types.StringType),
+	}
+
+	if hp := apiHost.GetHostParameters(); hp != nil {
+		host.Hidden = types.BoolValue(hp.Hidden)
+		host.Priority = types.Float64Value(hp.Priority)
+		host.Votes = types.Int64Value(hp.Votes)
+		host.SecondaryDelaySecs = types.Int64Value(hp.SecondaryDelaySecs)
+		if len(hp.Tags) > 0 {
+			host.Tags, _ = types.MapValueFrom(context.Background(), types.StringType, hp.Tags)
+		}
+	}
+
+	return host
+}
+
+func isMongod(h Host) bool {
+	return h.Type.ValueString() == mongodb.Host_MONGOD.String()
+}
+
+func hostTags(h Host) map[string]string {
+	tags := make(map[string]string, len(h.Tags.Elements()))
+	for k, v := range h.Tags.Elements() {
+		if s, ok := v.(types.String); ok {
+			tags[k] = s.ValueString()
+		}
+	}
+	return tags
+}
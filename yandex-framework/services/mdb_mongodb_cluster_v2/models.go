@@ -0,0 +1,125 @@
+package mdb_mongodb_cluster_v2
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/mdbcommon"
+)
+
+type Cluster struct {
+	Id                            types.String   `tfsdk:"id"`
+	FolderId                      types.String   `tfsdk:"folder_id"`
+	NetworkId                     types.String   `tfsdk:"network_id"`
+	Name                          types.String   `tfsdk:"name"`
+	Description                   types.String   `tfsdk:"description"`
+	Environment                   types.String   `tfsdk:"environment"`
+	Labels                        types.Map      `tfsdk:"labels"`
+	SecurityGroupIds              types.Set      `tfsdk:"security_group_ids"`
+	DeletionProtection            types.Bool     `tfsdk:"deletion_protection"`
+	FinalBackup                   types.Bool     `tfsdk:"final_backup"`
+	MaintenanceWindow             types.Object   `tfsdk:"maintenance_window"`
+	DiskEncryptionKeyId           types.String   `tfsdk:"disk_encryption_key_id"`
+	Sharded                       types.Bool     `tfsdk:"sharded"`
+	Version                       types.String   `tfsdk:"version"`
+	FeatureCompatibilityVersion   types.String   `tfsdk:"feature_compatibility_version"`
+	BackupRetainPeriodDays        types.Int64    `tfsdk:"backup_retain_period_days"`
+	BackupWindowStart             types.Object   `tfsdk:"backup_window_start"`
+	Access                        types.Object   `tfsdk:"access"`
+	PerformanceDiagnostics        types.Object   `tfsdk:"performance_diagnostics"`
+	ResourcesMongod               types.Object   `tfsdk:"resources_mongod"`
+	ResourcesMongos               types.Object   `tfsdk:"resources_mongos"`
+	ResourcesMongocfg             types.Object   `tfsdk:"resources_mongocfg"`
+	ResourcesMongoinfra           types.Object   `tfsdk:"resources_mongoinfra"`
+	DiskSizeAutoscalingMongod     types.Object   `tfsdk:"disk_size_autoscaling_mongod"`
+	DiskSizeAutoscalingMongos     types.Object   `tfsdk:"disk_size_autoscaling_mongos"`
+	DiskSizeAutoscalingMongocfg   types.Object   `tfsdk:"disk_size_autoscaling_mongocfg"`
+	DiskSizeAutoscalingMongoinfra types.Object   `tfsdk:"disk_size_autoscaling_mongoinfra"`
+	HostSpecs                     types.Map      `tfsdk:"hosts"`
+	HostUpdateStrategy            types.Object   `tfsdk:"host_update_strategy"`
+	Endpoints                     types.Object   `tfsdk:"endpoints"`
+	Restore                       types.Object   `tfsdk:"restore"`
+	Timeouts                      timeouts.Value `tfsdk:"timeouts"`
+}
+
+type Host struct {
+	Zone               types.String  `tfsdk:"zone"`
+	SubnetId           types.String  `tfsdk:"subnet_id"`
+	AssignPublicIp     types.Bool    `tfsdk:"assign_public_ip"`
+	ShardName          types.String  `tfsdk:"shard_name"`
+	Type               types.String  `tfsdk:"type"`
+	FQDN               types.String  `tfsdk:"fqdn"`
+	Hidden             types.Bool    `tfsdk:"hidden"`
+	Priority           types.Float64 `tfsdk:"priority"`
+	Votes              types.Int64   `tfsdk:"votes"`
+	SecondaryDelaySecs types.Int64   `tfsdk:"secondary_delay_secs"`
+	Tags               types.Map     `tfsdk:"tags"`
+}
+
+var hostType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"zone":                 types.StringType,
+		"subnet_id":            types.StringType,
+		"assign_public_ip":     types.BoolType,
+		"shard_name":           types.StringType,
+		"type":                 types.StringType,
+		"fqdn":                 types.StringType,
+		"hidden":               types.BoolType,
+		"priority":             types.Float64Type,
+		"votes":                types.Int64Type,
+		"secondary_delay_secs": types.Int64Type,
+		"tags":                 types.MapType{ElemType: types.StringType},
+	},
+}
+
+func (h Host) GetFQDN() types.String {
+	return h.FQDN
+}
+
+func (h Host) GetShard() string {
+	return h.ShardName.ValueString()
+}
+
+type Access struct {
+	DataLens     bool `tfsdk:"data_lens"`
+	WebSQL       bool `tfsdk:"web_sql"`
+	DataTransfer bool `tfsdk:"data_transfer"`
+}
+
+var accessAttrTypes = mdbcommon.AccessAttrTypes(true, true, false, true, false)
+
+type PerformanceDiagnostics struct {
+	Enabled types.Bool `tfsdk:"enabled"`
+}
+
+var PerformanceDiagnosticsAttrTypes = map[string]attr.Type{
+	"enabled": types.BoolType,
+}
+
+type DiskSizeAutoscaling struct {
+	DiskSizeLimit           types.Int64 `tfsdk:"disk_size_limit"`
+	PlannedUsageThreshold   types.Int64 `tfsdk:"planned_usage_threshold"`
+	EmergencyUsageThreshold types.Int64 `tfsdk:"emergency_usage_threshold"`
+}
+
+var DiskSizeAutoscalingAttrTypes = map[string]attr.Type{
+	"disk_size_limit":           types.Int64Type,
+	"planned_usage_threshold":   types.Int64Type,
+	"emergency_usage_threshold": types.Int64Type,
+}
+
+type Restore struct {
+	BackupId types.String `tfsdk:"backup_id"`
+	Time     types.String `tfsdk:"time"`
+}
+
+var RestoreAttrTypes = map[string]attr.Type{
+	"backup_id": types.StringType,
+	"time":      types.StringType,
+}
+
+var MaintenanceWindowAttrTypes = map[string]attr.Type{
+	"type": types.StringType,
+	"day":  types.StringType,
+	"hour": types.Int64Type,
+}
@@ -0,0 +1,334 @@
+package mdb_mongodb_cluster_v2
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/mdbcommon"
+)
+
+const legacyClusterTypeName = "yandex_mdb_mongodb_cluster"
+
+// legacyCluster is the yandex_mdb_mongodb_cluster state moved to this resource.
+// Inline users and databases are not moved: they are managed by the standalone resources,
+// the move reports their import blocks.
+type legacyCluster struct {
+	ID                            string                      `json:"id"`
+	FolderID                      string                      `json:"folder_id"`
+	NetworkID                     string                      `json:"network_id"`
+	Name                          string                      `json:"name"`
+	Description                   string                      `json:"description"`
+	Environment                   string                      `json:"environment"`
+	Labels                        map[string]string           `json:"labels"`
+	SecurityGroupIDs              []string                    `json:"security_group_ids"`
+	DeletionProtection            bool                        `json:"deletion_protection"`
+	DiskEncryptionKeyID           string                      `json:"disk_encryption_key_id"`
+	Sharded                       bool                        `json:"sharded"`
+	MaintenanceWindow             []legacyMaintenanceWindow   `json:"maintenance_window"`
+	Hosts                         []legacyHost                `json:"host"`
+	Resources                     []legacyResources           `json:"resources"`
+	ResourcesMongod               []legacyResources           `json:"resources_mongod"`
+	ResourcesMongos               []legacyResources           `json:"resources_mongos"`
+	ResourcesMongocfg             []legacyResources           `json:"resources_mongocfg"`
+	ResourcesMongoinfra           []legacyResources           `json:"resources_mongoinfra"`
+	DiskSizeAutoscalingMongod     []legacyDiskSizeAutoscaling `json:"disk_size_autoscaling_mongod"`
+	DiskSizeAutoscalingMongos     []legacyDiskSizeAutoscaling `json:"disk_size_autoscaling_mongos"`
+	DiskSizeAutoscalingMongocfg   []legacyDiskSizeAutoscaling `json:"disk_size_autoscaling_mongocfg"`
+	DiskSizeAutoscalingMongoinfra []legacyDiskSizeAutoscaling `json:"disk_size_autoscaling_mongoinfra"`
+	ClusterConfig                 []legacyClusterConfig       `json:"cluster_config"`
+	Restore                       []legacyRestore             `json:"restore"`
+	Users                         []legacyNamed               `json:"user"`
+	Databases                     []legacyNamed               `json:"database"`
+}
+
+type legacyNamed struct {
+	Name string `json:"name"`
+}
+
+type legacyRestore struct {
+	BackupID string `json:"backup_id"`
+	Time     string `json:"time"`
+}
+
+type legacyMaintenanceWindow struct {
+	Type string `json:"type"`
+	Day  string `json:"day"`
+	Hour int64  `json:"hour"`
+}
+
+type legacyHost struct {
+	Name           string                 `json:"name"`
+	ZoneID         string                 `json:"zone_id"`
+	SubnetID       string                 `json:"subnet_id"`
+	AssignPublicIP bool                   `json:"assign_public_ip"`
+	ShardName      string                 `json:"shard_name"`
+	Type           string                 `json:"type"`
+	HostParameters []legacyHostParameters `json:"host_parameters"`
+}
+
+type legacyHostParameters struct {
+	Hidden             bool              `json:"hidden"`
+	Priority           float64           `json:"priority"`
+	Votes              int64             `json:"votes"`
+	SecondaryDelaySecs int64             `json:"secondary_delay_secs"`
+	Tags               map[string]string `json:"tags"`
+}
+
+type legacyResources struct {
+	ResourcePresetID string `json:"resource_preset_id"`
+	DiskSize         int64  `json:"disk_size"`
+	DiskTypeID       string `json:"disk_type_id"`
+}
+
+type legacyDiskSizeAutoscaling struct {
+	DiskSizeLimit           int64 `json:"disk_size_limit"`
+	PlannedUsageThreshold   int64 `json:"planned_usage_threshold"`
+	EmergencyUsageThreshold int64 `json:"emergency_usage_threshold"`
+}
+
+type legacyClusterConfig struct {
+	Version                     string `json:"version"`
+	FeatureCompatibilityVersion string `json:"feature_compatibility_version"`
+	BackupRetainPeriodDays      int64  `json:"backup_retain_period_days"`
+	BackupWindowStart           []struct {
+		Hours   int64 `json:"hours"`
+		Minutes int64 `json:"minutes"`
+	} `json:"backup_window_start"`
+	PerformanceDiagnostics []struct {
+		Enabled bool `json:"enabled"`
+	} `json:"performance_diagnostics"`
+	Access []struct {
+		DataLens     bool `json:"data_lens"`
+		DataTransfer bool `json:"data_transfer"`
+		WebSQL       bool `json:"web_sql"`
+	} `json:"access"`
+}
+
+func (r *clusterResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		{
+			StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+				if req.SourceTypeName != legacyClusterTypeName || req.SourceRawState == nil {
+					return
+				}
+
+				var legacy legacyCluster
+				if err := json.Unmarshal(req.SourceRawState.JSON, &legacy); err != nil {
+					resp.Diagnostics.AddError(
+						"Unable to Move Resource State",
+						fmt.Sprintf("Error while decoding the state of %s: %s", legacyClusterTypeName, err.Error()),
+					)
+					return
+				}
+
+				state := legacyClusterToState(ctx, &legacy, &resp.Diagnostics)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				resp.Diagnostics.Append(resp.TargetState.Set(ctx, state)...)
+			},
+		},
+	}
+}
+
+func legacyClusterToState(ctx context.Context, legacy *legacyCluster, diags *diag.Diagnostics) *Cluster {
+	if len(legacy.ClusterConfig) == 0 {
+		diags.AddError(
+			"Unable to Move Resource State",
+			fmt.Sprintf("The state of %s %q has no cluster_config block", legacyClusterTypeName, legacy.ID),
+		)
+		return nil
+	}
+	cfg := legacy.ClusterConfig[0]
+
+	state := &Cluster{
+		Id:                            types.StringValue(legacy.ID),
+		FolderId:                      types.StringValue(legacy.FolderID),
+		NetworkId:                     types.StringValue(legacy.NetworkID),
+		Name:                          types.StringValue(legacy.Name),
+		Description:                   types.StringValue(legacy.Description),
+		Environment:                   types.StringValue(legacy.Environment),
+		Labels:                        mdbcommon.FlattenMapString(ctx, legacy.Labels, diags),
+		SecurityGroupIds:              mdbcommon.FlattenSetString(ctx, legacy.SecurityGroupIDs, diags),
+		DeletionProtection:            types.BoolValue(legacy.DeletionProtection),
+		FinalBackup:                   types.BoolValue(false),
+		MaintenanceWindow:             legacyMaintenanceWindowToState(ctx, legacy.MaintenanceWindow, diags),
+		DiskEncryptionKeyId:           mdbcommon.FlattenStringOrNull(legacy.DiskEncryptionKeyID),
+		Sharded:                       types.BoolValue(legacy.Sharded),
+		Version:                       types.StringValue(cfg.Version),
+		FeatureCompatibilityVersion:   types.StringValue(cfg.FeatureCompatibilityVersion),
+		BackupRetainPeriodDays:        types.Int64Value(cfg.BackupRetainPeriodDays),
+		BackupWindowStart:             types.ObjectNull(mdbcommon.BackupWindowType.AttrTypes),
+		Access:                        types.ObjectNull(accessAttrTypes),
+		PerformanceDiagnostics:        types.ObjectNull(PerformanceDiagnosticsAttrTypes),
+		ResourcesMongod:               legacyResourcesToState(ctx, legacy.ResourcesMongod, diags),
+		ResourcesMongos:               legacyResourcesToState(ctx, legacy.ResourcesMongos, diags),
+		ResourcesMongocfg:             legacyResourcesToState(ctx, legacy.ResourcesMongocfg, diags),
+		ResourcesMongoinfra:           legacyResourcesToState(ctx, legacy.ResourcesMongoinfra, diags),
+		DiskSizeAutoscalingMongod:     legacyDiskSizeAutoscalingToState(ctx, legacy.DiskSizeAutoscalingMongod, diags),
+		DiskSizeAutoscalingMongos:     legacyDiskSizeAutoscalingToState(ctx, legacy.DiskSizeAutoscalingMongos, diags),
+		DiskSizeAutoscalingMongocfg:   legacyDiskSizeAutoscalingToState(ctx, legacy.DiskSizeAutoscalingMongocfg, diags),
+		DiskSizeAutoscalingMongoinfra: legacyDiskSizeAutoscalingToState(ctx, legacy.DiskSizeAutoscalingMongoinfra, diags),
+		HostSpecs:                     legacyHostsToState(ctx, legacy.Hosts, diags),
+		HostUpdateStrategy:            types.ObjectNull(mdbcommon.HostUpdateStrategyAttrTypes),
+		Endpoints:                     types.ObjectNull(mdbcommon.EndpointsAttrTypes),
+		Restore:                       legacyRestoreToState(ctx, legacy.Restore, diags),
+		Timeouts: timeouts.Value{
+			Object: types.ObjectNull(map[string]attr.Type{
+				"create": types.StringType,
+				"update": types.StringType,
+				"delete": types.StringType,
+			}),
+		},
+	}
+
+	blocks := make([]mdbcommon.ImportBlock, 0, len(legacy.Users)+len(legacy.Databases))
+	for _, u := range legacy.Users {
+		blocks = append(blocks, mdbcommon.ImportBlock{ResourceType: "yandex_mdb_mongodb_user", Name: u.Name, ID: legacy.ID + ":" + u.Name})
+	}
+	for _, db := range legacy.Databases {
+		blocks = append(blocks, mdbcommon.ImportBlock{ResourceType: "yandex_mdb_mongodb_database", Name: db.Name, ID: legacy.ID + ":" + db.Name})
+	}
+	mdbcommon.AddNotMovedWarning(diags, fmt.Sprintf("%s %q", legacyClusterTypeName, legacy.ID), "user and database blocks", blocks)
+
+	// The deprecated resources block was shared by all the subclusters.
+	if len(legacy.ResourcesMongod) == 0 && len(legacy.Resources) > 0 {
+		state.ResourcesMongod = legacyResourcesToState(ctx, legacy.Resources, diags)
+	}
+
+	if len(cfg.BackupWindowStart) > 0 {
+		obj, d := types.ObjectValueFrom(ctx, mdbcommon.BackupWindowType.AttrTypes, mdbcommon.BackupWindow{
+			Hours:   types.Int64Value(cfg.BackupWindowStart[0].Hours),
+			Minutes: types.Int64Value(cfg.BackupWindowStart[0].Minutes),
+		})
+		diags.Append(d...)
+		state.BackupWindowStart = obj
+	}
+
+	if len(cfg.PerformanceDiagnostics) > 0 {
+		obj, d := types.ObjectValueFrom(ctx, PerformanceDiagnosticsAttrTypes, PerformanceDiagnostics{
+			Enabled: types.BoolValue(cfg.PerformanceDiagnostics[0].Enabled),
+		})
+		diags.Append(d...)
+		state.PerformanceDiagnostics = obj
+	}
+
+	if len(cfg.Access) > 0 {
+		obj, d := types.ObjectValueFrom(ctx, accessAttrTypes, Access{
+			DataLens:     cfg.Access[0].DataLens,
+			WebSQL:       cfg.Access[0].WebSQL,
+			DataTransfer: cfg.Access[0].DataTransfer,
+		})
+		diags.Append(d...)
+		state.Access = obj
+	}
+
+	return state
+}
+
+// legacyRestoreToState keeps the restore block of a cluster created from a backup,
+// otherwise the moved cluster would be replaced.
+func legacyRestoreToState(ctx context.Context, restore []legacyRestore, diags *diag.Diagnostics) types.Object {
+	if len(restore) == 0 || restore[0].BackupID == "" {
+		return types.ObjectNull(RestoreAttrTypes)
+	}
+
+	obj, d := types.ObjectValueFrom(ctx, RestoreAttrTypes, Restore{
+		BackupId: types.StringValue(restore[0].BackupID),
+		Time:     mdbcommon.FlattenStringOrNull(restore[0].Time),
+	})
+	diags.Append(d...)
+	return obj
+}
+
+func legacyResourcesToState(ctx context.Context, resources []legacyResources, diags *diag.Diagnostics) types.Object {
+	if len(resources) == 0 {
+		return types.ObjectNull(mdbcommon.ResourceType.AttrTypes)
+	}
+
+	obj, d := types.ObjectValueFrom(ctx, mdbcommon.ResourceType.AttrTypes, mdbcommon.Resource{
+		ResourcePresetId: types.StringValue(resources[0].ResourcePresetID),
+		DiskSize:         types.Int64Value(resources[0].DiskSize),
+		DiskTypeId:       types.StringValue(resources[0].DiskTypeID),
+	})
+	diags.Append(d...)
+	return obj
+}
+
+func legacyDiskSizeAutoscalingToState(ctx context.Context, dsa []legacyDiskSizeAutoscaling, diags *diag.Diagnostics) types.Object {
+	if len(dsa) == 0 || dsa[0].DiskSizeLimit == 0 {
+		return types.ObjectNull(DiskSizeAutoscalingAttrTypes)
+	}
+
+	obj, d := types.ObjectValueFrom(ctx, DiskSizeAutoscalingAttrTypes, DiskSizeAutoscaling{
+		DiskSizeLimit:           types.Int64Value(dsa[0].DiskSizeLimit),
+		PlannedUsageThreshold:   types.Int64Value(dsa[0].PlannedUsageThreshold),
+		EmergencyUsageThreshold: types.Int64Value(dsa[0].EmergencyUsageThreshold),
+	})
+	diags.Append(d...)
+	return obj
+}
+
+func legacyMaintenanceWindowToState(ctx context.Context, mw []legacyMaintenanceWindow, diags *diag.Diagnostics) types.Object {
+	if len(mw) == 0 {
+		return types.ObjectNull(MaintenanceWindowAttrTypes)
+	}
+
+	day, hour := types.StringNull(), types.Int64Null()
+	if mw[0].Type == "WEEKLY" {
+		day, hour = types.StringValue(mw[0].Day), types.Int64Value(mw[0].Hour)
+	}
+
+	obj, d := types.ObjectValue(MaintenanceWindowAttrTypes, map[string]attr.Value{
+		"type": types.StringValue(mw[0].Type),
+		"day":  day,
+		"hour": hour,
+	})
+	diags.Append(d...)
+	return obj
+}
+
+// legacyHostsToState keys the hosts by their FQDN: the legacy resource has no host labels.
+func legacyHostsToState(ctx context.Context, hosts []legacyHost, diags *diag.Diagnostics) types.Map {
+	result := make(map[string]Host, len(hosts))
+	for _, h := range hosts {
+		host := Host{
+			Zone:               types.StringValue(h.ZoneID),
+			SubnetId:           types.StringValue(h.SubnetID),
+			AssignPublicIp:     types.BoolValue(h.AssignPublicIP),
+			ShardName:          types.StringValue(h.ShardName),
+			Type:               types.StringValue(strings.ToUpper(h.Type)),
+			FQDN:               types.StringValue(h.Name),
+			Hidden:             types.BoolValue(false),
+			Priority:           types.Float64Value(defaultHostPriority),
+			Votes:              types.Int64Value(defaultHostVotes),
+			SecondaryDelaySecs: types.Int64Value(defaultHostSecondaryDelaySecs),
+			Tags:               types.MapNull(types.StringType),
+		}
+
+		if len(h.HostParameters) > 0 {
+			hp := h.HostParameters[0]
+			host.Hidden = types.BoolValue(hp.Hidden)
+			host.Priority = types.Float64Value(hp.Priority)
+			host.Votes = types.Int64Value(hp.Votes)
+			host.SecondaryDelaySecs = types.Int64Value(hp.SecondaryDelaySecs)
+			if len(hp.Tags) > 0 {
+				host.Tags = mdbcommon.FlattenMapString(ctx, hp.Tags, diags)
+			}
+		}
+
+		result[h.Name] = host
+	}
+
+	obj, d := types.MapValueFrom(ctx, hostType, result)
+	diags.Append(d...)
+	return obj
+}
@@ -0,0 +1,138 @@
+package mdb_mongodb_cluster_v2
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+const legacyClusterJSON = `{
+	"id": "cid",
+	"folder_id": "folder",
+	"network_id": "network",
+	"name": "mongo",
+	"description": "",
+	"environment": "PRODUCTION",
+	"labels": {"env": "test"},
+	"security_group_ids": ["sg"],
+	"deletion_protection": false,
+	"disk_encryption_key_id": "",
+	"sharded": true,
+	"maintenance_window": [{"type": "ANYTIME", "day": "", "hour": 0}],
+	"host": [
+		{"name": "rs01-a", "zone_id": "ru-central1-a", "subnet_id": "subnet", "assign_public_ip": false, "shard_name": "rs01", "type": "mongod",
+		 "host_parameters": [{"hidden": true, "priority": 0, "votes": 0, "secondary_delay_secs": 60, "tags": {"dc": "a"}}]},
+		{"name": "rs02-a", "zone_id": "ru-central1-a", "subnet_id": "subnet", "assign_public_ip": false, "shard_name": "rs02", "type": "mongod", "host_parameters": []},
+		{"name": "infra-a", "zone_id": "ru-central1-a", "subnet_id": "subnet", "assign_public_ip": false, "shard_name": "", "type": "mongoinfra", "host_parameters": []}
+	],
+	"user": [{"name": "inline"}],
+	"database": [{"name": "inline"}],
+	"resources": [{"resource_preset_id": "s2.micro", "disk_size": 10, "disk_type_id": "network-ssd"}],
+	"resources_mongod": [],
+	"resources_mongoinfra": [{"resource_preset_id": "s2.small", "disk_size": 20, "disk_type_id": "network-hdd"}],
+	"disk_size_autoscaling_mongod": [{"disk_size_limit": 50, "planned_usage_threshold": 70, "emergency_usage_threshold": 90}],
+	"disk_size_autoscaling_mongoinfra": [{"disk_size_limit": 0, "planned_usage_threshold": 0, "emergency_usage_threshold": 0}],
+	"cluster_config": [{
+		"version": "7.0",
+		"feature_compatibility_version": "6.0",
+		"backup_retain_period_days": 7,
+		"backup_window_start": [{"hours": 3, "minutes": 30}],
+		"performance_diagnostics": [],
+		"access": [{"data_lens": true, "data_transfer": false, "web_sql": true}]
+	}],
+	"restore": [{"backup_id": "cid:backup", "time": ""}]
+}`
+
+func TestLegacyClusterToState(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	var legacy legacyCluster
+	if err := json.Unmarshal([]byte(legacyClusterJSON), &legacy); err != nil {
+		t.Fatalf("failed to decode legacy state: %v", err)
+	}
+
+	var diags diag.Diagnostics
+	state := legacyClusterToState(ctx, &legacy, &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if diags.WarningsCount() != 1 {
+		t.Fatalf("expected a warning about inline users and databases, got %v", diags)
+	}
+	for _, block := range []string{
+		"to = yandex_mdb_mongodb_user.inline\n  id = \"cid:inline\"",
+		"to = yandex_mdb_mongodb_database.inline\n  id = \"cid:inline\"",
+	} {
+		if !strings.Contains(diags[0].Detail(), block) {
+			t.Errorf("warning %q does not contain %q", diags[0].Detail(), block)
+		}
+	}
+
+	if state.Version.ValueString() != "7.0" || state.FeatureCompatibilityVersion.ValueString() != "6.0" {
+		t.Errorf("unexpected version: %s (%s)", state.Version, state.FeatureCompatibilityVersion)
+	}
+	if !state.Sharded.ValueBool() {
+		t.Error("sharded should be moved")
+	}
+	if !state.DiskEncryptionKeyId.IsNull() {
+		t.Errorf("empty disk_encryption_key_id should be moved as null, got %s", state.DiskEncryptionKeyId)
+	}
+	if state.ResourcesMongod.IsNull() || state.ResourcesMongod.Attributes()["resource_preset_id"].String() != `"s2.micro"` {
+		t.Errorf("deprecated resources should be moved to resources_mongod, got %s", state.ResourcesMongod)
+	}
+	if state.ResourcesMongoinfra.IsNull() || !state.ResourcesMongos.IsNull() || !state.ResourcesMongocfg.IsNull() {
+		t.Errorf("unexpected infrastructure resources: mongos %s, mongocfg %s, mongoinfra %s",
+			state.ResourcesMongos, state.ResourcesMongocfg, state.ResourcesMongoinfra)
+	}
+	if state.DiskSizeAutoscalingMongod.IsNull() || !state.DiskSizeAutoscalingMongoinfra.IsNull() {
+		t.Errorf("unexpected disk_size_autoscaling: mongod %s, mongoinfra %s",
+			state.DiskSizeAutoscalingMongod, state.DiskSizeAutoscalingMongoinfra)
+	}
+	if !state.PerformanceDiagnostics.IsNull() || state.Access.IsNull() || state.BackupWindowStart.IsNull() {
+		t.Errorf("unexpected config blocks: performance_diagnostics %s, access %s, backup_window_start %s",
+			state.PerformanceDiagnostics, state.Access, state.BackupWindowStart)
+	}
+
+	var restore Restore
+	if diags := state.Restore.As(ctx, &restore, basetypes.ObjectAsOptions{}); diags.HasError() {
+		t.Fatalf("failed to read restore: %v", diags)
+	}
+	if restore.BackupId.ValueString() != "cid:backup" || !restore.Time.IsNull() {
+		t.Errorf("unexpected restore: %+v", restore)
+	}
+
+	hosts := make(map[string]Host)
+	if diags := state.HostSpecs.ElementsAs(ctx, &hosts, false); diags.HasError() {
+		t.Fatalf("failed to read hosts: %v", diags)
+	}
+	if len(hosts) != 3 {
+		t.Fatalf("unexpected hosts: %s", state.HostSpecs)
+	}
+	if h := hosts["rs01-a"]; h.Type.ValueString() != "MONGOD" || !h.Hidden.ValueBool() ||
+		h.SecondaryDelaySecs.ValueInt64() != 60 || !h.Tags.Elements()["dc"].Equal(types.StringValue("a")) {
+		t.Errorf("unexpected mongod host with parameters: %+v", h)
+	}
+	if h := hosts["rs02-a"]; h.Priority.ValueFloat64() != defaultHostPriority || h.Votes.ValueInt64() != defaultHostVotes || !h.Tags.IsNull() {
+		t.Errorf("host without parameters should be moved with defaults: %+v", h)
+	}
+	if h := hosts["infra-a"]; h.Type.ValueString() != "MONGOINFRA" || h.FQDN.ValueString() != "infra-a" {
+		t.Errorf("unexpected mongoinfra host: %+v", h)
+	}
+}
+
+func TestLegacyClusterToStateWithoutConfig(t *testing.T) {
+	t.Parallel()
+
+	var diags diag.Diagnostics
+	legacyClusterToState(context.Background(), &legacyCluster{ID: "cid"}, &diags)
+	if !diags.HasError() {
+		t.Error("expected error for the state without cluster_config block")
+	}
+}
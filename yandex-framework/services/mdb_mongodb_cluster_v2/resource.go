@@ -0,0 +1,725 @@
+package mdb_mongodb_cluster_v2
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/mongodb/v1"
+	"github.com/yandex-cloud/terraform-provider-yandex/common"
+	"github.com/yandex-cloud/terraform-provider-yandex/common/defaultschema"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/mdbcommon"
+	utils "github.com/yandex-cloud/terraform-provider-yandex/pkg/wrappers"
+	provider_config "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
+)
+
+const (
+	yandexMDBMongoDBClusterCreateTimeout = time.Hour
+	yandexMDBMongoDBClusterDeleteTimeout = time.Hour
+	yandexMDBMongoDBClusterUpdateTimeout = 2 * time.Hour
+)
+
+var (
+	_ resource.Resource                   = &clusterResource{}
+	_ resource.ResourceWithImportState    = &clusterResource{}
+	_ resource.ResourceWithModifyPlan     = &clusterResource{}
+	_ resource.ResourceWithMoveState      = &clusterResource{}
+	_ resource.ResourceWithValidateConfig = &clusterResource{}
+)
+
+type clusterResource struct {
+	providerConfig *provider_config.Config
+}
+
+func NewMongoDBClusterResourceV2() resource.Resource {
+	return &clusterResource{}
+}
+
+func (r *clusterResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mdb_mongodb_cluster_v2"
+}
+
+func (r *clusterResource) Configure(_ context.Context,
+	req resource.ConfigureRequest, resp *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerConfig, ok := req.ProviderData.(*provider_config.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider_config.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.providerConfig = providerConfig
+}
+
+func resourcesSchema(hosts string, required bool) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: fmt.Sprintf("Resources allocated to %s hosts of the MongoDB cluster.", hosts),
+		Required:    required,
+		Optional:    !required,
+		Attributes: map[string]schema.Attribute{
+			"resource_preset_id": schema.StringAttribute{
+				Description: fmt.Sprintf("The ID of the preset for computational resources available to a %s host (CPU, memory etc.). For more information, see [the official documentation](https://yandex.cloud/docs/managed-mongodb/concepts).", hosts),
+				Required:    true,
+			},
+			"disk_size": schema.Int64Attribute{
+				Description: fmt.Sprintf("Volume of the storage available to a %s host, in gigabytes.", hosts),
+				Required:    true,
+			},
+			"disk_type_id": schema.StringAttribute{
+				Description: fmt.Sprintf("Type of the storage of %s hosts. For more information see [the official documentation](https://yandex.cloud/docs/managed-mongodb/concepts/storage).", hosts),
+				Required:    true,
+			},
+		},
+	}
+}
+
+func diskSizeAutoscalingSchema(hosts, resourcesAttr string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: fmt.Sprintf("Disk autoscaling settings of %s hosts.", hosts),
+		Optional:    true,
+		Attributes: map[string]schema.Attribute{
+			"disk_size_limit": schema.Int64Attribute{
+				Description: "The overall maximum for disk size (GB) that limits all autoscaling iterations.",
+				Required:    true,
+				Validators: []validator.Int64{
+					mdbcommon.Int64GreaterValidator(path.MatchRoot(resourcesAttr).AtName("disk_size")),
+				},
+			},
+			"planned_usage_threshold": schema.Int64Attribute{
+				Description: "Percent of disk utilization. During maintenance disk will autoscale, if this threshold reached. Value is between 0 and 100. Default value is 0 (autoscaling disabled).",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(0),
+				Validators: []validator.Int64{
+					int64validator.Between(0, 100),
+				},
+			},
+			"emergency_usage_threshold": schema.Int64Attribute{
+				Description: "Percent of disk utilization. Disk will autoscale immediately, if this threshold reached. Value is between 0 and 100. Default value is 0 (autoscaling disabled). Must be not less then `planned_usage_threshold` value.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(0),
+				Validators: []validator.Int64{
+					int64validator.Between(0, 100),
+				},
+			},
+		},
+	}
+}
+
+func (r *clusterResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a MongoDB cluster within the Yandex Cloud. For more information, see [the official documentation](https://yandex.cloud/docs/managed-mongodb/concepts).\n\n" +
+			"~> Users and databases are not managed by this resource. Use `yandex_mdb_mongodb_user` and `yandex_mdb_mongodb_database` instead.\n",
+		Attributes: map[string]schema.Attribute{
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+			"id": schema.StringAttribute{
+				Description: common.ResourceDescriptions["id"],
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the MongoDB cluster. Provided by the client when the cluster is created.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the MongoDB cluster.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+			},
+			"folder_id":  defaultschema.FolderId(),
+			"network_id": defaultschema.NetworkId(),
+			"environment": schema.StringAttribute{
+				Description: "Deployment environment of the MongoDB cluster. Can be either `PRESTABLE` or `PRODUCTION`.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("PRODUCTION", "PRESTABLE"),
+				},
+			},
+			"labels":               defaultschema.Labels(),
+			"security_group_ids":   defaultschema.SecurityGroupIds(),
+			"deletion_protection":  defaultschema.DeletionProtection(),
+			"final_backup":         defaultschema.FinalBackup(),
+			"host_update_strategy": defaultschema.HostUpdateStrategy(),
+			"endpoints":            mdbcommon.EndpointsSchema(),
+			"disk_encryption_key_id": schema.StringAttribute{
+				Description: "ID of the KMS key to encrypt cluster disks.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"restore": schema.SingleNestedAttribute{
+				Description: "The cluster will be created from the specified backup.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"backup_id": schema.StringAttribute{
+						Description: "Backup ID. The cluster will be created from the specified backup. [How to get a list of MongoDB backups](https://yandex.cloud/docs/managed-mongodb/operations/cluster-backups).",
+						Required:    true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
+					},
+					"time": schema.StringAttribute{
+						Description: "Timestamp of the moment to which the MongoDB cluster should be restored. (Format: `2006-01-02T15:04:05` - UTC). When not set, current time is used.",
+						Optional:    true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
+						Validators: []validator.String{
+							mdbcommon.NewStringToTimeValidator(),
+						},
+					},
+				},
+			},
+			"sharded": schema.BoolAttribute{
+				Description: "MongoDB cluster mode. The cluster becomes sharded when `MONGOS`, `MONGOCFG` or `MONGOINFRA` hosts are added. Sharding can't be disabled.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"version": schema.StringAttribute{
+				Description: "Version of the MongoDB server software.",
+				Required:    true,
+			},
+			"feature_compatibility_version": schema.StringAttribute{
+				Description: "Feature compatibility version of the MongoDB cluster. The default is the server `version`.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"backup_retain_period_days": schema.Int64Attribute{
+				Description: "The period in days during which backups are stored.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"backup_window_start": schema.SingleNestedAttribute{
+				Description: "Time to start the daily backup, in the UTC timezone.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"hours": schema.Int64Attribute{
+						Description: "The hour at which backup will be started (UTC).",
+						Computed:    true,
+						Optional:    true,
+						Default:     int64default.StaticInt64(0),
+						Validators: []validator.Int64{
+							int64validator.Between(0, 23),
+						},
+					},
+					"minutes": schema.Int64Attribute{
+						Description: "The minute at which backup will be started.",
+						Computed:    true,
+						Optional:    true,
+						Default:     int64default.StaticInt64(0),
+						Validators: []validator.Int64{
+							int64validator.Between(0, 59),
+						},
+					},
+				},
+			},
+			"access": schema.SingleNestedAttribute{
+				Description: "Access policy to the MongoDB cluster.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"data_lens": schema.BoolAttribute{
+						Description: "Allow access for [Yandex DataLens](https://yandex.cloud/services/datalens).",
+						Optional:    true,
+						Computed:    true,
+						Default:     booldefault.StaticBool(false),
+					},
+					"web_sql": schema.BoolAttribute{
+						Description: "Allow access for SQL queries in the management console.",
+						Optional:    true,
+						Computed:    true,
+						Default:     booldefault.StaticBool(false),
+					},
+					"data_transfer": schema.BoolAttribute{
+						Description: "Allow access for [DataTransfer](https://yandex.cloud/services/data-transfer).",
+						Optional:    true,
+						Computed:    true,
+						Default:     booldefault.StaticBool(false),
+					},
+				},
+			},
+			"performance_diagnostics": schema.SingleNestedAttribute{
+				Description: "Performance diagnostics settings of the MongoDB cluster.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"enabled": schema.BoolAttribute{
+						Description: "Enable profiling of the cluster.",
+						Optional:    true,
+						Computed:    true,
+						Default:     booldefault.StaticBool(false),
+					},
+				},
+			},
+			"resources_mongod":                 resourcesSchema("MONGOD", true),
+			"resources_mongos":                 resourcesSchema("MONGOS", false),
+			"resources_mongocfg":               resourcesSchema("MONGOCFG", false),
+			"resources_mongoinfra":             resourcesSchema("MONGOINFRA", false),
+			"disk_size_autoscaling_mongod":     diskSizeAutoscalingSchema("MONGOD", "resources_mongod"),
+			"disk_size_autoscaling_mongos":     diskSizeAutoscalingSchema("MONGOS", "resources_mongos"),
+			"disk_size_autoscaling_mongocfg":   diskSizeAutoscalingSchema("MONGOCFG", "resources_mongocfg"),
+			"disk_size_autoscaling_mongoinfra": diskSizeAutoscalingSchema("MONGOINFRA", "resources_mongoinfra"),
+			// Optional nested attribute maintenance_window required all optional nested attributes
+			// But if the block is specified explicitly, then the type attribute is required
+			"maintenance_window": schema.SingleNestedAttribute{
+				Description: "Maintenance policy of the MongoDB cluster.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Object{
+					NewMaintenanceWindowStructValidator(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						Description: "Type of maintenance window. Can be either ANYTIME or WEEKLY. A day and hour of window need to be specified with weekly window.",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("ANYTIME", "WEEKLY"),
+						},
+					},
+					"day": schema.StringAttribute{
+						Description: "Day of the week (in DDD format). Allowed values: \"MON\", \"TUE\", \"WED\", \"THU\", \"FRI\", \"SAT\",\"SUN\"",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(
+								"MON", "TUE",
+								"WED", "THU",
+								"FRI", "SAT",
+								"SUN",
+							),
+						},
+					},
+					"hour": schema.Int64Attribute{
+						Description: "Hour of the day in UTC (in HH format). Allowed value is between 1 and 24.",
+						Optional:    true,
+						Validators: []validator.Int64{
+							int64validator.Between(1, 24),
+						},
+					},
+				},
+			},
+			"hosts": schema.MapNestedAttribute{
+				Required:            true,
+				MarkdownDescription: "A hosts of the MongoDB cluster as label:host_info pairs. The cluster becomes sharded when hosts of the `MONGOS`, `MONGOCFG` or `MONGOINFRA` type are added.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"zone": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: common.ResourceDescriptions["zone"],
+						},
+						"type": schema.StringAttribute{
+							Optional:            true,
+							Computed:            true,
+							Default:             stringdefault.StaticString(mongodb.Host_MONGOD.String()),
+							MarkdownDescription: "Type of the host. Can be either `MONGOD`, `MONGOS`, `MONGOCFG` or `MONGOINFRA`. The default is `MONGOD`.",
+							Validators: []validator.String{
+								stringvalidator.OneOf(
+									mongodb.Host_MONGOD.String(),
+									mongodb.Host_MONGOS.String(),
+									mongodb.Host_MONGOCFG.String(),
+									mongodb.Host_MONGOINFRA.String(),
+								),
+							},
+						},
+						"shard_name": schema.StringAttribute{
+							Optional:            true,
+							Computed:            true,
+							MarkdownDescription: "Name of the shard of a `MONGOD` host. Required for sharded clusters with more than one shard.",
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"subnet_id": schema.StringAttribute{
+							Optional:            true,
+							Computed:            true,
+							MarkdownDescription: "ID of the subnet where the host is located.",
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"fqdn": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Fully Qualified Domain Name. In other words, hostname.",
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"assign_public_ip": schema.BoolAttribute{
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(false),
+							MarkdownDescription: "Assign a public IP address to the host. Can be either true or false.",
+						},
+						"hidden": schema.BoolAttribute{
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(false),
+							MarkdownDescription: "Hide the `MONGOD` host from client applications.",
+						},
+						"priority": schema.Float64Attribute{
+							Optional:            true,
+							Computed:            true,
+							Default:             float64default.StaticFloat64(defaultHostPriority),
+							MarkdownDescription: "Priority of the `MONGOD` host in the replica set election.",
+						},
+						"votes": schema.Int64Attribute{
+							Optional:            true,
+							Computed:            true,
+							Default:             int64default.StaticInt64(defaultHostVotes),
+							MarkdownDescription: "Number of votes of the `MONGOD` host in the replica set election.",
+						},
+						"secondary_delay_secs": schema.Int64Attribute{
+							Optional:            true,
+							Computed:            true,
+							Default:             int64default.StaticInt64(defaultHostSecondaryDelaySecs),
+							MarkdownDescription: "Replication lag of the `MONGOD` host behind the primary, in seconds.",
+						},
+						"tags": schema.MapAttribute{
+							Optional:            true,
+							ElementType:         types.StringType,
+							MarkdownDescription: "Replica set tags of the `MONGOD` host.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *clusterResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var t topology
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("hosts"), &t.Hosts)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("resources_mongos"), &t.ResourcesMongos)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("resources_mongocfg"), &t.ResourcesMongocfg)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("resources_mongoinfra"), &t.ResourcesMongoinfra)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validateTopology(ctx, t, &resp.Diagnostics)
+}
+
+func (r *clusterResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	var plan Cluster
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.HostSpecs.IsUnknown() {
+		return
+	}
+
+	sharded := hasInfraHosts(ctx, plan.HostSpecs, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if req.State.Raw.IsNull() {
+		plan.Sharded = types.BoolValue(sharded)
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, plan)...)
+		return
+	}
+
+	var state Cluster
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Modifying plan for cluster", map[string]interface{}{"id": plan.Id.ValueString()})
+
+	if state.Sharded.ValueBool() && !sharded {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("hosts"),
+			"Invalid MongoDB cluster topology",
+			"Disabling sharding on MongoDB cluster is not supported. Keep the MONGOS, MONGOCFG or MONGOINFRA hosts in the configuration.",
+		)
+		return
+	}
+	plan.Sharded = types.BoolValue(state.Sharded.ValueBool() || sharded)
+
+	// remove changes on disk_size from plan if enabled autoscaling
+	plan.ResourcesMongod = mdbcommon.FixDiskSizeOnAutoscalingChanges(ctx, plan.ResourcesMongod, state.ResourcesMongod, utils.IsPresent(attr.Value(state.DiskSizeAutoscalingMongod)), &resp.Diagnostics)
+	if utils.IsPresent(attr.Value(plan.ResourcesMongos)) {
+		plan.ResourcesMongos = mdbcommon.FixDiskSizeOnAutoscalingChanges(ctx, plan.ResourcesMongos, state.ResourcesMongos, utils.IsPresent(attr.Value(state.DiskSizeAutoscalingMongos)), &resp.Diagnostics)
+	}
+	if utils.IsPresent(attr.Value(plan.ResourcesMongocfg)) {
+		plan.ResourcesMongocfg = mdbcommon.FixDiskSizeOnAutoscalingChanges(ctx, plan.ResourcesMongocfg, state.ResourcesMongocfg, utils.IsPresent(attr.Value(state.DiskSizeAutoscalingMongocfg)), &resp.Diagnostics)
+	}
+	if utils.IsPresent(attr.Value(plan.ResourcesMongoinfra)) {
+		plan.ResourcesMongoinfra = mdbcommon.FixDiskSizeOnAutoscalingChanges(ctx, plan.ResourcesMongoinfra, state.ResourcesMongoinfra, utils.IsPresent(attr.Value(state.DiskSizeAutoscalingMongoinfra)), &resp.Diagnostics)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, plan)...)
+}
+
+func (r *clusterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan Cluster
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, yandexMDBMongoDBClusterCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	tflog.Debug(ctx, "Creating MongoDB Cluster")
+
+	var cid string
+	if utils.IsPresent(plan.Restore) {
+		request, diags := prepareRestoreRequest(ctx, &plan, &r.providerConfig.ProviderState)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		cid = mongodbApi.RestoreCluster(ctx, r.providerConfig.SDKv2, &resp.Diagnostics, request)
+	} else {
+		request, diags := prepareCreateRequest(ctx, &plan, &r.providerConfig.ProviderState)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		cid = mongodbApi.CreateCluster(ctx, r.providerConfig.SDKv2, &resp.Diagnostics, request)
+	}
+	// A failed restore may still leave the cluster created. Keep tracking it by
+	// its id so terraform manages it instead of leaving it orphaned.
+	if cid == "" {
+		return
+	}
+	plan.Id = types.StringValue(cid)
+
+	r.refreshResourceState(ctx, &plan, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *clusterResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state Cluster
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.refreshResourceState(ctx, &state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *clusterResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan Cluster
+	var state Cluster
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, yandexMDBMongoDBClusterUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	tflog.Debug(ctx, "Updating MongoDB Cluster", map[string]any{"id": plan.Id.ValueString()})
+
+	cid := state.Id.ValueString()
+	sdk := r.providerConfig.SDKv2
+
+	updateRequest, diags := prepareUpdateRequest(ctx, &state, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	mongodbApi.UpdateCluster(ctx, sdk, &resp.Diagnostics, updateRequest)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Sharding is enabled before the new shards are added, the infrastructure hosts are created with it.
+	enableShardingRequest, diags := prepareEnableShardingRequest(ctx, &state, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if enableShardingRequest != nil {
+		mongodbApi.EnableSharding(ctx, sdk, &resp.Diagnostics, enableShardingRequest)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	planMongod, planInfra := splitHostsByType(ctx, plan.HostSpecs, &resp.Diagnostics)
+	stateMongod, stateInfra := splitHostsByType(ctx, state.HostSpecs, &resp.Diagnostics)
+	hostUpdateStrategy := mdbcommon.ExpandHostUpdateStrategy(ctx, plan.HostUpdateStrategy, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	mdbcommon.UpdateClusterHostsWithShards[Host, *mongodb.Host, *mongodb.HostSpec, mongodb.UpdateHostSpec, struct{}](
+		ctx, sdk, &resp.Diagnostics, mongodbHostService, &mongodbApi, cid, struct{}{}, hostUpdateStrategy,
+		hostsMapValue(ctx, planMongod, &resp.Diagnostics), hostsMapValue(ctx, stateMongod, &resp.Diagnostics),
+	)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if enableShardingRequest == nil {
+		mdbcommon.UpdateClusterHosts[Host, *mongodb.Host, *mongodb.HostSpec, mongodb.UpdateHostSpec, struct{}](
+			ctx, sdk, &resp.Diagnostics, mongodbHostService, &mongodbApi, cid, struct{}{}, hostUpdateStrategy,
+			hostsMapValue(ctx, planInfra, &resp.Diagnostics), hostsMapValue(ctx, stateInfra, &resp.Diagnostics),
+		)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	r.refreshResourceState(ctx, &plan, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *clusterResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state Cluster
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, yandexMDBMongoDBClusterDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	cid := state.Id.ValueString()
+	if state.FinalBackup.ValueBool() {
+		mongodbApi.BackupCluster(ctx, r.providerConfig.SDKv2, &resp.Diagnostics, cid)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	mongodbApi.DeleteCluster(ctx, r.providerConfig.SDKv2, &resp.Diagnostics, cid)
+}
+
+func (r *clusterResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *clusterResource) refreshResourceState(ctx context.Context, state *Cluster, respDiagnostics *diag.Diagnostics) {
+	cid := state.Id.ValueString()
+	cluster := mongodbApi.GetCluster(ctx, r.providerConfig.SDKv2, respDiagnostics, cid)
+	if respDiagnostics.HasError() {
+		return
+	}
+
+	hosts := mdbcommon.ReadHosts[Host, *mongodb.Host, *mongodb.HostSpec, mongodb.UpdateHostSpec, struct{}](
+		ctx, r.providerConfig.SDKv2, respDiagnostics, mongodbHostService, &mongodbApi, state.HostSpecs, cid,
+	)
+	if respDiagnostics.HasError() {
+		return
+	}
+
+	cfg := cluster.GetConfig()
+
+	state.Id = types.StringValue(cluster.Id)
+	state.FolderId = types.StringValue(cluster.FolderId)
+	state.NetworkId = types.StringValue(cluster.NetworkId)
+	state.Name = types.StringValue(cluster.Name)
+	state.Description = types.StringValue(cluster.Description)
+	state.Environment = types.StringValue(cluster.Environment.String())
+	state.Labels = mdbcommon.FlattenMapString(ctx, cluster.Labels, respDiagnostics)
+	state.SecurityGroupIds = mdbcommon.FlattenSetString(ctx, cluster.SecurityGroupIds, respDiagnostics)
+	state.DeletionProtection = types.BoolValue(cluster.GetDeletionProtection())
+	state.MaintenanceWindow = flattenMaintenanceWindow(ctx, cluster.MaintenanceWindow, respDiagnostics)
+	state.DiskEncryptionKeyId = mdbcommon.FlattenStringWrapper(ctx, cluster.DiskEncryptionKeyId, respDiagnostics)
+	state.Sharded = types.BoolValue(cluster.GetSharded())
+
+	state.Version = types.StringValue(cfg.GetVersion())
+	state.FeatureCompatibilityVersion = types.StringValue(cfg.GetFeatureCompatibilityVersion())
+	state.BackupRetainPeriodDays = mdbcommon.FlattenInt64Wrapper(ctx, cfg.GetBackupRetainPeriodDays(), respDiagnostics)
+	state.BackupWindowStart = mdbcommon.FlattenBackupWindowStart(ctx, cfg.GetBackupWindowStart(), respDiagnostics)
+	state.Access = mdbcommon.FlattenAccess[Access](ctx, cfg.GetAccess().ProtoReflect(), accessAttrTypes, respDiagnostics)
+	state.PerformanceDiagnostics = flattenPerformanceDiagnostics(ctx, cfg.GetPerformanceDiagnostics(), respDiagnostics)
+	flattenMongodbConfig(ctx, state, cfg, respDiagnostics)
+
+	hostsMap, d := types.MapValueFrom(ctx, hostType, hosts)
+	respDiagnostics.Append(d...)
+	state.HostSpecs = hostsMap
+	state.Endpoints = flattenEndpoints(ctx, hosts, respDiagnostics)
+}
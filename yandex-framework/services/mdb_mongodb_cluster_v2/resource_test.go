@@ -0,0 +1,250 @@
+package mdb_mongodb_cluster_v2_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/mongodb/v1"
+	mongodbsdk "github.com/yandex-cloud/go-sdk/services/mdb/mongodb/v1"
+	test "github.com/yandex-cloud/terraform-provider-yandex/pkg/testhelpers"
+	yandex_framework "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider"
+)
+
+const (
+	mgV2ResourceName = "yandex_mdb_mongodb_cluster_v2.foo"
+	mgResourceName   = "yandex_mdb_mongodb_cluster.foo"
+)
+
+const mgV2Dependencies = `
+resource "yandex_vpc_network" "foo" {}
+
+resource "yandex_vpc_subnet" "foo" {
+  zone           = "ru-central1-a"
+  network_id     = yandex_vpc_network.foo.id
+  v4_cidr_blocks = ["10.1.0.0/24"]
+}
+`
+
+const mgV2ReplicaSetHosts = `
+  hosts = {
+    "a" = {
+      zone      = "ru-central1-a"
+      subnet_id = yandex_vpc_subnet.foo.id
+    }
+  }
+`
+
+const mgV2ShardedHosts = `
+  resources_mongoinfra = {
+    resource_preset_id = "s2.micro"
+    disk_size          = 10
+    disk_type_id       = "network-ssd"
+  }
+
+  hosts = {
+    "a" = {
+      zone      = "ru-central1-a"
+      subnet_id = yandex_vpc_subnet.foo.id
+    }
+    "infra" = {
+      zone      = "ru-central1-a"
+      subnet_id = yandex_vpc_subnet.foo.id
+      type      = "MONGOINFRA"
+    }
+  }
+`
+
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
+
+func TestAccMDBMongoDBClusterV2_sharding(t *testing.T) {
+	t.Parallel()
+
+	clusterName := acctest.RandomWithPrefix("tf-mongodb-cluster-v2")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.AccPreCheck(t) },
+		ProtoV6ProviderFactories: test.AccProviderFactories,
+		CheckDestroy:             testAccCheckMDBMongoDBClusterV2Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMDBMongoDBClusterV2Config(clusterName, mgV2ReplicaSetHosts),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMDBMongoDBClusterV2Exists(mgV2ResourceName, false),
+					resource.TestCheckResourceAttr(mgV2ResourceName, "sharded", "false"),
+					resource.TestCheckResourceAttr(mgV2ResourceName, "hosts.a.type", "MONGOD"),
+					resource.TestCheckResourceAttrSet(mgV2ResourceName, "hosts.a.fqdn"),
+				),
+			},
+			{
+				ResourceName:            mgV2ResourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"hosts", "timeouts"},
+			},
+			{
+				Config: testAccMDBMongoDBClusterV2Config(clusterName, mgV2ShardedHosts),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(mgV2ResourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMDBMongoDBClusterV2Exists(mgV2ResourceName, true),
+					resource.TestCheckResourceAttr(mgV2ResourceName, "sharded", "true"),
+					resource.TestCheckResourceAttr(mgV2ResourceName, "hosts.infra.type", "MONGOINFRA"),
+					resource.TestCheckResourceAttr(mgV2ResourceName, "resources_mongoinfra.resource_preset_id", "s2.micro"),
+				),
+			},
+			{
+				Config:      testAccMDBMongoDBClusterV2Config(clusterName, mgV2ReplicaSetHosts),
+				ExpectError: regexp.MustCompile(`Disabling sharding on MongoDB cluster is not supported`),
+			},
+		},
+	})
+}
+
+func TestAccMDBMongoDBClusterV2_topologyValidation(t *testing.T) {
+	t.Parallel()
+
+	clusterName := acctest.RandomWithPrefix("tf-mongodb-cluster-v2")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.AccPreCheck(t) },
+		ProtoV6ProviderFactories: test.AccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMDBMongoDBClusterV2Config(clusterName, `
+  hosts = {
+    "a" = {
+      zone      = "ru-central1-a"
+      subnet_id = yandex_vpc_subnet.foo.id
+    }
+    "s" = {
+      zone      = "ru-central1-a"
+      subnet_id = yandex_vpc_subnet.foo.id
+      type      = "MONGOS"
+    }
+  }
+`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Invalid MongoDB cluster topology`),
+			},
+		},
+	})
+}
+
+func TestAccMDBMongoDBClusterV2_moveState(t *testing.T) {
+	t.Parallel()
+
+	clusterName := acctest.RandomWithPrefix("tf-mongodb-cluster-v2")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.AccPreCheck(t) },
+		ProtoV6ProviderFactories: test.AccProviderFactories,
+		CheckDestroy:             testAccCheckMDBMongoDBClusterV2Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: mgV2Dependencies + fmt.Sprintf(`
+resource "yandex_mdb_mongodb_cluster" "foo" {
+  name        = "%s"
+  environment = "PRESTABLE"
+  network_id  = yandex_vpc_network.foo.id
+
+  cluster_config {
+    version = "7.0"
+  }
+
+  resources_mongod {
+    resource_preset_id = "s2.micro"
+    disk_size          = 10
+    disk_type_id       = "network-ssd"
+  }
+
+  host {
+    zone_id   = "ru-central1-a"
+    subnet_id = yandex_vpc_subnet.foo.id
+  }
+}
+`, clusterName),
+				Check: resource.TestCheckResourceAttrSet(mgResourceName, "id"),
+			},
+			{
+				Config: testAccMDBMongoDBClusterV2Config(clusterName, mgV2ReplicaSetHosts) + `
+moved {
+  from = yandex_mdb_mongodb_cluster.foo
+  to   = yandex_mdb_mongodb_cluster_v2.foo
+}
+`,
+				Check: testAccCheckMDBMongoDBClusterV2Exists(mgV2ResourceName, false),
+			},
+		},
+	})
+}
+
+func testAccCheckMDBMongoDBClusterV2Exists(resourceName string, sharded bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+
+		config := test.AccProvider.(*yandex_framework.Provider).GetConfig()
+		cluster, err := mongodbsdk.NewClusterClient(config.SDKv2).Get(context.Background(), &mongodb.GetClusterRequest{
+			ClusterId: rs.Primary.ID,
+		})
+		if err != nil {
+			return fmt.Errorf("MongoDB cluster not found: %v", err)
+		}
+
+		if cluster.GetSharded() != sharded {
+			return fmt.Errorf("expected MongoDB cluster %q sharded %t, got %t", cluster.Id, sharded, cluster.GetSharded())
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckMDBMongoDBClusterV2Destroy(s *terraform.State) error {
+	config := test.AccProvider.(*yandex_framework.Provider).GetConfig()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "yandex_mdb_mongodb_cluster_v2" {
+			continue
+		}
+
+		_, err := mongodbsdk.NewClusterClient(config.SDKv2).Get(context.Background(), &mongodb.GetClusterRequest{
+			ClusterId: rs.Primary.ID,
+		})
+		if err == nil {
+			return fmt.Errorf("MongoDB cluster %q still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccMDBMongoDBClusterV2Config(clusterName, body string) string {
+	return mgV2Dependencies + fmt.Sprintf(`
+resource "yandex_mdb_mongodb_cluster_v2" "foo" {
+  name        = "%s"
+  environment = "PRESTABLE"
+  network_id  = yandex_vpc_network.foo.id
+  version     = "7.0"
+
+  resources_mongod = {
+    resource_preset_id = "s2.micro"
+    disk_size          = 10
+    disk_type_id       = "network-ssd"
+  }
+%s
+}
+`, clusterName, body)
+}
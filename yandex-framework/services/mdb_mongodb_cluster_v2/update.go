@@ -0,0 +1,259 @@
+package mdb_mongodb_cluster_v2
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/mongodb/v1"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/datasize"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/mdbcommon"
+	"google.golang.org/genproto/protobuf/field_mask"
+)
+
+// prepareUpdateRequest builds the request for the cluster settings.
+// Changes of the infrastructure subclusters are sent only when the cluster is already sharded,
+// otherwise they are applied by the enable sharding request.
+func prepareUpdateRequest(ctx context.Context, state, plan *Cluster) (*mongodb.UpdateClusterRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	request := &mongodb.UpdateClusterRequest{
+		ClusterId:  state.Id.ValueString(),
+		UpdateMask: &field_mask.FieldMask{},
+	}
+
+	if !plan.Name.Equal(state.Name) {
+		request.SetName(plan.Name.ValueString())
+		request.UpdateMask.Paths = append(request.UpdateMask.Paths, "name")
+	}
+
+	if !plan.Description.Equal(state.Description) {
+		request.SetDescription(plan.Description.ValueString())
+		request.UpdateMask.Paths = append(request.UpdateMask.Paths, "description")
+	}
+
+	if !plan.Labels.Equal(state.Labels) {
+		request.SetLabels(mdbcommon.ExpandLabels(ctx, plan.Labels, &diags))
+		request.UpdateMask.Paths = append(request.UpdateMask.Paths, "labels")
+	}
+
+	config := &mongodb.ConfigSpec{Mongodb: &mongodb.MongodbSpec{}}
+	updConf := false
+
+	if !plan.Version.Equal(state.Version) {
+		updConf = true
+		config.Version = plan.Version.ValueString()
+		request.UpdateMask.Paths = append(request.UpdateMask.Paths, "config_spec.version")
+	}
+
+	if !plan.FeatureCompatibilityVersion.Equal(state.FeatureCompatibilityVersion) {
+		updConf = true
+		config.FeatureCompatibilityVersion = plan.FeatureCompatibilityVersion.ValueString()
+		request.UpdateMask.Paths = append(request.UpdateMask.Paths, "config_spec.feature_compatibility_version")
+	}
+
+	if !plan.BackupWindowStart.Equal(state.BackupWindowStart) {
+		updConf = true
+		config.BackupWindowStart = mdbcommon.ExpandBackupWindow(ctx, plan.BackupWindowStart, &diags)
+		request.UpdateMask.Paths = append(request.UpdateMask.Paths, "config_spec.backup_window_start")
+	}
+
+	if !plan.BackupRetainPeriodDays.Equal(state.BackupRetainPeriodDays) {
+		updConf = true
+		config.BackupRetainPeriodDays = mdbcommon.ExpandInt64Wrapper(ctx, plan.BackupRetainPeriodDays, &diags)
+		request.UpdateMask.Paths = append(request.UpdateMask.Paths, "config_spec.backup_retain_period_days")
+	}
+
+	if !plan.PerformanceDiagnostics.Equal(state.PerformanceDiagnostics) {
+		updConf = true
+		config.PerformanceDiagnostics = expandPerformanceDiagnostics(ctx, plan.PerformanceDiagnostics, &diags)
+		request.UpdateMask.Paths = append(request.UpdateMask.Paths, "config_spec.performance_diagnostics")
+	}
+
+	if !plan.Access.Equal(state.Access) {
+		updConf = true
+		config.Access = mdbcommon.ExpandAccess[*mongodb.Access](ctx, plan.Access, &diags)
+
+		var pa, sa Access
+		diags.Append(plan.Access.As(ctx, &pa, unhandledAsEmpty)...)
+		diags.Append(state.Access.As(ctx, &sa, unhandledAsEmpty)...)
+		if pa.DataLens != sa.DataLens {
+			request.UpdateMask.Paths = append(request.UpdateMask.Paths, "config_spec.access.data_lens")
+		}
+		if pa.WebSQL != sa.WebSQL {
+			request.UpdateMask.Paths = append(request.UpdateMask.Paths, "config_spec.access.web_sql")
+		}
+		if pa.DataTransfer != sa.DataTransfer {
+			request.UpdateMask.Paths = append(request.UpdateMask.Paths, "config_spec.access.data_transfer")
+		}
+	}
+
+	if !plan.ResourcesMongod.Equal(state.ResourcesMongod) || !plan.DiskSizeAutoscalingMongod.Equal(state.DiskSizeAutoscalingMongod) {
+		updConf = true
+		config.Mongodb.Mongod = &mongodb.MongodbSpec_Mongod{
+			Resources:           mdbcommon.ExpandResources[mongodb.Resources](ctx, plan.ResourcesMongod, &diags),
+			DiskSizeAutoscaling: expandDiskSizeAutoscaling(ctx, plan.DiskSizeAutoscalingMongod, &diags),
+		}
+		request.UpdateMask.Paths = append(request.UpdateMask.Paths, roleUpdatePaths(
+			ctx, "config_spec.mongodb.mongod",
+			state.ResourcesMongod, plan.ResourcesMongod,
+			state.DiskSizeAutoscalingMongod, plan.DiskSizeAutoscalingMongod,
+			&diags,
+		)...)
+	}
+
+	if state.Sharded.ValueBool() {
+		if !plan.ResourcesMongos.Equal(state.ResourcesMongos) || !plan.DiskSizeAutoscalingMongos.Equal(state.DiskSizeAutoscalingMongos) {
+			updConf = true
+			config.Mongodb.Mongos = &mongodb.MongodbSpec_Mongos{
+				Resources:           mdbcommon.ExpandResources[mongodb.Resources](ctx, plan.ResourcesMongos, &diags),
+				DiskSizeAutoscaling: expandDiskSizeAutoscaling(ctx, plan.DiskSizeAutoscalingMongos, &diags),
+			}
+			request.UpdateMask.Paths = append(request.UpdateMask.Paths, roleUpdatePaths(
+				ctx, "config_spec.mongodb.mongos",
+				state.ResourcesMongos, plan.ResourcesMongos,
+				state.DiskSizeAutoscalingMongos, plan.DiskSizeAutoscalingMongos,
+				&diags,
+			)...)
+		}
+
+		if !plan.ResourcesMongocfg.Equal(state.ResourcesMongocfg) || !plan.DiskSizeAutoscalingMongocfg.Equal(state.DiskSizeAutoscalingMongocfg) {
+			updConf = true
+			config.Mongodb.Mongocfg = &mongodb.MongodbSpec_MongoCfg{
+				Resources:           mdbcommon.ExpandResources[mongodb.Resources](ctx, plan.ResourcesMongocfg, &diags),
+				DiskSizeAutoscaling: expandDiskSizeAutoscaling(ctx, plan.DiskSizeAutoscalingMongocfg, &diags),
+			}
+			request.UpdateMask.Paths = append(request.UpdateMask.Paths, roleUpdatePaths(
+				ctx, "config_spec.mongodb.mongocfg",
+				state.ResourcesMongocfg, plan.ResourcesMongocfg,
+				state.DiskSizeAutoscalingMongocfg, plan.DiskSizeAutoscalingMongocfg,
+				&diags,
+			)...)
+		}
+
+		if !plan.ResourcesMongoinfra.Equal(state.ResourcesMongoinfra) || !plan.DiskSizeAutoscalingMongoinfra.Equal(state.DiskSizeAutoscalingMongoinfra) {
+			updConf = true
+			config.Mongodb.Mongoinfra = &mongodb.MongodbSpec_MongoInfra{
+				Resources:           mdbcommon.ExpandResources[mongodb.Resources](ctx, plan.ResourcesMongoinfra, &diags),
+				DiskSizeAutoscaling: expandDiskSizeAutoscaling(ctx, plan.DiskSizeAutoscalingMongoinfra, &diags),
+			}
+			request.UpdateMask.Paths = append(request.UpdateMask.Paths, roleUpdatePaths(
+				ctx, "config_spec.mongodb.mongoinfra",
+				state.ResourcesMongoinfra, plan.ResourcesMongoinfra,
+				state.DiskSizeAutoscalingMongoinfra, plan.DiskSizeAutoscalingMongoinfra,
+				&diags,
+			)...)
+		}
+	}
+
+	if updConf {
+		request.SetConfigSpec(config)
+	}
+
+	if !plan.DeletionProtection.Equal(state.DeletionProtection) {
+		request.SetDeletionProtection(plan.DeletionProtection.ValueBool())
+		request.UpdateMask.Paths = append(request.UpdateMask.Paths, "deletion_protection")
+	}
+
+	if !plan.SecurityGroupIds.Equal(state.SecurityGroupIds) {
+		request.SetSecurityGroupIds(mdbcommon.ExpandSecurityGroupIds(ctx, plan.SecurityGroupIds, &diags))
+		request.UpdateMask.Paths = append(request.UpdateMask.Paths, "security_group_ids")
+	}
+
+	if !plan.MaintenanceWindow.Equal(state.MaintenanceWindow) {
+		request.SetMaintenanceWindow(expandMaintenanceWindow(ctx, plan.MaintenanceWindow, &diags))
+		request.UpdateMask.Paths = append(request.UpdateMask.Paths, "maintenance_window")
+	}
+
+	return request, diags
+}
+
+// roleUpdatePaths returns the update mask paths of a subcluster's resources and disk autoscaling.
+func roleUpdatePaths(ctx context.Context, prefix string, stateResources, planResources, stateDSA, planDSA types.Object, diags *diag.Diagnostics) []string {
+	var paths []string
+
+	if !planResources.Equal(stateResources) {
+		var sr, pr mdbcommon.Resource
+		diags.Append(stateResources.As(ctx, &sr, datasize.UnhandledOpts)...)
+		diags.Append(planResources.As(ctx, &pr, datasize.UnhandledOpts)...)
+
+		if !pr.ResourcePresetId.Equal(sr.ResourcePresetId) {
+			paths = append(paths, prefix+".resources.resource_preset_id")
+		}
+		if !pr.DiskSize.Equal(sr.DiskSize) {
+			paths = append(paths, prefix+".resources.disk_size")
+		}
+		if !pr.DiskTypeId.Equal(sr.DiskTypeId) {
+			paths = append(paths, prefix+".resources.disk_type_id")
+		}
+	}
+
+	if !planDSA.Equal(stateDSA) {
+		paths = append(paths, prefix+".disk_size_autoscaling")
+	}
+
+	return paths
+}
+
+// prepareEnableShardingRequest turns the replica set into a sharded cluster with the planned
+// infrastructure hosts. Returns nil if sharding is already enabled or no infrastructure hosts are planned.
+func prepareEnableShardingRequest(ctx context.Context, state, plan *Cluster) (*mongodb.EnableClusterShardingRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if state.Sharded.ValueBool() {
+		return nil, diags
+	}
+
+	_, infraHosts := splitHostsByType(ctx, plan.HostSpecs, &diags)
+	if diags.HasError() || len(infraHosts) == 0 {
+		return nil, diags
+	}
+
+	request := &mongodb.EnableClusterShardingRequest{
+		ClusterId: state.Id.ValueString(),
+	}
+	for _, h := range infraHosts {
+		request.HostSpecs = append(request.HostSpecs, mongodbHostService.ConvertToProto(h))
+	}
+
+	if r := mdbcommon.ExpandResources[mongodb.Resources](ctx, plan.ResourcesMongos, &diags); r != nil {
+		request.Mongos = &mongodb.EnableClusterShardingRequest_Mongos{Resources: r}
+	}
+	if r := mdbcommon.ExpandResources[mongodb.Resources](ctx, plan.ResourcesMongocfg, &diags); r != nil {
+		request.Mongocfg = &mongodb.EnableClusterShardingRequest_MongoCfg{Resources: r}
+	}
+	if r := mdbcommon.ExpandResources[mongodb.Resources](ctx, plan.ResourcesMongoinfra, &diags); r != nil {
+		request.Mongoinfra = &mongodb.EnableClusterShardingRequest_MongoInfra{Resources: r}
+	}
+
+	return request, diags
+}
+
+// splitHostsByType separates mongod hosts, which are grouped by shards,
+// from the mongos, mongocfg and mongoinfra hosts of the sharding infrastructure.
+func splitHostsByType(ctx context.Context, hosts types.Map, diags *diag.Diagnostics) (map[string]Host, map[string]Host) {
+	mongod := make(map[string]Host)
+	infra := make(map[string]Host)
+
+	all := make(map[string]Host)
+	diags.Append(hosts.ElementsAs(ctx, &all, false)...)
+	if diags.HasError() {
+		return mongod, infra
+	}
+
+	for label, h := range all {
+		if isMongod(h) {
+			mongod[label] = h
+		} else {
+			infra[label] = h
+		}
+	}
+	return mongod, infra
+}
+
+// hostsMapValue is the inverse of splitHostsByType for a single group of hosts.
+func hostsMapValue(ctx context.Context, hosts map[string]Host, diags *diag.Diagnostics) types.Map {
+	m, d := types.MapValueFrom(ctx, hostType, hosts)
+	diags.Append(d...)
+	return m
+}
@@ -0,0 +1,259 @@
+package mdb_mongodb_cluster_v2
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/mdbcommon"
+)
+
+func testResources(t *testing.T, preset string, diskSize int64) types.Object {
+	t.Helper()
+
+	obj, d := types.ObjectValue(mdbcommon.ResourceType.AttrTypes, map[string]attr.Value{
+		"resource_preset_id": types.StringValue(preset),
+		"disk_size":          types.Int64Value(diskSize),
+		"disk_type_id":       types.StringValue("network-ssd"),
+	})
+	if d.HasError() {
+		t.Fatalf("failed to build resources: %v", d)
+	}
+	return obj
+}
+
+func testHost(hostType, shard, fqdn string) Host {
+	return Host{
+		Zone:               types.StringValue("ru-central1-a"),
+		SubnetId:           types.StringValue("subnet"),
+		AssignPublicIp:     types.BoolValue(false),
+		ShardName:          types.StringValue(shard),
+		Type:               types.StringValue(hostType),
+		FQDN:               types.StringValue(fqdn),
+		Hidden:             types.BoolValue(false),
+		Priority:           types.Float64Value(defaultHostPriority),
+		Votes:              types.Int64Value(defaultHostVotes),
+		SecondaryDelaySecs: types.Int64Value(defaultHostSecondaryDelaySecs),
+		Tags:               types.MapNull(types.StringType),
+	}
+}
+
+func testCluster(t *testing.T, sharded bool, hosts map[string]Host) *Cluster {
+	t.Helper()
+
+	var diags diag.Diagnostics
+	hostsMap := hostsMapValue(context.Background(), hosts, &diags)
+	if diags.HasError() {
+		t.Fatalf("failed to build hosts: %v", diags)
+	}
+
+	return &Cluster{
+		Id:                            types.StringValue("cid"),
+		Name:                          types.StringValue("mongo"),
+		Description:                   types.StringValue(""),
+		Labels:                        types.MapNull(types.StringType),
+		SecurityGroupIds:              types.SetNull(types.StringType),
+		DeletionProtection:            types.BoolValue(false),
+		FinalBackup:                   types.BoolValue(false),
+		MaintenanceWindow:             types.ObjectNull(MaintenanceWindowAttrTypes),
+		Sharded:                       types.BoolValue(sharded),
+		Version:                       types.StringValue("7.0"),
+		FeatureCompatibilityVersion:   types.StringValue("7.0"),
+		BackupRetainPeriodDays:        types.Int64Value(7),
+		BackupWindowStart:             types.ObjectNull(mdbcommon.BackupWindowType.AttrTypes),
+		Access:                        types.ObjectNull(accessAttrTypes),
+		PerformanceDiagnostics:        types.ObjectNull(PerformanceDiagnosticsAttrTypes),
+		ResourcesMongod:               testResources(t, "s2.micro", 10),
+		ResourcesMongos:               types.ObjectNull(mdbcommon.ResourceType.AttrTypes),
+		ResourcesMongocfg:             types.ObjectNull(mdbcommon.ResourceType.AttrTypes),
+		ResourcesMongoinfra:           types.ObjectNull(mdbcommon.ResourceType.AttrTypes),
+		DiskSizeAutoscalingMongod:     types.ObjectNull(DiskSizeAutoscalingAttrTypes),
+		DiskSizeAutoscalingMongos:     types.ObjectNull(DiskSizeAutoscalingAttrTypes),
+		DiskSizeAutoscalingMongocfg:   types.ObjectNull(DiskSizeAutoscalingAttrTypes),
+		DiskSizeAutoscalingMongoinfra: types.ObjectNull(DiskSizeAutoscalingAttrTypes),
+		HostSpecs:                     hostsMap,
+		HostUpdateStrategy:            types.ObjectNull(mdbcommon.HostUpdateStrategyAttrTypes),
+		Endpoints:                     types.ObjectNull(mdbcommon.EndpointsAttrTypes),
+		Restore:                       types.ObjectNull(RestoreAttrTypes),
+	}
+}
+
+func TestPrepareUpdateRequestMongod(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	hosts := map[string]Host{"a": testHost("MONGOD", "rs01", "a.mdb")}
+
+	state := testCluster(t, false, hosts)
+	plan := testCluster(t, false, hosts)
+	plan.ResourcesMongod = testResources(t, "s2.small", 10)
+	plan.FeatureCompatibilityVersion = types.StringValue("6.0")
+
+	req, diags := prepareUpdateRequest(ctx, state, plan)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	expected := []string{
+		"config_spec.feature_compatibility_version",
+		"config_spec.mongodb.mongod.resources.resource_preset_id",
+	}
+	if !slices.Equal(req.GetUpdateMask().GetPaths(), expected) {
+		t.Errorf("unexpected update mask: %v, want %v", req.GetUpdateMask().GetPaths(), expected)
+	}
+	if req.GetConfigSpec().GetMongodb().GetMongod().GetResources().GetResourcePresetId() != "s2.small" {
+		t.Errorf("unexpected mongod resources: %v", req.GetConfigSpec().GetMongodb().GetMongod())
+	}
+}
+
+func TestPrepareUpdateRequestInfraResources(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	hosts := map[string]Host{
+		"a":     testHost("MONGOD", "rs01", "a.mdb"),
+		"infra": testHost("MONGOINFRA", "", "infra.mdb"),
+	}
+
+	// Infrastructure resources of a replica set are sent with the enable sharding request.
+	state := testCluster(t, false, hosts)
+	plan := testCluster(t, false, hosts)
+	plan.ResourcesMongoinfra = testResources(t, "s2.micro", 10)
+
+	req, diags := prepareUpdateRequest(ctx, state, plan)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if paths := req.GetUpdateMask().GetPaths(); len(paths) != 0 {
+		t.Errorf("expected no update paths for a replica set, got %v", paths)
+	}
+
+	state = testCluster(t, true, hosts)
+	state.ResourcesMongoinfra = testResources(t, "s2.micro", 10)
+	plan = testCluster(t, true, hosts)
+	plan.ResourcesMongoinfra = testResources(t, "s2.micro", 20)
+
+	req, diags = prepareUpdateRequest(ctx, state, plan)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	expected := []string{"config_spec.mongodb.mongoinfra.resources.disk_size"}
+	if !slices.Equal(req.GetUpdateMask().GetPaths(), expected) {
+		t.Errorf("unexpected update mask: %v, want %v", req.GetUpdateMask().GetPaths(), expected)
+	}
+}
+
+func TestPrepareEnableShardingRequest(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	mongod := map[string]Host{"a": testHost("MONGOD", "", "a.mdb")}
+
+	state := testCluster(t, false, mongod)
+	if req, diags := prepareEnableShardingRequest(ctx, state, testCluster(t, false, mongod)); diags.HasError() || req != nil {
+		t.Errorf("expected no request without infrastructure hosts, got %v (%v)", req, diags)
+	}
+
+	plan := testCluster(t, true, map[string]Host{
+		"a":     testHost("MONGOD", "", "a.mdb"),
+		"infra": testHost("MONGOINFRA", "", ""),
+	})
+	plan.ResourcesMongoinfra = testResources(t, "s2.micro", 10)
+
+	req, diags := prepareEnableShardingRequest(ctx, state, plan)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if req == nil || req.GetClusterId() != "cid" || len(req.GetHostSpecs()) != 1 {
+		t.Fatalf("unexpected enable sharding request: %v", req)
+	}
+	if req.GetMongoinfra().GetResources().GetResourcePresetId() != "s2.micro" || req.GetMongos() != nil || req.GetMongocfg() != nil {
+		t.Errorf("unexpected subclusters in the enable sharding request: %v", req)
+	}
+
+	if req, _ := prepareEnableShardingRequest(ctx, testCluster(t, true, mongod), plan); req != nil {
+		t.Errorf("expected no request for the sharded cluster, got %v", req)
+	}
+}
+
+func TestGetHostChanges(t *testing.T) {
+	t.Parallel()
+
+	state := testHost("MONGOD", "rs01", "a.mdb")
+	if spec, diags := mongodbHostService.GetChanges(state, state); diags.HasError() || spec != nil {
+		t.Errorf("expected no changes, got %v (%v)", spec, diags)
+	}
+
+	plan := state
+	plan.Hidden = types.BoolValue(true)
+	plan.Priority = types.Float64Value(0)
+	spec, diags := mongodbHostService.GetChanges(plan, state)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if !slices.Equal(spec.GetUpdateMask().GetPaths(), []string{"hidden", "priority"}) || spec.GetHostName() != "a.mdb" {
+		t.Errorf("unexpected update host spec: %v", spec)
+	}
+
+	// Replica set parameters are ignored for the infrastructure hosts.
+	infraState := testHost("MONGOS", "", "s.mdb")
+	infraPlan := infraState
+	infraPlan.Hidden = types.BoolValue(true)
+	if spec, diags := mongodbHostService.GetChanges(infraPlan, infraState); diags.HasError() || spec != nil {
+		t.Errorf("expected no changes for mongos host, got %v (%v)", spec, diags)
+	}
+
+	plan = state
+	plan.ShardName = types.StringValue("rs02")
+	if _, diags := mongodbHostService.GetChanges(plan, state); !diags.HasError() {
+		t.Error("expected error on shard_name change")
+	}
+}
+
+func TestValidateTopology(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	cases := []struct {
+		name     string
+		hosts    map[string]Host
+		mongos   bool
+		mongocfg bool
+		infra    bool
+		wantErr  bool
+	}{
+		{name: "replica set", hosts: map[string]Host{"a": testHost("MONGOD", "", "")}},
+		{name: "mongoinfra", hosts: map[string]Host{"a": testHost("MONGOD", "", ""), "i": testHost("MONGOINFRA", "", "")}, infra: true},
+		{name: "mongoinfra without resources", hosts: map[string]Host{"a": testHost("MONGOD", "", ""), "i": testHost("MONGOINFRA", "", "")}, wantErr: true},
+		{name: "mongos without config servers", hosts: map[string]Host{"a": testHost("MONGOD", "", ""), "s": testHost("MONGOS", "", "")}, mongos: true, wantErr: true},
+		{
+			name:     "mongos and mongocfg",
+			hosts:    map[string]Host{"a": testHost("MONGOD", "", ""), "s": testHost("MONGOS", "", ""), "c": testHost("MONGOCFG", "", "")},
+			mongos:   true,
+			mongocfg: true,
+		},
+	}
+
+	resources := func(present bool) types.Object {
+		if present {
+			return testResources(t, "s2.micro", 10)
+		}
+		return types.ObjectNull(mdbcommon.ResourceType.AttrTypes)
+	}
+
+	for _, c := range cases {
+		var diags diag.Diagnostics
+		validateTopology(ctx, topology{
+			Hosts:               hostsMapValue(ctx, c.hosts, &diags),
+			ResourcesMongos:     resources(c.mongos),
+			ResourcesMongocfg:   resources(c.mongocfg),
+			ResourcesMongoinfra: resources(c.infra),
+		}, &diags)
+		if diags.HasError() != c.wantErr {
+			t.Errorf("%s: unexpected diagnostics: %v", c.name, diags)
+		}
+	}
+}
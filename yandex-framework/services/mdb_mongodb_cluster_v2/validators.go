@@ -0,0 +1,129 @@
+package mdb_mongodb_cluster_v2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/mongodb/v1"
+)
+
+var _ validator.Object = &maintenanceWindowStructValidator{}
+
+type maintenanceWindowStructValidator struct{}
+
+func NewMaintenanceWindowStructValidator() *maintenanceWindowStructValidator {
+	return &maintenanceWindowStructValidator{}
+}
+
+func (m *maintenanceWindowStructValidator) ValidateObject(ctx context.Context, req validator.ObjectRequest, resp *validator.ObjectResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	var t, d types.String
+	var h types.Int64
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, req.Path.AtName("type"), &t)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, req.Path.AtName("day"), &d)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, req.Path.AtName("hour"), &h)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if t.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Failed to validate maintenance_window",
+			`Field "type" should be set`,
+		)
+		return
+	}
+
+	if t.ValueString() == "ANYTIME" && (!d.IsNull() || !h.IsNull()) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Failed to validate maintenance_window",
+			`day and hour should not be set, when using ANYTIME`,
+		)
+		return
+	}
+
+	if t.ValueString() == "WEEKLY" && (d.IsNull() || h.IsNull()) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Failed to validate maintenance_window",
+			`day and hour should be set, when using WEEKLY`,
+		)
+	}
+}
+
+func (m *maintenanceWindowStructValidator) Description(_ context.Context) string {
+	return `
+		Maintenance window block validation. 
+		Check block structure in general for ANYTIME and WEEKLY maintenance. 
+		Attributes hour and day should be set ONLY for WEEKLY maintenance.
+	`
+}
+
+func (m *maintenanceWindowStructValidator) MarkdownDescription(_ context.Context) string {
+	return `
+		Maintenance window block validation. 
+		Check block structure in general for *ANYTIME* and *WEEKLY* maintenance. 
+		Attributes hour and day should be set ONLY for *WEEKLY* maintenance.
+	`
+}
+
+// topology is the part of the configuration describing the cluster roles.
+type topology struct {
+	Hosts               types.Map    `tfsdk:"hosts"`
+	ResourcesMongos     types.Object `tfsdk:"resources_mongos"`
+	ResourcesMongocfg   types.Object `tfsdk:"resources_mongocfg"`
+	ResourcesMongoinfra types.Object `tfsdk:"resources_mongoinfra"`
+}
+
+// validateTopology checks that the sharding infrastructure is complete:
+// every host type has its resources, and mongos hosts are accompanied by config servers.
+func validateTopology(ctx context.Context, t topology, diags *diag.Diagnostics) {
+	if t.Hosts.IsUnknown() {
+		return
+	}
+	hostTypes := planHostTypes(ctx, t.Hosts, diags)
+	if diags.HasError() {
+		return
+	}
+	_, hasMongos := hostTypes[mongodb.Host_MONGOS.String()]
+	_, hasMongocfg := hostTypes[mongodb.Host_MONGOCFG.String()]
+	_, hasMongoinfra := hostTypes[mongodb.Host_MONGOINFRA.String()]
+
+	checkResources := func(present bool, hostType, attr string, o types.Object) {
+		if present && o.IsNull() {
+			diags.AddAttributeError(
+				path.Root(attr),
+				"Invalid MongoDB cluster topology",
+				fmt.Sprintf("Attribute %q must be set when the cluster has %s hosts.", attr, hostType),
+			)
+		}
+	}
+	checkResources(hasMongos, "MONGOS", "resources_mongos", t.ResourcesMongos)
+	checkResources(hasMongocfg, "MONGOCFG", "resources_mongocfg", t.ResourcesMongocfg)
+	checkResources(hasMongoinfra, "MONGOINFRA", "resources_mongoinfra", t.ResourcesMongoinfra)
+
+	if hasMongos && !hasMongocfg && !hasMongoinfra {
+		diags.AddAttributeError(
+			path.Root("hosts"),
+			"Invalid MongoDB cluster topology",
+			"MONGOS hosts require MONGOCFG or MONGOINFRA hosts in the sharded cluster.",
+		)
+	}
+	if hasMongocfg && !hasMongos && !hasMongoinfra {
+		diags.AddAttributeError(
+			path.Root("hosts"),
+			"Invalid MongoDB cluster topology",
+			"MONGOCFG hosts require MONGOS or MONGOINFRA hosts in the sharded cluster.",
+		)
+	}
+}
@@ -217,6 +217,31 @@ func (r *MysqlAPI) DeleteCluster(ctx context.Context, sdk *ycsdk.SDK, diags *dia
 	}
 }
 
+func (r *MysqlAPI) BackupCluster(ctx context.Context, sdk *ycsdk.SDK, diags *diag.Diagnostics, cid string) {
+	op, err := retry.ConflictingOperationV2(ctx, sdk, func() (*mysqlsdk.ClusterBackupOperation, error) {
+		return mysqlsdk.NewClusterClient(sdk).Backup(ctx, &mysql.BackupClusterRequest{
+			ClusterId: cid,
+		})
+	})
+
+	if err != nil {
+		diags.AddError(
+			"Failed to backup resource",
+			fmt.Sprintf("Error while requesting API to backup MySQL cluster %q: %s", cid, err.Error()),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Creating final backup of MySQL Cluster", map[string]any{"cluster_id": cid})
+
+	if _, err = op.Wait(ctx); err != nil {
+		diags.AddError(
+			"Failed to backup resource",
+			fmt.Sprintf("Error while waiting for operation %q to backup MySQL cluster %q: %s", op.ID(), cid, err.Error()),
+		)
+	}
+}
+
 func (r *MysqlAPI) CreateCluster(ctx context.Context, sdk *ycsdk.SDK, diags *diag.Diagnostics, req *mysql.CreateClusterRequest) string {
 	op, err := mysqlsdk.NewClusterClient(sdk).Create(ctx, req)
 	if err != nil {
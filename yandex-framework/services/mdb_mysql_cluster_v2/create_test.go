@@ -58,6 +58,7 @@ var (
 		"maintenance_window":        types.ObjectType{AttrTypes: expectedMWAttrs},
 		"security_group_ids":        types.SetType{ElemType: types.StringType},
 		"deletion_protection":       types.BoolType,
+		"final_backup":              types.BoolType,
 		"folder_id":                 types.StringType,
 		"hosts":                     types.MapType{ElemType: types.StringType},
 		"id":                        types.StringType,
@@ -182,6 +183,7 @@ func TestYandexProvider_MDBMySQLClusterPrepareCreateRequest(t *testing.T) {
 						},
 					),
 					"deletion_protection": types.BoolValue(true),
+					"final_backup":        types.BoolNull(),
 					"security_group_ids": types.SetValueMust(types.StringType, []attr.Value{
 						types.StringValue("test-sg"),
 					}),
@@ -277,6 +279,7 @@ func TestYandexProvider_MDBMySQLClusterPrepareCreateRequest(t *testing.T) {
 					"access":              types.ObjectNull(AccessAttrTypes),
 					"maintenance_window":  types.ObjectNull(expectedMWAttrs),
 					"deletion_protection": types.BoolNull(),
+					"final_backup":        types.BoolNull(),
 					"restore": types.ObjectValueMust(expectedRestoreAttrTypes, map[string]attr.Value{
 						"backup_id": types.StringNull(),
 						"time":      types.StringNull(),
@@ -453,6 +456,7 @@ func TestYandexProvider_MDBMySQLClusterPrepareRestoreRequest(t *testing.T) {
 						},
 					),
 					"deletion_protection": types.BoolValue(true),
+					"final_backup":        types.BoolNull(),
 					"security_group_ids": types.SetValueMust(types.StringType, []attr.Value{
 						types.StringValue("test-sg"),
 					}),
@@ -24,6 +24,10 @@ func (p *MsSettingsAttributeInfoProvider) GetSetAttributes() map[string]struct{}
 	return listAttributes
 }
 
+func (p *MsSettingsAttributeInfoProvider) GetSettingsDisruption() map[string]mdbcommon.SettingDisruption {
+	return msSettingsDisruption
+}
+
 var msSettingsEnumNames = map[string]map[int32]string{
 	"default_authentication_plugin": config.MysqlConfig8_0_AuthPlugin_name,
 	"transaction_isolation":         config.MysqlConfig8_0_TransactionIsolation_name,
@@ -52,6 +56,21 @@ var listAttributes = map[string]struct{}{
 	"sql_mode": {},
 }
 
+// MySQL settings that are not dynamic are applied on restart of the server.
+var msSettingsDisruption = map[string]mdbcommon.SettingDisruption{
+	"innodb_log_file_size":           mdbcommon.SettingRequiresFailover,
+	"innodb_numa_interleave":         mdbcommon.SettingRequiresFailover,
+	"innodb_read_io_threads":         mdbcommon.SettingRequiresFailover,
+	"innodb_write_io_threads":        mdbcommon.SettingRequiresFailover,
+	"innodb_purge_threads":           mdbcommon.SettingRequiresFailover,
+	"innodb_ft_min_token_size":       mdbcommon.SettingRequiresFailover,
+	"innodb_ft_max_token_size":       mdbcommon.SettingRequiresFailover,
+	"innodb_temp_data_file_max_size": mdbcommon.SettingRequiresFailover,
+	"table_open_cache_instances":     mdbcommon.SettingRequiresFailover,
+	"thread_stack":                   mdbcommon.SettingRequiresFailover,
+	"max_digest_length":              mdbcommon.SettingRequiresFailover,
+}
+
 var msAttrProvider = &MsSettingsAttributeInfoProvider{}
 
 func NewMsSettingsMapType() mdbcommon.SettingsMapType {
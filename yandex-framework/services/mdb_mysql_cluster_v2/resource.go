@@ -151,7 +151,11 @@ func (r *clusterResource) Schema(ctx context.Context, _ resource.SchemaRequest,
 					},
 				},
 			},
-			"deletion_protection": defaultschema.DeletionProtection(),
+			"deletion_protection":           defaultschema.DeletionProtection(),
+			"require_disruptive_change_ack": defaultschema.RequireDisruptiveChangeAck(),
+			"final_backup":                  defaultschema.FinalBackup(),
+			"host_update_strategy":          defaultschema.HostUpdateStrategy(),
+			"endpoints":                     mdbcommon.EndpointsSchema(),
 			"version": schema.StringAttribute{
 				Description: "Version of the MySQL cluster.",
 				Required:    true,
@@ -452,6 +456,16 @@ func (r *clusterResource) ModifyPlan(ctx context.Context, req resource.ModifyPla
 
 	autoscalingOn := utils.IsPresent(attr.Value(state.DiskSizeAutoscaling))
 
+	mdbcommon.ReportDisruptiveChanges(
+		path.Root("mysql_config"),
+		plan.MySQLConfig.DisruptiveChanges(state.MySQLConfig),
+		plan.RequireDisruptiveChangeAck.ValueBool(),
+		&resp.Diagnostics,
+	)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// remove changes on disk_size from plan if enabled autoscaling
 	plan.Resources = mdbcommon.FixDiskSizeOnAutoscalingChanges(ctx, plan.Resources, state.Resources, autoscalingOn, &resp.Diagnostics)
 
@@ -620,6 +634,7 @@ func (r *clusterResource) createCluster(
 		&mysqlApi,
 		cid,
 		struct{}{},
+		nil,
 		resolvedHostSpecs,
 		plan.HostSpecs,
 	)
@@ -708,6 +723,7 @@ func (r *clusterResource) Update(ctx context.Context, req resource.UpdateRequest
 
 	// Resolve replication_source_name labels to FQDNs before updating hosts.
 	resolvedPlanHostSpecs := resolveReplicationSourceNames(ctx, plan.HostSpecs, state.HostSpecs, &resp.Diagnostics)
+	hostUpdateStrategy := mdbcommon.ExpandHostUpdateStrategy(ctx, plan.HostUpdateStrategy, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -720,6 +736,7 @@ func (r *clusterResource) Update(ctx context.Context, req resource.UpdateRequest
 		&mysqlApi,
 		plan.Id.ValueString(),
 		struct{}{},
+		hostUpdateStrategy,
 		resolvedPlanHostSpecs,
 		state.HostSpecs,
 	)
@@ -745,6 +762,12 @@ func (r *clusterResource) Delete(ctx context.Context, req resource.DeleteRequest
 	defer cancel()
 
 	cid := state.Id.ValueString()
+	if state.FinalBackup.ValueBool() {
+		mysqlApi.BackupCluster(ctx, r.providerConfig.SDKv2, &resp.Diagnostics, cid)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 	mysqlApi.DeleteCluster(ctx, r.providerConfig.SDKv2, &resp.Diagnostics, cid)
 }
 
@@ -811,4 +834,11 @@ func (r *clusterResource) refreshResourceState(ctx context.Context, state *Clust
 	state.BackupWindowStart = cfg.BackupWindowStart
 	state.MySQLConfig = cfg.MySQLConfig
 	state.DiskEncryptionKeyId = mdbcommon.FlattenStringWrapper(ctx, cluster.DiskEncryptionKeyId, respDiagnostics)
+	state.Endpoints = mdbcommon.FlattenEndpoints(
+		ctx,
+		mdbcommon.HostPort(mdbcommon.ClusterReadWriteHost(cluster.Id), mdbcommon.MySQLPort),
+		mdbcommon.HostPort(mdbcommon.ClusterReadOnlyHost(cluster.Id), mdbcommon.MySQLPort),
+		nil,
+		respDiagnostics,
+	)
 }
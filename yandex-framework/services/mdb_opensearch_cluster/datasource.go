@@ -355,6 +355,16 @@ func (o *openSearchClusterDataSource) Schema(ctx context.Context, _ datasource.S
 				Computed:            true,
 				Optional:            true,
 			},
+			"restore": schema.SingleNestedAttribute{
+				MarkdownDescription: descriptions.Restore,
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"backup_id": schema.StringAttribute{
+						MarkdownDescription: descriptions.RestoreBackupID,
+						Computed:            true,
+					},
+				},
+			},
 			"auth_settings": schema.SingleNestedAttribute{
 				MarkdownDescription: descriptions.AuthSettings,
 				Optional:            true,
@@ -472,6 +472,7 @@ func NewUpgraderFromV0(ctx context.Context) resource.StateUpgrader {
 				DeletionProtection: oldModel.DeletionProtection,
 				MaintenanceWindow:  newMaintenanceWindow,
 				AuthSettings:       newAuthSettings,
+				Restore:            types.ObjectNull(model.RestoreAttrTypes),
 				Timeouts:           oldModel.Timeouts,
 			}
 
@@ -438,6 +438,7 @@ func NewUpgraderFromV1(ctx context.Context) resource.StateUpgrader {
 				DeletionProtection: oldModel.DeletionProtection,
 				MaintenanceWindow:  oldModel.MaintenanceWindow,
 				AuthSettings:       newAuthSettings,
+				Restore:            types.ObjectNull(model.RestoreAttrTypes),
 				Timeouts:           oldModel.Timeouts,
 			}
 
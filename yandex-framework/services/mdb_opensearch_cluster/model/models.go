@@ -34,6 +34,7 @@ type OpenSearch struct {
 	MaintenanceWindow   types.Object   `tfsdk:"maintenance_window"`
 	AuthSettings        types.Object   `tfsdk:"auth_settings"`
 	DiskEncryptionKeyID types.String   `tfsdk:"disk_encryption_key_id"`
+	Restore             types.Object   `tfsdk:"restore"`
 }
 
 type Config struct {
@@ -0,0 +1,14 @@
+package model
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type Restore struct {
+	BackupID types.String `tfsdk:"backup_id"`
+}
+
+var RestoreAttrTypes = map[string]attr.Type{
+	"backup_id": types.StringType,
+}
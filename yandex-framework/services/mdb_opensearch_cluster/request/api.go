@@ -91,6 +91,30 @@ func CreateCluster(ctx context.Context, sdk *ycsdk.SDK, diag *diag.Diagnostics,
 	return md.ClusterId
 }
 
+func RestoreCluster(ctx context.Context, sdk *ycsdk.SDK, diag *diag.Diagnostics, req *opensearch.RestoreClusterRequest) string {
+	op, err := opensearchsdk.NewClusterClient(sdk).Restore(ctx, req)
+	if err != nil {
+		diag.AddError(
+			"Failed to Create resource",
+			"Error while requesting API to restore OpenSearch cluster from backup "+req.BackupId+": "+err.Error(),
+		)
+		return ""
+	}
+
+	_, err = op.WaitInterval(ctx, func(int) time.Duration { return 5 * time.Second })
+	if err != nil {
+		diag.AddError(
+			"Failed to Create resource",
+			"Error while requesting API to restore OpenSearch cluster from backup "+req.BackupId+". Failed to wait: "+err.Error(),
+		)
+		return ""
+	}
+
+	md := op.Metadata()
+
+	return md.ClusterId
+}
+
 func DeleteCluster(ctx context.Context, sdk *ycsdk.SDK, diags *diag.Diagnostics, cid string) {
 	op, err := retry.ConflictingOperationV2(ctx, sdk, func() (*opensearchsdk.ClusterDeleteOperation, error) {
 		op, err := opensearchsdk.NewClusterClient(sdk).Delete(ctx, &opensearch.DeleteClusterRequest{
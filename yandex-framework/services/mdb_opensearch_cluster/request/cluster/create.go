@@ -7,11 +7,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/opensearch/v1"
 	osconfig "github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/opensearch/v1/config"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/datasize"
 	"github.com/yandex-cloud/terraform-provider-yandex/pkg/mdbcommon"
 	"github.com/yandex-cloud/terraform-provider-yandex/pkg/validate"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/mdb_opensearch_cluster/model"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/mdb_opensearch_cluster/request/nodegroups"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 func PrepareCreateRequest(ctx context.Context, plan *model.OpenSearch, adminPasswordWo types.String, providerConfig *config.State) (*opensearch.CreateClusterRequest, diag.Diagnostics) {
@@ -193,3 +195,34 @@ func tryToAccess(ctx context.Context, cfg *model.Config) (*opensearch.Access, di
 		Serverless:   access.Serverless.ValueBool(),
 	}, diag.Diagnostics{}
 }
+
+func PrepareRestoreRequest(ctx context.Context, plan *model.OpenSearch, createRequest *opensearch.CreateClusterRequest) (*opensearch.RestoreClusterRequest, diag.Diagnostics) {
+	var restore model.Restore
+	diags := plan.Restore.As(ctx, &restore, datasize.DefaultOpts)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	req := &opensearch.RestoreClusterRequest{
+		BackupId:            restore.BackupID.ValueString(),
+		FolderId:            createRequest.FolderId,
+		Name:                createRequest.Name,
+		Description:         createRequest.Description,
+		Labels:              createRequest.Labels,
+		Environment:         createRequest.Environment,
+		ConfigSpec:          createRequest.ConfigSpec,
+		NetworkId:           createRequest.NetworkId,
+		SecurityGroupIds:    createRequest.SecurityGroupIds,
+		ServiceAccountId:    createRequest.ServiceAccountId,
+		DeletionProtection:  createRequest.DeletionProtection,
+		MaintenanceWindow:   createRequest.MaintenanceWindow,
+		DiskEncryptionKeyId: createRequest.DiskEncryptionKeyId,
+	}
+
+	// Empty string will remove encryption when restoring
+	if req.DiskEncryptionKeyId == nil {
+		req.DiskEncryptionKeyId = wrapperspb.String("")
+	}
+
+	return req, diags
+}
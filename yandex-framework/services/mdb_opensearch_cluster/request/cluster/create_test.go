@@ -6,6 +6,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/opensearch/v1"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/mdb_opensearch_cluster/model"
 )
 
@@ -73,3 +74,30 @@ func openSearchTestModel(t *testing.T, config *model.Config) *model.OpenSearch {
 	}
 	return &model.OpenSearch{Config: configValue}
 }
+
+func TestPrepareRestoreRequest(t *testing.T) {
+	plan := &model.OpenSearch{
+		Restore: types.ObjectValueMust(model.RestoreAttrTypes, map[string]attr.Value{
+			"backup_id": types.StringValue("cid:backup"),
+		}),
+	}
+	createRequest := &opensearch.CreateClusterRequest{
+		FolderId:         "folder",
+		Name:             "opensearch",
+		NetworkId:        "network",
+		ServiceAccountId: "sa",
+		ConfigSpec:       &opensearch.ConfigCreateSpec{Version: "2"},
+	}
+
+	req, diags := PrepareRestoreRequest(context.Background(), plan, createRequest)
+
+	if diags.HasError() {
+		t.Fatalf("PrepareRestoreRequest() diagnostics: %#v", diags)
+	}
+	if req.GetBackupId() != "cid:backup" || req.GetName() != "opensearch" || req.GetServiceAccountId() != "sa" || req.GetConfigSpec().GetVersion() != "2" {
+		t.Fatalf("restore request = %v, want the create request parameters", req)
+	}
+	if req.GetDiskEncryptionKeyId() == nil || req.GetDiskEncryptionKeyId().GetValue() != "" {
+		t.Fatalf("DiskEncryptionKeyId = %v, want empty value", req.GetDiskEncryptionKeyId())
+	}
+}
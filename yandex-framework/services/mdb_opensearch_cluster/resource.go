@@ -183,9 +183,22 @@ func (o *openSearchClusterResource) Create(ctx context.Context, req resource.Cre
 		return
 	}
 
-	tflog.Debug(ctx, fmt.Sprintf("Creating OpenSearch Cluster request: %+v", log.RedactCreateClusterRequest(clusterCreateRequest)))
+	var clusterID string
+	if !plan.Restore.IsUnknown() && !plan.Restore.IsNull() {
+		clusterRestoreRequest, diags := cluster.PrepareRestoreRequest(ctx, &plan, clusterCreateRequest)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		tflog.Debug(ctx, fmt.Sprintf("Restoring OpenSearch Cluster from backup %q", clusterRestoreRequest.BackupId))
 
-	clusterID := request.CreateCluster(ctx, o.providerConfig.SDKv2, &resp.Diagnostics, clusterCreateRequest)
+		clusterID = request.RestoreCluster(ctx, o.providerConfig.SDKv2, &resp.Diagnostics, clusterRestoreRequest)
+	} else {
+		tflog.Debug(ctx, fmt.Sprintf("Creating OpenSearch Cluster request: %+v", log.RedactCreateClusterRequest(clusterCreateRequest)))
+
+		clusterID = request.CreateCluster(ctx, o.providerConfig.SDKv2, &resp.Diagnostics, clusterCreateRequest)
+	}
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -811,6 +824,22 @@ func (o *openSearchClusterResource) Schema(ctx context.Context, req resource.Sch
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"restore": schema.SingleNestedAttribute{
+				MarkdownDescription: descriptions.Restore,
+				Optional:            true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"backup_id": schema.StringAttribute{
+						MarkdownDescription: descriptions.RestoreBackupID,
+						Required:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
+					},
+				},
+			},
 			"auth_settings": schema.SingleNestedAttribute{
 				MarkdownDescription: descriptions.AuthSettings,
 				Optional:            true,
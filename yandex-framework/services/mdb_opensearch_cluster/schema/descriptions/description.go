@@ -48,6 +48,8 @@ const (
 	SecurityGroupIDs    = "A set of security groups IDs which assigned to hosts of the cluster."
 	ServiceAccountID    = "ID of the service account authorized for this cluster."
 	DiskEncryptionKeyID = "ID of the KMS key for cluster disk encryption."
+	Restore             = "The cluster will be created from the specified backup."
+	RestoreBackupID     = "Backup ID. The cluster will be created from the specified backup. [How to get a list of OpenSearch backups](https://yandex.cloud/docs/managed-opensearch/operations/cluster-backups)."
 
 	// SAML settings
 	AuthSettings               = "Authentication settings for Dashboards."
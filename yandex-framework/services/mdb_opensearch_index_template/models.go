@@ -0,0 +1,14 @@
+package mdb_opensearch_index_template
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type IndexTemplate struct {
+	Id            types.String `tfsdk:"id"`
+	ClusterID     types.String `tfsdk:"cluster_id"`
+	AdminPassword types.String `tfsdk:"admin_password"`
+	CACertificate types.String `tfsdk:"ca_certificate"`
+	Name          types.String `tfsdk:"name"`
+	Body          types.String `tfsdk:"body"`
+}
@@ -0,0 +1,230 @@
+package mdb_opensearch_index_template
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yandex-cloud/terraform-provider-yandex/common"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/opensearchapi"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/opensearchcommon"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/resourceid"
+	provider_config "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
+)
+
+var (
+	_ resource.Resource                = &indexTemplateResource{}
+	_ resource.ResourceWithImportState = &indexTemplateResource{}
+)
+
+type indexTemplateResource struct {
+	providerConfig *provider_config.Config
+}
+
+func NewResource() resource.Resource {
+	return &indexTemplateResource{}
+}
+
+func (r *indexTemplateResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mdb_opensearch_index_template"
+}
+
+func (r *indexTemplateResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerConfig, ok := req.ProviderData.(*provider_config.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider_config.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.providerConfig = providerConfig
+}
+
+func (r *indexTemplateResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: common.ResourceDescriptions["id"],
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "The name of the index template.",
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"body": schema.StringAttribute{
+			MarkdownDescription: "The JSON body of the composable index template, e.g. `jsonencode({ index_patterns = [\"logs-*\"], template = { settings = { number_of_shards = 1 } } })`. " +
+				"The fields filled by OpenSearch with the defaults are not reported as the changes.",
+			Required: true,
+			Validators: []validator.String{
+				opensearchcommon.NewJSONObjectValidator(),
+			},
+		},
+	}
+	maps.Copy(attributes, opensearchcommon.ConnectionAttributes())
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a composable index template of a Managed OpenSearch cluster within the Yandex Cloud. " +
+			"The template is managed through the REST API of the cluster as the `admin` user, so the OpenSearch hosts of the cluster must be reachable by the provider. " +
+			"For more information, see [the official documentation](https://docs.opensearch.org/latest/im-plugin/index-templates/).",
+		Attributes: attributes,
+	}
+}
+
+func (r *indexTemplateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan IndexTemplate
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	password := plan.AdminPassword.ValueString()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.put(ctx, &plan, password, &resp.Diagnostics, "Failed to Create resource")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Id = types.StringValue(resourceid.Construct(plan.ClusterID.ValueString(), plan.Name.ValueString()))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *indexTemplateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state IndexTemplate
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	password := state.AdminPassword.ValueString()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cid := state.ClusterID.ValueString()
+	name := state.Name.ValueString()
+	entity := fmt.Sprintf("index template %q in cluster %q", name, cid)
+	if password == "" {
+		opensearchcommon.UnknownPasswordWarning(&resp.Diagnostics, entity)
+		return
+	}
+
+	client, err := opensearchcommon.NewClient(ctx, r.providerConfig, cid, password, state.CACertificate.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to Read resource", err.Error())
+		return
+	}
+
+	body, err := client.GetIndexTemplate(ctx, name)
+	if err != nil {
+		opensearchcommon.HandleReadError(ctx, resp, entity, err)
+		return
+	}
+
+	if !bodyMatches(body, state.Body.ValueString()) {
+		state.Body = types.StringValue(string(body))
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *indexTemplateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan IndexTemplate
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	password := plan.AdminPassword.ValueString()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.put(ctx, &plan, password, &resp.Diagnostics, "Failed to Update resource")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *indexTemplateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state IndexTemplate
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	password := state.AdminPassword.ValueString()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cid := state.ClusterID.ValueString()
+	name := state.Name.ValueString()
+	client, err := opensearchcommon.NewClient(ctx, r.providerConfig, cid, password, state.CACertificate.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to Delete resource", err.Error())
+		return
+	}
+
+	if err := client.DeleteIndexTemplate(ctx, name); err != nil && !opensearchapi.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Failed to Delete resource",
+			opensearchcommon.APIErrorDetail(fmt.Sprintf("delete index template %q in cluster %q", name, cid), err),
+		)
+	}
+}
+
+func (r *indexTemplateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	cid, name, err := resourceid.Deconstruct(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <cluster_id>:<name>. Got: %q. Error: %s", req.ID, err.Error()),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &IndexTemplate{
+		Id:            types.StringValue(req.ID),
+		ClusterID:     types.StringValue(cid),
+		AdminPassword: types.StringNull(),
+		CACertificate: types.StringNull(),
+		Name:          types.StringValue(name),
+		Body:          types.StringNull(),
+	})...)
+}
+
+func (r *indexTemplateResource) put(ctx context.Context, plan *IndexTemplate, password string, diags *diag.Diagnostics, summary string) {
+	cid := plan.ClusterID.ValueString()
+	name := plan.Name.ValueString()
+
+	client, err := opensearchcommon.NewClient(ctx, r.providerConfig, cid, password, plan.CACertificate.ValueString())
+	if err != nil {
+		diags.AddError(summary, err.Error())
+		return
+	}
+
+	if err := client.PutIndexTemplate(ctx, name, json.RawMessage(plan.Body.ValueString())); err != nil {
+		diags.AddError(summary, opensearchcommon.APIErrorDetail(fmt.Sprintf("put index template %q in cluster %q", name, cid), err))
+	}
+}
+
+// bodyMatches reports whether the template returned by the API contains the configured body,
+// the invalid configured body never matches.
+func bodyMatches(actual json.RawMessage, body string) bool {
+	expected, err := opensearchapi.ParseDocument([]byte(body))
+	if err != nil {
+		return false
+	}
+	doc, err := opensearchapi.ParseDocument(actual)
+	if err != nil {
+		return false
+	}
+	return opensearchapi.Contains(doc, opensearchapi.NormalizeIndexTemplate(expected))
+}
@@ -0,0 +1,184 @@
+package mdb_opensearch_index_template_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/opensearchapi"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/opensearchcommon"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/resourceid"
+	test "github.com/yandex-cloud/terraform-provider-yandex/pkg/testhelpers"
+	yandex_framework "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider"
+)
+
+const (
+	openSearchIndexTemplateResourceName = "yandex_mdb_opensearch_index_template.logs"
+	openSearchISMPolicyResourceName     = "yandex_mdb_opensearch_ism_policy.logs_retention"
+	openSearchAdminPassword             = "dummy_P@ssw0rd"
+)
+
+const openSearchIndexTemplateConfig = `
+resource "yandex_vpc_network" "foo" {}
+
+resource "yandex_vpc_subnet" "foo" {
+  zone           = "ru-central1-a"
+  network_id     = yandex_vpc_network.foo.id
+  v4_cidr_blocks = ["10.1.0.0/24"]
+}
+
+resource "yandex_mdb_opensearch_cluster" "foo" {
+  name                = "%s"
+  environment         = "PRESTABLE"
+  network_id          = yandex_vpc_network.foo.id
+  deletion_protection = false
+
+  config {
+    admin_password = "%s"
+
+    opensearch {
+      node_groups {
+        name             = "datamaster0"
+        assign_public_ip = true
+        hosts_count      = 1
+        zone_ids         = ["ru-central1-a"]
+        subnet_ids       = [yandex_vpc_subnet.foo.id]
+        roles            = ["data", "manager"]
+        resources {
+          resource_preset_id = "s2.micro"
+          disk_size          = 10737418240
+          disk_type_id       = "network-ssd"
+        }
+      }
+    }
+  }
+}
+
+resource "yandex_mdb_opensearch_index_template" "logs" {
+  cluster_id     = yandex_mdb_opensearch_cluster.foo.id
+  admin_password = "%[2]s"
+
+  name = "logs"
+  body = jsonencode({
+    index_patterns = ["logs-*"]
+    template = {
+      settings = {
+        number_of_shards = %[3]d
+      }
+    }
+  })
+}
+
+resource "yandex_mdb_opensearch_ism_policy" "logs_retention" {
+  cluster_id     = yandex_mdb_opensearch_cluster.foo.id
+  admin_password = "%[2]s"
+
+  policy_id = "logs-retention"
+  body = jsonencode({
+    policy = {
+      description   = "Delete the logs after %[4]s"
+      default_state = "hot"
+      states = [
+        {
+          name    = "hot"
+          actions = []
+          transitions = [
+            {
+              state_name = "delete"
+              conditions = { min_index_age = "%[4]s" }
+            }
+          ]
+        },
+        {
+          name        = "delete"
+          actions     = [{ delete = {} }]
+          transitions = []
+        }
+      ]
+    }
+  })
+}
+`
+
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
+
+func TestAccMDBOpenSearchIndexTemplate_basic(t *testing.T) {
+	t.Parallel()
+
+	clusterName := acctest.RandomWithPrefix("tf-opensearch-index-template")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.AccPreCheck(t) },
+		ProtoV6ProviderFactories: test.AccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(openSearchIndexTemplateConfig, clusterName, openSearchAdminPassword, 1, "30d"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMDBOpenSearchIndexTemplateExists(openSearchIndexTemplateResourceName),
+					testAccCheckMDBOpenSearchISMPolicyExists(openSearchISMPolicyResourceName),
+				),
+			},
+			{
+				// The defaults filled by OpenSearch are not reported as the changes.
+				Config:   fmt.Sprintf(openSearchIndexTemplateConfig, clusterName, openSearchAdminPassword, 1, "30d"),
+				PlanOnly: true,
+			},
+			{
+				Config: fmt.Sprintf(openSearchIndexTemplateConfig, clusterName, openSearchAdminPassword, 2, "7d"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMDBOpenSearchIndexTemplateExists(openSearchIndexTemplateResourceName),
+					testAccCheckMDBOpenSearchISMPolicyExists(openSearchISMPolicyResourceName),
+				),
+			},
+		},
+	})
+}
+
+func testAccOpenSearchClient(s *terraform.State, resourceName string) (*opensearchapi.Client, string, error) {
+	rs, ok := s.RootModule().Resources[resourceName]
+	if !ok {
+		return nil, "", fmt.Errorf("not found: %s", resourceName)
+	}
+
+	cid, name, err := resourceid.Deconstruct(rs.Primary.ID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	config := test.AccProvider.(*yandex_framework.Provider).GetConfig()
+	client, err := opensearchcommon.NewClient(context.Background(), &config, cid, openSearchAdminPassword, "")
+	return client, name, err
+}
+
+func testAccCheckMDBOpenSearchIndexTemplateExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client, name, err := testAccOpenSearchClient(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		if _, err := client.GetIndexTemplate(context.Background(), name); err != nil {
+			return fmt.Errorf("OpenSearch index template %q not found: %v", name, err)
+		}
+		return nil
+	}
+}
+
+func testAccCheckMDBOpenSearchISMPolicyExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client, id, err := testAccOpenSearchClient(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		if _, err := client.GetISMPolicy(context.Background(), id); err != nil {
+			return fmt.Errorf("OpenSearch ISM policy %q not found: %v", id, err)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,14 @@
+package mdb_opensearch_ism_policy
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type ISMPolicy struct {
+	Id            types.String `tfsdk:"id"`
+	ClusterID     types.String `tfsdk:"cluster_id"`
+	AdminPassword types.String `tfsdk:"admin_password"`
+	CACertificate types.String `tfsdk:"ca_certificate"`
+	PolicyID      types.String `tfsdk:"policy_id"`
+	Body          types.String `tfsdk:"body"`
+}
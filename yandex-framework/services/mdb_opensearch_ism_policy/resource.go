@@ -0,0 +1,237 @@
+package mdb_opensearch_ism_policy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yandex-cloud/terraform-provider-yandex/common"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/opensearchapi"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/opensearchcommon"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/resourceid"
+	provider_config "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
+)
+
+var (
+	_ resource.Resource                = &ismPolicyResource{}
+	_ resource.ResourceWithImportState = &ismPolicyResource{}
+)
+
+type ismPolicyResource struct {
+	providerConfig *provider_config.Config
+}
+
+func NewResource() resource.Resource {
+	return &ismPolicyResource{}
+}
+
+func (r *ismPolicyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mdb_opensearch_ism_policy"
+}
+
+func (r *ismPolicyResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerConfig, ok := req.ProviderData.(*provider_config.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider_config.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.providerConfig = providerConfig
+}
+
+func (r *ismPolicyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: common.ResourceDescriptions["id"],
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"policy_id": schema.StringAttribute{
+			MarkdownDescription: "The ID of the policy.",
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"body": schema.StringAttribute{
+			MarkdownDescription: "The JSON body of the policy with the `policy` field, e.g. `jsonencode({ policy = { description = \"...\", default_state = \"hot\", states = [...] } })`. " +
+				"The fields filled by OpenSearch, e.g. `policy_id` and `last_updated_time`, are not reported as the changes.",
+			Required: true,
+			Validators: []validator.String{
+				opensearchcommon.NewJSONObjectValidator(),
+			},
+		},
+	}
+	maps.Copy(attributes, opensearchcommon.ConnectionAttributes())
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages an Index State Management policy of a Managed OpenSearch cluster within the Yandex Cloud. " +
+			"The policy is managed through the REST API of the cluster as the `admin` user, so the OpenSearch hosts of the cluster must be reachable by the provider. " +
+			"For more information, see [the official documentation](https://docs.opensearch.org/latest/im-plugin/ism/index/).",
+		Attributes: attributes,
+	}
+}
+
+func (r *ismPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ISMPolicy
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	password := plan.AdminPassword.ValueString()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cid := plan.ClusterID.ValueString()
+	policyID := plan.PolicyID.ValueString()
+	client, err := opensearchcommon.NewClient(ctx, r.providerConfig, cid, password, plan.CACertificate.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to Create resource", err.Error())
+		return
+	}
+
+	if err := client.PutISMPolicy(ctx, policyID, json.RawMessage(plan.Body.ValueString()), nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Create resource",
+			opensearchcommon.APIErrorDetail(fmt.Sprintf("create ISM policy %q in cluster %q", policyID, cid), err),
+		)
+		return
+	}
+
+	plan.Id = types.StringValue(resourceid.Construct(cid, policyID))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ismPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ISMPolicy
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	password := state.AdminPassword.ValueString()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cid := state.ClusterID.ValueString()
+	policyID := state.PolicyID.ValueString()
+	entity := fmt.Sprintf("ISM policy %q in cluster %q", policyID, cid)
+	if password == "" {
+		opensearchcommon.UnknownPasswordWarning(&resp.Diagnostics, entity)
+		return
+	}
+
+	client, err := opensearchcommon.NewClient(ctx, r.providerConfig, cid, password, state.CACertificate.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to Read resource", err.Error())
+		return
+	}
+
+	policy, err := client.GetISMPolicy(ctx, policyID)
+	if err != nil {
+		opensearchcommon.HandleReadError(ctx, resp, entity, err)
+		return
+	}
+
+	body, err := json.Marshal(map[string]json.RawMessage{"policy": policy.Policy})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to Read resource", fmt.Sprintf("Error while encoding %s: %s", entity, err.Error()))
+		return
+	}
+	if matches, err := opensearchapi.DocumentMatches(body, state.Body.ValueString()); err != nil || !matches {
+		state.Body = types.StringValue(string(body))
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ismPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ISMPolicy
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	password := plan.AdminPassword.ValueString()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cid := plan.ClusterID.ValueString()
+	policyID := plan.PolicyID.ValueString()
+	client, err := opensearchcommon.NewClient(ctx, r.providerConfig, cid, password, plan.CACertificate.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to Update resource", err.Error())
+		return
+	}
+
+	// The policy is replaced only if it was not changed since it was read.
+	current, err := client.GetISMPolicy(ctx, policyID)
+	if err != nil && !opensearchapi.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Failed to Update resource",
+			opensearchcommon.APIErrorDetail(fmt.Sprintf("read ISM policy %q in cluster %q", policyID, cid), err),
+		)
+		return
+	}
+
+	if err := client.PutISMPolicy(ctx, policyID, json.RawMessage(plan.Body.ValueString()), current); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Update resource",
+			opensearchcommon.APIErrorDetail(fmt.Sprintf("update ISM policy %q in cluster %q", policyID, cid), err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ismPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ISMPolicy
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	password := state.AdminPassword.ValueString()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cid := state.ClusterID.ValueString()
+	policyID := state.PolicyID.ValueString()
+	client, err := opensearchcommon.NewClient(ctx, r.providerConfig, cid, password, state.CACertificate.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to Delete resource", err.Error())
+		return
+	}
+
+	if err := client.DeleteISMPolicy(ctx, policyID); err != nil && !opensearchapi.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Failed to Delete resource",
+			opensearchcommon.APIErrorDetail(fmt.Sprintf("delete ISM policy %q in cluster %q", policyID, cid), err),
+		)
+	}
+}
+
+func (r *ismPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	cid, policyID, err := resourceid.Deconstruct(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <cluster_id>:<policy_id>. Got: %q. Error: %s", req.ID, err.Error()),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &ISMPolicy{
+		Id:            types.StringValue(req.ID),
+		ClusterID:     types.StringValue(cid),
+		AdminPassword: types.StringNull(),
+		CACertificate: types.StringNull(),
+		PolicyID:      types.StringValue(policyID),
+		Body:          types.StringNull(),
+	})...)
+}
@@ -0,0 +1,132 @@
+package mdb_opensearch_role
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/opensearchapi"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/opensearchcommon"
+)
+
+type Role struct {
+	Id                 types.String `tfsdk:"id"`
+	ClusterID          types.String `tfsdk:"cluster_id"`
+	AdminPassword      types.String `tfsdk:"admin_password"`
+	CACertificate      types.String `tfsdk:"ca_certificate"`
+	Name               types.String `tfsdk:"name"`
+	Description        types.String `tfsdk:"description"`
+	ClusterPermissions types.Set    `tfsdk:"cluster_permissions"`
+	IndexPermissions   types.List   `tfsdk:"index_permissions"`
+	TenantPermissions  types.List   `tfsdk:"tenant_permissions"`
+}
+
+type IndexPermission struct {
+	IndexPatterns         types.Set    `tfsdk:"index_patterns"`
+	AllowedActions        types.Set    `tfsdk:"allowed_actions"`
+	DocumentLevelSecurity types.String `tfsdk:"document_level_security"`
+	FieldLevelSecurity    types.Set    `tfsdk:"field_level_security"`
+	MaskedFields          types.Set    `tfsdk:"masked_fields"`
+}
+
+var indexPermissionType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"index_patterns":          types.SetType{ElemType: types.StringType},
+		"allowed_actions":         types.SetType{ElemType: types.StringType},
+		"document_level_security": types.StringType,
+		"field_level_security":    types.SetType{ElemType: types.StringType},
+		"masked_fields":           types.SetType{ElemType: types.StringType},
+	},
+}
+
+type TenantPermission struct {
+	TenantPatterns types.Set `tfsdk:"tenant_patterns"`
+	AllowedActions types.Set `tfsdk:"allowed_actions"`
+}
+
+var tenantPermissionType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"tenant_patterns": types.SetType{ElemType: types.StringType},
+		"allowed_actions": types.SetType{ElemType: types.StringType},
+	},
+}
+
+func expandRole(ctx context.Context, r *Role, diags *diag.Diagnostics) *opensearchapi.Role {
+	role := &opensearchapi.Role{
+		Description:        r.Description.ValueString(),
+		ClusterPermissions: opensearchcommon.ExpandSetString(ctx, r.ClusterPermissions, diags),
+	}
+
+	var indexPermissions []IndexPermission
+	if !r.IndexPermissions.IsNull() && !r.IndexPermissions.IsUnknown() {
+		diags.Append(r.IndexPermissions.ElementsAs(ctx, &indexPermissions, false)...)
+	}
+	for _, p := range indexPermissions {
+		role.IndexPermissions = append(role.IndexPermissions, opensearchapi.IndexPermission{
+			IndexPatterns:  opensearchcommon.ExpandSetString(ctx, p.IndexPatterns, diags),
+			AllowedActions: opensearchcommon.ExpandSetString(ctx, p.AllowedActions, diags),
+			DLS:            p.DocumentLevelSecurity.ValueString(),
+			FLS:            opensearchcommon.ExpandSetString(ctx, p.FieldLevelSecurity, diags),
+			MaskedFields:   opensearchcommon.ExpandSetString(ctx, p.MaskedFields, diags),
+		})
+	}
+
+	var tenantPermissions []TenantPermission
+	if !r.TenantPermissions.IsNull() && !r.TenantPermissions.IsUnknown() {
+		diags.Append(r.TenantPermissions.ElementsAs(ctx, &tenantPermissions, false)...)
+	}
+	for _, p := range tenantPermissions {
+		role.TenantPermissions = append(role.TenantPermissions, opensearchapi.TenantPermission{
+			TenantPatterns: opensearchcommon.ExpandSetString(ctx, p.TenantPatterns, diags),
+			AllowedActions: opensearchcommon.ExpandSetString(ctx, p.AllowedActions, diags),
+		})
+	}
+
+	return role
+}
+
+func flattenRole(ctx context.Context, role *opensearchapi.Role, state *Role, diags *diag.Diagnostics) {
+	state.Description = types.StringNull()
+	if role.Description != "" {
+		state.Description = types.StringValue(role.Description)
+	}
+	state.ClusterPermissions = opensearchcommon.FlattenSetStringOrNull(ctx, role.ClusterPermissions, diags)
+
+	state.IndexPermissions = types.ListNull(indexPermissionType)
+	if len(role.IndexPermissions) > 0 {
+		indexPermissions := make([]IndexPermission, 0, len(role.IndexPermissions))
+		for _, p := range role.IndexPermissions {
+			dls := types.StringNull()
+			if p.DLS != "" {
+				dls = types.StringValue(p.DLS)
+			}
+			indexPermissions = append(indexPermissions, IndexPermission{
+				IndexPatterns:         opensearchcommon.FlattenSetStringOrNull(ctx, p.IndexPatterns, diags),
+				AllowedActions:        opensearchcommon.FlattenSetStringOrNull(ctx, p.AllowedActions, diags),
+				DocumentLevelSecurity: dls,
+				FieldLevelSecurity:    opensearchcommon.FlattenSetStringOrNull(ctx, p.FLS, diags),
+				MaskedFields:          opensearchcommon.FlattenSetStringOrNull(ctx, p.MaskedFields, diags),
+			})
+		}
+
+		list, d := types.ListValueFrom(ctx, indexPermissionType, indexPermissions)
+		diags.Append(d...)
+		state.IndexPermissions = list
+	}
+
+	state.TenantPermissions = types.ListNull(tenantPermissionType)
+	if len(role.TenantPermissions) > 0 {
+		tenantPermissions := make([]TenantPermission, 0, len(role.TenantPermissions))
+		for _, p := range role.TenantPermissions {
+			tenantPermissions = append(tenantPermissions, TenantPermission{
+				TenantPatterns: opensearchcommon.FlattenSetStringOrNull(ctx, p.TenantPatterns, diags),
+				AllowedActions: opensearchcommon.FlattenSetStringOrNull(ctx, p.AllowedActions, diags),
+			})
+		}
+
+		list, d := types.ListValueFrom(ctx, tenantPermissionType, tenantPermissions)
+		diags.Append(d...)
+		state.TenantPermissions = list
+	}
+}
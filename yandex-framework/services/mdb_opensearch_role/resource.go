@@ -0,0 +1,275 @@
+package mdb_opensearch_role
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yandex-cloud/terraform-provider-yandex/common"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/opensearchapi"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/opensearchcommon"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/resourceid"
+	provider_config "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
+)
+
+var (
+	_ resource.Resource                = &roleResource{}
+	_ resource.ResourceWithImportState = &roleResource{}
+)
+
+type roleResource struct {
+	providerConfig *provider_config.Config
+}
+
+func NewResource() resource.Resource {
+	return &roleResource{}
+}
+
+func (r *roleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mdb_opensearch_role"
+}
+
+func (r *roleResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerConfig, ok := req.ProviderData.(*provider_config.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider_config.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.providerConfig = providerConfig
+}
+
+func (r *roleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: common.ResourceDescriptions["id"],
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "The name of the role.",
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"description": schema.StringAttribute{
+			MarkdownDescription: "Description of the role.",
+			Optional:            true,
+		},
+		"cluster_permissions": schema.SetAttribute{
+			MarkdownDescription: "The cluster-wide permissions, e.g. `cluster_composite_ops_ro`.",
+			Optional:            true,
+			ElementType:         types.StringType,
+		},
+		"index_permissions": schema.ListNestedAttribute{
+			MarkdownDescription: "The permissions on the indices.",
+			Optional:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"index_patterns": schema.SetAttribute{
+						MarkdownDescription: "The patterns of the indices the permission is granted on, e.g. `logs-*`.",
+						Required:            true,
+						ElementType:         types.StringType,
+					},
+					"allowed_actions": schema.SetAttribute{
+						MarkdownDescription: "The allowed actions or the action groups, e.g. `read`.",
+						Required:            true,
+						ElementType:         types.StringType,
+					},
+					"document_level_security": schema.StringAttribute{
+						MarkdownDescription: "The query restricting the documents the role has access to.",
+						Optional:            true,
+					},
+					"field_level_security": schema.SetAttribute{
+						MarkdownDescription: "The fields the role has access to, the fields prefixed with `~` are excluded.",
+						Optional:            true,
+						ElementType:         types.StringType,
+					},
+					"masked_fields": schema.SetAttribute{
+						MarkdownDescription: "The fields whose values are masked.",
+						Optional:            true,
+						ElementType:         types.StringType,
+					},
+				},
+			},
+		},
+		"tenant_permissions": schema.ListNestedAttribute{
+			MarkdownDescription: "The permissions on the OpenSearch Dashboards tenants.",
+			Optional:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"tenant_patterns": schema.SetAttribute{
+						MarkdownDescription: "The patterns of the tenants the permission is granted on.",
+						Required:            true,
+						ElementType:         types.StringType,
+					},
+					"allowed_actions": schema.SetAttribute{
+						MarkdownDescription: "The allowed actions, e.g. `kibana_all_read`.",
+						Required:            true,
+						ElementType:         types.StringType,
+					},
+				},
+			},
+		},
+	}
+	maps.Copy(attributes, opensearchcommon.ConnectionAttributes())
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a role of the security plugin of a Managed OpenSearch cluster within the Yandex Cloud. " +
+			"The role is managed through the REST API of the cluster as the `admin` user, so the OpenSearch hosts of the cluster must be reachable by the provider. " +
+			"Use `yandex_mdb_opensearch_role_mapping` to assign the role to the users. " +
+			"For more information, see [the official documentation](https://docs.opensearch.org/latest/security/access-control/users-roles/).",
+		Attributes: attributes,
+	}
+}
+
+func (r *roleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan Role
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	password := plan.AdminPassword.ValueString()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.put(ctx, &plan, password, &resp.Diagnostics, "Failed to Create resource")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Id = types.StringValue(resourceid.Construct(plan.ClusterID.ValueString(), plan.Name.ValueString()))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *roleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state Role
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	password := state.AdminPassword.ValueString()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cid := state.ClusterID.ValueString()
+	name := state.Name.ValueString()
+	entity := fmt.Sprintf("role %q in cluster %q", name, cid)
+	if password == "" {
+		opensearchcommon.UnknownPasswordWarning(&resp.Diagnostics, entity)
+		return
+	}
+
+	client, err := opensearchcommon.NewClient(ctx, r.providerConfig, cid, password, state.CACertificate.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to Read resource", err.Error())
+		return
+	}
+
+	role, err := client.GetRole(ctx, name)
+	if err != nil {
+		opensearchcommon.HandleReadError(ctx, resp, entity, err)
+		return
+	}
+
+	flattenRole(ctx, role, &state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *roleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan Role
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	password := plan.AdminPassword.ValueString()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.put(ctx, &plan, password, &resp.Diagnostics, "Failed to Update resource")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *roleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state Role
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	password := state.AdminPassword.ValueString()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cid := state.ClusterID.ValueString()
+	name := state.Name.ValueString()
+	client, err := opensearchcommon.NewClient(ctx, r.providerConfig, cid, password, state.CACertificate.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to Delete resource", err.Error())
+		return
+	}
+
+	if err := client.DeleteRole(ctx, name); err != nil && !opensearchapi.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Failed to Delete resource",
+			opensearchcommon.APIErrorDetail(fmt.Sprintf("delete role %q in cluster %q", name, cid), err),
+		)
+	}
+}
+
+func (r *roleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	cid, name, err := resourceid.Deconstruct(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <cluster_id>:<name>. Got: %q. Error: %s", req.ID, err.Error()),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &Role{
+		Id:                 types.StringValue(req.ID),
+		ClusterID:          types.StringValue(cid),
+		AdminPassword:      types.StringNull(),
+		CACertificate:      types.StringNull(),
+		Name:               types.StringValue(name),
+		Description:        types.StringNull(),
+		ClusterPermissions: types.SetNull(types.StringType),
+		IndexPermissions:   types.ListNull(indexPermissionType),
+		TenantPermissions:  types.ListNull(tenantPermissionType),
+	})...)
+}
+
+func (r *roleResource) put(ctx context.Context, plan *Role, password string, diags *diag.Diagnostics, summary string) {
+	cid := plan.ClusterID.ValueString()
+	name := plan.Name.ValueString()
+
+	role := expandRole(ctx, plan, diags)
+	if diags.HasError() {
+		return
+	}
+
+	client, err := opensearchcommon.NewClient(ctx, r.providerConfig, cid, password, plan.CACertificate.ValueString())
+	if err != nil {
+		diags.AddError(summary, err.Error())
+		return
+	}
+
+	if err := client.PutRole(ctx, name, role); err != nil {
+		diags.AddError(summary, opensearchcommon.APIErrorDetail(fmt.Sprintf("put role %q in cluster %q", name, cid), err))
+	}
+}
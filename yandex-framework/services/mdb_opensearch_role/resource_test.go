@@ -0,0 +1,173 @@
+package mdb_opensearch_role_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/opensearchapi"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/opensearchcommon"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/resourceid"
+	test "github.com/yandex-cloud/terraform-provider-yandex/pkg/testhelpers"
+	yandex_framework "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider"
+)
+
+const (
+	openSearchRoleResourceName        = "yandex_mdb_opensearch_role.logs_reader"
+	openSearchRoleMappingResourceName = "yandex_mdb_opensearch_role_mapping.logs_reader"
+	openSearchAdminPassword           = "dummy_P@ssw0rd"
+)
+
+const openSearchRoleConfig = `
+resource "yandex_vpc_network" "foo" {}
+
+resource "yandex_vpc_subnet" "foo" {
+  zone           = "ru-central1-a"
+  network_id     = yandex_vpc_network.foo.id
+  v4_cidr_blocks = ["10.1.0.0/24"]
+}
+
+resource "yandex_mdb_opensearch_cluster" "foo" {
+  name                = "%s"
+  environment         = "PRESTABLE"
+  network_id          = yandex_vpc_network.foo.id
+  deletion_protection = false
+
+  config {
+    admin_password = "%s"
+
+    opensearch {
+      node_groups {
+        name             = "datamaster0"
+        assign_public_ip = true
+        hosts_count      = 1
+        zone_ids         = ["ru-central1-a"]
+        subnet_ids       = [yandex_vpc_subnet.foo.id]
+        roles            = ["data", "manager"]
+        resources {
+          resource_preset_id = "s2.micro"
+          disk_size          = 10737418240
+          disk_type_id       = "network-ssd"
+        }
+      }
+    }
+  }
+}
+
+resource "yandex_mdb_opensearch_role" "logs_reader" {
+  cluster_id     = yandex_mdb_opensearch_cluster.foo.id
+  admin_password = "%[2]s"
+
+  name                = "logs_reader"
+  description         = "%s"
+  cluster_permissions = ["cluster_composite_ops_ro"]
+
+  index_permissions = [
+    {
+      index_patterns  = ["logs-*"]
+      allowed_actions = ["read"]
+    }
+  ]
+}
+
+resource "yandex_mdb_opensearch_role_mapping" "logs_reader" {
+  cluster_id     = yandex_mdb_opensearch_cluster.foo.id
+  admin_password = "%[2]s"
+
+  role_name     = yandex_mdb_opensearch_role.logs_reader.name
+  backend_roles = ["analysts"]
+}
+`
+
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
+
+func TestAccMDBOpenSearchRole_basic(t *testing.T) {
+	t.Parallel()
+
+	clusterName := acctest.RandomWithPrefix("tf-opensearch-role")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.AccPreCheck(t) },
+		ProtoV6ProviderFactories: test.AccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(openSearchRoleConfig, clusterName, openSearchAdminPassword, "Read access to the logs"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMDBOpenSearchRoleExists(openSearchRoleResourceName, "Read access to the logs"),
+					testAccCheckMDBOpenSearchRoleMappingExists(openSearchRoleMappingResourceName, "analysts"),
+					resource.TestCheckResourceAttr(openSearchRoleResourceName, "index_permissions.#", "1"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(openSearchRoleConfig, clusterName, openSearchAdminPassword, "Read-only access to the logs"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMDBOpenSearchRoleExists(openSearchRoleResourceName, "Read-only access to the logs"),
+				),
+			},
+		},
+	})
+}
+
+func testAccOpenSearchClient(cid string) (*opensearchapi.Client, error) {
+	config := test.AccProvider.(*yandex_framework.Provider).GetConfig()
+	return opensearchcommon.NewClient(context.Background(), &config, cid, openSearchAdminPassword, "")
+}
+
+func testAccCheckMDBOpenSearchRoleExists(resourceName, description string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+
+		cid, name, err := resourceid.Deconstruct(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		client, err := testAccOpenSearchClient(cid)
+		if err != nil {
+			return err
+		}
+
+		role, err := client.GetRole(context.Background(), name)
+		if err != nil {
+			return fmt.Errorf("OpenSearch role %q not found: %v", rs.Primary.ID, err)
+		}
+		if role.Description != description {
+			return fmt.Errorf("OpenSearch role %q has description %q, want %q", rs.Primary.ID, role.Description, description)
+		}
+		return nil
+	}
+}
+
+func testAccCheckMDBOpenSearchRoleMappingExists(resourceName, backendRole string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+
+		cid, roleName, err := resourceid.Deconstruct(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		client, err := testAccOpenSearchClient(cid)
+		if err != nil {
+			return err
+		}
+
+		mapping, err := client.GetRoleMapping(context.Background(), roleName)
+		if err != nil {
+			return fmt.Errorf("OpenSearch role mapping %q not found: %v", rs.Primary.ID, err)
+		}
+		if len(mapping.BackendRoles) != 1 || mapping.BackendRoles[0] != backendRole {
+			return fmt.Errorf("OpenSearch role mapping %q has backend roles %v, want [%s]", rs.Primary.ID, mapping.BackendRoles, backendRole)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,44 @@
+package mdb_opensearch_role_mapping
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/opensearchapi"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/opensearchcommon"
+)
+
+type RoleMapping struct {
+	Id              types.String `tfsdk:"id"`
+	ClusterID       types.String `tfsdk:"cluster_id"`
+	AdminPassword   types.String `tfsdk:"admin_password"`
+	CACertificate   types.String `tfsdk:"ca_certificate"`
+	RoleName        types.String `tfsdk:"role_name"`
+	Description     types.String `tfsdk:"description"`
+	Users           types.Set    `tfsdk:"users"`
+	BackendRoles    types.Set    `tfsdk:"backend_roles"`
+	AndBackendRoles types.Set    `tfsdk:"and_backend_roles"`
+	Hosts           types.Set    `tfsdk:"hosts"`
+}
+
+func expandRoleMapping(ctx context.Context, m *RoleMapping, diags *diag.Diagnostics) *opensearchapi.RoleMapping {
+	return &opensearchapi.RoleMapping{
+		Description:     m.Description.ValueString(),
+		Users:           opensearchcommon.ExpandSetString(ctx, m.Users, diags),
+		BackendRoles:    opensearchcommon.ExpandSetString(ctx, m.BackendRoles, diags),
+		AndBackendRoles: opensearchcommon.ExpandSetString(ctx, m.AndBackendRoles, diags),
+		Hosts:           opensearchcommon.ExpandSetString(ctx, m.Hosts, diags),
+	}
+}
+
+func flattenRoleMapping(ctx context.Context, mapping *opensearchapi.RoleMapping, state *RoleMapping, diags *diag.Diagnostics) {
+	state.Description = types.StringNull()
+	if mapping.Description != "" {
+		state.Description = types.StringValue(mapping.Description)
+	}
+	state.Users = opensearchcommon.FlattenSetStringOrNull(ctx, mapping.Users, diags)
+	state.BackendRoles = opensearchcommon.FlattenSetStringOrNull(ctx, mapping.BackendRoles, diags)
+	state.AndBackendRoles = opensearchcommon.FlattenSetStringOrNull(ctx, mapping.AndBackendRoles, diags)
+	state.Hosts = opensearchcommon.FlattenSetStringOrNull(ctx, mapping.Hosts, diags)
+}
@@ -0,0 +1,241 @@
+package mdb_opensearch_role_mapping
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yandex-cloud/terraform-provider-yandex/common"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/opensearchapi"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/opensearchcommon"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/resourceid"
+	provider_config "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
+)
+
+var (
+	_ resource.Resource                = &roleMappingResource{}
+	_ resource.ResourceWithImportState = &roleMappingResource{}
+)
+
+type roleMappingResource struct {
+	providerConfig *provider_config.Config
+}
+
+func NewResource() resource.Resource {
+	return &roleMappingResource{}
+}
+
+func (r *roleMappingResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mdb_opensearch_role_mapping"
+}
+
+func (r *roleMappingResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerConfig, ok := req.ProviderData.(*provider_config.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider_config.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.providerConfig = providerConfig
+}
+
+func (r *roleMappingResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: common.ResourceDescriptions["id"],
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"role_name": schema.StringAttribute{
+			MarkdownDescription: "The name of the role the users are mapped to, either a predefined one, e.g. `all_access`, or the one created by `yandex_mdb_opensearch_role`.",
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"description": schema.StringAttribute{
+			MarkdownDescription: "Description of the role mapping.",
+			Optional:            true,
+		},
+		"users": schema.SetAttribute{
+			MarkdownDescription: "The users mapped to the role.",
+			Optional:            true,
+			ElementType:         types.StringType,
+		},
+		"backend_roles": schema.SetAttribute{
+			MarkdownDescription: "The backend roles mapped to the role, e.g. the SAML roles.",
+			Optional:            true,
+			ElementType:         types.StringType,
+		},
+		"and_backend_roles": schema.SetAttribute{
+			MarkdownDescription: "The backend roles all of which the user must have to be mapped to the role.",
+			Optional:            true,
+			ElementType:         types.StringType,
+		},
+		"hosts": schema.SetAttribute{
+			MarkdownDescription: "The hosts the requests of which are mapped to the role.",
+			Optional:            true,
+			ElementType:         types.StringType,
+		},
+	}
+	maps.Copy(attributes, opensearchcommon.ConnectionAttributes())
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the mapping of the users and the backend roles to a role of the security plugin of a Managed OpenSearch cluster within the Yandex Cloud. " +
+			"The mapping is managed through the REST API of the cluster as the `admin` user, so the OpenSearch hosts of the cluster must be reachable by the provider. " +
+			"The mapping replaces the existing mapping of the role and is deleted with the resource. " +
+			"For more information, see [the official documentation](https://docs.opensearch.org/latest/security/access-control/users-roles/#mapping-users-to-roles).",
+		Attributes: attributes,
+	}
+}
+
+func (r *roleMappingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan RoleMapping
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	password := plan.AdminPassword.ValueString()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.put(ctx, &plan, password, &resp.Diagnostics, "Failed to Create resource")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Id = types.StringValue(resourceid.Construct(plan.ClusterID.ValueString(), plan.RoleName.ValueString()))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *roleMappingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state RoleMapping
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	password := state.AdminPassword.ValueString()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cid := state.ClusterID.ValueString()
+	roleName := state.RoleName.ValueString()
+	entity := fmt.Sprintf("role mapping %q in cluster %q", roleName, cid)
+	if password == "" {
+		opensearchcommon.UnknownPasswordWarning(&resp.Diagnostics, entity)
+		return
+	}
+
+	client, err := opensearchcommon.NewClient(ctx, r.providerConfig, cid, password, state.CACertificate.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to Read resource", err.Error())
+		return
+	}
+
+	mapping, err := client.GetRoleMapping(ctx, roleName)
+	if err != nil {
+		opensearchcommon.HandleReadError(ctx, resp, entity, err)
+		return
+	}
+
+	flattenRoleMapping(ctx, mapping, &state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *roleMappingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan RoleMapping
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	password := plan.AdminPassword.ValueString()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.put(ctx, &plan, password, &resp.Diagnostics, "Failed to Update resource")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *roleMappingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state RoleMapping
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	password := state.AdminPassword.ValueString()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cid := state.ClusterID.ValueString()
+	roleName := state.RoleName.ValueString()
+	client, err := opensearchcommon.NewClient(ctx, r.providerConfig, cid, password, state.CACertificate.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to Delete resource", err.Error())
+		return
+	}
+
+	if err := client.DeleteRoleMapping(ctx, roleName); err != nil && !opensearchapi.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Failed to Delete resource",
+			opensearchcommon.APIErrorDetail(fmt.Sprintf("delete role mapping %q in cluster %q", roleName, cid), err),
+		)
+	}
+}
+
+func (r *roleMappingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	cid, roleName, err := resourceid.Deconstruct(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <cluster_id>:<role_name>. Got: %q. Error: %s", req.ID, err.Error()),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &RoleMapping{
+		Id:              types.StringValue(req.ID),
+		ClusterID:       types.StringValue(cid),
+		AdminPassword:   types.StringNull(),
+		CACertificate:   types.StringNull(),
+		RoleName:        types.StringValue(roleName),
+		Description:     types.StringNull(),
+		Users:           types.SetNull(types.StringType),
+		BackendRoles:    types.SetNull(types.StringType),
+		AndBackendRoles: types.SetNull(types.StringType),
+		Hosts:           types.SetNull(types.StringType),
+	})...)
+}
+
+func (r *roleMappingResource) put(ctx context.Context, plan *RoleMapping, password string, diags *diag.Diagnostics, summary string) {
+	cid := plan.ClusterID.ValueString()
+	roleName := plan.RoleName.ValueString()
+
+	mapping := expandRoleMapping(ctx, plan, diags)
+	if diags.HasError() {
+		return
+	}
+
+	client, err := opensearchcommon.NewClient(ctx, r.providerConfig, cid, password, plan.CACertificate.ValueString())
+	if err != nil {
+		diags.AddError(summary, err.Error())
+		return
+	}
+
+	if err := client.PutRoleMapping(ctx, roleName, mapping); err != nil {
+		diags.AddError(summary, opensearchcommon.APIErrorDetail(fmt.Sprintf("put role mapping %q in cluster %q", roleName, cid), err))
+	}
+}
@@ -0,0 +1,60 @@
+package mdb_opensearch_snapshot_repository
+
+import (
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/opensearchapi"
+)
+
+type SnapshotRepository struct {
+	Id            types.String `tfsdk:"id"`
+	ClusterID     types.String `tfsdk:"cluster_id"`
+	AdminPassword types.String `tfsdk:"admin_password"`
+	CACertificate types.String `tfsdk:"ca_certificate"`
+	Name          types.String `tfsdk:"name"`
+	Bucket        types.String `tfsdk:"bucket"`
+	BasePath      types.String `tfsdk:"base_path"`
+	Readonly      types.Bool   `tfsdk:"readonly"`
+}
+
+// The settings of the S3 repository.
+const (
+	bucketSetting   = "bucket"
+	basePathSetting = "base_path"
+	endpointSetting = "endpoint"
+	readonlySetting = "readonly"
+)
+
+func expandSnapshotRepository(r *SnapshotRepository) *opensearchapi.SnapshotRepository {
+	settings := map[string]string{
+		bucketSetting:   r.Bucket.ValueString(),
+		endpointSetting: opensearchapi.StorageEndpoint,
+	}
+	if r.BasePath.ValueString() != "" {
+		settings[basePathSetting] = r.BasePath.ValueString()
+	}
+	if r.Readonly.ValueBool() {
+		settings[readonlySetting] = strconv.FormatBool(true)
+	}
+
+	return &opensearchapi.SnapshotRepository{
+		Type:     opensearchapi.S3RepositoryType,
+		Settings: settings,
+	}
+}
+
+func flattenSnapshotRepository(repo *opensearchapi.SnapshotRepository, state *SnapshotRepository) {
+	state.Bucket = types.StringValue(repo.Settings[bucketSetting])
+
+	state.BasePath = types.StringNull()
+	if basePath := repo.Settings[basePathSetting]; basePath != "" {
+		state.BasePath = types.StringValue(basePath)
+	}
+
+	// The omitted and the false readonly setting are the same.
+	readonly, _ := strconv.ParseBool(repo.Settings[readonlySetting])
+	if readonly || !state.Readonly.IsNull() {
+		state.Readonly = types.BoolValue(readonly)
+	}
+}
@@ -0,0 +1,220 @@
+package mdb_opensearch_snapshot_repository
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yandex-cloud/terraform-provider-yandex/common"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/opensearchapi"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/opensearchcommon"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/resourceid"
+	provider_config "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
+)
+
+var (
+	_ resource.Resource                = &snapshotRepositoryResource{}
+	_ resource.ResourceWithImportState = &snapshotRepositoryResource{}
+)
+
+type snapshotRepositoryResource struct {
+	providerConfig *provider_config.Config
+}
+
+func NewResource() resource.Resource {
+	return &snapshotRepositoryResource{}
+}
+
+func (r *snapshotRepositoryResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mdb_opensearch_snapshot_repository"
+}
+
+func (r *snapshotRepositoryResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerConfig, ok := req.ProviderData.(*provider_config.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider_config.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.providerConfig = providerConfig
+}
+
+func (r *snapshotRepositoryResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: common.ResourceDescriptions["id"],
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "The name of the repository.",
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"bucket": schema.StringAttribute{
+			MarkdownDescription: "The name of the Object Storage bucket the snapshots are stored in, e.g. the `bucket` of `yandex_storage_bucket`. " +
+				"The service account of the cluster must have access to the bucket.",
+			Required: true,
+		},
+		"base_path": schema.StringAttribute{
+			MarkdownDescription: "The path in the bucket the snapshots are stored under. By default, the snapshots are stored in the root of the bucket.",
+			Optional:            true,
+		},
+		"readonly": schema.BoolAttribute{
+			MarkdownDescription: "Register the repository as read-only, e.g. to restore the snapshots of another cluster. The default is `false`.",
+			Optional:            true,
+		},
+	}
+	maps.Copy(attributes, opensearchcommon.ConnectionAttributes())
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a snapshot repository of a Managed OpenSearch cluster within the Yandex Cloud, backed by an Object Storage bucket. " +
+			"The repository is managed through the REST API of the cluster as the `admin` user, so the OpenSearch hosts of the cluster must be reachable by the provider. " +
+			"The snapshots stay in the bucket after the repository is deleted. " +
+			"For more information, see [the official documentation](https://yandex.cloud/docs/managed-opensearch/operations/snapshot-repository).",
+		Attributes: attributes,
+	}
+}
+
+func (r *snapshotRepositoryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan SnapshotRepository
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	password := plan.AdminPassword.ValueString()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.put(ctx, &plan, password, &resp.Diagnostics, "Failed to Create resource")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Id = types.StringValue(resourceid.Construct(plan.ClusterID.ValueString(), plan.Name.ValueString()))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *snapshotRepositoryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state SnapshotRepository
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	password := state.AdminPassword.ValueString()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cid := state.ClusterID.ValueString()
+	name := state.Name.ValueString()
+	entity := fmt.Sprintf("snapshot repository %q in cluster %q", name, cid)
+	if password == "" {
+		opensearchcommon.UnknownPasswordWarning(&resp.Diagnostics, entity)
+		return
+	}
+
+	client, err := opensearchcommon.NewClient(ctx, r.providerConfig, cid, password, state.CACertificate.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to Read resource", err.Error())
+		return
+	}
+
+	repo, err := client.GetSnapshotRepository(ctx, name)
+	if err != nil {
+		opensearchcommon.HandleReadError(ctx, resp, entity, err)
+		return
+	}
+
+	flattenSnapshotRepository(repo, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *snapshotRepositoryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan SnapshotRepository
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	password := plan.AdminPassword.ValueString()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.put(ctx, &plan, password, &resp.Diagnostics, "Failed to Update resource")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *snapshotRepositoryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state SnapshotRepository
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	password := state.AdminPassword.ValueString()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cid := state.ClusterID.ValueString()
+	name := state.Name.ValueString()
+	client, err := opensearchcommon.NewClient(ctx, r.providerConfig, cid, password, state.CACertificate.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to Delete resource", err.Error())
+		return
+	}
+
+	if err := client.DeleteSnapshotRepository(ctx, name); err != nil && !opensearchapi.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Failed to Delete resource",
+			opensearchcommon.APIErrorDetail(fmt.Sprintf("delete snapshot repository %q in cluster %q", name, cid), err),
+		)
+	}
+}
+
+func (r *snapshotRepositoryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	cid, name, err := resourceid.Deconstruct(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <cluster_id>:<name>. Got: %q. Error: %s", req.ID, err.Error()),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &SnapshotRepository{
+		Id:            types.StringValue(req.ID),
+		ClusterID:     types.StringValue(cid),
+		AdminPassword: types.StringNull(),
+		CACertificate: types.StringNull(),
+		Name:          types.StringValue(name),
+		Bucket:        types.StringNull(),
+		BasePath:      types.StringNull(),
+		Readonly:      types.BoolNull(),
+	})...)
+}
+
+func (r *snapshotRepositoryResource) put(ctx context.Context, plan *SnapshotRepository, password string, diags *diag.Diagnostics, summary string) {
+	cid := plan.ClusterID.ValueString()
+	name := plan.Name.ValueString()
+
+	client, err := opensearchcommon.NewClient(ctx, r.providerConfig, cid, password, plan.CACertificate.ValueString())
+	if err != nil {
+		diags.AddError(summary, err.Error())
+		return
+	}
+
+	if err := client.PutSnapshotRepository(ctx, name, expandSnapshotRepository(plan)); err != nil {
+		diags.AddError(summary, opensearchcommon.APIErrorDetail(fmt.Sprintf("put snapshot repository %q in cluster %q", name, cid), err))
+	}
+}
@@ -0,0 +1,150 @@
+package mdb_opensearch_snapshot_repository_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/opensearchcommon"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/resourceid"
+	test "github.com/yandex-cloud/terraform-provider-yandex/pkg/testhelpers"
+	yandex_framework "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider"
+)
+
+const (
+	openSearchSnapshotRepositoryResourceName = "yandex_mdb_opensearch_snapshot_repository.snapshots"
+	openSearchAdminPassword                  = "dummy_P@ssw0rd"
+)
+
+const openSearchSnapshotRepositoryConfig = `
+resource "yandex_iam_service_account" "sa" {
+  name = "%[1]s"
+}
+
+resource "yandex_resourcemanager_folder_iam_member" "sa_storage_editor" {
+  folder_id   = "%[4]s"
+  member      = "serviceAccount:${yandex_iam_service_account.sa.id}"
+  role        = "storage.editor"
+  sleep_after = 30
+}
+
+resource "yandex_iam_service_account_static_access_key" "sa_key" {
+  service_account_id = yandex_iam_service_account.sa.id
+
+  depends_on = [yandex_resourcemanager_folder_iam_member.sa_storage_editor]
+}
+
+resource "yandex_storage_bucket" "snapshots" {
+  access_key = yandex_iam_service_account_static_access_key.sa_key.access_key
+  secret_key = yandex_iam_service_account_static_access_key.sa_key.secret_key
+  bucket     = "%[1]s"
+}
+resource "yandex_vpc_network" "foo" {}
+
+resource "yandex_vpc_subnet" "foo" {
+  zone           = "ru-central1-a"
+  network_id     = yandex_vpc_network.foo.id
+  v4_cidr_blocks = ["10.1.0.0/24"]
+}
+
+resource "yandex_mdb_opensearch_cluster" "foo" {
+  name                = "%[1]s"
+  environment         = "PRESTABLE"
+  network_id          = yandex_vpc_network.foo.id
+  service_account_id  = yandex_iam_service_account.sa.id
+  deletion_protection = false
+
+  config {
+    admin_password = "%[2]s"
+
+    opensearch {
+      node_groups {
+        name             = "datamaster0"
+        assign_public_ip = true
+        hosts_count      = 1
+        zone_ids         = ["ru-central1-a"]
+        subnet_ids       = [yandex_vpc_subnet.foo.id]
+        roles            = ["data", "manager"]
+        resources {
+          resource_preset_id = "s2.micro"
+          disk_size          = 10737418240
+          disk_type_id       = "network-ssd"
+        }
+      }
+    }
+  }
+
+  depends_on = [yandex_resourcemanager_folder_iam_member.sa_storage_editor]
+}
+
+resource "yandex_mdb_opensearch_snapshot_repository" "snapshots" {
+  cluster_id     = yandex_mdb_opensearch_cluster.foo.id
+  admin_password = "%[2]s"
+
+  name      = "snapshots"
+  bucket    = yandex_storage_bucket.snapshots.bucket
+  base_path = "%[3]s"
+}
+`
+
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
+
+func TestAccMDBOpenSearchSnapshotRepository_basic(t *testing.T) {
+	t.Parallel()
+
+	clusterName := acctest.RandomWithPrefix("tf-opensearch-snapshot-repository")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.AccPreCheck(t) },
+		ProtoV6ProviderFactories: test.AccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(openSearchSnapshotRepositoryConfig, clusterName, openSearchAdminPassword, "first", test.GetExampleFolderID()),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMDBOpenSearchSnapshotRepositoryExists(openSearchSnapshotRepositoryResourceName, "first"),
+					resource.TestCheckResourceAttr(openSearchSnapshotRepositoryResourceName, "bucket", clusterName),
+				),
+			},
+			{
+				Config: fmt.Sprintf(openSearchSnapshotRepositoryConfig, clusterName, openSearchAdminPassword, "second", test.GetExampleFolderID()),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMDBOpenSearchSnapshotRepositoryExists(openSearchSnapshotRepositoryResourceName, "second"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckMDBOpenSearchSnapshotRepositoryExists(resourceName, basePath string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+
+		cid, name, err := resourceid.Deconstruct(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		config := test.AccProvider.(*yandex_framework.Provider).GetConfig()
+		client, err := opensearchcommon.NewClient(context.Background(), &config, cid, openSearchAdminPassword, "")
+		if err != nil {
+			return err
+		}
+
+		repo, err := client.GetSnapshotRepository(context.Background(), name)
+		if err != nil {
+			return fmt.Errorf("OpenSearch snapshot repository %q not found: %v", rs.Primary.ID, err)
+		}
+		if repo.Settings["base_path"] != basePath {
+			return fmt.Errorf("OpenSearch snapshot repository %q has base path %q, want %q", rs.Primary.ID, repo.Settings["base_path"], basePath)
+		}
+		return nil
+	}
+}
@@ -217,6 +217,31 @@ func (p *PostgresqlAPI) DeleteCluster(ctx context.Context, sdk *ycsdk.SDK, diags
 	}
 }
 
+func (p *PostgresqlAPI) BackupCluster(ctx context.Context, sdk *ycsdk.SDK, diags *diag.Diagnostics, cid string) {
+	op, err := retry.ConflictingOperationV2(ctx, sdk, func() (*postgresqlsdk.ClusterBackupOperation, error) {
+		return postgresqlsdk.NewClusterClient(sdk).Backup(ctx, &postgresql.BackupClusterRequest{
+			ClusterId: cid,
+		})
+	})
+
+	if err != nil {
+		diags.AddError(
+			"Failed to backup resource",
+			fmt.Sprintf("Error while requesting API to backup PostgreSQL cluster %q: %s", cid, err.Error()),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Creating final backup of PostgreSQL Cluster", map[string]any{"cluster_id": cid})
+
+	if _, err = op.Wait(ctx); err != nil {
+		diags.AddError(
+			"Failed to backup resource",
+			fmt.Sprintf("Error while waiting for operation %q to backup PostgreSQL cluster %q: %s", op.ID(), cid, err.Error()),
+		)
+	}
+}
+
 func (p *PostgresqlAPI) CreateCluster(ctx context.Context, sdk *ycsdk.SDK, diags *diag.Diagnostics, req *postgresql.CreateClusterRequest) string {
 	op, err := postgresqlsdk.NewClusterClient(sdk).Create(ctx, req)
 	if err != nil {
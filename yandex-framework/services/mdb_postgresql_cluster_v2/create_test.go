@@ -54,6 +54,7 @@ var (
 		"restore":                types.ObjectType{AttrTypes: expectedRestoreAttrTypes},
 		"config":                 types.ObjectType{AttrTypes: expectedConfigAttrs},
 		"deletion_protection":    types.BoolType,
+		"final_backup":           types.BoolType,
 		"folder_id":              types.StringType,
 		"hosts":                  types.MapType{ElemType: types.StringType},
 		"id":                     types.StringType,
@@ -150,6 +151,7 @@ func TestYandexProvider_MDBPostgresClusterPrepareCreateRequest(t *testing.T) {
 					),
 					"config":              baseConfig,
 					"deletion_protection": types.BoolValue(true),
+					"final_backup":        types.BoolNull(),
 					"security_group_ids": types.SetValueMust(types.StringType, []attr.Value{
 						types.StringValue("test-sg"),
 					}),
@@ -224,6 +226,7 @@ func TestYandexProvider_MDBPostgresClusterPrepareCreateRequest(t *testing.T) {
 					"config":              baseConfig,
 					"maintenance_window":  types.ObjectNull(mdbcommon.MaintenanceWindowType.AttrTypes),
 					"deletion_protection": types.BoolNull(),
+					"final_backup":        types.BoolNull(),
 					"security_group_ids":  types.SetNull(types.StringType),
 					"restore": types.ObjectValueMust(expectedRestoreAttrTypes, map[string]attr.Value{
 						"backup_id":      types.StringNull(),
@@ -341,6 +344,7 @@ func TestYandexProvider_MDBPostgresClusterPrepareRestoreRequest(t *testing.T) {
 					),
 					"config":              baseConfig,
 					"deletion_protection": types.BoolValue(true),
+					"final_backup":        types.BoolNull(),
 					"security_group_ids": types.SetValueMust(types.StringType, []attr.Value{
 						types.StringValue("test-sg"),
 					}),
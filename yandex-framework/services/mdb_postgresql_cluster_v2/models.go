@@ -8,21 +8,25 @@ import (
 )
 
 type Cluster struct {
-	Id                  types.String   `tfsdk:"id"`
-	FolderId            types.String   `tfsdk:"folder_id"`
-	NetworkId           types.String   `tfsdk:"network_id"`
-	Name                types.String   `tfsdk:"name"`
-	Description         types.String   `tfsdk:"description"`
-	Environment         types.String   `tfsdk:"environment"`
-	Labels              types.Map      `tfsdk:"labels"`
-	Config              types.Object   `tfsdk:"config"`
-	HostSpecs           types.Map      `tfsdk:"hosts"`
-	MaintenanceWindow   types.Object   `tfsdk:"maintenance_window"`
-	DeletionProtection  types.Bool     `tfsdk:"deletion_protection"`
-	SecurityGroupIds    types.Set      `tfsdk:"security_group_ids"`
-	DiskEncryptionKeyId types.String   `tfsdk:"disk_encryption_key_id"`
-	Restore             types.Object   `tfsdk:"restore"`
-	Timeouts            timeouts.Value `tfsdk:"timeouts"`
+	Id                         types.String   `tfsdk:"id"`
+	FolderId                   types.String   `tfsdk:"folder_id"`
+	NetworkId                  types.String   `tfsdk:"network_id"`
+	Name                       types.String   `tfsdk:"name"`
+	Description                types.String   `tfsdk:"description"`
+	Environment                types.String   `tfsdk:"environment"`
+	Labels                     types.Map      `tfsdk:"labels"`
+	Config                     types.Object   `tfsdk:"config"`
+	HostSpecs                  types.Map      `tfsdk:"hosts"`
+	MaintenanceWindow          types.Object   `tfsdk:"maintenance_window"`
+	DeletionProtection         types.Bool     `tfsdk:"deletion_protection"`
+	FinalBackup                types.Bool     `tfsdk:"final_backup"`
+	RequireDisruptiveChangeAck types.Bool     `tfsdk:"require_disruptive_change_ack"`
+	SecurityGroupIds           types.Set      `tfsdk:"security_group_ids"`
+	DiskEncryptionKeyId        types.String   `tfsdk:"disk_encryption_key_id"`
+	HostUpdateStrategy         types.Object   `tfsdk:"host_update_strategy"`
+	Endpoints                  types.Object   `tfsdk:"endpoints"`
+	Restore                    types.Object   `tfsdk:"restore"`
+	Timeouts                   timeouts.Value `tfsdk:"timeouts"`
 }
 
 type Host struct {
@@ -101,6 +101,21 @@ var listAttributes = map[string]struct{}{
 	"shared_preload_libraries": {},
 }
 
+// PostgreSQL settings of the postmaster context are applied on restart of the server.
+var pgSettingsDisruption = map[string]mdbcommon.SettingDisruption{
+	"max_connections":                mdbcommon.SettingRequiresFailover,
+	"shared_buffers":                 mdbcommon.SettingRequiresFailover,
+	"max_locks_per_transaction":      mdbcommon.SettingRequiresFailover,
+	"max_pred_locks_per_transaction": mdbcommon.SettingRequiresFailover,
+	"max_prepared_transactions":      mdbcommon.SettingRequiresFailover,
+	"max_worker_processes":           mdbcommon.SettingRequiresFailover,
+	"autovacuum_max_workers":         mdbcommon.SettingRequiresFailover,
+	"track_activity_query_size":      mdbcommon.SettingRequiresFailover,
+	"old_snapshot_threshold":         mdbcommon.SettingRequiresFailover,
+	"wal_level":                      mdbcommon.SettingRequiresFailover,
+	"shared_preload_libraries":       mdbcommon.SettingRequiresFailover,
+}
+
 var pgAttrProvider = &PgSettingsAttributeInfoProvider{}
 
 type PgSettingsAttributeInfoProvider struct{}
@@ -117,6 +132,10 @@ func (p *PgSettingsAttributeInfoProvider) GetSetAttributes() map[string]struct{}
 	return listAttributes
 }
 
+func (p *PgSettingsAttributeInfoProvider) GetSettingsDisruption() map[string]mdbcommon.SettingDisruption {
+	return pgSettingsDisruption
+}
+
 func NewPgSettingsMapType() mdbcommon.SettingsMapType {
 	return mdbcommon.NewSettingsMapType(pgAttrProvider)
 }
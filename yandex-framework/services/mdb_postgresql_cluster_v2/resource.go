@@ -168,7 +168,11 @@ func (r *clusterResource) Schema(ctx context.Context, _ resource.SchemaRequest,
 					},
 				},
 			},
-			"deletion_protection": defaultschema.DeletionProtection(),
+			"deletion_protection":           defaultschema.DeletionProtection(),
+			"final_backup":                  defaultschema.FinalBackup(),
+			"require_disruptive_change_ack": defaultschema.RequireDisruptiveChangeAck(),
+			"host_update_strategy":          defaultschema.HostUpdateStrategy(),
+			"endpoints":                     mdbcommon.EndpointsSchema(),
 			"disk_encryption_key_id": schema.StringAttribute{
 				Description: "ID of the KMS key for cluster disk encryption.",
 				Optional:    true,
@@ -556,6 +560,16 @@ func (r *clusterResource) ModifyPlan(ctx context.Context, req resource.ModifyPla
 
 	autoscalingOn := utils.IsPresent(attr.Value(cfgState.DiskSizeAutoscaling))
 
+	mdbcommon.ReportDisruptiveChanges(
+		path.Root("config").AtName("postgresql_config"),
+		cfgPlan.PostgtgreSQLConfig.DisruptiveChanges(cfgState.PostgtgreSQLConfig),
+		plan.RequireDisruptiveChangeAck.ValueBool(),
+		&resp.Diagnostics,
+	)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	mdbcommon.ValidateClusterConnectionManagerFromConfig(ctx, req.Config, path.Root("config").AtName("connection_manager"), &resp.Diagnostics)
 
 	// remove changes on disk_size from plan if enabled autoscaling
@@ -679,6 +693,7 @@ func (r *clusterResource) createCluster(
 		&postgresqlApi,
 		cid,
 		struct{}{},
+		nil,
 		resolvedHostSpecs,
 		plan.HostSpecs,
 	)
@@ -828,6 +843,7 @@ func (r *clusterResource) Update(ctx context.Context, req resource.UpdateRequest
 
 	// Resolve replication_source_name labels to FQDNs before updating hosts.
 	resolvedPlanHostSpecs := resolveReplicationSourceNames(ctx, plan.HostSpecs, state.HostSpecs, &resp.Diagnostics)
+	hostUpdateStrategy := mdbcommon.ExpandHostUpdateStrategy(ctx, plan.HostUpdateStrategy, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -840,6 +856,7 @@ func (r *clusterResource) Update(ctx context.Context, req resource.UpdateRequest
 		&postgresqlApi,
 		plan.Id.ValueString(),
 		struct{}{},
+		hostUpdateStrategy,
 		resolvedPlanHostSpecs,
 		state.HostSpecs,
 	)
@@ -869,6 +886,12 @@ func (r *clusterResource) Delete(ctx context.Context, req resource.DeleteRequest
 	defer cancel()
 
 	cid := state.Id.ValueString()
+	if state.FinalBackup.ValueBool() {
+		postgresqlApi.BackupCluster(ctx, r.providerConfig.SDKv2, &resp.Diagnostics, cid)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 	postgresqlApi.DeleteCluster(ctx, r.providerConfig.SDKv2, &resp.Diagnostics, cid)
 }
 
@@ -930,4 +953,11 @@ func (r *clusterResource) refreshResourceState(ctx context.Context, state *Clust
 	](ctx, cluster.MaintenanceWindow, respDiagnostics)
 	state.SecurityGroupIds = mdbcommon.FlattenSetString(ctx, cluster.SecurityGroupIds, respDiagnostics)
 	state.DiskEncryptionKeyId = mdbcommon.FlattenStringWrapper(ctx, cluster.DiskEncryptionKeyId, respDiagnostics)
+	state.Endpoints = mdbcommon.FlattenEndpoints(
+		ctx,
+		mdbcommon.HostPort(mdbcommon.ClusterReadWriteHost(cluster.Id), mdbcommon.PostgreSQLPort),
+		mdbcommon.HostPort(mdbcommon.ClusterReadOnlyHost(cluster.Id), mdbcommon.PostgreSQLPort),
+		nil,
+		respDiagnostics,
+	)
 }
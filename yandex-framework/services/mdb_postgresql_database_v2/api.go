@@ -0,0 +1,93 @@
+package mdb_postgresql_database_v2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/postgresql/v1"
+	postgresqlsdk "github.com/yandex-cloud/go-sdk/services/mdb/postgresql/v1"
+	ycsdk "github.com/yandex-cloud/go-sdk/v2"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/retry"
+)
+
+func ReadDatabase(ctx context.Context, sdk *ycsdk.SDK, diags *diag.Diagnostics, cid, dbName string) *postgresql.Database {
+	db, err := postgresqlsdk.NewDatabaseClient(sdk).Get(ctx, &postgresql.GetDatabaseRequest{
+		ClusterId:    cid,
+		DatabaseName: dbName,
+	})
+	if err != nil {
+		diags.AddError(
+			"Failed to Read resource",
+			fmt.Sprintf("Error while requesting API to read PostgreSQL database %q in cluster %q: %s", dbName, cid, err.Error()),
+		)
+		return nil
+	}
+	return db
+}
+
+func CreateDatabase(ctx context.Context, sdk *ycsdk.SDK, diags *diag.Diagnostics, cid string, spec *postgresql.DatabaseSpec) {
+	op, err := retry.ConflictingOperationV2(ctx, sdk, func() (*postgresqlsdk.DatabaseCreateOperation, error) {
+		return postgresqlsdk.NewDatabaseClient(sdk).Create(ctx, &postgresql.CreateDatabaseRequest{
+			ClusterId:    cid,
+			DatabaseSpec: spec,
+		})
+	})
+	if err != nil {
+		diags.AddError(
+			"Failed to Create resource",
+			fmt.Sprintf("Error while requesting API to create PostgreSQL database %q in cluster %q: %s", spec.Name, cid, err.Error()),
+		)
+		return
+	}
+
+	if _, err = op.Wait(ctx); err != nil {
+		diags.AddError(
+			"Failed to Create resource",
+			fmt.Sprintf("Error while waiting for operation to create PostgreSQL database %q in cluster %q: %s", spec.Name, cid, err.Error()),
+		)
+	}
+}
+
+func UpdateDatabase(ctx context.Context, sdk *ycsdk.SDK, diags *diag.Diagnostics, req *postgresql.UpdateDatabaseRequest) {
+	op, err := retry.ConflictingOperationV2(ctx, sdk, func() (*postgresqlsdk.DatabaseUpdateOperation, error) {
+		return postgresqlsdk.NewDatabaseClient(sdk).Update(ctx, req)
+	})
+	if err != nil {
+		diags.AddError(
+			"Failed to Update resource",
+			fmt.Sprintf("Error while requesting API to update PostgreSQL database %q in cluster %q: %s", req.DatabaseName, req.ClusterId, err.Error()),
+		)
+		return
+	}
+
+	if _, err = op.Wait(ctx); err != nil {
+		diags.AddError(
+			"Failed to Update resource",
+			fmt.Sprintf("Error while waiting for operation to update PostgreSQL database %q in cluster %q: %s", req.DatabaseName, req.ClusterId, err.Error()),
+		)
+	}
+}
+
+func DeleteDatabase(ctx context.Context, sdk *ycsdk.SDK, diags *diag.Diagnostics, cid, dbName string) {
+	op, err := retry.ConflictingOperationV2(ctx, sdk, func() (*postgresqlsdk.DatabaseDeleteOperation, error) {
+		return postgresqlsdk.NewDatabaseClient(sdk).Delete(ctx, &postgresql.DeleteDatabaseRequest{
+			ClusterId:    cid,
+			DatabaseName: dbName,
+		})
+	})
+	if err != nil {
+		diags.AddError(
+			"Failed to Delete resource",
+			fmt.Sprintf("Error while requesting API to delete PostgreSQL database %q in cluster %q: %s", dbName, cid, err.Error()),
+		)
+		return
+	}
+
+	if _, err = op.Wait(ctx); err != nil {
+		diags.AddError(
+			"Failed to Delete resource",
+			fmt.Sprintf("Error while waiting for operation to delete PostgreSQL database %q in cluster %q: %s", dbName, cid, err.Error()),
+		)
+	}
+}
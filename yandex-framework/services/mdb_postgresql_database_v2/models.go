@@ -0,0 +1,126 @@
+package mdb_postgresql_database_v2
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/postgresql/v1"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/mdbcommon"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/resourceid"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+type Database struct {
+	Id                 types.String   `tfsdk:"id"`
+	ClusterID          types.String   `tfsdk:"cluster_id"`
+	Name               types.String   `tfsdk:"name"`
+	Owner              types.String   `tfsdk:"owner"`
+	LcCollate          types.String   `tfsdk:"lc_collate"`
+	LcType             types.String   `tfsdk:"lc_type"`
+	TemplateDb         types.String   `tfsdk:"template_db"`
+	Extensions         types.Set      `tfsdk:"extension"`
+	DeletionProtection types.String   `tfsdk:"deletion_protection"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
+}
+
+type Extension struct {
+	Name    types.String `tfsdk:"name"`
+	Version types.String `tfsdk:"version"`
+}
+
+var extensionAttrTypes = map[string]attr.Type{
+	"name":    types.StringType,
+	"version": types.StringType,
+}
+
+var tristateBoolean = map[string]*wrapperspb.BoolValue{
+	"true":        wrapperspb.Bool(true),
+	"false":       wrapperspb.Bool(false),
+	"unspecified": nil,
+}
+
+func flattenTristateBoolean(v *wrapperspb.BoolValue) types.String {
+	if v == nil {
+		return types.StringValue("unspecified")
+	}
+	if v.GetValue() {
+		return types.StringValue("true")
+	}
+	return types.StringValue("false")
+}
+
+func databaseToState(ctx context.Context, db *postgresql.Database, state *Database, diags *diag.Diagnostics) {
+	state.Id = types.StringValue(resourceid.Construct(db.ClusterId, db.Name))
+	state.ClusterID = types.StringValue(db.ClusterId)
+	state.Name = types.StringValue(db.Name)
+	state.Owner = types.StringValue(db.Owner)
+	state.LcCollate = types.StringValue(db.LcCollate)
+	state.LcType = types.StringValue(db.LcCtype)
+	state.TemplateDb = mdbcommon.FlattenStringOrNull(db.TemplateDb)
+	state.Extensions = flattenExtensions(ctx, db.Extensions, pinnedExtensions(ctx, state.Extensions, diags), diags)
+	state.DeletionProtection = flattenTristateBoolean(db.DeletionProtection)
+}
+
+// pinnedExtensions returns the names of the extensions with a version set in the state or plan.
+func pinnedExtensions(ctx context.Context, s types.Set, diags *diag.Diagnostics) map[string]struct{} {
+	pinned := make(map[string]struct{})
+	for _, e := range expandExtensions(ctx, s, diags) {
+		if e.Version != "" {
+			pinned[e.Name] = struct{}{}
+		}
+	}
+	return pinned
+}
+
+// flattenExtensions returns the extensions of the database. The version is set only
+// for the pinned extensions, the versions chosen by the server are not tracked.
+func flattenExtensions(ctx context.Context, es []*postgresql.Extension, pinned map[string]struct{}, diags *diag.Diagnostics) types.Set {
+	extensions := make([]Extension, 0, len(es))
+	for _, e := range es {
+		ext := Extension{
+			Name:    types.StringValue(e.Name),
+			Version: types.StringNull(),
+		}
+		if _, ok := pinned[e.Name]; ok {
+			ext.Version = types.StringValue(e.Version)
+		}
+		extensions = append(extensions, ext)
+	}
+
+	s, d := types.SetValueFrom(ctx, types.ObjectType{AttrTypes: extensionAttrTypes}, extensions)
+	diags.Append(d...)
+	return s
+}
+
+func expandExtensions(ctx context.Context, s types.Set, diags *diag.Diagnostics) []*postgresql.Extension {
+	if s.IsNull() || s.IsUnknown() {
+		return nil
+	}
+
+	var extensions []Extension
+	diags.Append(s.ElementsAs(ctx, &extensions, false)...)
+
+	out := make([]*postgresql.Extension, 0, len(extensions))
+	for _, e := range extensions {
+		out = append(out, &postgresql.Extension{
+			Name:    e.Name.ValueString(),
+			Version: e.Version.ValueString(),
+		})
+	}
+	return out
+}
+
+func stateToSpec(ctx context.Context, state *Database, diags *diag.Diagnostics) *postgresql.DatabaseSpec {
+	return &postgresql.DatabaseSpec{
+		Name:               state.Name.ValueString(),
+		Owner:              state.Owner.ValueString(),
+		LcCollate:          state.LcCollate.ValueString(),
+		LcCtype:            state.LcType.ValueString(),
+		TemplateDb:         state.TemplateDb.ValueString(),
+		Extensions:         expandExtensions(ctx, state.Extensions, diags),
+		DeletionProtection: tristateBoolean[state.DeletionProtection.ValueString()],
+	}
+}
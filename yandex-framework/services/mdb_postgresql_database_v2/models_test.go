@@ -0,0 +1,42 @@
+package mdb_postgresql_database_v2
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/postgresql/v1"
+)
+
+func TestFlattenExtensionsPinning(t *testing.T) {
+	ctx := context.Background()
+	var diags diag.Diagnostics
+
+	prior := flattenExtensions(ctx, []*postgresql.Extension{
+		{Name: "pg_trgm", Version: "1.6"},
+		{Name: "uuid-ossp"},
+	}, map[string]struct{}{"pg_trgm": {}}, &diags)
+	require.False(t, diags.HasError(), diags)
+
+	pinned := pinnedExtensions(ctx, prior, &diags)
+	require.False(t, diags.HasError(), diags)
+	assert.Equal(t, map[string]struct{}{"pg_trgm": {}}, pinned)
+
+	got := flattenExtensions(ctx, []*postgresql.Extension{
+		{Name: "pg_trgm", Version: "1.6"},
+		{Name: "uuid-ossp", Version: "1.1"},
+	}, pinned, &diags)
+	require.False(t, diags.HasError(), diags)
+
+	var extensions []Extension
+	diags.Append(got.ElementsAs(ctx, &extensions, false)...)
+	require.False(t, diags.HasError(), diags)
+
+	assert.ElementsMatch(t, []Extension{
+		{Name: types.StringValue("pg_trgm"), Version: types.StringValue("1.6")},
+		{Name: types.StringValue("uuid-ossp"), Version: types.StringNull()},
+	}, extensions)
+}
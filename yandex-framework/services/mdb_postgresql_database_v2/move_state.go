@@ -0,0 +1,92 @@
+package mdb_postgresql_database_v2
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/postgresql/v1"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/mdbcommon"
+)
+
+const legacyDatabaseTypeName = "yandex_mdb_postgresql_database"
+
+// legacyDatabase is the yandex_mdb_postgresql_database state moved to this resource.
+type legacyDatabase struct {
+	ID         string `json:"id"`
+	ClusterID  string `json:"cluster_id"`
+	Name       string `json:"name"`
+	Owner      string `json:"owner"`
+	LcCollate  string `json:"lc_collate"`
+	LcType     string `json:"lc_type"`
+	TemplateDb string `json:"template_db"`
+	Extensions []struct {
+		Name string `json:"name"`
+	} `json:"extension"`
+	DeletionProtection string `json:"deletion_protection"`
+}
+
+func (r *databaseResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		{
+			StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+				if req.SourceTypeName != legacyDatabaseTypeName || req.SourceRawState == nil {
+					return
+				}
+
+				var legacy legacyDatabase
+				if err := json.Unmarshal(req.SourceRawState.JSON, &legacy); err != nil {
+					resp.Diagnostics.AddError(
+						"Unable to Move Resource State",
+						fmt.Sprintf("Error while decoding the state of %s: %s", legacyDatabaseTypeName, err.Error()),
+					)
+					return
+				}
+
+				state := legacyDatabaseToState(ctx, &legacy, &resp.Diagnostics)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				resp.Diagnostics.Append(resp.TargetState.Set(ctx, state)...)
+			},
+		},
+	}
+}
+
+func legacyDatabaseToState(ctx context.Context, legacy *legacyDatabase, diags *diag.Diagnostics) *Database {
+	extensions := make([]*postgresql.Extension, 0, len(legacy.Extensions))
+	for _, e := range legacy.Extensions {
+		extensions = append(extensions, &postgresql.Extension{Name: e.Name})
+	}
+
+	state := &Database{
+		Id:                 types.StringValue(legacy.ID),
+		ClusterID:          types.StringValue(legacy.ClusterID),
+		Name:               types.StringValue(legacy.Name),
+		Owner:              types.StringValue(legacy.Owner),
+		LcCollate:          types.StringValue(legacy.LcCollate),
+		LcType:             types.StringValue(legacy.LcType),
+		TemplateDb:         mdbcommon.FlattenStringOrNull(legacy.TemplateDb),
+		Extensions:         flattenExtensions(ctx, extensions, nil, diags),
+		DeletionProtection: types.StringValue(legacy.DeletionProtection),
+		Timeouts: timeouts.Value{
+			Object: types.ObjectNull(map[string]attr.Type{
+				"create": types.StringType,
+				"update": types.StringType,
+				"delete": types.StringType,
+			}),
+		},
+	}
+
+	if state.DeletionProtection.ValueString() == "" {
+		state.DeletionProtection = types.StringValue("unspecified")
+	}
+
+	return state
+}
@@ -0,0 +1,356 @@
+package mdb_postgresql_database_v2
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/postgresql/v1"
+	postgresqlsdk "github.com/yandex-cloud/go-sdk/services/mdb/postgresql/v1"
+	"github.com/yandex-cloud/terraform-provider-yandex/common"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/resourceid"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/validate"
+	provider_config "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+const (
+	yandexMDBPostgreSQLDatabaseDefaultTimeout = 10 * time.Minute
+)
+
+var (
+	_ resource.Resource                = &databaseResource{}
+	_ resource.ResourceWithImportState = &databaseResource{}
+	_ resource.ResourceWithMoveState   = &databaseResource{}
+)
+
+type databaseResource struct {
+	providerConfig *provider_config.Config
+}
+
+func NewResource() resource.Resource {
+	return &databaseResource{}
+}
+
+func (r *databaseResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mdb_postgresql_database_v2"
+}
+
+func (r *databaseResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerConfig, ok := req.ProviderData.(*provider_config.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider_config.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.providerConfig = providerConfig
+}
+
+func (r *databaseResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a PostgreSQL database within the Yandex Cloud. For more information, see [the official documentation](https://yandex.cloud/docs/managed-postgresql/operations/databases).\n\n" +
+			"~> The state of a `yandex_mdb_postgresql_database` resource can be moved to this resource with the `moved` block.\n",
+		Attributes: map[string]schema.Attribute{
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+			"id": schema.StringAttribute{
+				MarkdownDescription: common.ResourceDescriptions["id"],
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"cluster_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the PostgreSQL cluster. Provided by the client when the database is created.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the database. The database is renamed in place when the name is changed.",
+				Required:            true,
+			},
+			"owner": schema.StringAttribute{
+				MarkdownDescription: "Name of the user assigned as the owner of the database. Forbidden to change in an existing database.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"lc_collate": schema.StringAttribute{
+				MarkdownDescription: "POSIX locale for string sorting order. Forbidden to change in an existing database.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("C"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"lc_type": schema.StringAttribute{
+				MarkdownDescription: "POSIX locale for character classification. Forbidden to change in an existing database.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("C"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"template_db": schema.StringAttribute{
+				MarkdownDescription: "Name of the template database.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"deletion_protection": schema.StringAttribute{
+				MarkdownDescription: common.ResourceDescriptions["deletion_protection"],
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("unspecified"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("unspecified", "true", "false"),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"extension": schema.SetNestedBlock{
+				MarkdownDescription: "Set of database extensions.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the database extension. For more information on available extensions see [the official documentation](https://yandex.cloud/docs/managed-postgresql/operations/cluster-extensions).",
+							Required:            true,
+						},
+						"version": schema.StringAttribute{
+							MarkdownDescription: "Version of the extension. When not set, the version is chosen by the server and is not tracked.",
+							Optional:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *databaseResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan Database
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, yandexMDBPostgreSQLDatabaseDefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	cid := plan.ClusterID.ValueString()
+	spec := stateToSpec(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	CreateDatabase(ctx, r.providerConfig.SDKv2, &resp.Diagnostics, cid, spec)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	db := ReadDatabase(ctx, r.providerConfig.SDKv2, &resp.Diagnostics, cid, spec.Name)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	databaseToState(ctx, db, &plan, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *databaseResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state Database
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cid, dbName, err := resourceid.Deconstruct(state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to parse resource ID",
+			fmt.Sprintf("Error parsing resource ID %q: %s", state.Id.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	db, err := postgresqlsdk.NewDatabaseClient(r.providerConfig.SDKv2).Get(ctx, &postgresql.GetDatabaseRequest{
+		ClusterId:    cid,
+		DatabaseName: dbName,
+	})
+	if err != nil {
+		f := resp.Diagnostics.AddError
+		if validate.IsStatusWithCode(err, codes.NotFound) {
+			resp.State.RemoveResource(ctx)
+			f = resp.Diagnostics.AddWarning
+		}
+
+		f(
+			"Failed to Read resource",
+			fmt.Sprintf("Error while requesting API to read PostgreSQL database %q in cluster %q: %s", dbName, cid, err.Error()),
+		)
+		return
+	}
+
+	databaseToState(ctx, db, &state, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *databaseResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan Database
+	var state Database
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, yandexMDBPostgreSQLDatabaseDefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	cid, dbName, err := resourceid.Deconstruct(state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to parse resource ID",
+			fmt.Sprintf("Error parsing resource ID %q: %s", state.Id.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	updateReq := &postgresql.UpdateDatabaseRequest{
+		ClusterId:    cid,
+		DatabaseName: dbName,
+	}
+
+	var updatePaths []string
+
+	if !plan.Name.Equal(state.Name) {
+		updateReq.NewDatabaseName = plan.Name.ValueString()
+		updatePaths = append(updatePaths, "new_database_name")
+	}
+
+	if !plan.Extensions.Equal(state.Extensions) {
+		updateReq.Extensions = expandExtensions(ctx, plan.Extensions, &resp.Diagnostics)
+		updatePaths = append(updatePaths, "extensions")
+	}
+
+	if !plan.DeletionProtection.Equal(state.DeletionProtection) {
+		updateReq.DeletionProtection = tristateBoolean[plan.DeletionProtection.ValueString()]
+		updatePaths = append(updatePaths, "deletion_protection")
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if len(updatePaths) > 0 {
+		updateReq.UpdateMask = &fieldmaskpb.FieldMask{
+			Paths: updatePaths,
+		}
+
+		UpdateDatabase(ctx, r.providerConfig.SDKv2, &resp.Diagnostics, updateReq)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	db := ReadDatabase(ctx, r.providerConfig.SDKv2, &resp.Diagnostics, cid, plan.Name.ValueString())
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	databaseToState(ctx, db, &plan, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *databaseResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state Database
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, yandexMDBPostgreSQLDatabaseDefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	cid, dbName, err := resourceid.Deconstruct(state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to parse resource ID",
+			fmt.Sprintf("Error parsing resource ID %q: %s", state.Id.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	DeleteDatabase(ctx, r.providerConfig.SDKv2, &resp.Diagnostics, cid, dbName)
+}
+
+func (r *databaseResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	cid, dbName, err := resourceid.Deconstruct(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <cluster_id>:<database_name>. Got: %q. Error: %s", req.ID, err.Error()),
+		)
+		return
+	}
+
+	db := ReadDatabase(ctx, r.providerConfig.SDKv2, &resp.Diagnostics, cid, dbName)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state := Database{
+		Extensions: types.SetNull(types.ObjectType{AttrTypes: extensionAttrTypes}),
+		Timeouts: timeouts.Value{
+			Object: types.ObjectNull(map[string]attr.Type{
+				"create": types.StringType,
+				"update": types.StringType,
+				"delete": types.StringType,
+			}),
+		},
+	}
+	databaseToState(ctx, db, &state, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,240 @@
+package mdb_postgresql_database_v2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/postgresql/v1"
+	postgresqlsdk "github.com/yandex-cloud/go-sdk/services/mdb/postgresql/v1"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/resourceid"
+	test "github.com/yandex-cloud/terraform-provider-yandex/pkg/testhelpers"
+	yandex_framework "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider"
+)
+
+const (
+	pgDatabaseV2ResourceName = "yandex_mdb_postgresql_database_v2.foo"
+	pgDatabaseResourceName   = "yandex_mdb_postgresql_database.foo"
+)
+
+const pgDatabaseV2Dependencies = `
+resource "yandex_vpc_network" "foo" {}
+
+resource "yandex_vpc_subnet" "foo" {
+  zone           = "ru-central1-a"
+  network_id     = yandex_vpc_network.foo.id
+  v4_cidr_blocks = ["10.1.0.0/24"]
+}
+
+resource "yandex_mdb_postgresql_cluster_v2" "foo" {
+  name        = "%s"
+  environment = "PRESTABLE"
+  network_id  = yandex_vpc_network.foo.id
+
+  hosts = {
+    "na" = {
+      zone      = "ru-central1-a"
+      subnet_id = yandex_vpc_subnet.foo.id
+    }
+  }
+
+  config {
+    version = "16"
+    resources {
+      resource_preset_id = "s2.micro"
+      disk_size          = 10
+      disk_type_id       = "network-ssd"
+    }
+  }
+}
+
+resource "yandex_mdb_postgresql_user_v2" "alice" {
+  cluster_id = yandex_mdb_postgresql_cluster_v2.foo.id
+  name       = "alice"
+  password   = "mysecurepassword"
+}
+`
+
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
+
+func TestAccMDBPostgreSQLDatabaseV2_basic(t *testing.T) {
+	t.Parallel()
+
+	clusterName := acctest.RandomWithPrefix("tf-postgresql-database-v2")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.AccPreCheck(t) },
+		ProtoV6ProviderFactories: test.AccProviderFactories,
+		CheckDestroy:             testAccCheckMDBPostgreSQLDatabaseV2Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMDBPostgreSQLDatabaseV2Config(clusterName, "testdb", `
+  extension {
+    name = "uuid-ossp"
+  }
+`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMDBPostgreSQLDatabaseV2Exists(pgDatabaseV2ResourceName, 1),
+					resource.TestCheckResourceAttr(pgDatabaseV2ResourceName, "owner", "alice"),
+					resource.TestCheckResourceAttr(pgDatabaseV2ResourceName, "lc_collate", "C"),
+					resource.TestCheckResourceAttr(pgDatabaseV2ResourceName, "extension.#", "1"),
+					resource.TestCheckNoResourceAttr(pgDatabaseV2ResourceName, "extension.0.version"),
+				),
+			},
+			mdbPostgreSQLDatabaseV2ImportStep(pgDatabaseV2ResourceName),
+			{
+				Config: testAccMDBPostgreSQLDatabaseV2Config(clusterName, "renameddb", `
+  deletion_protection = "false"
+
+  extension {
+    name = "uuid-ossp"
+  }
+
+  extension {
+    name    = "pg_trgm"
+    version = "1.6"
+  }
+`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(pgDatabaseV2ResourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMDBPostgreSQLDatabaseV2Exists(pgDatabaseV2ResourceName, 2),
+					resource.TestCheckResourceAttr(pgDatabaseV2ResourceName, "name", "renameddb"),
+					resource.TestCheckResourceAttr(pgDatabaseV2ResourceName, "deletion_protection", "false"),
+					resource.TestCheckTypeSetElemNestedAttrs(pgDatabaseV2ResourceName, "extension.*", map[string]string{
+						"name":    "pg_trgm",
+						"version": "1.6",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMDBPostgreSQLDatabaseV2_moveState(t *testing.T) {
+	t.Parallel()
+
+	clusterName := acctest.RandomWithPrefix("tf-postgresql-database-v2")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.AccPreCheck(t) },
+		ProtoV6ProviderFactories: test.AccProviderFactories,
+		CheckDestroy:             testAccCheckMDBPostgreSQLDatabaseV2Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(pgDatabaseV2Dependencies, clusterName) + `
+resource "yandex_mdb_postgresql_database" "foo" {
+  cluster_id = yandex_mdb_postgresql_cluster_v2.foo.id
+  name       = "testdb"
+  owner      = yandex_mdb_postgresql_user_v2.alice.name
+
+  extension {
+    name = "uuid-ossp"
+  }
+}
+`,
+				Check: resource.TestCheckResourceAttrSet(pgDatabaseResourceName, "id"),
+			},
+			{
+				Config: testAccMDBPostgreSQLDatabaseV2Config(clusterName, "testdb", `
+  extension {
+    name = "uuid-ossp"
+  }
+`) + `
+moved {
+  from = yandex_mdb_postgresql_database.foo
+  to   = yandex_mdb_postgresql_database_v2.foo
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(pgDatabaseV2ResourceName, plancheck.ResourceActionNoop),
+					},
+				},
+				Check: testAccCheckMDBPostgreSQLDatabaseV2Exists(pgDatabaseV2ResourceName, 1),
+			},
+		},
+	})
+}
+
+func mdbPostgreSQLDatabaseV2ImportStep(name string) resource.TestStep {
+	return resource.TestStep{
+		ResourceName:      name,
+		ImportState:       true,
+		ImportStateVerify: true,
+	}
+}
+
+func testAccCheckMDBPostgreSQLDatabaseV2Exists(resourceName string, extensions int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+
+		cid, dbName, err := resourceid.Deconstruct(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		config := test.AccProvider.(*yandex_framework.Provider).GetConfig()
+		db, err := postgresqlsdk.NewDatabaseClient(config.SDKv2).Get(context.Background(), &postgresql.GetDatabaseRequest{
+			ClusterId:    cid,
+			DatabaseName: dbName,
+		})
+		if err != nil {
+			return fmt.Errorf("PostgreSQL database not found: %v", err)
+		}
+
+		if len(db.Extensions) != extensions {
+			return fmt.Errorf("expected %d extensions of PostgreSQL database %q, got %d", extensions, dbName, len(db.Extensions))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckMDBPostgreSQLDatabaseV2Destroy(s *terraform.State) error {
+	config := test.AccProvider.(*yandex_framework.Provider).GetConfig()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "yandex_mdb_postgresql_database_v2" {
+			continue
+		}
+
+		cid, dbName, err := resourceid.Deconstruct(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = postgresqlsdk.NewDatabaseClient(config.SDKv2).Get(context.Background(), &postgresql.GetDatabaseRequest{
+			ClusterId:    cid,
+			DatabaseName: dbName,
+		})
+		if err == nil {
+			return fmt.Errorf("PostgreSQL database %q in cluster %q still exists", dbName, cid)
+		}
+	}
+
+	return nil
+}
+
+func testAccMDBPostgreSQLDatabaseV2Config(clusterName, dbName, dbBody string) string {
+	return fmt.Sprintf(pgDatabaseV2Dependencies, clusterName) + fmt.Sprintf(`
+resource "yandex_mdb_postgresql_database_v2" "foo" {
+  cluster_id = yandex_mdb_postgresql_cluster_v2.foo.id
+  name       = "%s"
+  owner      = yandex_mdb_postgresql_user_v2.alice.name
+%s
+}
+`, dbName, dbBody)
+}
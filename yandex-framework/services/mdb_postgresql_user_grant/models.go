@@ -0,0 +1,29 @@
+package mdb_postgresql_user_grant
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type Grant struct {
+	Id        types.String   `tfsdk:"id"`
+	ClusterID types.String   `tfsdk:"cluster_id"`
+	UserName  types.String   `tfsdk:"user_name"`
+	Grant     types.String   `tfsdk:"grant"`
+	Timeouts  timeouts.Value `tfsdk:"timeouts"`
+}
+
+func constructId(cid, userName, grant string) string {
+	return fmt.Sprintf("%s:%s:%s", cid, userName, grant)
+}
+
+func deconstructId(id string) (string, string, string, error) {
+	parts := strings.SplitN(id, ":", 3)
+	if len(parts) != 3 {
+		return "", "", "", fmt.Errorf("Invalid resource id format: %q", id)
+	}
+	return parts[0], parts[1], parts[2], nil
+}
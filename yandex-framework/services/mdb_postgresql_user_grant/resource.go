@@ -0,0 +1,290 @@
+package mdb_postgresql_user_grant
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/postgresql/v1"
+	postgresqlsdk "github.com/yandex-cloud/go-sdk/services/mdb/postgresql/v1"
+	"github.com/yandex-cloud/terraform-provider-yandex/common"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/globallock"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/validate"
+	provider_config "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/mdb_postgresql_user_v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+const (
+	yandexMDBPostgreSQLUserGrantDefaultTimeout = 10 * time.Minute
+)
+
+var (
+	_ resource.Resource                = &grantResource{}
+	_ resource.ResourceWithImportState = &grantResource{}
+)
+
+type grantResource struct {
+	providerConfig *provider_config.Config
+}
+
+func NewResource() resource.Resource {
+	return &grantResource{}
+}
+
+func (r *grantResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mdb_postgresql_user_grant"
+}
+
+func (r *grantResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerConfig, ok := req.ProviderData.(*provider_config.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider_config.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.providerConfig = providerConfig
+}
+
+func (r *grantResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Grants a role to a PostgreSQL user within the Yandex Cloud. For more information, see [the official documentation](https://yandex.cloud/docs/managed-postgresql/operations/grant).\n\n" +
+			"~> The other grants of the user are left untouched, so the grants of one user can be managed by the different modules.\n",
+		Attributes: map[string]schema.Attribute{
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+			"id": schema.StringAttribute{
+				MarkdownDescription: common.ResourceDescriptions["id"],
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"cluster_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the PostgreSQL cluster.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the user the role is granted to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"grant": schema.StringAttribute{
+				MarkdownDescription: "The name of the granted role, e.g. `mdb_admin` or the name of another user.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *grantResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan Grant
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, yandexMDBPostgreSQLUserGrantDefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	cid := plan.ClusterID.ValueString()
+	userName := plan.UserName.ValueString()
+	grant := plan.Grant.ValueString()
+
+	mutexKV := globallock.GetMutexKV()
+	mutexKV.Lock(mdb_postgresql_user_v2.MutexKey(cid, userName))
+	defer mutexKV.Unlock(mdb_postgresql_user_v2.MutexKey(cid, userName))
+
+	user := mdb_postgresql_user_v2.ReadUser(ctx, r.providerConfig.SDKv2, &resp.Diagnostics, cid, userName)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !slices.Contains(user.Grants, grant) {
+		r.updateGrants(ctx, cid, userName, append(user.Grants, grant), &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	plan.Id = types.StringValue(constructId(cid, userName, grant))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *grantResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state Grant
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cid, userName, grant, err := deconstructId(state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to parse resource ID",
+			fmt.Sprintf("Error parsing resource ID %q: %s", state.Id.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	user, err := postgresqlsdk.NewUserClient(r.providerConfig.SDKv2).Get(ctx, &postgresql.GetUserRequest{
+		ClusterId: cid,
+		UserName:  userName,
+	})
+	if err != nil {
+		f := resp.Diagnostics.AddError
+		if validate.IsStatusWithCode(err, codes.NotFound) {
+			resp.State.RemoveResource(ctx)
+			f = resp.Diagnostics.AddWarning
+		}
+
+		f(
+			"Failed to Read resource",
+			fmt.Sprintf("Error while requesting API to read PostgreSQL user %q in cluster %q: %s", userName, cid, err.Error()),
+		)
+		return
+	}
+
+	if !slices.Contains(user.Grants, grant) {
+		resp.State.RemoveResource(ctx)
+		resp.Diagnostics.AddWarning(
+			"Failed to Read resource",
+			fmt.Sprintf("Role %q is not granted to PostgreSQL user %q in cluster %q", grant, userName, cid),
+		)
+		return
+	}
+
+	state.ClusterID = types.StringValue(cid)
+	state.UserName = types.StringValue(userName)
+	state.Grant = types.StringValue(grant)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *grantResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan Grant
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// All the attributes except timeouts require replacement.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *grantResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state Grant
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, yandexMDBPostgreSQLUserGrantDefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	cid, userName, grant, err := deconstructId(state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to parse resource ID",
+			fmt.Sprintf("Error parsing resource ID %q: %s", state.Id.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	mutexKV := globallock.GetMutexKV()
+	mutexKV.Lock(mdb_postgresql_user_v2.MutexKey(cid, userName))
+	defer mutexKV.Unlock(mdb_postgresql_user_v2.MutexKey(cid, userName))
+
+	user, err := postgresqlsdk.NewUserClient(r.providerConfig.SDKv2).Get(ctx, &postgresql.GetUserRequest{
+		ClusterId: cid,
+		UserName:  userName,
+	})
+	if err != nil {
+		if validate.IsStatusWithCode(err, codes.NotFound) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failed to Delete resource",
+			fmt.Sprintf("Error while requesting API to read PostgreSQL user %q in cluster %q: %s", userName, cid, err.Error()),
+		)
+		return
+	}
+
+	grants := slices.DeleteFunc(slices.Clone(user.Grants), func(g string) bool { return g == grant })
+	if len(grants) == len(user.Grants) {
+		return
+	}
+
+	r.updateGrants(ctx, cid, userName, grants, &resp.Diagnostics)
+}
+
+func (r *grantResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	cid, userName, grant, err := deconstructId(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <cluster_id>:<user_name>:<grant>. Got: %q. Error: %s", req.ID, err.Error()),
+		)
+		return
+	}
+
+	state := Grant{
+		Id:        types.StringValue(req.ID),
+		ClusterID: types.StringValue(cid),
+		UserName:  types.StringValue(userName),
+		Grant:     types.StringValue(grant),
+		Timeouts: timeouts.Value{
+			Object: types.ObjectNull(map[string]attr.Type{
+				"create": types.StringType,
+				"delete": types.StringType,
+			}),
+		},
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *grantResource) updateGrants(ctx context.Context, cid, userName string, grants []string, diags *diag.Diagnostics) {
+	mdb_postgresql_user_v2.UpdateUser(ctx, r.providerConfig.SDKv2, diags, &postgresql.UpdateUserRequest{
+		ClusterId:  cid,
+		UserName:   userName,
+		Grants:     grants,
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"grants"}},
+	})
+}
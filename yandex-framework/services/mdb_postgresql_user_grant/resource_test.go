@@ -0,0 +1,151 @@
+package mdb_postgresql_user_grant_test
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/postgresql/v1"
+	postgresqlsdk "github.com/yandex-cloud/go-sdk/services/mdb/postgresql/v1"
+	test "github.com/yandex-cloud/terraform-provider-yandex/pkg/testhelpers"
+	yandex_framework "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider"
+)
+
+const pgUserGrantResourceName = "yandex_mdb_postgresql_user_grant.alice_admin"
+
+const pgUserGrantConfig = `
+resource "yandex_vpc_network" "foo" {}
+
+resource "yandex_vpc_subnet" "foo" {
+  zone           = "ru-central1-a"
+  network_id     = yandex_vpc_network.foo.id
+  v4_cidr_blocks = ["10.1.0.0/24"]
+}
+
+resource "yandex_mdb_postgresql_cluster_v2" "foo" {
+  name        = "%s"
+  environment = "PRESTABLE"
+  network_id  = yandex_vpc_network.foo.id
+
+  hosts = {
+    "na" = {
+      zone      = "ru-central1-a"
+      subnet_id = yandex_vpc_subnet.foo.id
+    }
+  }
+
+  config {
+    version = "16"
+    resources {
+      resource_preset_id = "s2.micro"
+      disk_size          = 10
+      disk_type_id       = "network-ssd"
+    }
+  }
+}
+
+resource "yandex_mdb_postgresql_user_v2" "alice" {
+  cluster_id = yandex_mdb_postgresql_cluster_v2.foo.id
+  name       = "alice"
+  password   = "mysecurepassword"
+}
+
+resource "yandex_mdb_postgresql_user_grant" "alice_admin" {
+  cluster_id = yandex_mdb_postgresql_cluster_v2.foo.id
+  user_name  = yandex_mdb_postgresql_user_v2.alice.name
+  grant      = "mdb_admin"
+}
+
+resource "yandex_mdb_postgresql_user_grant" "alice_monitor" {
+  cluster_id = yandex_mdb_postgresql_cluster_v2.foo.id
+  user_name  = yandex_mdb_postgresql_user_v2.alice.name
+  grant      = "mdb_monitor"
+}
+`
+
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
+
+func TestAccMDBPostgreSQLUserGrant_basic(t *testing.T) {
+	t.Parallel()
+
+	clusterName := acctest.RandomWithPrefix("tf-postgresql-user-grant")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.AccPreCheck(t) },
+		ProtoV6ProviderFactories: test.AccProviderFactories,
+		CheckDestroy:             testAccCheckMDBPostgreSQLUserGrantDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(pgUserGrantConfig, clusterName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMDBPostgreSQLUserGrantExists(pgUserGrantResourceName),
+					testAccCheckMDBPostgreSQLUserGrantExists("yandex_mdb_postgresql_user_grant.alice_monitor"),
+				),
+			},
+			{
+				ResourceName:      pgUserGrantResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccGetMDBPostgreSQLUserGrants(id string) (string, []string, error) {
+	parts := strings.SplitN(id, ":", 3)
+	if len(parts) != 3 {
+		return "", nil, fmt.Errorf("invalid resource id format: %q", id)
+	}
+
+	config := test.AccProvider.(*yandex_framework.Provider).GetConfig()
+	user, err := postgresqlsdk.NewUserClient(config.SDKv2).Get(context.Background(), &postgresql.GetUserRequest{
+		ClusterId: parts[0],
+		UserName:  parts[1],
+	})
+	if err != nil {
+		return "", nil, err
+	}
+
+	return parts[2], user.Grants, nil
+}
+
+func testAccCheckMDBPostgreSQLUserGrantExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+
+		grant, grants, err := testAccGetMDBPostgreSQLUserGrants(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("PostgreSQL user not found: %v", err)
+		}
+
+		if !slices.Contains(grants, grant) {
+			return fmt.Errorf("role %q is not granted, grants: %v", grant, grants)
+		}
+		return nil
+	}
+}
+
+func testAccCheckMDBPostgreSQLUserGrantDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "yandex_mdb_postgresql_user_grant" {
+			continue
+		}
+
+		grant, grants, err := testAccGetMDBPostgreSQLUserGrants(rs.Primary.ID)
+		if err == nil && slices.Contains(grants, grant) {
+			return fmt.Errorf("role %q is still granted", grant)
+		}
+	}
+
+	return nil
+}
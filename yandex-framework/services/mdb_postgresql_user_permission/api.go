@@ -0,0 +1,71 @@
+package mdb_postgresql_user_permission
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/postgresql/v1"
+	postgresqlsdk "github.com/yandex-cloud/go-sdk/services/mdb/postgresql/v1"
+	ycsdk "github.com/yandex-cloud/go-sdk/v2"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/retry"
+)
+
+func grantPermission(ctx context.Context, sdk *ycsdk.SDK, diags *diag.Diagnostics, cid, userName, dbName string) {
+	op, err := retry.ConflictingOperationV2(ctx, sdk, func() (*postgresqlsdk.UserGrantPermissionOperation, error) {
+		return postgresqlsdk.NewUserClient(sdk).GrantPermission(ctx, &postgresql.GrantUserPermissionRequest{
+			ClusterId: cid,
+			UserName:  userName,
+			Permission: &postgresql.Permission{
+				DatabaseName: dbName,
+			},
+		})
+	})
+	if err != nil {
+		diags.AddError(
+			"Failed to Create resource",
+			fmt.Sprintf("Error while requesting API to grant permission on database %q to PostgreSQL user %q in cluster %q: %s", dbName, userName, cid, err.Error()),
+		)
+		return
+	}
+
+	if _, err = op.Wait(ctx); err != nil {
+		diags.AddError(
+			"Failed to Create resource",
+			fmt.Sprintf("Error while waiting for operation to grant permission on database %q to PostgreSQL user %q in cluster %q: %s", dbName, userName, cid, err.Error()),
+		)
+	}
+}
+
+func revokePermission(ctx context.Context, sdk *ycsdk.SDK, diags *diag.Diagnostics, cid, userName, dbName string) {
+	op, err := retry.ConflictingOperationV2(ctx, sdk, func() (*postgresqlsdk.UserRevokePermissionOperation, error) {
+		return postgresqlsdk.NewUserClient(sdk).RevokePermission(ctx, &postgresql.RevokeUserPermissionRequest{
+			ClusterId:    cid,
+			UserName:     userName,
+			DatabaseName: dbName,
+		})
+	})
+	if err != nil {
+		diags.AddError(
+			"Failed to Delete resource",
+			fmt.Sprintf("Error while requesting API to revoke permission on database %q from PostgreSQL user %q in cluster %q: %s", dbName, userName, cid, err.Error()),
+		)
+		return
+	}
+
+	if _, err = op.Wait(ctx); err != nil {
+		diags.AddError(
+			"Failed to Delete resource",
+			fmt.Sprintf("Error while waiting for operation to revoke permission on database %q from PostgreSQL user %q in cluster %q: %s", dbName, userName, cid, err.Error()),
+		)
+	}
+}
+
+func hasPermission(user *postgresql.User, dbName string) bool {
+	for _, p := range user.GetPermissions() {
+		if p.GetDatabaseName() == dbName {
+			return true
+		}
+	}
+	return false
+}
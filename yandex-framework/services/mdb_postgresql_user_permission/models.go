@@ -0,0 +1,29 @@
+package mdb_postgresql_user_permission
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type Permission struct {
+	Id           types.String   `tfsdk:"id"`
+	ClusterID    types.String   `tfsdk:"cluster_id"`
+	UserName     types.String   `tfsdk:"user_name"`
+	DatabaseName types.String   `tfsdk:"database_name"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
+}
+
+func constructId(cid, userName, dbName string) string {
+	return fmt.Sprintf("%s:%s:%s", cid, userName, dbName)
+}
+
+func deconstructId(id string) (string, string, string, error) {
+	parts := strings.SplitN(id, ":", 3)
+	if len(parts) != 3 {
+		return "", "", "", fmt.Errorf("Invalid resource id format: %q", id)
+	}
+	return parts[0], parts[1], parts[2], nil
+}
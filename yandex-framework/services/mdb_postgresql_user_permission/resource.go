@@ -0,0 +1,277 @@
+package mdb_postgresql_user_permission
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/postgresql/v1"
+	postgresqlsdk "github.com/yandex-cloud/go-sdk/services/mdb/postgresql/v1"
+	"github.com/yandex-cloud/terraform-provider-yandex/common"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/globallock"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/validate"
+	provider_config "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/mdb_postgresql_user_v2"
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	yandexMDBPostgreSQLUserPermissionDefaultTimeout = 10 * time.Minute
+)
+
+var (
+	_ resource.Resource                = &permissionResource{}
+	_ resource.ResourceWithImportState = &permissionResource{}
+)
+
+type permissionResource struct {
+	providerConfig *provider_config.Config
+}
+
+func NewResource() resource.Resource {
+	return &permissionResource{}
+}
+
+func (r *permissionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mdb_postgresql_user_permission"
+}
+
+func (r *permissionResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerConfig, ok := req.ProviderData.(*provider_config.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider_config.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.providerConfig = providerConfig
+}
+
+func (r *permissionResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Grants a PostgreSQL user access to a database within the Yandex Cloud. For more information, see [the official documentation](https://yandex.cloud/docs/managed-postgresql/operations/cluster-users).\n\n" +
+			"~> The other permissions of the user are left untouched, so the database and the user can be managed by the different modules.\n",
+		Attributes: map[string]schema.Attribute{
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+			"id": schema.StringAttribute{
+				MarkdownDescription: common.ResourceDescriptions["id"],
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"cluster_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the PostgreSQL cluster.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the user the access is granted to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"database_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the database the user is granted access to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *permissionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan Permission
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, yandexMDBPostgreSQLUserPermissionDefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	cid := plan.ClusterID.ValueString()
+	userName := plan.UserName.ValueString()
+	dbName := plan.DatabaseName.ValueString()
+
+	mutexKV := globallock.GetMutexKV()
+	mutexKV.Lock(mdb_postgresql_user_v2.MutexKey(cid, userName))
+	defer mutexKV.Unlock(mdb_postgresql_user_v2.MutexKey(cid, userName))
+
+	user := mdb_postgresql_user_v2.ReadUser(ctx, r.providerConfig.SDKv2, &resp.Diagnostics, cid, userName)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !hasPermission(user, dbName) {
+		grantPermission(ctx, r.providerConfig.SDKv2, &resp.Diagnostics, cid, userName, dbName)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	plan.Id = types.StringValue(constructId(cid, userName, dbName))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *permissionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state Permission
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cid, userName, dbName, err := deconstructId(state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to parse resource ID",
+			fmt.Sprintf("Error parsing resource ID %q: %s", state.Id.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	user, err := postgresqlsdk.NewUserClient(r.providerConfig.SDKv2).Get(ctx, &postgresql.GetUserRequest{
+		ClusterId: cid,
+		UserName:  userName,
+	})
+	if err != nil {
+		f := resp.Diagnostics.AddError
+		if validate.IsStatusWithCode(err, codes.NotFound) {
+			resp.State.RemoveResource(ctx)
+			f = resp.Diagnostics.AddWarning
+		}
+
+		f(
+			"Failed to Read resource",
+			fmt.Sprintf("Error while requesting API to read PostgreSQL user %q in cluster %q: %s", userName, cid, err.Error()),
+		)
+		return
+	}
+
+	if !hasPermission(user, dbName) {
+		resp.State.RemoveResource(ctx)
+		resp.Diagnostics.AddWarning(
+			"Failed to Read resource",
+			fmt.Sprintf("PostgreSQL user %q in cluster %q has no permission on database %q", userName, cid, dbName),
+		)
+		return
+	}
+
+	state.ClusterID = types.StringValue(cid)
+	state.UserName = types.StringValue(userName)
+	state.DatabaseName = types.StringValue(dbName)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *permissionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan Permission
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// All the attributes except timeouts require replacement.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *permissionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state Permission
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, yandexMDBPostgreSQLUserPermissionDefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	cid, userName, dbName, err := deconstructId(state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to parse resource ID",
+			fmt.Sprintf("Error parsing resource ID %q: %s", state.Id.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	mutexKV := globallock.GetMutexKV()
+	mutexKV.Lock(mdb_postgresql_user_v2.MutexKey(cid, userName))
+	defer mutexKV.Unlock(mdb_postgresql_user_v2.MutexKey(cid, userName))
+
+	user, err := postgresqlsdk.NewUserClient(r.providerConfig.SDKv2).Get(ctx, &postgresql.GetUserRequest{
+		ClusterId: cid,
+		UserName:  userName,
+	})
+	if err != nil {
+		if validate.IsStatusWithCode(err, codes.NotFound) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failed to Delete resource",
+			fmt.Sprintf("Error while requesting API to read PostgreSQL user %q in cluster %q: %s", userName, cid, err.Error()),
+		)
+		return
+	}
+
+	if !hasPermission(user, dbName) {
+		return
+	}
+
+	revokePermission(ctx, r.providerConfig.SDKv2, &resp.Diagnostics, cid, userName, dbName)
+}
+
+func (r *permissionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	cid, userName, dbName, err := deconstructId(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <cluster_id>:<user_name>:<database_name>. Got: %q. Error: %s", req.ID, err.Error()),
+		)
+		return
+	}
+
+	state := Permission{
+		Id:           types.StringValue(req.ID),
+		ClusterID:    types.StringValue(cid),
+		UserName:     types.StringValue(userName),
+		DatabaseName: types.StringValue(dbName),
+		Timeouts: timeouts.Value{
+			Object: types.ObjectNull(map[string]attr.Type{
+				"create": types.StringType,
+				"delete": types.StringType,
+			}),
+		},
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,157 @@
+package mdb_postgresql_user_permission_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/postgresql/v1"
+	postgresqlsdk "github.com/yandex-cloud/go-sdk/services/mdb/postgresql/v1"
+	test "github.com/yandex-cloud/terraform-provider-yandex/pkg/testhelpers"
+	yandex_framework "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider"
+)
+
+const pgUserPermissionResourceName = "yandex_mdb_postgresql_user_permission.bob_testdb"
+
+const pgUserPermissionConfig = `
+resource "yandex_vpc_network" "foo" {}
+
+resource "yandex_vpc_subnet" "foo" {
+  zone           = "ru-central1-a"
+  network_id     = yandex_vpc_network.foo.id
+  v4_cidr_blocks = ["10.1.0.0/24"]
+}
+
+resource "yandex_mdb_postgresql_cluster_v2" "foo" {
+  name        = "%s"
+  environment = "PRESTABLE"
+  network_id  = yandex_vpc_network.foo.id
+
+  hosts = {
+    "na" = {
+      zone      = "ru-central1-a"
+      subnet_id = yandex_vpc_subnet.foo.id
+    }
+  }
+
+  config {
+    version = "16"
+    resources {
+      resource_preset_id = "s2.micro"
+      disk_size          = 10
+      disk_type_id       = "network-ssd"
+    }
+  }
+}
+
+resource "yandex_mdb_postgresql_user_v2" "alice" {
+  cluster_id = yandex_mdb_postgresql_cluster_v2.foo.id
+  name       = "alice"
+  password   = "mysecurepassword"
+}
+
+resource "yandex_mdb_postgresql_user_v2" "bob" {
+  cluster_id = yandex_mdb_postgresql_cluster_v2.foo.id
+  name       = "bob"
+  password   = "mysecurepassword"
+}
+
+resource "yandex_mdb_postgresql_database_v2" "testdb" {
+  cluster_id = yandex_mdb_postgresql_cluster_v2.foo.id
+  name       = "testdb"
+  owner      = yandex_mdb_postgresql_user_v2.alice.name
+}
+
+resource "yandex_mdb_postgresql_user_permission" "bob_testdb" {
+  cluster_id    = yandex_mdb_postgresql_cluster_v2.foo.id
+  user_name     = yandex_mdb_postgresql_user_v2.bob.name
+  database_name = yandex_mdb_postgresql_database_v2.testdb.name
+}
+`
+
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
+
+func TestAccMDBPostgreSQLUserPermission_basic(t *testing.T) {
+	t.Parallel()
+
+	clusterName := acctest.RandomWithPrefix("tf-postgresql-user-permission")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.AccPreCheck(t) },
+		ProtoV6ProviderFactories: test.AccProviderFactories,
+		CheckDestroy:             testAccCheckMDBPostgreSQLUserPermissionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(pgUserPermissionConfig, clusterName),
+				Check:  testAccCheckMDBPostgreSQLUserPermissionExists(pgUserPermissionResourceName),
+			},
+			{
+				ResourceName:      pgUserPermissionResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccHasMDBPostgreSQLUserPermission(id string) (bool, error) {
+	parts := strings.SplitN(id, ":", 3)
+	if len(parts) != 3 {
+		return false, fmt.Errorf("invalid resource id format: %q", id)
+	}
+
+	config := test.AccProvider.(*yandex_framework.Provider).GetConfig()
+	user, err := postgresqlsdk.NewUserClient(config.SDKv2).Get(context.Background(), &postgresql.GetUserRequest{
+		ClusterId: parts[0],
+		UserName:  parts[1],
+	})
+	if err != nil {
+		return false, err
+	}
+
+	for _, p := range user.Permissions {
+		if p.DatabaseName == parts[2] {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func testAccCheckMDBPostgreSQLUserPermissionExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+
+		ok, err := testAccHasMDBPostgreSQLUserPermission(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("PostgreSQL user not found: %v", err)
+		}
+		if !ok {
+			return fmt.Errorf("PostgreSQL user permission %q not found", rs.Primary.ID)
+		}
+		return nil
+	}
+}
+
+func testAccCheckMDBPostgreSQLUserPermissionDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "yandex_mdb_postgresql_user_permission" {
+			continue
+		}
+
+		ok, err := testAccHasMDBPostgreSQLUserPermission(rs.Primary.ID)
+		if err == nil && ok {
+			return fmt.Errorf("PostgreSQL user permission %q still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,98 @@
+package mdb_postgresql_user_v2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/postgresql/v1"
+	postgresqlsdk "github.com/yandex-cloud/go-sdk/services/mdb/postgresql/v1"
+	ycsdk "github.com/yandex-cloud/go-sdk/v2"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/retry"
+)
+
+// MutexKey returns the key to serialize the changes of a user made by the different resources.
+func MutexKey(cid, userName string) string {
+	return fmt.Sprintf("mdb-postgresql-user-%s-%s", cid, userName)
+}
+
+func ReadUser(ctx context.Context, sdk *ycsdk.SDK, diags *diag.Diagnostics, cid, userName string) *postgresql.User {
+	user, err := postgresqlsdk.NewUserClient(sdk).Get(ctx, &postgresql.GetUserRequest{
+		ClusterId: cid,
+		UserName:  userName,
+	})
+	if err != nil {
+		diags.AddError(
+			"Failed to Read resource",
+			fmt.Sprintf("Error while requesting API to read PostgreSQL user %q in cluster %q: %s", userName, cid, err.Error()),
+		)
+		return nil
+	}
+	return user
+}
+
+func CreateUser(ctx context.Context, sdk *ycsdk.SDK, diags *diag.Diagnostics, cid string, spec *postgresql.UserSpec) {
+	op, err := retry.ConflictingOperationV2(ctx, sdk, func() (*postgresqlsdk.UserCreateOperation, error) {
+		return postgresqlsdk.NewUserClient(sdk).Create(ctx, &postgresql.CreateUserRequest{
+			ClusterId: cid,
+			UserSpec:  spec,
+		})
+	})
+	if err != nil {
+		diags.AddError(
+			"Failed to Create resource",
+			fmt.Sprintf("Error while requesting API to create PostgreSQL user %q in cluster %q: %s", spec.Name, cid, err.Error()),
+		)
+		return
+	}
+
+	if _, err = op.Wait(ctx); err != nil {
+		diags.AddError(
+			"Failed to Create resource",
+			fmt.Sprintf("Error while waiting for operation to create PostgreSQL user %q in cluster %q: %s", spec.Name, cid, err.Error()),
+		)
+	}
+}
+
+func UpdateUser(ctx context.Context, sdk *ycsdk.SDK, diags *diag.Diagnostics, req *postgresql.UpdateUserRequest) {
+	op, err := retry.ConflictingOperationV2(ctx, sdk, func() (*postgresqlsdk.UserUpdateOperation, error) {
+		return postgresqlsdk.NewUserClient(sdk).Update(ctx, req)
+	})
+	if err != nil {
+		diags.AddError(
+			"Failed to Update resource",
+			fmt.Sprintf("Error while requesting API to update PostgreSQL user %q in cluster %q: %s", req.UserName, req.ClusterId, err.Error()),
+		)
+		return
+	}
+
+	if _, err = op.Wait(ctx); err != nil {
+		diags.AddError(
+			"Failed to Update resource",
+			fmt.Sprintf("Error while waiting for operation to update PostgreSQL user %q in cluster %q: %s", req.UserName, req.ClusterId, err.Error()),
+		)
+	}
+}
+
+func DeleteUser(ctx context.Context, sdk *ycsdk.SDK, diags *diag.Diagnostics, cid, userName string) {
+	op, err := retry.ConflictingOperationV2(ctx, sdk, func() (*postgresqlsdk.UserDeleteOperation, error) {
+		return postgresqlsdk.NewUserClient(sdk).Delete(ctx, &postgresql.DeleteUserRequest{
+			ClusterId: cid,
+			UserName:  userName,
+		})
+	})
+	if err != nil {
+		diags.AddError(
+			"Failed to Delete resource",
+			fmt.Sprintf("Error while requesting API to delete PostgreSQL user %q in cluster %q: %s", userName, cid, err.Error()),
+		)
+		return
+	}
+
+	if _, err = op.Wait(ctx); err != nil {
+		diags.AddError(
+			"Failed to Delete resource",
+			fmt.Sprintf("Error while waiting for operation to delete PostgreSQL user %q in cluster %q: %s", userName, cid, err.Error()),
+		)
+	}
+}
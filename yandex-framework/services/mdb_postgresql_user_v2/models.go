@@ -0,0 +1,234 @@
+package mdb_postgresql_user_v2
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/postgresql/v1"
+	protobuf_adapter "github.com/yandex-cloud/terraform-provider-yandex/pkg/adapters/protobuf"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/mdbcommon"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/resourceid"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+const pgAuditLogPrefix = "PG_AUDIT_SETTINGS_LOG_"
+
+type User struct {
+	Id                     types.String               `tfsdk:"id"`
+	ClusterID              types.String               `tfsdk:"cluster_id"`
+	Name                   types.String               `tfsdk:"name"`
+	Password               types.String               `tfsdk:"password"`
+	GeneratePassword       types.Bool                 `tfsdk:"generate_password"`
+	Login                  types.Bool                 `tfsdk:"login"`
+	ConnLimit              types.Int64                `tfsdk:"conn_limit"`
+	Settings               mdbcommon.SettingsMapValue `tfsdk:"settings"`
+	Pgaudit                types.Object               `tfsdk:"pgaudit"`
+	AuthMethod             types.String               `tfsdk:"auth_method"`
+	UserPasswordEncryption types.String               `tfsdk:"user_password_encryption"`
+	ConnectionManager      types.Map                  `tfsdk:"connection_manager"`
+	DeletionProtection     types.String               `tfsdk:"deletion_protection"`
+	Timeouts               timeouts.Value             `tfsdk:"timeouts"`
+}
+
+type Pgaudit struct {
+	Log types.Set `tfsdk:"log"`
+}
+
+var pgauditAttrTypes = map[string]attr.Type{
+	"log": types.SetType{ElemType: types.StringType},
+}
+
+var pgAuditLogValues = func() []string {
+	values := make([]string, 0, len(postgresql.PGAuditSettings_PGAuditSettingsLog_value))
+	for name, v := range postgresql.PGAuditSettings_PGAuditSettingsLog_value {
+		if v == 0 {
+			continue
+		}
+		values = append(values, strings.TrimPrefix(name, pgAuditLogPrefix))
+	}
+	sort.Strings(values)
+	return values
+}()
+
+var tristateBoolean = map[string]*wrapperspb.BoolValue{
+	"true":        wrapperspb.Bool(true),
+	"false":       wrapperspb.Bool(false),
+	"unspecified": nil,
+}
+
+func flattenTristateBoolean(v *wrapperspb.BoolValue) types.String {
+	if v == nil {
+		return types.StringValue("unspecified")
+	}
+	if v.GetValue() {
+		return types.StringValue("true")
+	}
+	return types.StringValue("false")
+}
+
+func userToState(ctx context.Context, user *postgresql.User, state *User, diags *diag.Diagnostics) {
+	state.Id = types.StringValue(resourceid.Construct(user.ClusterId, user.Name))
+	state.ClusterID = types.StringValue(user.ClusterId)
+	state.Name = types.StringValue(user.Name)
+	state.Login = types.BoolValue(user.GetLogin().GetValue())
+	state.ConnLimit = types.Int64Value(user.ConnLimit)
+	state.AuthMethod = types.StringValue(user.AuthMethod.String())
+	state.UserPasswordEncryption = types.StringValue(user.UserPasswordEncryption.String())
+	state.DeletionProtection = flattenTristateBoolean(user.DeletionProtection)
+
+	// Settings from the configuration are kept as is, so that the values of
+	// the settings not managed by the user do not produce a diff.
+	if state.Settings.IsNull() || state.Settings.IsUnknown() {
+		state.Settings = flattenSettings(ctx, user.Settings, diags)
+	}
+	state.Pgaudit = flattenPgaudit(ctx, user.GetSettings().GetPgaudit(), diags)
+
+	cm := map[string]string{}
+	if id := user.GetConnectionManager().GetConnectionId(); id != "" {
+		cm["connection_id"] = id
+	}
+	state.ConnectionManager = mdbcommon.FlattenMapString(ctx, cm, diags)
+}
+
+func flattenSettings(ctx context.Context, s *postgresql.UserSettings, diags *diag.Diagnostics) mdbcommon.SettingsMapValue {
+	if s == nil {
+		s = &postgresql.UserSettings{}
+	}
+
+	a := protobuf_adapter.NewProtobufMapDataAdapter()
+	attrs := a.Extract(ctx, s, diags)
+	if diags.HasError() {
+		return NewPgUserSettingsMapNull()
+	}
+	// pgaudit is a nested message, it is managed by the separate attribute
+	delete(attrs, "log")
+
+	attrsPresent := make(map[string]attr.Value)
+	for name, val := range attrs {
+		if ok := mdbcommon.IsAttrZeroValue(val, diags); !ok {
+			attrsPresent[name] = val
+		}
+	}
+
+	mv, d := NewPgUserSettingsMapValue(attrsPresent)
+	diags.Append(d...)
+	return mv
+}
+
+func flattenPgaudit(ctx context.Context, p *postgresql.PGAuditSettings, diags *diag.Diagnostics) types.Object {
+	if len(p.GetLog()) == 0 {
+		return types.ObjectNull(pgauditAttrTypes)
+	}
+
+	logs := make([]string, 0, len(p.GetLog()))
+	for _, l := range p.GetLog() {
+		logs = append(logs, strings.TrimPrefix(l.String(), pgAuditLogPrefix))
+	}
+
+	obj, d := types.ObjectValueFrom(ctx, pgauditAttrTypes, Pgaudit{
+		Log: mdbcommon.FlattenSetString(ctx, logs, diags),
+	})
+	diags.Append(d...)
+	return obj
+}
+
+func expandSettings(ctx context.Context, settings mdbcommon.SettingsMapValue, pgaudit types.Object, diags *diag.Diagnostics) *postgresql.UserSettings {
+	s := &postgresql.UserSettings{}
+
+	a := protobuf_adapter.NewProtobufMapDataAdapter()
+	// Depth 1 skips the nested pgaudit message, it is filled below.
+	a.FillWithDepth(ctx, s, settings.PrimitiveElements(ctx, diags), diags, 1)
+	if diags.HasError() {
+		return nil
+	}
+
+	s.Pgaudit = expandPgaudit(ctx, pgaudit, diags)
+	return s
+}
+
+func expandPgaudit(ctx context.Context, o types.Object, diags *diag.Diagnostics) *postgresql.PGAuditSettings {
+	if o.IsNull() || o.IsUnknown() {
+		return nil
+	}
+
+	var p Pgaudit
+	diags.Append(o.As(ctx, &p, basetypes.ObjectAsOptions{UnhandledNullAsEmpty: true, UnhandledUnknownAsEmpty: true})...)
+
+	var logs []string
+	diags.Append(p.Log.ElementsAs(ctx, &logs, false)...)
+	if diags.HasError() {
+		return nil
+	}
+
+	out := &postgresql.PGAuditSettings{}
+	for _, l := range logs {
+		v, ok := postgresql.PGAuditSettings_PGAuditSettingsLog_value[pgAuditLogPrefix+strings.ToUpper(l)]
+		if !ok {
+			diags.AddError("Invalid pgaudit log setting", fmt.Sprintf("Unknown pgaudit log setting %q", l))
+			continue
+		}
+		out.Log = append(out.Log, postgresql.PGAuditSettings_PGAuditSettingsLog(v))
+	}
+	return out
+}
+
+func stateToSpec(ctx context.Context, state *User, diags *diag.Diagnostics) *postgresql.UserSpec {
+	spec := &postgresql.UserSpec{
+		Name:               state.Name.ValueString(),
+		Password:           state.Password.ValueString(),
+		Login:              mdbcommon.ExpandBoolWrapper(ctx, state.Login, diags),
+		Settings:           expandSettings(ctx, state.Settings, state.Pgaudit, diags),
+		DeletionProtection: tristateBoolean[state.DeletionProtection.ValueString()],
+	}
+
+	if !state.GeneratePassword.IsNull() && !state.GeneratePassword.IsUnknown() {
+		spec.GeneratePassword = wrapperspb.Bool(state.GeneratePassword.ValueBool())
+	}
+
+	if !state.ConnLimit.IsNull() && !state.ConnLimit.IsUnknown() {
+		spec.ConnLimit = wrapperspb.Int64(state.ConnLimit.ValueInt64())
+	}
+
+	if v := state.AuthMethod.ValueString(); v != "" {
+		spec.AuthMethod = postgresql.AuthMethod(postgresql.AuthMethod_value[v])
+	}
+
+	if v := state.UserPasswordEncryption.ValueString(); v != "" {
+		spec.UserPasswordEncryption = postgresql.UserPasswordEncryption(postgresql.UserPasswordEncryption_value[v])
+	}
+
+	return spec
+}
+
+// settingsUpdatePaths returns update mask paths for the settings changed between state and plan.
+func settingsUpdatePaths(plan, state mdbcommon.SettingsMapValue) []string {
+	planElems := plan.Elements()
+	stateElems := state.Elements()
+
+	names := make(map[string]struct{})
+	for name := range planElems {
+		names[name] = struct{}{}
+	}
+	for name := range stateElems {
+		names[name] = struct{}{}
+	}
+
+	var paths []string
+	for name := range names {
+		p, inPlan := planElems[name]
+		s, inState := stateElems[name]
+		if inPlan && inState && p.Equal(s) {
+			continue
+		}
+		paths = append(paths, "settings."+name)
+	}
+	sort.Strings(paths)
+	return paths
+}
@@ -0,0 +1,189 @@
+package mdb_postgresql_user_v2
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/mdbcommon"
+)
+
+const legacyUserTypeName = "yandex_mdb_postgresql_user"
+
+// legacyUser is the part of the yandex_mdb_postgresql_user state moved to this resource.
+type legacyUser struct {
+	ID                     string             `json:"id"`
+	ClusterID              string             `json:"cluster_id"`
+	Name                   string             `json:"name"`
+	Password               string             `json:"password"`
+	GeneratePassword       bool               `json:"generate_password"`
+	Login                  *bool              `json:"login"`
+	ConnLimit              int64              `json:"conn_limit"`
+	Settings               map[string]string  `json:"settings"`
+	AuthMethod             string             `json:"auth_method"`
+	UserPasswordEncryption string             `json:"user_password_encryption"`
+	ConnectionManager      map[string]string  `json:"connection_manager"`
+	DeletionProtection     string             `json:"deletion_protection"`
+	Permissions            []legacyPermission `json:"permission"`
+	Grants                 []string           `json:"grants"`
+}
+
+type legacyPermission struct {
+	DatabaseName string `json:"database_name"`
+}
+
+// legacyEnumPrefixes are the prefixes of API enum values for the settings
+// that were stored as human-readable names ("read committed") by yandex_mdb_postgresql_user.
+var legacyEnumPrefixes = map[string]string{
+	"default_transaction_isolation": "TRANSACTION_ISOLATION_",
+	"synchronous_commit":            "SYNCHRONOUS_COMMIT_",
+	"log_statement":                 "LOG_STATEMENT_",
+	"pool_mode":                     "",
+}
+
+func (r *userResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		{
+			StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+				if req.SourceTypeName != legacyUserTypeName || req.SourceRawState == nil {
+					return
+				}
+
+				var legacy legacyUser
+				if err := json.Unmarshal(req.SourceRawState.JSON, &legacy); err != nil {
+					resp.Diagnostics.AddError(
+						"Unable to Move Resource State",
+						fmt.Sprintf("Error while decoding the state of %s: %s", legacyUserTypeName, err.Error()),
+					)
+					return
+				}
+
+				state := legacyUserToState(ctx, &legacy, &resp.Diagnostics)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				resp.Diagnostics.Append(resp.TargetState.Set(ctx, state)...)
+			},
+		},
+	}
+}
+
+func legacyUserToState(ctx context.Context, legacy *legacyUser, diags *diag.Diagnostics) *User {
+	settings, pgauditLog, err := convertLegacySettings(legacy.Settings)
+	if err != nil {
+		diags.AddError("Unable to Move Resource State", err.Error())
+		return nil
+	}
+
+	state := &User{
+		Id:                     types.StringValue(legacy.ID),
+		ClusterID:              types.StringValue(legacy.ClusterID),
+		Name:                   types.StringValue(legacy.Name),
+		Password:               mdbcommon.FlattenStringOrNull(legacy.Password),
+		GeneratePassword:       types.BoolValue(legacy.GeneratePassword),
+		Login:                  types.BoolValue(legacy.Login == nil || *legacy.Login),
+		ConnLimit:              types.Int64Value(legacy.ConnLimit),
+		Pgaudit:                types.ObjectNull(pgauditAttrTypes),
+		AuthMethod:             types.StringValue(legacy.AuthMethod),
+		UserPasswordEncryption: types.StringValue(legacy.UserPasswordEncryption),
+		ConnectionManager:      mdbcommon.FlattenMapString(ctx, legacy.ConnectionManager, diags),
+		DeletionProtection:     types.StringValue(legacy.DeletionProtection),
+		Timeouts: timeouts.Value{
+			Object: types.ObjectNull(map[string]attr.Type{
+				"create": types.StringType,
+				"update": types.StringType,
+				"delete": types.StringType,
+			}),
+		},
+	}
+
+	if state.AuthMethod.ValueString() == "" {
+		state.AuthMethod = types.StringValue("AUTH_METHOD_PASSWORD")
+	}
+	if state.DeletionProtection.ValueString() == "" {
+		state.DeletionProtection = types.StringValue("unspecified")
+	}
+
+	var d diag.Diagnostics
+	state.Settings, d = NewPgUserSettingsMapValue(settings)
+	diags.Append(d...)
+
+	blocks := make([]mdbcommon.ImportBlock, 0, len(legacy.Permissions)+len(legacy.Grants))
+	for _, p := range legacy.Permissions {
+		blocks = append(blocks, mdbcommon.ImportBlock{
+			ResourceType: "yandex_mdb_postgresql_user_permission",
+			Name:         legacy.Name + "_" + p.DatabaseName,
+			ID:           legacy.ClusterID + ":" + legacy.Name + ":" + p.DatabaseName,
+		})
+	}
+	for _, g := range legacy.Grants {
+		blocks = append(blocks, mdbcommon.ImportBlock{
+			ResourceType: "yandex_mdb_postgresql_user_grant",
+			Name:         legacy.Name + "_" + g,
+			ID:           legacy.ClusterID + ":" + legacy.Name + ":" + g,
+		})
+	}
+	mdbcommon.AddNotMovedWarning(diags, fmt.Sprintf("%s %q", legacyUserTypeName, legacy.ID), "permission blocks and grants", blocks)
+
+	if len(pgauditLog) > 0 {
+		state.Pgaudit, d = types.ObjectValueFrom(ctx, pgauditAttrTypes, Pgaudit{
+			Log: mdbcommon.FlattenSetString(ctx, pgauditLog, diags),
+		})
+		diags.Append(d...)
+	}
+
+	return state
+}
+
+// convertLegacySettings converts the settings map of yandex_mdb_postgresql_user into
+// the typed settings and the pgaudit log classes.
+func convertLegacySettings(legacy map[string]string) (map[string]attr.Value, []string, error) {
+	settings := make(map[string]attr.Value, len(legacy))
+	var pgauditLog []string
+
+	for name, value := range legacy {
+		if name == "pgaudit" {
+			if value == "" {
+				continue
+			}
+			var pgaudit struct {
+				Log []string `json:"log"`
+			}
+			if err := json.Unmarshal([]byte(value), &pgaudit); err != nil {
+				return nil, nil, fmt.Errorf("invalid pgaudit setting %q: %w", value, err)
+			}
+			for _, l := range pgaudit.Log {
+				pgauditLog = append(pgauditLog, strings.ToUpper(l))
+			}
+			continue
+		}
+
+		prefix, isEnum := legacyEnumPrefixes[name]
+		if !isEnum {
+			settings[name] = types.StringValue(value)
+			continue
+		}
+
+		enumName := value
+		if _, ok := pgUserSettingsEnumValues[name][enumName]; !ok {
+			enumName = prefix + strings.ToUpper(strings.ReplaceAll(value, " ", "_"))
+		}
+		v, ok := pgUserSettingsEnumValues[name][enumName]
+		if !ok {
+			return nil, nil, fmt.Errorf("unknown value %q of setting %q", value, name)
+		}
+		if v == 0 {
+			continue
+		}
+		settings[name] = types.Int64Value(int64(v))
+	}
+
+	return settings, pgauditLog, nil
+}
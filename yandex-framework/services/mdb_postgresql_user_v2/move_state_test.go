@@ -0,0 +1,106 @@
+package mdb_postgresql_user_v2
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/postgresql/v1"
+)
+
+func TestConvertLegacySettings(t *testing.T) {
+	tests := []struct {
+		name       string
+		legacy     map[string]string
+		settings   map[string]attr.Value
+		pgauditLog []string
+		error      string
+	}{
+		{
+			name: "human readable enums",
+			legacy: map[string]string{
+				"default_transaction_isolation": "read committed",
+				"synchronous_commit":            "remote write",
+				"log_statement":                 "ddl",
+				"pool_mode":                     "transaction",
+			},
+			settings: map[string]attr.Value{
+				"default_transaction_isolation": types.Int64Value(int64(postgresql.UserSettings_TRANSACTION_ISOLATION_READ_COMMITTED)),
+				"synchronous_commit":            types.Int64Value(int64(postgresql.UserSettings_SYNCHRONOUS_COMMIT_REMOTE_WRITE)),
+				"log_statement":                 types.Int64Value(int64(postgresql.UserSettings_LOG_STATEMENT_DDL)),
+				"pool_mode":                     types.Int64Value(int64(postgresql.UserSettings_TRANSACTION)),
+			},
+		},
+		{
+			name: "api enums and primitives",
+			legacy: map[string]string{
+				"default_transaction_isolation": "TRANSACTION_ISOLATION_SERIALIZABLE",
+				"lock_timeout":                  "1000",
+				"prepared_statements_pooling":   "true",
+				"pool_mode":                     "unspecified",
+			},
+			settings: map[string]attr.Value{
+				"default_transaction_isolation": types.Int64Value(int64(postgresql.UserSettings_TRANSACTION_ISOLATION_SERIALIZABLE)),
+				"lock_timeout":                  types.StringValue("1000"),
+				"prepared_statements_pooling":   types.StringValue("true"),
+			},
+		},
+		{
+			name: "pgaudit",
+			legacy: map[string]string{
+				"pgaudit": `{"log": ["read", "misc_set"]}`,
+			},
+			settings:   map[string]attr.Value{},
+			pgauditLog: []string{"READ", "MISC_SET"},
+		},
+		{
+			name: "unknown enum value",
+			legacy: map[string]string{
+				"log_statement": "everything",
+			},
+			error: `unknown value "everything" of setting "log_statement"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			settings, pgauditLog, err := convertLegacySettings(tt.legacy)
+			if tt.error != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.error)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.settings, settings)
+			assert.Equal(t, tt.pgauditLog, pgauditLog)
+		})
+	}
+}
+
+func TestLegacyUserToStateWarnsAboutPermissionsAndGrants(t *testing.T) {
+	legacy := &legacyUser{
+		ID:          "cid:alice",
+		ClusterID:   "cid",
+		Name:        "alice",
+		Permissions: []legacyPermission{{DatabaseName: "db1"}},
+		Grants:      []string{"mdb_admin"},
+	}
+
+	var diags diag.Diagnostics
+	state := legacyUserToState(context.Background(), legacy, &diags)
+	require.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+	require.NotNil(t, state)
+	require.Equal(t, 1, diags.WarningsCount())
+
+	detail := diags[0].Detail()
+	assert.Contains(t, detail, "import {\n  to = yandex_mdb_postgresql_user_permission.alice_db1\n  id = \"cid:alice:db1\"\n}")
+	assert.Contains(t, detail, "import {\n  to = yandex_mdb_postgresql_user_grant.alice_mdb_admin\n  id = \"cid:alice:mdb_admin\"\n}")
+
+	diags = nil
+	legacyUserToState(context.Background(), &legacyUser{ID: "cid:bob", ClusterID: "cid", Name: "bob"}, &diags)
+	assert.Equal(t, 0, diags.WarningsCount())
+}
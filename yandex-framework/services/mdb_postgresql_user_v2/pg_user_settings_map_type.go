@@ -0,0 +1,50 @@
+package mdb_postgresql_user_v2
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/postgresql/v1"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/mdbcommon"
+)
+
+var pgUserSettingsEnumNames = map[string]map[int32]string{
+	"default_transaction_isolation": postgresql.UserSettings_TransactionIsolation_name,
+	"synchronous_commit":            postgresql.UserSettings_SynchronousCommit_name,
+	"log_statement":                 postgresql.UserSettings_LogStatement_name,
+	"pool_mode":                     postgresql.UserSettings_PoolingMode_name,
+}
+
+var pgUserSettingsEnumValues = map[string]map[string]int32{
+	"default_transaction_isolation": postgresql.UserSettings_TransactionIsolation_value,
+	"synchronous_commit":            postgresql.UserSettings_SynchronousCommit_value,
+	"log_statement":                 postgresql.UserSettings_LogStatement_value,
+	"pool_mode":                     postgresql.UserSettings_PoolingMode_value,
+}
+
+var pgUserAttrProvider = &PgUserSettingsAttributeInfoProvider{}
+
+type PgUserSettingsAttributeInfoProvider struct{}
+
+func (p *PgUserSettingsAttributeInfoProvider) GetSettingsEnumNames() map[string]map[int32]string {
+	return pgUserSettingsEnumNames
+}
+
+func (p *PgUserSettingsAttributeInfoProvider) GetSettingsEnumValues() map[string]map[string]int32 {
+	return pgUserSettingsEnumValues
+}
+
+func (p *PgUserSettingsAttributeInfoProvider) GetSetAttributes() map[string]struct{} {
+	return map[string]struct{}{}
+}
+
+func NewPgUserSettingsMapType() mdbcommon.SettingsMapType {
+	return mdbcommon.NewSettingsMapType(pgUserAttrProvider)
+}
+
+func NewPgUserSettingsMapValue(elements map[string]attr.Value) (mdbcommon.SettingsMapValue, diag.Diagnostics) {
+	return mdbcommon.NewSettingsMapValue(elements, pgUserAttrProvider)
+}
+
+func NewPgUserSettingsMapNull() mdbcommon.SettingsMapValue {
+	return mdbcommon.NewSettingsMapNull()
+}
@@ -0,0 +1,435 @@
+package mdb_postgresql_user_v2
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/postgresql/v1"
+	postgresqlsdk "github.com/yandex-cloud/go-sdk/services/mdb/postgresql/v1"
+	"github.com/yandex-cloud/terraform-provider-yandex/common"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/globallock"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/resourceid"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/validate"
+	provider_config "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+const (
+	yandexMDBPostgreSQLUserDefaultTimeout = 10 * time.Minute
+)
+
+var (
+	_ resource.Resource                = &userResource{}
+	_ resource.ResourceWithImportState = &userResource{}
+	_ resource.ResourceWithMoveState   = &userResource{}
+)
+
+type userResource struct {
+	providerConfig *provider_config.Config
+}
+
+func NewResource() resource.Resource {
+	return &userResource{}
+}
+
+func (r *userResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mdb_postgresql_user_v2"
+}
+
+func (r *userResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerConfig, ok := req.ProviderData.(*provider_config.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider_config.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.providerConfig = providerConfig
+}
+
+func (r *userResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a PostgreSQL user within the Yandex Cloud. For more information, see [the official documentation](https://yandex.cloud/docs/managed-postgresql/operations/cluster-users).\n\n" +
+			"~> Grants and database permissions of the user are managed by the `yandex_mdb_postgresql_user_grant` and `yandex_mdb_postgresql_user_permission` resources.\n\n" +
+			"~> The state of a `yandex_mdb_postgresql_user` resource can be moved to this resource with the `moved` block. Grants and permissions of the moved user are left untouched in the cluster: the move warns about the `permission` blocks and `grants` with the `import` blocks of the `yandex_mdb_postgresql_user_permission` and `yandex_mdb_postgresql_user_grant` resources.\n",
+		Attributes: map[string]schema.Attribute{
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+			"id": schema.StringAttribute{
+				MarkdownDescription: common.ResourceDescriptions["id"],
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"cluster_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the PostgreSQL cluster.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the user.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "The password of the user.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"generate_password": schema.BoolAttribute{
+				MarkdownDescription: "Generate password using Connection Manager. Used only during creation.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"login": schema.BoolAttribute{
+				MarkdownDescription: "User's ability to login.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"conn_limit": schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of connections per user.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"settings": schema.MapAttribute{
+				CustomType:          NewPgUserSettingsMapType(),
+				MarkdownDescription: "Map of user settings. Enum settings take the values of the API, for example `default_transaction_isolation = \"TRANSACTION_ISOLATION_READ_COMMITTED\"`. For the full list of settings see [the API reference](https://yandex.cloud/docs/managed-postgresql/api-ref/grpc/User/create#yandex.cloud.mdb.postgresql.v1.UserSettings).",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"pgaudit": schema.SingleNestedAttribute{
+				MarkdownDescription: "Settings of the PostgreSQL Audit Extension (pgaudit) for the user.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"log": schema.SetAttribute{
+						MarkdownDescription: "Classes of statements to be logged.",
+						ElementType:         types.StringType,
+						Required:            true,
+						Validators: []validator.Set{
+							setvalidator.ValueStringsAre(stringvalidator.OneOf(pgAuditLogValues...)),
+						},
+					},
+				},
+			},
+			"auth_method": schema.StringAttribute{
+				MarkdownDescription: "Authentication method for the user.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("AUTH_METHOD_PASSWORD"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("AUTH_METHOD_PASSWORD", "AUTH_METHOD_IAM"),
+				},
+			},
+			"user_password_encryption": schema.StringAttribute{
+				MarkdownDescription: "Password-based authentication method for the user. The default is `password_encryption` setting of the cluster.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						"USER_PASSWORD_ENCRYPTION_UNSPECIFIED",
+						"USER_PASSWORD_ENCRYPTION_MD5",
+						"USER_PASSWORD_ENCRYPTION_SCRAM_SHA_256",
+					),
+				},
+			},
+			"connection_manager": schema.MapAttribute{
+				MarkdownDescription: "Connection Manager connection configuration. Filled in by the server automatically.",
+				Computed:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"deletion_protection": schema.StringAttribute{
+				MarkdownDescription: common.ResourceDescriptions["deletion_protection"],
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("unspecified"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("true", "false", "unspecified"),
+				},
+			},
+		},
+	}
+}
+
+func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan User
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, yandexMDBPostgreSQLUserDefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	if plan.Password.ValueString() != "" && plan.GeneratePassword.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("generate_password"),
+			"Conflicting password configuration",
+			"Must specify either password or generate_password",
+		)
+		return
+	}
+
+	cid := plan.ClusterID.ValueString()
+	spec := stateToSpec(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	mutexKV := globallock.GetMutexKV()
+	mutexKV.Lock(MutexKey(cid, spec.Name))
+	defer mutexKV.Unlock(MutexKey(cid, spec.Name))
+
+	CreateUser(ctx, r.providerConfig.SDKv2, &resp.Diagnostics, cid, spec)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	user := ReadUser(ctx, r.providerConfig.SDKv2, &resp.Diagnostics, cid, spec.Name)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userToState(ctx, user, &plan, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *userResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state User
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cid, userName, err := resourceid.Deconstruct(state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to parse resource ID",
+			fmt.Sprintf("Error parsing resource ID %q: %s", state.Id.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	user, err := postgresqlsdk.NewUserClient(r.providerConfig.SDKv2).Get(ctx, &postgresql.GetUserRequest{
+		ClusterId: cid,
+		UserName:  userName,
+	})
+	if err != nil {
+		f := resp.Diagnostics.AddError
+		if validate.IsStatusWithCode(err, codes.NotFound) {
+			resp.State.RemoveResource(ctx)
+			f = resp.Diagnostics.AddWarning
+		}
+		f(
+			"Failed to Read resource",
+			fmt.Sprintf("Error while requesting API to read PostgreSQL user %q in cluster %q: %s", userName, cid, err.Error()),
+		)
+		return
+	}
+
+	userToState(ctx, user, &state, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state User
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, yandexMDBPostgreSQLUserDefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	cid, userName, err := resourceid.Deconstruct(state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to parse resource ID",
+			fmt.Sprintf("Error parsing resource ID %q: %s", state.Id.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	spec := stateToSpec(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var updatePaths []string
+	if !plan.Password.IsNull() && !plan.Password.Equal(state.Password) {
+		updatePaths = append(updatePaths, "password")
+	}
+	if !plan.Login.Equal(state.Login) {
+		updatePaths = append(updatePaths, "login")
+	}
+	if !plan.ConnLimit.IsUnknown() && !plan.ConnLimit.Equal(state.ConnLimit) {
+		updatePaths = append(updatePaths, "conn_limit")
+	}
+	if !plan.Settings.IsUnknown() {
+		updatePaths = append(updatePaths, settingsUpdatePaths(plan.Settings, state.Settings)...)
+	}
+	if !plan.Pgaudit.Equal(state.Pgaudit) {
+		updatePaths = append(updatePaths, "settings.pgaudit")
+	}
+	if !plan.AuthMethod.Equal(state.AuthMethod) {
+		updatePaths = append(updatePaths, "auth_method")
+	}
+	if !plan.UserPasswordEncryption.IsUnknown() && !plan.UserPasswordEncryption.Equal(state.UserPasswordEncryption) {
+		updatePaths = append(updatePaths, "user_password_encryption")
+	}
+	if !plan.DeletionProtection.Equal(state.DeletionProtection) {
+		updatePaths = append(updatePaths, "deletion_protection")
+	}
+
+	if len(updatePaths) > 0 {
+		mutexKV := globallock.GetMutexKV()
+		mutexKV.Lock(MutexKey(cid, userName))
+		defer mutexKV.Unlock(MutexKey(cid, userName))
+
+		UpdateUser(ctx, r.providerConfig.SDKv2, &resp.Diagnostics, &postgresql.UpdateUserRequest{
+			ClusterId:              cid,
+			UserName:               userName,
+			Password:               spec.Password,
+			Login:                  spec.Login,
+			ConnLimit:              spec.ConnLimit.GetValue(),
+			Settings:               spec.Settings,
+			AuthMethod:             spec.AuthMethod,
+			UserPasswordEncryption: spec.UserPasswordEncryption,
+			DeletionProtection:     spec.DeletionProtection,
+			UpdateMask:             &fieldmaskpb.FieldMask{Paths: updatePaths},
+		})
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	user := ReadUser(ctx, r.providerConfig.SDKv2, &resp.Diagnostics, cid, userName)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userToState(ctx, user, &plan, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *userResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state User
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, yandexMDBPostgreSQLUserDefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	cid, userName, err := resourceid.Deconstruct(state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to parse resource ID",
+			fmt.Sprintf("Error parsing resource ID %q: %s", state.Id.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	mutexKV := globallock.GetMutexKV()
+	mutexKV.Lock(MutexKey(cid, userName))
+	defer mutexKV.Unlock(MutexKey(cid, userName))
+
+	DeleteUser(ctx, r.providerConfig.SDKv2, &resp.Diagnostics, cid, userName)
+}
+
+func (r *userResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	cid, userName, err := resourceid.Deconstruct(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <cluster_id>:<user_name>. Got: %q. Error: %s", req.ID, err.Error()),
+		)
+		return
+	}
+
+	user := ReadUser(ctx, r.providerConfig.SDKv2, &resp.Diagnostics, cid, userName)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state := User{
+		Settings: NewPgUserSettingsMapNull(),
+	}
+	userToState(ctx, user, &state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.Password = types.StringNull()
+	state.GeneratePassword = types.BoolValue(false)
+	state.Timeouts = timeouts.Value{
+		Object: types.ObjectNull(map[string]attr.Type{
+			"create": types.StringType,
+			"update": types.StringType,
+			"delete": types.StringType,
+		}),
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
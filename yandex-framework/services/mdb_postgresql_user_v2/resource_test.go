@@ -0,0 +1,241 @@
+package mdb_postgresql_user_v2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/postgresql/v1"
+	postgresqlsdk "github.com/yandex-cloud/go-sdk/services/mdb/postgresql/v1"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/resourceid"
+	test "github.com/yandex-cloud/terraform-provider-yandex/pkg/testhelpers"
+	yandex_framework "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider"
+)
+
+const (
+	pgUserV2ResourceName = "yandex_mdb_postgresql_user_v2.alice"
+	pgUserResourceName   = "yandex_mdb_postgresql_user.alice"
+)
+
+const pgUserV2Dependencies = `
+resource "yandex_vpc_network" "foo" {}
+
+resource "yandex_vpc_subnet" "foo" {
+  zone           = "ru-central1-a"
+  network_id     = yandex_vpc_network.foo.id
+  v4_cidr_blocks = ["10.1.0.0/24"]
+}
+
+resource "yandex_mdb_postgresql_cluster_v2" "foo" {
+  name        = "%s"
+  environment = "PRESTABLE"
+  network_id  = yandex_vpc_network.foo.id
+
+  hosts = {
+    "na" = {
+      zone      = "ru-central1-a"
+      subnet_id = yandex_vpc_subnet.foo.id
+    }
+  }
+
+  config {
+    version = "16"
+    resources {
+      resource_preset_id = "s2.micro"
+      disk_size          = 10
+      disk_type_id       = "network-ssd"
+    }
+  }
+}
+`
+
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
+
+func TestAccMDBPostgreSQLUserV2_basic(t *testing.T) {
+	t.Parallel()
+
+	clusterName := acctest.RandomWithPrefix("tf-postgresql-user-v2")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.AccPreCheck(t) },
+		ProtoV6ProviderFactories: test.AccProviderFactories,
+		CheckDestroy:             testAccCheckMDBPostgreSQLUserV2Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMDBPostgreSQLUserV2Config(clusterName, `
+  conn_limit = 10
+  settings = {
+    default_transaction_isolation = "TRANSACTION_ISOLATION_READ_COMMITTED"
+    lock_timeout                  = 1000
+  }
+`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMDBPostgreSQLUserV2Exists(pgUserV2ResourceName, func(u *postgresql.User) error {
+						if u.GetSettings().GetDefaultTransactionIsolation() != postgresql.UserSettings_TRANSACTION_ISOLATION_READ_COMMITTED {
+							return fmt.Errorf("unexpected default_transaction_isolation %s", u.GetSettings().GetDefaultTransactionIsolation())
+						}
+						return nil
+					}),
+					resource.TestCheckResourceAttr(pgUserV2ResourceName, "conn_limit", "10"),
+					resource.TestCheckResourceAttr(pgUserV2ResourceName, "login", "true"),
+					resource.TestCheckResourceAttr(pgUserV2ResourceName, "settings.lock_timeout", "1000"),
+				),
+			},
+			mdbPostgreSQLUserV2ImportStep(pgUserV2ResourceName),
+			{
+				Config: testAccMDBPostgreSQLUserV2Config(clusterName, `
+  conn_limit = 20
+  login      = false
+  settings = {
+    default_transaction_isolation = "TRANSACTION_ISOLATION_SERIALIZABLE"
+    lock_timeout                  = 1000
+  }
+  pgaudit = {
+    log = ["READ", "WRITE"]
+  }
+`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMDBPostgreSQLUserV2Exists(pgUserV2ResourceName, func(u *postgresql.User) error {
+						if len(u.GetSettings().GetPgaudit().GetLog()) != 2 {
+							return fmt.Errorf("unexpected pgaudit settings %v", u.GetSettings().GetPgaudit())
+						}
+						return nil
+					}),
+					resource.TestCheckResourceAttr(pgUserV2ResourceName, "conn_limit", "20"),
+					resource.TestCheckResourceAttr(pgUserV2ResourceName, "login", "false"),
+					resource.TestCheckResourceAttr(pgUserV2ResourceName, "settings.default_transaction_isolation", "TRANSACTION_ISOLATION_SERIALIZABLE"),
+					resource.TestCheckResourceAttr(pgUserV2ResourceName, "pgaudit.log.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMDBPostgreSQLUserV2_moveState(t *testing.T) {
+	t.Parallel()
+
+	clusterName := acctest.RandomWithPrefix("tf-postgresql-user-v2")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.AccPreCheck(t) },
+		ProtoV6ProviderFactories: test.AccProviderFactories,
+		CheckDestroy:             testAccCheckMDBPostgreSQLUserV2Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(pgUserV2Dependencies, clusterName) + `
+resource "yandex_mdb_postgresql_user" "alice" {
+  cluster_id = yandex_mdb_postgresql_cluster_v2.foo.id
+  name       = "alice"
+  password   = "mysecurepassword"
+  conn_limit = 10
+  settings = {
+    default_transaction_isolation = "read committed"
+    pgaudit                       = "{\"log\": [\"READ\"]}"
+  }
+}
+`,
+				Check: resource.TestCheckResourceAttrSet(pgUserResourceName, "id"),
+			},
+			{
+				Config: testAccMDBPostgreSQLUserV2Config(clusterName, `
+  conn_limit = 10
+  settings = {
+    default_transaction_isolation = "TRANSACTION_ISOLATION_READ_COMMITTED"
+  }
+  pgaudit = {
+    log = ["READ"]
+  }
+`) + `
+moved {
+  from = yandex_mdb_postgresql_user.alice
+  to   = yandex_mdb_postgresql_user_v2.alice
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(pgUserV2ResourceName, plancheck.ResourceActionNoop),
+					},
+				},
+				Check: resource.TestCheckResourceAttr(pgUserV2ResourceName, "conn_limit", "10"),
+			},
+		},
+	})
+}
+
+func mdbPostgreSQLUserV2ImportStep(name string) resource.TestStep {
+	return resource.TestStep{
+		ResourceName:      name,
+		ImportState:       true,
+		ImportStateVerify: true,
+		ImportStateVerifyIgnore: []string{
+			"password",
+		},
+	}
+}
+
+func testAccCheckMDBPostgreSQLUserV2Exists(resourceName string, check func(*postgresql.User) error) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+
+		cid, userName, err := resourceid.Deconstruct(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		config := test.AccProvider.(*yandex_framework.Provider).GetConfig()
+		user, err := postgresqlsdk.NewUserClient(config.SDKv2).Get(context.Background(), &postgresql.GetUserRequest{
+			ClusterId: cid,
+			UserName:  userName,
+		})
+		if err != nil {
+			return fmt.Errorf("PostgreSQL user not found: %v", err)
+		}
+
+		return check(user)
+	}
+}
+
+func testAccCheckMDBPostgreSQLUserV2Destroy(s *terraform.State) error {
+	config := test.AccProvider.(*yandex_framework.Provider).GetConfig()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "yandex_mdb_postgresql_user_v2" {
+			continue
+		}
+
+		cid, userName, err := resourceid.Deconstruct(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = postgresqlsdk.NewUserClient(config.SDKv2).Get(context.Background(), &postgresql.GetUserRequest{
+			ClusterId: cid,
+			UserName:  userName,
+		})
+		if err == nil {
+			return fmt.Errorf("PostgreSQL user %q in cluster %q still exists", userName, cid)
+		}
+	}
+
+	return nil
+}
+
+func testAccMDBPostgreSQLUserV2Config(clusterName, userBody string) string {
+	return fmt.Sprintf(pgUserV2Dependencies, clusterName) + fmt.Sprintf(`
+resource "yandex_mdb_postgresql_user_v2" "alice" {
+  cluster_id = yandex_mdb_postgresql_cluster_v2.foo.id
+  name       = "alice"
+  password   = "mysecurepassword"
+%s
+}
+`, userBody)
+}
@@ -57,6 +57,27 @@ func (r *RedisAPI) DeleteCluster(ctx context.Context, sdk *ycsdk.SDK, diag *diag
 	}
 }
 
+func (r *RedisAPI) BackupCluster(ctx context.Context, sdk *ycsdk.SDK, diag *diag.Diagnostics, cid string) {
+	op, err := redissdk.NewClusterClient(sdk).Backup(ctx, &redis.BackupClusterRequest{
+		ClusterId: cid,
+	})
+
+	if err != nil {
+		diag.AddError(
+			"API Error Backing up",
+			fmt.Sprintf("Error while requesting API to backup Redis cluster %q: %s", cid, err.Error()),
+		)
+		return
+	}
+
+	if _, err = op.Wait(ctx); err != nil {
+		diag.AddError(
+			"API Error Backing up",
+			fmt.Sprintf("Error while waiting for operation %q to backup Redis cluster %q: %s", op.ID(), cid, err.Error()),
+		)
+	}
+}
+
 func (r *RedisAPI) CreateCluster(ctx context.Context, sdk *ycsdk.SDK, diag *diag.Diagnostics, req *redis.CreateClusterRequest) string {
 	op, err := redissdk.NewClusterClient(sdk).Create(ctx, req)
 	if err != nil {
@@ -82,6 +103,30 @@ func (r *RedisAPI) CreateCluster(ctx context.Context, sdk *ycsdk.SDK, diag *diag
 	return md.ClusterId
 }
 
+func (r *RedisAPI) RestoreCluster(ctx context.Context, sdk *ycsdk.SDK, diag *diag.Diagnostics, req *redis.RestoreClusterRequest) string {
+	op, err := redissdk.NewClusterClient(sdk).Restore(ctx, req)
+	if err != nil {
+		diag.AddError(
+			"API Error Restoring",
+			fmt.Sprintf("Error while requesting API to restore Redis cluster from backup %q: %s", req.BackupId, err.Error()),
+		)
+		return ""
+	}
+
+	md := op.Metadata()
+
+	log.Printf("[DEBUG] Restoring Redis Cluster %q from backup %q", md.ClusterId, req.BackupId)
+
+	if _, err = op.Wait(ctx); err != nil {
+		diag.AddError(
+			"API Error Restoring",
+			fmt.Sprintf("Error while waiting for operation %q to restore Redis cluster from backup %q: %s", op.ID(), req.BackupId, err.Error()),
+		)
+	}
+
+	return md.ClusterId
+}
+
 func (r *RedisAPI) UpdateCluster(ctx context.Context, sdk *ycsdk.SDK, diag *diag.Diagnostics, req *redis.UpdateClusterRequest) {
 	op, err := redissdk.NewClusterClient(sdk).Update(ctx, req)
 	if err != nil {
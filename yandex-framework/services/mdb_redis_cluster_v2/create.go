@@ -107,3 +107,37 @@ func prepareCreateRedisRequest(ctx context.Context, meta *provider_config.Config
 	}
 	return &req
 }
+
+func prepareRestoreRedisRequest(ctx context.Context, diagnostics *diag.Diagnostics, plan *Cluster, createRequest *redis.CreateClusterRequest) *redis.RestoreClusterRequest {
+	var restore Restore
+	diagnostics.Append(plan.Restore.As(ctx, &restore, baseOptions)...)
+	if diagnostics.HasError() {
+		return nil
+	}
+
+	req := &redis.RestoreClusterRequest{
+		BackupId:            restore.BackupId.ValueString(),
+		FolderId:            createRequest.FolderId,
+		Name:                createRequest.Name,
+		Description:         createRequest.Description,
+		Labels:              createRequest.Labels,
+		Environment:         createRequest.Environment,
+		ConfigSpec:          createRequest.ConfigSpec,
+		HostSpecs:           createRequest.HostSpecs,
+		NetworkId:           createRequest.NetworkId,
+		SecurityGroupIds:    createRequest.SecurityGroupIds,
+		TlsEnabled:          createRequest.TlsEnabled,
+		PersistenceMode:     createRequest.PersistenceMode,
+		DeletionProtection:  createRequest.DeletionProtection,
+		AnnounceHostnames:   createRequest.AnnounceHostnames,
+		MaintenanceWindow:   createRequest.MaintenanceWindow,
+		AuthSentinel:        createRequest.AuthSentinel,
+		DiskEncryptionKeyId: createRequest.DiskEncryptionKeyId,
+	}
+
+	// Empty string will remove encryption when restoring
+	if req.DiskEncryptionKeyId == nil {
+		req.DiskEncryptionKeyId = &wrappers.StringValue{Value: ""}
+	}
+	return req
+}
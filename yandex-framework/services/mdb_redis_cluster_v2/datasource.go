@@ -178,6 +178,7 @@ func (o *redisClusterDataSource) Schema(ctx context.Context, _ datasource.Schema
 				Computed:    true,
 				Description: "ID of the symmetric encryption key used to encrypt the disk of the cluster.",
 			},
+			"endpoints": mdbcommon.EndpointsDataSourceSchema(),
 			"resources": schema.SingleNestedAttribute{
 				MarkdownDescription: "Resources allocated to hosts of the Redis cluster.",
 				Computed:            true,
@@ -32,6 +32,7 @@ type clusterModel struct {
 	MaintenanceWindow   types.Object `tfsdk:"maintenance_window"`
 	Resources           types.Object `tfsdk:"resources"`
 	Modules             types.Object `tfsdk:"modules"`
+	Endpoints           types.Object `tfsdk:"endpoints"`
 
 	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
@@ -39,7 +40,10 @@ type clusterModel struct {
 type Cluster struct {
 	clusterModel
 
-	Config *Config `tfsdk:"config"`
+	FinalBackup        types.Bool   `tfsdk:"final_backup"`
+	HostUpdateStrategy types.Object `tfsdk:"host_update_strategy"`
+	Restore            types.Object `tfsdk:"restore"`
+	Config             *Config      `tfsdk:"config"`
 }
 
 func (c *Cluster) commonCluster() *clusterModel {
@@ -53,6 +57,16 @@ func (c *Cluster) commonConfig() *configModel {
 	return &c.Config.configModel
 }
 
+type Restore struct {
+	BackupId types.String `tfsdk:"backup_id"`
+}
+
+var RestoreType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"backup_id": types.StringType,
+	},
+}
+
 type Access struct {
 	DataLens types.Bool `tfsdk:"data_lens"`
 	WebSql   types.Bool `tfsdk:"web_sql"`
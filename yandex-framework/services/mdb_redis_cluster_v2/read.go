@@ -80,4 +80,26 @@ func clusterRead(ctx context.Context, sdk *ycsdk.SDK, diagnostics *diag.Diagnost
 	if diagnostics.HasError() {
 		return
 	}
+
+	clusterState.Endpoints = flattenEndpoints(ctx, cluster, entityIdToApiHosts, diagnostics)
+}
+
+// flattenEndpoints returns the endpoints of the cluster. The special FQDN points to the master
+// of a non-sharded cluster only, the shards of a sharded one are reached by their hosts.
+func flattenEndpoints(ctx context.Context, cluster *redis.Cluster, hosts map[string]Host, diags *diag.Diagnostics) types.Object {
+	port := mdbcommon.RedisPort
+	if cluster.TlsEnabled {
+		port = mdbcommon.RedisTLSPort
+	}
+
+	if !cluster.Sharded {
+		return mdbcommon.FlattenEndpoints(ctx, mdbcommon.HostPort(mdbcommon.ClusterReadWriteHost(cluster.Id), port), "", nil, diags)
+	}
+
+	shards := make(map[string][]string)
+	for _, host := range hosts {
+		shardName := host.ShardName.ValueString()
+		shards[shardName] = append(shards[shardName], mdbcommon.HostPort(host.FQDN.ValueString(), port))
+	}
+	return mdbcommon.FlattenEndpoints(ctx, "", "", shards, diags)
 }
@@ -204,10 +204,28 @@ func (r *redisClusterResource) Schema(ctx context.Context,
 				},
 				MarkdownDescription: "Announce fqdn instead of ip address. Can be either true or false.",
 			},
-			"folder_id":           defaultschema.FolderId(),
-			"created_at":          defaultschema.CreatedAt(),
-			"security_group_ids":  defaultschema.SecurityGroupIds(),
-			"deletion_protection": defaultschema.DeletionProtection(),
+			"folder_id":            defaultschema.FolderId(),
+			"created_at":           defaultschema.CreatedAt(),
+			"security_group_ids":   defaultschema.SecurityGroupIds(),
+			"deletion_protection":  defaultschema.DeletionProtection(),
+			"final_backup":         defaultschema.FinalBackup(),
+			"host_update_strategy": defaultschema.HostUpdateStrategy(),
+			"restore": schema.SingleNestedAttribute{
+				MarkdownDescription: "The cluster will be created from the specified backup. Redis backups are restored to the moment they were taken, point-in-time recovery is not supported.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"backup_id": schema.StringAttribute{
+						MarkdownDescription: "Backup ID. The cluster will be created from the specified backup. [How to get a list of Redis backups](https://yandex.cloud/docs/managed-redis/operations/cluster-backups).",
+						Required:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
+					},
+				},
+			},
 			"auth_sentinel": schema.BoolAttribute{
 				Optional: true,
 				Computed: true,
@@ -224,6 +242,7 @@ func (r *redisClusterResource) Schema(ctx context.Context,
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"endpoints": mdbcommon.EndpointsSchema(),
 			"resources": schema.SingleNestedAttribute{
 				MarkdownDescription: "Resources allocated to hosts of the Redis cluster.",
 				Required:            true,
@@ -629,9 +648,23 @@ func (r *redisClusterResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
-	cid := redisAPI.CreateCluster(ctx, r.providerConfig.SDKv2, &resp.Diagnostics, request)
-	if resp.Diagnostics.HasError() {
-		return
+	var cid string
+	if utils.IsPresent(plan.Restore) {
+		restoreRequest := prepareRestoreRedisRequest(ctx, &resp.Diagnostics, &plan, request)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		// A failed restore may still leave the cluster created. Keep tracking it by
+		// its id so terraform manages it instead of leaving it orphaned.
+		cid = redisAPI.RestoreCluster(ctx, r.providerConfig.SDKv2, &resp.Diagnostics, restoreRequest)
+		if cid == "" {
+			return
+		}
+	} else {
+		cid = redisAPI.CreateCluster(ctx, r.providerConfig.SDKv2, &resp.Diagnostics, request)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 	}
 
 	plan.ID = types.StringValue(cid)
@@ -693,6 +726,11 @@ func (r *redisClusterResource) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 
+	hostUpdateStrategy := mdbcommon.ExpandHostUpdateStrategy(ctx, plan.HostUpdateStrategy, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	mdbcommon.UpdateClusterHostsWithShards[Host, *redis.Host, *redis.HostSpec, redis.UpdateHostSpec, struct{}](
 		ctx,
 		r.providerConfig.SDKv2,
@@ -701,6 +739,7 @@ func (r *redisClusterResource) Update(ctx context.Context, req resource.UpdateRe
 		&redisAPI,
 		plan.ID.ValueString(),
 		struct{}{},
+		hostUpdateStrategy,
 		plan.HostSpecs,
 		state.HostSpecs,
 	)
@@ -731,6 +770,12 @@ func (r *redisClusterResource) Delete(ctx context.Context, req resource.DeleteRe
 	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
 	defer cancel()
 
+	if state.FinalBackup.ValueBool() {
+		redisAPI.BackupCluster(ctx, r.providerConfig.SDKv2, &resp.Diagnostics, state.ID.ValueString())
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 	redisAPI.DeleteCluster(ctx, r.providerConfig.SDKv2, &resp.Diagnostics, state.ID.ValueString())
 	if resp.Diagnostics.HasError() {
 		return
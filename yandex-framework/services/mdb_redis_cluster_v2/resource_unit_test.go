@@ -4,9 +4,13 @@ import (
 	"context"
 	"testing"
 
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	frameworkresource "github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/redis/v1"
 )
 
 func TestPasswordWoSchema(t *testing.T) {
@@ -81,3 +85,38 @@ func TestRedisClusterPasswordChange(t *testing.T) {
 		}
 	})
 }
+
+func TestPrepareRestoreRedisRequest(t *testing.T) {
+	plan := &Cluster{
+		Restore: types.ObjectValueMust(RestoreType.AttrTypes, map[string]attr.Value{
+			"backup_id": types.StringValue("cid:backup"),
+		}),
+	}
+	createRequest := &redis.CreateClusterRequest{
+		FolderId:         "folder",
+		Name:             "redis",
+		NetworkId:        "network",
+		Sharded:          true,
+		TlsEnabled:       &wrappers.BoolValue{Value: true},
+		PersistenceMode:  redis.Cluster_OFF,
+		HostSpecs:        []*redis.HostSpec{{ZoneId: "ru-central1-a"}},
+		ConfigSpec:       &redis.ConfigSpec{Version: "7.2"},
+		SecurityGroupIds: []string{"sg"},
+	}
+
+	var diags diag.Diagnostics
+	req := prepareRestoreRedisRequest(context.Background(), &diags, plan, createRequest)
+	if diags.HasError() {
+		t.Fatalf("prepareRestoreRedisRequest() diagnostics: %#v", diags)
+	}
+
+	if req.GetBackupId() != "cid:backup" || req.GetName() != "redis" || req.GetFolderId() != "folder" || len(req.GetHostSpecs()) != 1 {
+		t.Fatalf("restore request = %v, want the create request parameters", req)
+	}
+	if !req.GetTlsEnabled().GetValue() || req.GetPersistenceMode() != redis.Cluster_OFF || req.GetConfigSpec().GetVersion() != "7.2" {
+		t.Fatalf("restore request = %v, want cluster settings of the create request", req)
+	}
+	if req.GetDiskEncryptionKeyId() == nil || req.GetDiskEncryptionKeyId().GetValue() != "" {
+		t.Fatalf("disk_encryption_key_id = %v, want empty value to drop encryption of the source cluster", req.GetDiskEncryptionKeyId())
+	}
+}
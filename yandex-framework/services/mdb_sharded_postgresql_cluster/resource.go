@@ -70,7 +70,8 @@ func (r *clusterResource) Configure(_ context.Context,
 
 func (r *clusterResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Manages a Sharded Postgresql cluster within the Yandex Cloud.",
+		MarkdownDescription: "Manages a Sharded Postgresql cluster within the Yandex Cloud.\n\n" +
+			"~> Creating the cluster from a backup is not supported: the Sharded PostgreSQL API has no restore method.\n",
 		Attributes: map[string]schema.Attribute{
 			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
 				Create: true,
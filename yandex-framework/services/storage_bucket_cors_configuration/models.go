@@ -0,0 +1,20 @@
+package storage_bucket_cors_configuration
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type StorageBucketCORSConfigurationResourceModel struct {
+	Bucket    types.String `tfsdk:"bucket"`
+	CORSRules types.List   `tfsdk:"cors_rule"`
+	AccessKey types.String `tfsdk:"access_key"`
+	SecretKey types.String `tfsdk:"secret_key"`
+}
+
+type StorageBucketCORSRuleModel struct {
+	AllowedHeaders types.List  `tfsdk:"allowed_headers"`
+	AllowedMethods types.List  `tfsdk:"allowed_methods"`
+	AllowedOrigins types.List  `tfsdk:"allowed_origins"`
+	ExposeHeaders  types.List  `tfsdk:"expose_headers"`
+	MaxAgeSeconds  types.Int64 `tfsdk:"max_age_seconds"`
+}
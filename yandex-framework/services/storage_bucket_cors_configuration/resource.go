@@ -0,0 +1,241 @@
+package storage_bucket_cors_configuration
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	storage "github.com/yandex-cloud/terraform-provider-yandex/pkg/storage/s3"
+	provider_config "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
+)
+
+var (
+	_ resource.Resource                = &storageBucketCORSConfigurationResource{}
+	_ resource.ResourceWithConfigure   = &storageBucketCORSConfigurationResource{}
+	_ resource.ResourceWithImportState = &storageBucketCORSConfigurationResource{}
+)
+
+type storageBucketCORSConfigurationResource struct {
+	providerConfig *provider_config.Config
+}
+
+func NewResource() resource.Resource {
+	return &storageBucketCORSConfigurationResource{}
+}
+
+func (r *storageBucketCORSConfigurationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_storage_bucket_cors_configuration"
+}
+
+func (r *storageBucketCORSConfigurationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = ResourceSchema(ctx)
+}
+
+func (r *storageBucketCORSConfigurationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerConfig, ok := req.ProviderData.(*provider_config.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider_config.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.providerConfig = providerConfig
+}
+
+func (r *storageBucketCORSConfigurationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("bucket"), req, resp)
+}
+
+func (r *storageBucketCORSConfigurationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan StorageBucketCORSConfigurationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.updateBucketCORS(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *storageBucketCORSConfigurationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state StorageBucketCORSConfigurationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	found := r.readBucketCORS(ctx, &state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *storageBucketCORSConfigurationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan StorageBucketCORSConfigurationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.updateBucketCORS(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *storageBucketCORSConfigurationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state StorageBucketCORSConfigurationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	s3Client, err := r.getS3Client(ctx, &state)
+	if err != nil {
+		resp.Diagnostics.AddError("Error getting storage client", err.Error())
+		return
+	}
+
+	err = s3Client.UpdateBucketCORS(ctx, state.Bucket.ValueString(), nil)
+	if err != nil && !storage.IsErr(err, storage.NoSuchBucket) {
+		resp.Diagnostics.AddError("Error deleting bucket CORS configuration", err.Error())
+		return
+	}
+}
+
+func (r *storageBucketCORSConfigurationResource) updateBucketCORS(ctx context.Context, model *StorageBucketCORSConfigurationResourceModel, diags *diag.Diagnostics) {
+	s3Client, err := r.getS3Client(ctx, model)
+	if err != nil {
+		diags.AddError("Error getting storage client", err.Error())
+		return
+	}
+
+	var rules []StorageBucketCORSRuleModel
+	diags.Append(model.CORSRules.ElementsAs(ctx, &rules, false)...)
+	if diags.HasError() {
+		return
+	}
+
+	s3Rules, d := modelCORSRulesToS3CORSRules(ctx, rules)
+	diags.Append(d...)
+	if diags.HasError() {
+		return
+	}
+
+	err = s3Client.UpdateBucketCORS(ctx, model.Bucket.ValueString(), s3Rules)
+	if err != nil {
+		diags.AddError("Error updating bucket CORS configuration", err.Error())
+		return
+	}
+}
+
+func (r *storageBucketCORSConfigurationResource) readBucketCORS(ctx context.Context, model *StorageBucketCORSConfigurationResourceModel, diags *diag.Diagnostics) bool {
+	s3Client, err := r.getS3Client(ctx, model)
+	if err != nil {
+		diags.AddError("Error getting storage client", err.Error())
+		return false
+	}
+
+	s3Rules, err := s3Client.GetBucketCORS(ctx, model.Bucket.ValueString())
+	if err != nil {
+		if storage.IsErr(err, storage.NoSuchBucket) {
+			return false
+		}
+		diags.AddError("Unable to read Storage Bucket CORS Configuration", err.Error())
+		return false
+	}
+	if len(s3Rules) == 0 {
+		return false
+	}
+
+	rules, d := s3CORSRulesToModelCORSRules(ctx, s3Rules)
+	diags.Append(d...)
+	if diags.HasError() {
+		return false
+	}
+
+	rulesList, d := types.ListValueFrom(ctx, corsRuleObjectType, rules)
+	diags.Append(d...)
+	if diags.HasError() {
+		return false
+	}
+
+	model.CORSRules = rulesList
+	return true
+}
+
+func (r *storageBucketCORSConfigurationResource) getS3Client(ctx context.Context, model *StorageBucketCORSConfigurationResourceModel) (*storage.Client, error) {
+	return r.providerConfig.GetS3Client(ctx, model.AccessKey.ValueString(), model.SecretKey.ValueString())
+}
+
+func modelCORSRulesToS3CORSRules(ctx context.Context, rules []StorageBucketCORSRuleModel) ([]*s3.CORSRule, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	s3Rules := make([]*s3.CORSRule, 0, len(rules))
+	for _, rule := range rules {
+		s3Rule := &s3.CORSRule{}
+		diags.Append(rule.AllowedHeaders.ElementsAs(ctx, &s3Rule.AllowedHeaders, false)...)
+		diags.Append(rule.AllowedMethods.ElementsAs(ctx, &s3Rule.AllowedMethods, false)...)
+		diags.Append(rule.AllowedOrigins.ElementsAs(ctx, &s3Rule.AllowedOrigins, false)...)
+		diags.Append(rule.ExposeHeaders.ElementsAs(ctx, &s3Rule.ExposeHeaders, false)...)
+		if !rule.MaxAgeSeconds.IsNull() && !rule.MaxAgeSeconds.IsUnknown() {
+			s3Rule.MaxAgeSeconds = aws.Int64(rule.MaxAgeSeconds.ValueInt64())
+		}
+		s3Rules = append(s3Rules, s3Rule)
+	}
+
+	return s3Rules, diags
+}
+
+func s3CORSRulesToModelCORSRules(ctx context.Context, s3Rules []*s3.CORSRule) ([]StorageBucketCORSRuleModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	rules := make([]StorageBucketCORSRuleModel, 0, len(s3Rules))
+	for _, s3Rule := range s3Rules {
+		rule := StorageBucketCORSRuleModel{
+			AllowedHeaders: stringListOrNull(ctx, s3Rule.AllowedHeaders, &diags),
+			AllowedMethods: stringListOrNull(ctx, s3Rule.AllowedMethods, &diags),
+			AllowedOrigins: stringListOrNull(ctx, s3Rule.AllowedOrigins, &diags),
+			ExposeHeaders:  stringListOrNull(ctx, s3Rule.ExposeHeaders, &diags),
+			MaxAgeSeconds:  types.Int64PointerValue(s3Rule.MaxAgeSeconds),
+		}
+		rules = append(rules, rule)
+	}
+
+	return rules, diags
+}
+
+// stringListOrNull keeps omitted optional lists null instead of empty to avoid a diff with configuration
+func stringListOrNull(ctx context.Context, values []*string, diags *diag.Diagnostics) types.List {
+	if len(values) == 0 {
+		return types.ListNull(types.StringType)
+	}
+
+	list, d := types.ListValueFrom(ctx, types.StringType, aws.StringValueSlice(values))
+	diags.Append(d...)
+	return list
+}
@@ -0,0 +1,137 @@
+package storage_bucket_cors_configuration_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	test "github.com/yandex-cloud/terraform-provider-yandex/pkg/testhelpers"
+	yandex_framework "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider"
+)
+
+// TestMain - add sweepers flag to the go test command
+// important for sweepers run.
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
+
+func TestAccStorageBucketResourceCORSConfiguration(t *testing.T) {
+	var (
+		bucketName   = test.ResourceName(63)
+		resourceName = "yandex_storage_bucket_cors_configuration.test-bucket-cors"
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.AccPreCheck(t) },
+		ProtoV6ProviderFactories: test.AccProviderFactories,
+		CheckDestroy:             test.AccCheckBucketDestroy(bucketName),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStorageBucketCORSConfigurationConfig(bucketName, test.GetExampleFolderID()),
+				Check: resource.ComposeTestCheckFunc(
+					test.BucketExists(bucketName),
+					testAccStorageBucketCORSRulesCount(bucketName, 1),
+					resource.TestCheckResourceAttr(resourceName, "bucket", bucketName),
+					resource.TestCheckResourceAttr(resourceName, "cors_rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "cors_rule.0.allowed_methods.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "cors_rule.0.allowed_origins.0", "https://example.com"),
+					resource.TestCheckResourceAttr(resourceName, "cors_rule.0.max_age_seconds", "3000"),
+				),
+			},
+			{
+				Config: testAccStorageBucketCORSConfigurationConfigUpdated(bucketName, test.GetExampleFolderID()),
+				Check: resource.ComposeTestCheckFunc(
+					testAccStorageBucketCORSRulesCount(bucketName, 2),
+					resource.TestCheckResourceAttr(resourceName, "cors_rule.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "cors_rule.1.allowed_headers.0", "*"),
+					resource.TestCheckNoResourceAttr(resourceName, "cors_rule.1.max_age_seconds"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateId:                        bucketName,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "bucket",
+			},
+		},
+	})
+}
+
+func testAccStorageBucketCORSConfigurationConfig(bucketName, folderID string) string {
+	return fmt.Sprintf(`
+resource "yandex_storage_bucket" "test-bucket" {
+  bucket = "%s"
+  folder_id = "%s"
+
+  lifecycle {
+    ignore_changes = [cors_rule]
+  }
+}
+
+resource "yandex_storage_bucket_cors_configuration" "test-bucket-cors" {
+  bucket = yandex_storage_bucket.test-bucket.bucket
+
+  cors_rule {
+    allowed_methods = ["GET", "PUT"]
+    allowed_origins = ["https://example.com"]
+    expose_headers  = ["ETag"]
+    max_age_seconds = 3000
+  }
+}
+`, bucketName, folderID)
+}
+
+func testAccStorageBucketCORSConfigurationConfigUpdated(bucketName, folderID string) string {
+	return fmt.Sprintf(`
+resource "yandex_storage_bucket" "test-bucket" {
+  bucket = "%s"
+  folder_id = "%s"
+
+  lifecycle {
+    ignore_changes = [cors_rule]
+  }
+}
+
+resource "yandex_storage_bucket_cors_configuration" "test-bucket-cors" {
+  bucket = yandex_storage_bucket.test-bucket.bucket
+
+  cors_rule {
+    allowed_methods = ["GET", "PUT"]
+    allowed_origins = ["https://example.com"]
+    expose_headers  = ["ETag"]
+    max_age_seconds = 3000
+  }
+
+  cors_rule {
+    allowed_headers = ["*"]
+    allowed_methods = ["GET"]
+    allowed_origins = ["*"]
+  }
+}
+`, bucketName, folderID)
+}
+
+func testAccStorageBucketCORSRulesCount(bucketName string, expected int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := test.AccProvider.(*yandex_framework.Provider).GetConfig()
+
+		s3Client, err := config.GetS3Client(context.Background(), "", "")
+		if err != nil {
+			return fmt.Errorf("error getting S3 client: %s", err)
+		}
+
+		rules, err := s3Client.GetBucketCORS(context.Background(), bucketName)
+		if err != nil {
+			return fmt.Errorf("error getting bucket CORS configuration: %s", err)
+		}
+
+		if len(rules) != expected {
+			return fmt.Errorf("expected %d CORS rules, got %d", expected, len(rules))
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,101 @@
+package storage_bucket_cors_configuration
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	corsAllowedMethods = []string{"GET", "PUT", "POST", "DELETE", "HEAD"}
+
+	corsRuleObjectType = types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"allowed_headers": types.ListType{ElemType: types.StringType},
+			"allowed_methods": types.ListType{ElemType: types.StringType},
+			"allowed_origins": types.ListType{ElemType: types.StringType},
+			"expose_headers":  types.ListType{ElemType: types.StringType},
+			"max_age_seconds": types.Int64Type,
+		},
+	}
+)
+
+func ResourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Allows management of [CORS configuration](https://yandex.cloud/docs/storage/concepts/cors) of an existing Yandex Cloud Storage Bucket.\n\n~> By default, for authentication, you need to use [IAM token](https://yandex.cloud/docs/iam/concepts/authorization/iam-token) with the necessary permissions.\n\n~> Alternatively, you can provide [static access keys](https://yandex.cloud/docs/iam/concepts/authorization/access-key) (Access and Secret). To generate these keys, you will need a Service Account with the appropriate permissions.\n\n~> Do not use this resource together with the `cors_rule` block of `yandex_storage_bucket` for the same bucket. If the bucket is managed by `yandex_storage_bucket`, add `cors_rule` to its `lifecycle.ignore_changes`, otherwise the bucket resource will overwrite the configuration.",
+		Attributes: map[string]schema.Attribute{
+			"bucket": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the bucket.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"access_key": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The access key to use when applying changes. This value can also be provided as `storage_access_key` specified in provider config (explicitly or within `shared_credentials_file`) is used.",
+			},
+			"secret_key": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The secret key to use when applying changes. This value can also be provided as `storage_secret_key` specified in provider config (explicitly or within `shared_credentials_file`) is used.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"cors_rule": schema.ListNestedBlock{
+				MarkdownDescription: "A rule of [Cross-Origin Resource Sharing](https://yandex.cloud/docs/storage/concepts/cors). At least one rule is required.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"allowed_headers": schema.ListAttribute{
+							ElementType:         types.StringType,
+							Optional:            true,
+							MarkdownDescription: "Specifies which headers are allowed.",
+						},
+						"allowed_methods": schema.ListAttribute{
+							ElementType:         types.StringType,
+							Required:            true,
+							MarkdownDescription: "Specifies which methods are allowed. Can be `GET`, `PUT`, `POST`, `DELETE` or `HEAD`.",
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+								listvalidator.ValueStringsAre(stringvalidator.OneOf(corsAllowedMethods...)),
+							},
+						},
+						"allowed_origins": schema.ListAttribute{
+							ElementType:         types.StringType,
+							Required:            true,
+							MarkdownDescription: "Specifies which origins are allowed.",
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+							},
+						},
+						"expose_headers": schema.ListAttribute{
+							ElementType:         types.StringType,
+							Optional:            true,
+							MarkdownDescription: "Specifies expose header in the response.",
+						},
+						"max_age_seconds": schema.Int64Attribute{
+							Optional:            true,
+							MarkdownDescription: "Specifies time in seconds that browser can cache the response for a preflight request.",
+							Validators: []validator.Int64{
+								int64validator.AtLeast(0),
+							},
+						},
+					},
+				},
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,66 @@
+package storage_bucket_lifecycle_configuration
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type StorageBucketLifecycleConfigurationResourceModel struct {
+	Bucket    types.String                      `tfsdk:"bucket"`
+	Rules     []StorageBucketLifecycleRuleModel `tfsdk:"rule"`
+	AccessKey types.String                      `tfsdk:"access_key"`
+	SecretKey types.String                      `tfsdk:"secret_key"`
+}
+
+type StorageBucketLifecycleRuleModel struct {
+	ID                                 types.String                                      `tfsdk:"id"`
+	Enabled                            types.Bool                                        `tfsdk:"enabled"`
+	AbortIncompleteMultipartUploadDays types.Int64                                       `tfsdk:"abort_incomplete_multipart_upload_days"`
+	Filter                             *StorageBucketLifecycleFilterModel                `tfsdk:"filter"`
+	Expiration                         *StorageBucketLifecycleExpirationModel            `tfsdk:"expiration"`
+	Transitions                        []StorageBucketLifecycleTransitionModel           `tfsdk:"transition"`
+	NoncurrentVersionExpiration        *StorageBucketLifecycleNoncurrentExpirationModel  `tfsdk:"noncurrent_version_expiration"`
+	NoncurrentVersionTransitions       []StorageBucketLifecycleNoncurrentTransitionModel `tfsdk:"noncurrent_version_transition"`
+}
+
+type StorageBucketLifecycleFilterModel struct {
+	Prefix                types.String                          `tfsdk:"prefix"`
+	ObjectSizeGreaterThan types.Int64                           `tfsdk:"object_size_greater_than"`
+	ObjectSizeLessThan    types.Int64                           `tfsdk:"object_size_less_than"`
+	Tag                   *StorageBucketLifecycleTagModel       `tfsdk:"tag"`
+	And                   *StorageBucketLifecycleAndFilterModel `tfsdk:"and"`
+}
+
+type StorageBucketLifecycleTagModel struct {
+	Key   types.String `tfsdk:"key"`
+	Value types.String `tfsdk:"value"`
+}
+
+type StorageBucketLifecycleAndFilterModel struct {
+	Prefix                types.String `tfsdk:"prefix"`
+	ObjectSizeGreaterThan types.Int64  `tfsdk:"object_size_greater_than"`
+	ObjectSizeLessThan    types.Int64  `tfsdk:"object_size_less_than"`
+	Tags                  types.Map    `tfsdk:"tags"`
+}
+
+type StorageBucketLifecycleExpirationModel struct {
+	Date                      types.String `tfsdk:"date"`
+	Days                      types.Int64  `tfsdk:"days"`
+	ExpiredObjectDeleteMarker types.Bool   `tfsdk:"expired_object_delete_marker"`
+}
+
+type StorageBucketLifecycleTransitionModel struct {
+	Date         types.String `tfsdk:"date"`
+	Days         types.Int64  `tfsdk:"days"`
+	StorageClass types.String `tfsdk:"storage_class"`
+}
+
+type StorageBucketLifecycleNoncurrentExpirationModel struct {
+	Days                    types.Int64 `tfsdk:"days"`
+	NewerNoncurrentVersions types.Int64 `tfsdk:"newer_noncurrent_versions"`
+}
+
+type StorageBucketLifecycleNoncurrentTransitionModel struct {
+	Days                    types.Int64  `tfsdk:"days"`
+	NewerNoncurrentVersions types.Int64  `tfsdk:"newer_noncurrent_versions"`
+	StorageClass            types.String `tfsdk:"storage_class"`
+}
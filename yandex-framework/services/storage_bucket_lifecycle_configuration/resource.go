@@ -0,0 +1,178 @@
+package storage_bucket_lifecycle_configuration
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	storage "github.com/yandex-cloud/terraform-provider-yandex/pkg/storage/s3"
+	provider_config "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
+)
+
+var (
+	_ resource.Resource                = &storageBucketLifecycleConfigurationResource{}
+	_ resource.ResourceWithConfigure   = &storageBucketLifecycleConfigurationResource{}
+	_ resource.ResourceWithImportState = &storageBucketLifecycleConfigurationResource{}
+)
+
+type storageBucketLifecycleConfigurationResource struct {
+	providerConfig *provider_config.Config
+}
+
+func NewResource() resource.Resource {
+	return &storageBucketLifecycleConfigurationResource{}
+}
+
+func (r *storageBucketLifecycleConfigurationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_storage_bucket_lifecycle_configuration"
+}
+
+func (r *storageBucketLifecycleConfigurationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = ResourceSchema(ctx)
+}
+
+func (r *storageBucketLifecycleConfigurationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerConfig, ok := req.ProviderData.(*provider_config.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider_config.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.providerConfig = providerConfig
+}
+
+func (r *storageBucketLifecycleConfigurationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("bucket"), req, resp)
+}
+
+func (r *storageBucketLifecycleConfigurationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan StorageBucketLifecycleConfigurationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.updateBucketLifecycle(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *storageBucketLifecycleConfigurationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state StorageBucketLifecycleConfigurationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	found := r.readBucketLifecycle(ctx, &state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *storageBucketLifecycleConfigurationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan StorageBucketLifecycleConfigurationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.updateBucketLifecycle(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *storageBucketLifecycleConfigurationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state StorageBucketLifecycleConfigurationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	s3Client, err := r.getS3Client(ctx, &state)
+	if err != nil {
+		resp.Diagnostics.AddError("Error getting storage client", err.Error())
+		return
+	}
+
+	err = s3Client.UpdateBucketLifecycle(ctx, state.Bucket.ValueString(), nil)
+	if err != nil && !storage.IsErr(err, storage.NoSuchBucket) {
+		resp.Diagnostics.AddError("Error deleting bucket lifecycle configuration", err.Error())
+		return
+	}
+}
+
+func (r *storageBucketLifecycleConfigurationResource) updateBucketLifecycle(ctx context.Context, model *StorageBucketLifecycleConfigurationResourceModel, diags *diag.Diagnostics) {
+	s3Client, err := r.getS3Client(ctx, model)
+	if err != nil {
+		diags.AddError("Error getting storage client", err.Error())
+		return
+	}
+
+	rules, d := expandLifecycleRules(ctx, model.Rules)
+	diags.Append(d...)
+	if diags.HasError() {
+		return
+	}
+
+	err = s3Client.UpdateBucketLifecycle(ctx, model.Bucket.ValueString(), rules)
+	if err != nil {
+		diags.AddError("Error updating bucket lifecycle configuration", err.Error())
+		return
+	}
+}
+
+func (r *storageBucketLifecycleConfigurationResource) readBucketLifecycle(ctx context.Context, model *StorageBucketLifecycleConfigurationResourceModel, diags *diag.Diagnostics) bool {
+	s3Client, err := r.getS3Client(ctx, model)
+	if err != nil {
+		diags.AddError("Error getting storage client", err.Error())
+		return false
+	}
+
+	rules, err := s3Client.GetBucketLifecycle(ctx, model.Bucket.ValueString())
+	if err != nil {
+		if storage.IsErr(err, storage.NoSuchBucket) {
+			return false
+		}
+		diags.AddError("Unable to read Storage Bucket Lifecycle Configuration", err.Error())
+		return false
+	}
+	if len(rules) == 0 {
+		return false
+	}
+
+	modelRules, d := flattenLifecycleRules(ctx, rules)
+	diags.Append(d...)
+	if diags.HasError() {
+		return false
+	}
+
+	model.Rules = modelRules
+	return true
+}
+
+func (r *storageBucketLifecycleConfigurationResource) getS3Client(ctx context.Context, model *StorageBucketLifecycleConfigurationResourceModel) (*storage.Client, error) {
+	return r.providerConfig.GetS3Client(ctx, model.AccessKey.ValueString(), model.SecretKey.ValueString())
+}
@@ -0,0 +1,178 @@
+package storage_bucket_lifecycle_configuration_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	test "github.com/yandex-cloud/terraform-provider-yandex/pkg/testhelpers"
+	yandex_framework "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider"
+)
+
+// TestMain - add sweepers flag to the go test command
+// important for sweepers run.
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
+
+func TestAccStorageBucketResourceLifecycleConfiguration(t *testing.T) {
+	var (
+		bucketName   = test.ResourceName(63)
+		resourceName = "yandex_storage_bucket_lifecycle_configuration.test-bucket-lifecycle"
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.AccPreCheck(t) },
+		ProtoV6ProviderFactories: test.AccProviderFactories,
+		CheckDestroy:             test.AccCheckBucketDestroy(bucketName),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStorageBucketLifecycleConfigurationConfig(bucketName, test.GetExampleFolderID()),
+				Check: resource.ComposeTestCheckFunc(
+					test.BucketExists(bucketName),
+					testAccStorageBucketLifecycleRulesCount(bucketName, 1),
+					resource.TestCheckResourceAttr(resourceName, "bucket", bucketName),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.id", "logs"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.filter.prefix", "logs/"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.expiration.days", "90"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.transition.0.days", "30"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.transition.0.storage_class", "COLD"),
+				),
+			},
+			{
+				Config: testAccStorageBucketLifecycleConfigurationConfigUpdated(bucketName, test.GetExampleFolderID()),
+				Check: resource.ComposeTestCheckFunc(
+					testAccStorageBucketLifecycleRulesCount(bucketName, 2),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "rule.1.id", "tmp"),
+					resource.TestCheckResourceAttr(resourceName, "rule.1.filter.and.prefix", "tmp/"),
+					resource.TestCheckResourceAttr(resourceName, "rule.1.filter.and.object_size_greater_than", "1024"),
+					resource.TestCheckResourceAttr(resourceName, "rule.1.abort_incomplete_multipart_upload_days", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.1.noncurrent_version_expiration.days", "7"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateId:                        bucketName,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "bucket",
+			},
+		},
+	})
+}
+
+func testAccStorageBucketLifecycleConfigurationConfig(bucketName, folderID string) string {
+	return fmt.Sprintf(`
+resource "yandex_storage_bucket" "test-bucket" {
+  bucket = "%s"
+  folder_id = "%s"
+
+  lifecycle {
+    ignore_changes = [lifecycle_rule]
+  }
+}
+
+resource "yandex_storage_bucket_lifecycle_configuration" "test-bucket-lifecycle" {
+  bucket = yandex_storage_bucket.test-bucket.bucket
+
+  rule {
+    id      = "logs"
+    enabled = true
+
+    filter {
+      prefix = "logs/"
+    }
+
+    transition {
+      days          = 30
+      storage_class = "COLD"
+    }
+
+    expiration {
+      days = 90
+    }
+  }
+}
+`, bucketName, folderID)
+}
+
+func testAccStorageBucketLifecycleConfigurationConfigUpdated(bucketName, folderID string) string {
+	return fmt.Sprintf(`
+resource "yandex_storage_bucket" "test-bucket" {
+  bucket = "%s"
+  folder_id = "%s"
+
+  lifecycle {
+    ignore_changes = [lifecycle_rule]
+  }
+}
+
+resource "yandex_storage_bucket_lifecycle_configuration" "test-bucket-lifecycle" {
+  bucket = yandex_storage_bucket.test-bucket.bucket
+
+  rule {
+    id      = "logs"
+    enabled = false
+
+    filter {
+      prefix = "logs/"
+    }
+
+    transition {
+      days          = 30
+      storage_class = "COLD"
+    }
+
+    expiration {
+      days = 90
+    }
+  }
+
+  rule {
+    id      = "tmp"
+    enabled = true
+
+    filter {
+      and {
+        prefix                   = "tmp/"
+        object_size_greater_than = 1024
+      }
+    }
+
+    abort_incomplete_multipart_upload_days = 1
+
+    noncurrent_version_expiration {
+      days = 7
+    }
+  }
+}
+`, bucketName, folderID)
+}
+
+func testAccStorageBucketLifecycleRulesCount(bucketName string, expected int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := test.AccProvider.(*yandex_framework.Provider).GetConfig()
+
+		s3Client, err := config.GetS3Client(context.Background(), "", "")
+		if err != nil {
+			return fmt.Errorf("error getting S3 client: %s", err)
+		}
+
+		rules, err := s3Client.GetBucketLifecycle(context.Background(), bucketName)
+		if err != nil {
+			return fmt.Errorf("error getting bucket lifecycle configuration: %s", err)
+		}
+
+		if len(rules) != expected {
+			return fmt.Errorf("expected %d lifecycle rules, got %d", expected, len(rules))
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,281 @@
+package storage_bucket_lifecycle_configuration
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	storage "github.com/yandex-cloud/terraform-provider-yandex/pkg/storage/s3"
+)
+
+func ResourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Allows management of [object lifecycle configuration](https://yandex.cloud/docs/storage/concepts/lifecycles) of an existing Yandex Cloud Storage Bucket.\n\n~> By default, for authentication, you need to use [IAM token](https://yandex.cloud/docs/iam/concepts/authorization/iam-token) with the necessary permissions.\n\n~> Alternatively, you can provide [static access keys](https://yandex.cloud/docs/iam/concepts/authorization/access-key) (Access and Secret). To generate these keys, you will need a Service Account with the appropriate permissions.\n\n~> Do not use this resource together with the `lifecycle_rule` block of `yandex_storage_bucket` for the same bucket. If the bucket is managed by `yandex_storage_bucket`, add `lifecycle_rule` to its `lifecycle.ignore_changes`, otherwise the bucket resource will overwrite the configuration.",
+		Attributes: map[string]schema.Attribute{
+			"bucket": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the bucket.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"access_key": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The access key to use when applying changes. This value can also be provided as `storage_access_key` specified in provider config (explicitly or within `shared_credentials_file`) is used.",
+			},
+			"secret_key": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The secret key to use when applying changes. This value can also be provided as `storage_secret_key` specified in provider config (explicitly or within `shared_credentials_file`) is used.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"rule": schema.ListNestedBlock{
+				MarkdownDescription: "A lifecycle rule. At least one rule is required.\n\nAt least one of `abort_incomplete_multipart_upload_days`, `expiration`, `transition`, `noncurrent_version_expiration`, `noncurrent_version_transition` must be specified in a rule.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "Unique identifier for the rule. Must be less than or equal to 255 characters in length.",
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 255),
+							},
+						},
+						"enabled": schema.BoolAttribute{
+							Required:            true,
+							MarkdownDescription: "Specifies lifecycle rule status.",
+						},
+						"abort_incomplete_multipart_upload_days": schema.Int64Attribute{
+							Optional:            true,
+							MarkdownDescription: "Specifies the number of days after initiating a multipart upload when the multipart upload must be completed.",
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"filter":                        filterBlock(),
+						"expiration":                    expirationBlock(),
+						"transition":                    transitionBlock(),
+						"noncurrent_version_expiration": noncurrentVersionExpirationBlock(),
+						"noncurrent_version_transition": noncurrentVersionTransitionBlock(),
+					},
+					Validators: []validator.Object{
+						ruleActionsValidator{},
+					},
+				},
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func filterBlock() schema.Block {
+	return schema.SingleNestedBlock{
+		MarkdownDescription: "Filter block identifies one or more objects to which the rule applies. A filter must have at most one of `prefix`, `tag`, `and`, `object_size_greater_than`, `object_size_less_than` specified. Use `and` to combine several conditions. If the filter is omitted, the rule applies to all objects in the bucket.",
+		Attributes: map[string]schema.Attribute{
+			"prefix": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Object key prefix identifying one or more objects to which the rule applies.",
+			},
+			"object_size_greater_than": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Minimum object size to which the rule applies.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"object_size_less_than": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Maximum object size to which the rule applies.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"tag": schema.SingleNestedBlock{
+				MarkdownDescription: "A key and value pair for filtering objects.",
+				Attributes: map[string]schema.Attribute{
+					"key": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "A key.",
+					},
+					"value": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "A value.",
+					},
+				},
+				Validators: []validator.Object{
+					objectvalidator.AlsoRequires(
+						path.MatchRelative().AtName("key"),
+						path.MatchRelative().AtName("value"),
+					),
+				},
+			},
+			"and": schema.SingleNestedBlock{
+				MarkdownDescription: "A logical `and` operator applied to one or more filter parameters. It should be used when two or more of the above parameters are used.",
+				Attributes: map[string]schema.Attribute{
+					"prefix": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "Object key prefix identifying one or more objects to which the rule applies.",
+					},
+					"object_size_greater_than": schema.Int64Attribute{
+						Optional:            true,
+						MarkdownDescription: "Minimum object size to which the rule applies.",
+						Validators: []validator.Int64{
+							int64validator.AtLeast(0),
+						},
+					},
+					"object_size_less_than": schema.Int64Attribute{
+						Optional:            true,
+						MarkdownDescription: "Maximum object size to which the rule applies.",
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+					"tags": schema.MapAttribute{
+						ElementType:         types.StringType,
+						Optional:            true,
+						MarkdownDescription: "Key-value pairs for filtering objects.",
+					},
+				},
+			},
+		},
+		Validators: []validator.Object{
+			filterConditionsValidator{},
+		},
+	}
+}
+
+func expirationBlock() schema.Block {
+	return schema.SingleNestedBlock{
+		MarkdownDescription: "Specifies a period in the object's expire.",
+		Attributes: map[string]schema.Attribute{
+			"date": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Specifies the date after which you want the corresponding action to take effect, in the `YYYY-MM-DD` format.",
+				Validators: []validator.String{
+					dateValidator{},
+					stringvalidator.ConflictsWith(
+						path.MatchRelative().AtParent().AtName("days"),
+						path.MatchRelative().AtParent().AtName("expired_object_delete_marker"),
+					),
+				},
+			},
+			"days": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Specifies the number of days after object creation when the specific rule action takes effect.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+					int64validator.ConflictsWith(path.MatchRelative().AtParent().AtName("expired_object_delete_marker")),
+				},
+			},
+			"expired_object_delete_marker": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "In a versioned bucket (versioning-enabled or versioning-suspended bucket), you can add this element in the lifecycle configuration to direct Object Storage to delete expired object delete markers.",
+			},
+		},
+	}
+}
+
+func transitionBlock() schema.Block {
+	return schema.ListNestedBlock{
+		MarkdownDescription: "Specifies a period in the object's transitions.",
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"date": schema.StringAttribute{
+					Optional:            true,
+					MarkdownDescription: "Specifies the date after which you want the corresponding action to take effect, in the `YYYY-MM-DD` format.",
+					Validators: []validator.String{
+						dateValidator{},
+						stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("days")),
+					},
+				},
+				"days": schema.Int64Attribute{
+					Optional:            true,
+					MarkdownDescription: "Specifies the number of days after object creation when the specific rule action takes effect.",
+					Validators: []validator.Int64{
+						int64validator.AtLeast(0),
+					},
+				},
+				"storage_class": schema.StringAttribute{
+					Required:            true,
+					MarkdownDescription: "Specifies the storage class to which you want the object to transition. Supported values: [`STANDARD_IA`, `COLD`, `ICE`, `INTELLIGENT_TIERING`].",
+					Validators: []validator.String{
+						stringvalidator.OneOf(storage.StorageClassValues...),
+					},
+				},
+			},
+		},
+	}
+}
+
+func noncurrentVersionExpirationBlock() schema.Block {
+	return schema.SingleNestedBlock{
+		MarkdownDescription: "Specifies when noncurrent object versions expire.",
+		Attributes: map[string]schema.Attribute{
+			"days": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Specifies the number of days noncurrent object versions expire.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"newer_noncurrent_versions": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Specifies the number of noncurrent versions to retain. Object Storage permanently deletes any additional noncurrent versions beyond this number.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+		},
+		Validators: []validator.Object{
+			objectvalidator.AlsoRequires(path.MatchRelative().AtName("days")),
+		},
+	}
+}
+
+func noncurrentVersionTransitionBlock() schema.Block {
+	return schema.ListNestedBlock{
+		MarkdownDescription: "Specifies when noncurrent object versions transitions.",
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"days": schema.Int64Attribute{
+					Required:            true,
+					MarkdownDescription: "Specifies the number of days noncurrent object versions transition.",
+					Validators: []validator.Int64{
+						int64validator.AtLeast(0),
+					},
+				},
+				"newer_noncurrent_versions": schema.Int64Attribute{
+					Optional:            true,
+					MarkdownDescription: "Specifies the number of noncurrent versions to retain. Object Storage permanently deletes any additional noncurrent versions beyond this number.",
+					Validators: []validator.Int64{
+						int64validator.AtLeast(1),
+					},
+				},
+				"storage_class": schema.StringAttribute{
+					Required:            true,
+					MarkdownDescription: "Specifies the storage class to which you want the noncurrent object versions to transition. Supported values: [`STANDARD_IA`, `COLD`, `ICE`, `INTELLIGENT_TIERING`].",
+					Validators: []validator.String{
+						stringvalidator.OneOf(storage.StorageClassValues...),
+					},
+				},
+			},
+		},
+	}
+}
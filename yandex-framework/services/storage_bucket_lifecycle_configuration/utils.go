@@ -0,0 +1,239 @@
+package storage_bucket_lifecycle_configuration
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	storage "github.com/yandex-cloud/terraform-provider-yandex/pkg/storage/s3"
+)
+
+func expandLifecycleRules(ctx context.Context, rules []StorageBucketLifecycleRuleModel) ([]*s3.LifecycleRule, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	s3Rules := make([]*s3.LifecycleRule, 0, len(rules))
+	for _, rule := range rules {
+		s3Rule := &s3.LifecycleRule{
+			ID:     aws.String(rule.ID.ValueString()),
+			Status: aws.String(storage.ExpirationStatusDisabled),
+			Filter: &s3.LifecycleRuleFilter{},
+		}
+		if rule.Enabled.ValueBool() {
+			s3Rule.Status = aws.String(storage.ExpirationStatusEnabled)
+		}
+
+		if v := rule.AbortIncompleteMultipartUploadDays; !v.IsNull() {
+			s3Rule.AbortIncompleteMultipartUpload = &s3.AbortIncompleteMultipartUpload{
+				DaysAfterInitiation: v.ValueInt64Pointer(),
+			}
+		}
+
+		if f := rule.Filter; f != nil {
+			filter, d := expandLifecycleFilter(ctx, f)
+			diags.Append(d...)
+			s3Rule.Filter = filter
+		}
+
+		if e := rule.Expiration; e != nil {
+			s3Rule.Expiration = &s3.LifecycleExpiration{
+				Date:                      expandLifecycleDate(e.Date),
+				Days:                      e.Days.ValueInt64Pointer(),
+				ExpiredObjectDeleteMarker: e.ExpiredObjectDeleteMarker.ValueBoolPointer(),
+			}
+		}
+
+		for _, t := range rule.Transitions {
+			s3Rule.Transitions = append(s3Rule.Transitions, &s3.Transition{
+				Date:         expandLifecycleDate(t.Date),
+				Days:         t.Days.ValueInt64Pointer(),
+				StorageClass: aws.String(t.StorageClass.ValueString()),
+			})
+		}
+
+		if e := rule.NoncurrentVersionExpiration; e != nil {
+			s3Rule.NoncurrentVersionExpiration = &s3.NoncurrentVersionExpiration{
+				NoncurrentDays:          e.Days.ValueInt64Pointer(),
+				NewerNoncurrentVersions: e.NewerNoncurrentVersions.ValueInt64Pointer(),
+			}
+		}
+
+		for _, t := range rule.NoncurrentVersionTransitions {
+			s3Rule.NoncurrentVersionTransitions = append(s3Rule.NoncurrentVersionTransitions, &s3.NoncurrentVersionTransition{
+				NoncurrentDays:          t.Days.ValueInt64Pointer(),
+				NewerNoncurrentVersions: t.NewerNoncurrentVersions.ValueInt64Pointer(),
+				StorageClass:            aws.String(t.StorageClass.ValueString()),
+			})
+		}
+
+		s3Rules = append(s3Rules, s3Rule)
+	}
+
+	return s3Rules, diags
+}
+
+func expandLifecycleFilter(ctx context.Context, f *StorageBucketLifecycleFilterModel) (*s3.LifecycleRuleFilter, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	filter := &s3.LifecycleRuleFilter{
+		Prefix:                f.Prefix.ValueStringPointer(),
+		ObjectSizeGreaterThan: f.ObjectSizeGreaterThan.ValueInt64Pointer(),
+		ObjectSizeLessThan:    f.ObjectSizeLessThan.ValueInt64Pointer(),
+	}
+	if f.Tag != nil {
+		filter.Tag = &s3.Tag{
+			Key:   aws.String(f.Tag.Key.ValueString()),
+			Value: aws.String(f.Tag.Value.ValueString()),
+		}
+	}
+	if f.And != nil {
+		var tags map[string]string
+		diags.Append(f.And.Tags.ElementsAs(ctx, &tags, false)...)
+
+		filter.And = &s3.LifecycleRuleAndOperator{
+			Prefix:                f.And.Prefix.ValueStringPointer(),
+			ObjectSizeGreaterThan: f.And.ObjectSizeGreaterThan.ValueInt64Pointer(),
+			ObjectSizeLessThan:    f.And.ObjectSizeLessThan.ValueInt64Pointer(),
+		}
+		for key, value := range tags {
+			filter.And.Tags = append(filter.And.Tags, &s3.Tag{
+				Key:   aws.String(key),
+				Value: aws.String(value),
+			})
+		}
+	}
+
+	return filter, diags
+}
+
+func expandLifecycleDate(v types.String) *time.Time {
+	if v.IsNull() || v.IsUnknown() {
+		return nil
+	}
+
+	// The value has already been checked by dateValidator
+	t, err := time.Parse(lifecycleDateLayout, v.ValueString())
+	if err != nil {
+		return nil
+	}
+	return &t
+}
+
+func flattenLifecycleRules(ctx context.Context, s3Rules []*s3.LifecycleRule) ([]StorageBucketLifecycleRuleModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	rules := make([]StorageBucketLifecycleRuleModel, 0, len(s3Rules))
+	for _, s3Rule := range s3Rules {
+		rule := StorageBucketLifecycleRuleModel{
+			ID:                                 types.StringPointerValue(s3Rule.ID),
+			Enabled:                            types.BoolValue(aws.StringValue(s3Rule.Status) == storage.ExpirationStatusEnabled),
+			AbortIncompleteMultipartUploadDays: types.Int64Null(),
+			Transitions:                        []StorageBucketLifecycleTransitionModel{},
+			NoncurrentVersionTransitions:       []StorageBucketLifecycleNoncurrentTransitionModel{},
+		}
+
+		if v := s3Rule.AbortIncompleteMultipartUpload; v != nil {
+			rule.AbortIncompleteMultipartUploadDays = types.Int64PointerValue(v.DaysAfterInitiation)
+		}
+
+		if f := s3Rule.Filter; f != nil {
+			filter, d := flattenLifecycleFilter(ctx, f)
+			diags.Append(d...)
+			rule.Filter = filter
+		}
+
+		if e := s3Rule.Expiration; e != nil {
+			rule.Expiration = &StorageBucketLifecycleExpirationModel{
+				Date:                      flattenLifecycleDate(e.Date),
+				Days:                      types.Int64PointerValue(e.Days),
+				ExpiredObjectDeleteMarker: types.BoolPointerValue(e.ExpiredObjectDeleteMarker),
+			}
+		}
+
+		for _, t := range s3Rule.Transitions {
+			rule.Transitions = append(rule.Transitions, StorageBucketLifecycleTransitionModel{
+				Date:         flattenLifecycleDate(t.Date),
+				Days:         types.Int64PointerValue(t.Days),
+				StorageClass: types.StringPointerValue(t.StorageClass),
+			})
+		}
+
+		if e := s3Rule.NoncurrentVersionExpiration; e != nil {
+			rule.NoncurrentVersionExpiration = &StorageBucketLifecycleNoncurrentExpirationModel{
+				Days:                    types.Int64PointerValue(e.NoncurrentDays),
+				NewerNoncurrentVersions: types.Int64PointerValue(e.NewerNoncurrentVersions),
+			}
+		}
+
+		for _, t := range s3Rule.NoncurrentVersionTransitions {
+			rule.NoncurrentVersionTransitions = append(rule.NoncurrentVersionTransitions, StorageBucketLifecycleNoncurrentTransitionModel{
+				Days:                    types.Int64PointerValue(t.NoncurrentDays),
+				NewerNoncurrentVersions: types.Int64PointerValue(t.NewerNoncurrentVersions),
+				StorageClass:            types.StringPointerValue(t.StorageClass),
+			})
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return rules, diags
+}
+
+// flattenLifecycleFilter returns nil for an empty filter, which is sent when the filter is omitted in configuration
+func flattenLifecycleFilter(ctx context.Context, f *s3.LifecycleRuleFilter) (*StorageBucketLifecycleFilterModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if aws.StringValue(f.Prefix) == "" && f.ObjectSizeGreaterThan == nil && f.ObjectSizeLessThan == nil && f.Tag == nil && f.And == nil {
+		return nil, diags
+	}
+
+	filter := &StorageBucketLifecycleFilterModel{
+		Prefix:                flattenLifecyclePrefix(f.Prefix),
+		ObjectSizeGreaterThan: types.Int64PointerValue(f.ObjectSizeGreaterThan),
+		ObjectSizeLessThan:    types.Int64PointerValue(f.ObjectSizeLessThan),
+	}
+	if f.Tag != nil {
+		filter.Tag = &StorageBucketLifecycleTagModel{
+			Key:   types.StringPointerValue(f.Tag.Key),
+			Value: types.StringPointerValue(f.Tag.Value),
+		}
+	}
+	if f.And != nil {
+		filter.And = &StorageBucketLifecycleAndFilterModel{
+			Prefix:                flattenLifecyclePrefix(f.And.Prefix),
+			ObjectSizeGreaterThan: types.Int64PointerValue(f.And.ObjectSizeGreaterThan),
+			ObjectSizeLessThan:    types.Int64PointerValue(f.And.ObjectSizeLessThan),
+			Tags:                  types.MapNull(types.StringType),
+		}
+		if len(f.And.Tags) > 0 {
+			tags := make(map[string]string, len(f.And.Tags))
+			for _, tag := range f.And.Tags {
+				tags[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+			}
+
+			tagsMap, d := types.MapValueFrom(ctx, types.StringType, tags)
+			diags.Append(d...)
+			filter.And.Tags = tagsMap
+		}
+	}
+
+	return filter, diags
+}
+
+func flattenLifecycleDate(t *time.Time) types.String {
+	if t == nil {
+		return types.StringNull()
+	}
+	return types.StringValue(t.UTC().Format(lifecycleDateLayout))
+}
+
+// flattenLifecyclePrefix treats an empty prefix as not set, since storage returns it for filters without a prefix
+func flattenLifecyclePrefix(prefix *string) types.String {
+	if aws.StringValue(prefix) == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(*prefix)
+}
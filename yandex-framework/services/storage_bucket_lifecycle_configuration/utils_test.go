@@ -0,0 +1,119 @@
+package storage_bucket_lifecycle_configuration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLifecycleRulesRoundTrip(t *testing.T) {
+	ctx := context.Background()
+
+	tags, diags := types.MapValueFrom(ctx, types.StringType, map[string]string{"env": "test"})
+	require.False(t, diags.HasError())
+
+	rules := []StorageBucketLifecycleRuleModel{
+		{
+			ID:                                 types.StringValue("logs"),
+			Enabled:                            types.BoolValue(true),
+			AbortIncompleteMultipartUploadDays: types.Int64Value(7),
+			Filter: &StorageBucketLifecycleFilterModel{
+				Prefix:                types.StringNull(),
+				ObjectSizeGreaterThan: types.Int64Null(),
+				ObjectSizeLessThan:    types.Int64Null(),
+				And: &StorageBucketLifecycleAndFilterModel{
+					Prefix:                types.StringValue("logs/"),
+					ObjectSizeGreaterThan: types.Int64Value(1024),
+					ObjectSizeLessThan:    types.Int64Null(),
+					Tags:                  tags,
+				},
+			},
+			Expiration: &StorageBucketLifecycleExpirationModel{
+				Date:                      types.StringNull(),
+				Days:                      types.Int64Value(90),
+				ExpiredObjectDeleteMarker: types.BoolNull(),
+			},
+			Transitions: []StorageBucketLifecycleTransitionModel{
+				{
+					Date:         types.StringValue("2030-01-01"),
+					Days:         types.Int64Null(),
+					StorageClass: types.StringValue("COLD"),
+				},
+			},
+			NoncurrentVersionTransitions: []StorageBucketLifecycleNoncurrentTransitionModel{},
+		},
+		{
+			ID:                                 types.StringValue("all"),
+			Enabled:                            types.BoolValue(false),
+			AbortIncompleteMultipartUploadDays: types.Int64Null(),
+			NoncurrentVersionExpiration: &StorageBucketLifecycleNoncurrentExpirationModel{
+				Days:                    types.Int64Value(30),
+				NewerNoncurrentVersions: types.Int64Null(),
+			},
+			Transitions:                  []StorageBucketLifecycleTransitionModel{},
+			NoncurrentVersionTransitions: []StorageBucketLifecycleNoncurrentTransitionModel{},
+		},
+	}
+
+	s3Rules, diags := expandLifecycleRules(ctx, rules)
+	require.False(t, diags.HasError())
+	require.Len(t, s3Rules, 2)
+
+	assert.Equal(t, "Enabled", aws.StringValue(s3Rules[0].Status))
+	assert.Equal(t, "Disabled", aws.StringValue(s3Rules[1].Status))
+	assert.Equal(t, "2030-01-01T00:00:00Z", s3Rules[0].Transitions[0].Date.Format("2006-01-02T15:04:05Z07:00"))
+	assert.NotNil(t, s3Rules[1].Filter)
+
+	flattened, diags := flattenLifecycleRules(ctx, s3Rules)
+	require.False(t, diags.HasError())
+	assert.Equal(t, rules, flattened)
+}
+
+func TestLifecycleFilterConditionsValidator(t *testing.T) {
+	attrTypes := map[string]attr.Type{
+		"prefix": types.StringType,
+		"tag":    types.ObjectType{AttrTypes: map[string]attr.Type{"key": types.StringType}},
+	}
+
+	tests := []struct {
+		name    string
+		prefix  types.String
+		tag     types.Object
+		wantErr bool
+	}{
+		{
+			name:   "prefix only",
+			prefix: types.StringValue("logs/"),
+			tag:    types.ObjectNull(attrTypes["tag"].(types.ObjectType).AttrTypes),
+		},
+		{
+			name:    "prefix and tag",
+			prefix:  types.StringValue("logs/"),
+			tag:     types.ObjectValueMust(attrTypes["tag"].(types.ObjectType).AttrTypes, map[string]attr.Value{"key": types.StringValue("k")}),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			value := types.ObjectValueMust(attrTypes, map[string]attr.Value{
+				"prefix": tt.prefix,
+				"tag":    tt.tag,
+			})
+
+			resp := &validator.ObjectResponse{}
+			filterConditionsValidator{}.ValidateObject(context.Background(), validator.ObjectRequest{
+				Path:        path.Root("filter"),
+				ConfigValue: value,
+			}, resp)
+
+			assert.Equal(t, tt.wantErr, resp.Diagnostics.HasError())
+		})
+	}
+}
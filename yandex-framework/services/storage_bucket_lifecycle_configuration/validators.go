@@ -0,0 +1,119 @@
+package storage_bucket_lifecycle_configuration
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const lifecycleDateLayout = "2006-01-02"
+
+var (
+	_ validator.String = dateValidator{}
+	_ validator.Object = filterConditionsValidator{}
+	_ validator.Object = ruleActionsValidator{}
+)
+
+// dateValidator checks that the value is a date in the YYYY-MM-DD format
+type dateValidator struct{}
+
+func (v dateValidator) Description(ctx context.Context) string {
+	return "value must be a date in the YYYY-MM-DD format"
+}
+
+func (v dateValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v dateValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := time.Parse(lifecycleDateLayout, req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid date", fmt.Sprintf("%s: %s", v.Description(ctx), err))
+	}
+}
+
+// filterConditionsValidator checks that a filter has at most one condition, several conditions must be combined with "and"
+type filterConditionsValidator struct{}
+
+func (v filterConditionsValidator) Description(ctx context.Context) string {
+	return "at most one of prefix, object_size_greater_than, object_size_less_than, tag, and must be specified"
+}
+
+func (v filterConditionsValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v filterConditionsValidator) ValidateObject(ctx context.Context, req validator.ObjectRequest, resp *validator.ObjectResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	specified := specifiedAttributes(req.ConfigValue.Attributes())
+	if len(specified) > 1 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid lifecycle rule filter",
+			fmt.Sprintf("%s, got: %s. Use the \"and\" block to combine several conditions.", v.Description(ctx), strings.Join(specified, ", ")),
+		)
+	}
+}
+
+// ruleActionsValidator checks that a lifecycle rule has at least one action
+type ruleActionsValidator struct{}
+
+var ruleActions = []string{
+	"abort_incomplete_multipart_upload_days",
+	"expiration",
+	"transition",
+	"noncurrent_version_expiration",
+	"noncurrent_version_transition",
+}
+
+func (v ruleActionsValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("at least one of %s must be specified", strings.Join(ruleActions, ", "))
+}
+
+func (v ruleActionsValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v ruleActionsValidator) ValidateObject(ctx context.Context, req validator.ObjectRequest, resp *validator.ObjectResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	attributes := req.ConfigValue.Attributes()
+	actions := make(map[string]attr.Value, len(ruleActions))
+	for _, name := range ruleActions {
+		actions[name] = attributes[name]
+	}
+
+	if len(specifiedAttributes(actions)) == 0 {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid lifecycle rule", v.Description(ctx))
+	}
+}
+
+// specifiedAttributes returns sorted names of attributes that are set in configuration
+func specifiedAttributes(attributes map[string]attr.Value) []string {
+	var specified []string
+	for name, value := range attributes {
+		if value == nil || value.IsNull() {
+			continue
+		}
+		if list, ok := value.(types.List); ok && !list.IsUnknown() && len(list.Elements()) == 0 {
+			continue
+		}
+		specified = append(specified, name)
+	}
+	slices.Sort(specified)
+	return specified
+}
@@ -0,0 +1,13 @@
+package storage_bucket_logging
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type StorageBucketLoggingResourceModel struct {
+	Bucket       types.String `tfsdk:"bucket"`
+	TargetBucket types.String `tfsdk:"target_bucket"`
+	TargetPrefix types.String `tfsdk:"target_prefix"`
+	AccessKey    types.String `tfsdk:"access_key"`
+	SecretKey    types.String `tfsdk:"secret_key"`
+}
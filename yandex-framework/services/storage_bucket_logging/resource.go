@@ -0,0 +1,175 @@
+package storage_bucket_logging
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	storage "github.com/yandex-cloud/terraform-provider-yandex/pkg/storage/s3"
+	provider_config "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
+)
+
+var (
+	_ resource.Resource                = &storageBucketLoggingResource{}
+	_ resource.ResourceWithConfigure   = &storageBucketLoggingResource{}
+	_ resource.ResourceWithImportState = &storageBucketLoggingResource{}
+)
+
+type storageBucketLoggingResource struct {
+	providerConfig *provider_config.Config
+}
+
+func NewResource() resource.Resource {
+	return &storageBucketLoggingResource{}
+}
+
+func (r *storageBucketLoggingResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_storage_bucket_logging"
+}
+
+func (r *storageBucketLoggingResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = ResourceSchema(ctx)
+}
+
+func (r *storageBucketLoggingResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerConfig, ok := req.ProviderData.(*provider_config.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider_config.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.providerConfig = providerConfig
+}
+
+func (r *storageBucketLoggingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("bucket"), req, resp)
+}
+
+func (r *storageBucketLoggingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan StorageBucketLoggingResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.updateBucketLogging(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *storageBucketLoggingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state StorageBucketLoggingResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	found := r.readBucketLogging(ctx, &state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *storageBucketLoggingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan StorageBucketLoggingResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.updateBucketLogging(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *storageBucketLoggingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state StorageBucketLoggingResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	s3Client, err := r.getS3Client(ctx, &state)
+	if err != nil {
+		resp.Diagnostics.AddError("Error getting storage client", err.Error())
+		return
+	}
+
+	err = s3Client.UpdateBucketLogging(ctx, state.Bucket.ValueString(), nil)
+	if err != nil && !storage.IsErr(err, storage.NoSuchBucket) {
+		resp.Diagnostics.AddError("Error disabling bucket logging", err.Error())
+		return
+	}
+}
+
+func (r *storageBucketLoggingResource) updateBucketLogging(ctx context.Context, model *StorageBucketLoggingResourceModel, diags *diag.Diagnostics) {
+	s3Client, err := r.getS3Client(ctx, model)
+	if err != nil {
+		diags.AddError("Error getting storage client", err.Error())
+		return
+	}
+
+	logging := &s3.LoggingEnabled{
+		TargetBucket: aws.String(model.TargetBucket.ValueString()),
+		TargetPrefix: aws.String(model.TargetPrefix.ValueString()),
+	}
+
+	err = s3Client.UpdateBucketLogging(ctx, model.Bucket.ValueString(), logging)
+	if err != nil {
+		diags.AddError("Error updating bucket logging", err.Error())
+		return
+	}
+}
+
+func (r *storageBucketLoggingResource) readBucketLogging(ctx context.Context, model *StorageBucketLoggingResourceModel, diags *diag.Diagnostics) bool {
+	s3Client, err := r.getS3Client(ctx, model)
+	if err != nil {
+		diags.AddError("Error getting storage client", err.Error())
+		return false
+	}
+
+	logging, err := s3Client.GetBucketLogging(ctx, model.Bucket.ValueString())
+	if err != nil {
+		if storage.IsErr(err, storage.NoSuchBucket) {
+			return false
+		}
+		diags.AddError("Unable to read Storage Bucket Logging", err.Error())
+		return false
+	}
+	if logging == nil {
+		return false
+	}
+
+	model.TargetBucket = types.StringValue(aws.StringValue(logging.TargetBucket))
+	model.TargetPrefix = types.StringValue(aws.StringValue(logging.TargetPrefix))
+	return true
+}
+
+func (r *storageBucketLoggingResource) getS3Client(ctx context.Context, model *StorageBucketLoggingResourceModel) (*storage.Client, error) {
+	return r.providerConfig.GetS3Client(ctx, model.AccessKey.ValueString(), model.SecretKey.ValueString())
+}
@@ -0,0 +1,114 @@
+package storage_bucket_logging_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	test "github.com/yandex-cloud/terraform-provider-yandex/pkg/testhelpers"
+	yandex_framework "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider"
+)
+
+// TestMain - add sweepers flag to the go test command
+// important for sweepers run.
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
+
+func TestAccStorageBucketResourceLogging(t *testing.T) {
+	var (
+		bucketName       = test.ResourceName(63)
+		targetBucketName = test.ResourceName(63)
+		resourceName     = "yandex_storage_bucket_logging.test-bucket-logging"
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.AccPreCheck(t) },
+		ProtoV6ProviderFactories: test.AccProviderFactories,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			test.AccCheckBucketDestroy(bucketName),
+			test.AccCheckBucketDestroy(targetBucketName),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStorageBucketLoggingConfig(bucketName, targetBucketName, test.GetExampleFolderID(), "log/"),
+				Check: resource.ComposeTestCheckFunc(
+					test.BucketExists(bucketName),
+					testAccStorageBucketLoggingTarget(bucketName, targetBucketName, "log/"),
+					resource.TestCheckResourceAttr(resourceName, "bucket", bucketName),
+					resource.TestCheckResourceAttr(resourceName, "target_bucket", targetBucketName),
+					resource.TestCheckResourceAttr(resourceName, "target_prefix", "log/"),
+				),
+			},
+			{
+				Config: testAccStorageBucketLoggingConfig(bucketName, targetBucketName, test.GetExampleFolderID(), "access-log/"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccStorageBucketLoggingTarget(bucketName, targetBucketName, "access-log/"),
+					resource.TestCheckResourceAttr(resourceName, "target_prefix", "access-log/"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateId:                        bucketName,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "bucket",
+			},
+		},
+	})
+}
+
+func testAccStorageBucketLoggingConfig(bucketName, targetBucketName, folderID, prefix string) string {
+	return fmt.Sprintf(`
+resource "yandex_storage_bucket" "test-bucket" {
+  bucket = "%s"
+  folder_id = "%s"
+
+  lifecycle {
+    ignore_changes = [logging]
+  }
+}
+
+resource "yandex_storage_bucket" "test-target-bucket" {
+  bucket = "%s"
+  folder_id = "%s"
+}
+
+resource "yandex_storage_bucket_logging" "test-bucket-logging" {
+  bucket        = yandex_storage_bucket.test-bucket.bucket
+  target_bucket = yandex_storage_bucket.test-target-bucket.bucket
+  target_prefix = "%s"
+}
+`, bucketName, folderID, targetBucketName, folderID, prefix)
+}
+
+func testAccStorageBucketLoggingTarget(bucketName, targetBucketName, prefix string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := test.AccProvider.(*yandex_framework.Provider).GetConfig()
+
+		s3Client, err := config.GetS3Client(context.Background(), "", "")
+		if err != nil {
+			return fmt.Errorf("error getting S3 client: %s", err)
+		}
+
+		logging, err := s3Client.GetBucketLogging(context.Background(), bucketName)
+		if err != nil {
+			return fmt.Errorf("error getting bucket logging: %s", err)
+		}
+
+		if logging == nil {
+			return fmt.Errorf("bucket logging is disabled")
+		}
+		if got := aws.StringValue(logging.TargetBucket); got != targetBucketName {
+			return fmt.Errorf("expected target bucket %q, got %q", targetBucketName, got)
+		}
+		if got := aws.StringValue(logging.TargetPrefix); got != prefix {
+			return fmt.Errorf("expected target prefix %q, got %q", prefix, got)
+		}
+
+		return nil
+	}
+}
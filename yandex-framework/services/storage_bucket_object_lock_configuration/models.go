@@ -0,0 +1,23 @@
+package storage_bucket_object_lock_configuration
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type StorageBucketObjectLockConfigurationResourceModel struct {
+	Bucket            types.String                 `tfsdk:"bucket"`
+	ObjectLockEnabled types.String                 `tfsdk:"object_lock_enabled"`
+	Rule              *StorageBucketObjectLockRule `tfsdk:"rule"`
+	AccessKey         types.String                 `tfsdk:"access_key"`
+	SecretKey         types.String                 `tfsdk:"secret_key"`
+}
+
+type StorageBucketObjectLockRule struct {
+	DefaultRetention *StorageBucketObjectLockDefaultRetention `tfsdk:"default_retention"`
+}
+
+type StorageBucketObjectLockDefaultRetention struct {
+	Mode  types.String `tfsdk:"mode"`
+	Days  types.Int64  `tfsdk:"days"`
+	Years types.Int64  `tfsdk:"years"`
+}
@@ -0,0 +1,199 @@
+package storage_bucket_object_lock_configuration
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	storage "github.com/yandex-cloud/terraform-provider-yandex/pkg/storage/s3"
+	provider_config "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
+)
+
+var (
+	_ resource.Resource                = &storageBucketObjectLockConfigurationResource{}
+	_ resource.ResourceWithConfigure   = &storageBucketObjectLockConfigurationResource{}
+	_ resource.ResourceWithImportState = &storageBucketObjectLockConfigurationResource{}
+)
+
+type storageBucketObjectLockConfigurationResource struct {
+	providerConfig *provider_config.Config
+}
+
+func NewResource() resource.Resource {
+	return &storageBucketObjectLockConfigurationResource{}
+}
+
+func (r *storageBucketObjectLockConfigurationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_storage_bucket_object_lock_configuration"
+}
+
+func (r *storageBucketObjectLockConfigurationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = ResourceSchema(ctx)
+}
+
+func (r *storageBucketObjectLockConfigurationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerConfig, ok := req.ProviderData.(*provider_config.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider_config.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.providerConfig = providerConfig
+}
+
+func (r *storageBucketObjectLockConfigurationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("bucket"), req, resp)
+}
+
+func (r *storageBucketObjectLockConfigurationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan StorageBucketObjectLockConfigurationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.updateBucketObjectLock(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *storageBucketObjectLockConfigurationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state StorageBucketObjectLockConfigurationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	found := r.readBucketObjectLock(ctx, &state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *storageBucketObjectLockConfigurationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan StorageBucketObjectLockConfigurationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.updateBucketObjectLock(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *storageBucketObjectLockConfigurationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state StorageBucketObjectLockConfigurationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	s3Client, err := r.getS3Client(ctx, &state)
+	if err != nil {
+		resp.Diagnostics.AddError("Error getting storage client", err.Error())
+		return
+	}
+
+	// Object lock can not be disabled, so only the default retention rule is removed
+	configuration := &s3.ObjectLockConfiguration{
+		ObjectLockEnabled: aws.String(storage.ObjectLockEnabled),
+	}
+
+	err = s3Client.UpdateBucketObjectLock(ctx, state.Bucket.ValueString(), configuration)
+	if err != nil && !storage.IsErr(err, storage.NoSuchBucket) {
+		resp.Diagnostics.AddError("Error deleting bucket object lock configuration", err.Error())
+		return
+	}
+}
+
+func (r *storageBucketObjectLockConfigurationResource) updateBucketObjectLock(ctx context.Context, model *StorageBucketObjectLockConfigurationResourceModel, diags *diag.Diagnostics) {
+	s3Client, err := r.getS3Client(ctx, model)
+	if err != nil {
+		diags.AddError("Error getting storage client", err.Error())
+		return
+	}
+
+	configuration := &s3.ObjectLockConfiguration{
+		ObjectLockEnabled: aws.String(model.ObjectLockEnabled.ValueString()),
+	}
+	if model.Rule != nil && model.Rule.DefaultRetention != nil {
+		retention := model.Rule.DefaultRetention
+		configuration.Rule = &s3.ObjectLockRule{
+			DefaultRetention: &s3.DefaultRetention{
+				Mode:  aws.String(retention.Mode.ValueString()),
+				Days:  retention.Days.ValueInt64Pointer(),
+				Years: retention.Years.ValueInt64Pointer(),
+			},
+		}
+	}
+
+	err = s3Client.UpdateBucketObjectLock(ctx, model.Bucket.ValueString(), configuration)
+	if err != nil {
+		diags.AddError("Error updating bucket object lock configuration", err.Error())
+		return
+	}
+}
+
+func (r *storageBucketObjectLockConfigurationResource) readBucketObjectLock(ctx context.Context, model *StorageBucketObjectLockConfigurationResourceModel, diags *diag.Diagnostics) bool {
+	s3Client, err := r.getS3Client(ctx, model)
+	if err != nil {
+		diags.AddError("Error getting storage client", err.Error())
+		return false
+	}
+
+	configuration, err := s3Client.GetBucketObjectLock(ctx, model.Bucket.ValueString())
+	if err != nil {
+		if storage.IsErr(err, storage.NoSuchBucket) {
+			return false
+		}
+		diags.AddError("Unable to read Storage Bucket Object Lock Configuration", err.Error())
+		return false
+	}
+	if configuration == nil || configuration.ObjectLockEnabled == nil {
+		return false
+	}
+
+	model.ObjectLockEnabled = types.StringValue(aws.StringValue(configuration.ObjectLockEnabled))
+	model.Rule = nil
+	if configuration.Rule != nil && configuration.Rule.DefaultRetention != nil {
+		retention := configuration.Rule.DefaultRetention
+		model.Rule = &StorageBucketObjectLockRule{
+			DefaultRetention: &StorageBucketObjectLockDefaultRetention{
+				Mode:  types.StringPointerValue(retention.Mode),
+				Days:  types.Int64PointerValue(retention.Days),
+				Years: types.Int64PointerValue(retention.Years),
+			},
+		}
+	}
+	return true
+}
+
+func (r *storageBucketObjectLockConfigurationResource) getS3Client(ctx context.Context, model *StorageBucketObjectLockConfigurationResourceModel) (*storage.Client, error) {
+	return r.providerConfig.GetS3Client(ctx, model.AccessKey.ValueString(), model.SecretKey.ValueString())
+}
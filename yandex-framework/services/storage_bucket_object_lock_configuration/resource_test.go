@@ -0,0 +1,115 @@
+package storage_bucket_object_lock_configuration_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	test "github.com/yandex-cloud/terraform-provider-yandex/pkg/testhelpers"
+	yandex_framework "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider"
+)
+
+// TestMain - add sweepers flag to the go test command
+// important for sweepers run.
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
+
+func TestAccStorageBucketResourceObjectLockConfiguration(t *testing.T) {
+	var (
+		bucketName   = test.ResourceName(63)
+		resourceName = "yandex_storage_bucket_object_lock_configuration.test-bucket-object-lock"
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.AccPreCheck(t) },
+		ProtoV6ProviderFactories: test.AccProviderFactories,
+		CheckDestroy:             test.AccCheckBucketDestroy(bucketName),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStorageBucketObjectLockConfigurationConfig(bucketName, test.GetExampleFolderID(), "GOVERNANCE", "days = 1"),
+				Check: resource.ComposeTestCheckFunc(
+					test.BucketExists(bucketName),
+					testAccStorageBucketObjectLockMode(bucketName, "GOVERNANCE"),
+					resource.TestCheckResourceAttr(resourceName, "bucket", bucketName),
+					resource.TestCheckResourceAttr(resourceName, "object_lock_enabled", "Enabled"),
+					resource.TestCheckResourceAttr(resourceName, "rule.default_retention.mode", "GOVERNANCE"),
+					resource.TestCheckResourceAttr(resourceName, "rule.default_retention.days", "1"),
+				),
+			},
+			{
+				Config: testAccStorageBucketObjectLockConfigurationConfig(bucketName, test.GetExampleFolderID(), "COMPLIANCE", "years = 1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccStorageBucketObjectLockMode(bucketName, "COMPLIANCE"),
+					resource.TestCheckResourceAttr(resourceName, "rule.default_retention.mode", "COMPLIANCE"),
+					resource.TestCheckResourceAttr(resourceName, "rule.default_retention.years", "1"),
+					resource.TestCheckNoResourceAttr(resourceName, "rule.default_retention.days"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateId:                        bucketName,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "bucket",
+			},
+		},
+	})
+}
+
+func testAccStorageBucketObjectLockConfigurationConfig(bucketName, folderID, mode, period string) string {
+	return fmt.Sprintf(`
+resource "yandex_storage_bucket" "test-bucket" {
+  bucket = "%s"
+  folder_id = "%s"
+
+  lifecycle {
+    ignore_changes = [object_lock_configuration]
+  }
+}
+
+resource "yandex_storage_bucket_versioning" "test-bucket-versioning" {
+  bucket  = yandex_storage_bucket.test-bucket.bucket
+  enabled = true
+}
+
+resource "yandex_storage_bucket_object_lock_configuration" "test-bucket-object-lock" {
+  bucket = yandex_storage_bucket_versioning.test-bucket-versioning.bucket
+
+  rule {
+    default_retention {
+      mode = "%s"
+      %s
+    }
+  }
+}
+`, bucketName, folderID, mode, period)
+}
+
+func testAccStorageBucketObjectLockMode(bucketName, expected string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := test.AccProvider.(*yandex_framework.Provider).GetConfig()
+
+		s3Client, err := config.GetS3Client(context.Background(), "", "")
+		if err != nil {
+			return fmt.Errorf("error getting S3 client: %s", err)
+		}
+
+		configuration, err := s3Client.GetBucketObjectLock(context.Background(), bucketName)
+		if err != nil {
+			return fmt.Errorf("error getting bucket object lock configuration: %s", err)
+		}
+
+		if configuration == nil || configuration.Rule == nil || configuration.Rule.DefaultRetention == nil {
+			return fmt.Errorf("bucket object lock default retention is not configured")
+		}
+		if got := aws.StringValue(configuration.Rule.DefaultRetention.Mode); got != expected {
+			return fmt.Errorf("expected object lock mode %q, got %q", expected, got)
+		}
+
+		return nil
+	}
+}
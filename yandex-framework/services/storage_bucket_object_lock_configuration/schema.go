@@ -0,0 +1,95 @@
+package storage_bucket_object_lock_configuration
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	storage "github.com/yandex-cloud/terraform-provider-yandex/pkg/storage/s3"
+)
+
+func ResourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Allows management of [object lock configuration](https://yandex.cloud/docs/storage/concepts/object-lock) of an existing Yandex Cloud Storage Bucket.\n\n~> By default, for authentication, you need to use [IAM token](https://yandex.cloud/docs/iam/concepts/authorization/iam-token) with the necessary permissions.\n\n~> Alternatively, you can provide [static access keys](https://yandex.cloud/docs/iam/concepts/authorization/access-key) (Access and Secret). To generate these keys, you will need a Service Account with the appropriate permissions.\n\n~> Object lock requires versioning to be enabled on the bucket. Object lock can not be disabled once enabled, destroying this resource only removes the default retention rule.\n\n~> Do not use this resource together with the `object_lock_configuration` block of `yandex_storage_bucket` for the same bucket. If the bucket is managed by `yandex_storage_bucket`, add `object_lock_configuration` to its `lifecycle.ignore_changes`, otherwise the bucket resource will overwrite the configuration.",
+		Attributes: map[string]schema.Attribute{
+			"bucket": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the bucket.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"object_lock_enabled": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(storage.ObjectLockEnabled),
+				MarkdownDescription: "Enable object locking in a bucket. The only valid value is `Enabled`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(storage.ObjectLockEnabled),
+				},
+			},
+			"access_key": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The access key to use when applying changes. This value can also be provided as `storage_access_key` specified in provider config (explicitly or within `shared_credentials_file`) is used.",
+			},
+			"secret_key": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The secret key to use when applying changes. This value can also be provided as `storage_secret_key` specified in provider config (explicitly or within `shared_credentials_file`) is used.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"rule": schema.SingleNestedBlock{
+				MarkdownDescription: "Specifies a default locking configuration for added objects.",
+				Blocks: map[string]schema.Block{
+					"default_retention": schema.SingleNestedBlock{
+						MarkdownDescription: "Default retention object.",
+						Attributes: map[string]schema.Attribute{
+							"mode": schema.StringAttribute{
+								Optional:            true,
+								MarkdownDescription: "Specifies a type of object lock. One of `[\"GOVERNANCE\", \"COMPLIANCE\"]`.",
+								Validators: []validator.String{
+									stringvalidator.OneOf(storage.ObjectLockRetentionModeValues...),
+								},
+							},
+							"days": schema.Int64Attribute{
+								Optional:            true,
+								MarkdownDescription: "Specifies a retention period in days after uploading an object version. It must be a positive integer. You can't set it simultaneously with `years`.",
+								Validators: []validator.Int64{
+									int64validator.AtLeast(1),
+									int64validator.ConflictsWith(path.MatchRelative().AtParent().AtName("years")),
+								},
+							},
+							"years": schema.Int64Attribute{
+								Optional:            true,
+								MarkdownDescription: "Specifies a retention period in years after uploading an object version. It must be a positive integer. You can't set it simultaneously with `days`.",
+								Validators: []validator.Int64{
+									int64validator.AtLeast(1),
+								},
+							},
+						},
+						Validators: []validator.Object{
+							objectvalidator.AlsoRequires(path.MatchRelative().AtName("mode")),
+							objectvalidator.Any(
+								objectvalidator.AlsoRequires(path.MatchRelative().AtName("days")),
+								objectvalidator.AlsoRequires(path.MatchRelative().AtName("years")),
+							),
+						},
+					},
+				},
+				Validators: []validator.Object{
+					objectvalidator.AlsoRequires(path.MatchRelative().AtName("default_retention")),
+				},
+			},
+		},
+	}
+}
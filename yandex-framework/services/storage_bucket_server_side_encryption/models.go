@@ -0,0 +1,13 @@
+package storage_bucket_server_side_encryption
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type StorageBucketServerSideEncryptionResourceModel struct {
+	Bucket         types.String `tfsdk:"bucket"`
+	KMSMasterKeyID types.String `tfsdk:"kms_master_key_id"`
+	SSEAlgorithm   types.String `tfsdk:"sse_algorithm"`
+	AccessKey      types.String `tfsdk:"access_key"`
+	SecretKey      types.String `tfsdk:"secret_key"`
+}
@@ -0,0 +1,182 @@
+package storage_bucket_server_side_encryption
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	storage "github.com/yandex-cloud/terraform-provider-yandex/pkg/storage/s3"
+	provider_config "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
+)
+
+var (
+	_ resource.Resource                = &storageBucketServerSideEncryptionResource{}
+	_ resource.ResourceWithConfigure   = &storageBucketServerSideEncryptionResource{}
+	_ resource.ResourceWithImportState = &storageBucketServerSideEncryptionResource{}
+)
+
+type storageBucketServerSideEncryptionResource struct {
+	providerConfig *provider_config.Config
+}
+
+func NewResource() resource.Resource {
+	return &storageBucketServerSideEncryptionResource{}
+}
+
+func (r *storageBucketServerSideEncryptionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_storage_bucket_server_side_encryption"
+}
+
+func (r *storageBucketServerSideEncryptionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = ResourceSchema(ctx)
+}
+
+func (r *storageBucketServerSideEncryptionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerConfig, ok := req.ProviderData.(*provider_config.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider_config.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.providerConfig = providerConfig
+}
+
+func (r *storageBucketServerSideEncryptionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("bucket"), req, resp)
+}
+
+func (r *storageBucketServerSideEncryptionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan StorageBucketServerSideEncryptionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.updateBucketEncryption(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *storageBucketServerSideEncryptionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state StorageBucketServerSideEncryptionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	found := r.readBucketEncryption(ctx, &state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *storageBucketServerSideEncryptionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan StorageBucketServerSideEncryptionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.updateBucketEncryption(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *storageBucketServerSideEncryptionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state StorageBucketServerSideEncryptionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	s3Client, err := r.getS3Client(ctx, &state)
+	if err != nil {
+		resp.Diagnostics.AddError("Error getting storage client", err.Error())
+		return
+	}
+
+	err = s3Client.UpdateBucketEncryption(ctx, state.Bucket.ValueString(), nil)
+	if err != nil && !storage.IsErr(err, storage.NoSuchBucket) {
+		resp.Diagnostics.AddError("Error deleting bucket encryption", err.Error())
+		return
+	}
+}
+
+func (r *storageBucketServerSideEncryptionResource) updateBucketEncryption(ctx context.Context, model *StorageBucketServerSideEncryptionResourceModel, diags *diag.Diagnostics) {
+	s3Client, err := r.getS3Client(ctx, model)
+	if err != nil {
+		diags.AddError("Error getting storage client", err.Error())
+		return
+	}
+
+	configuration := &s3.ServerSideEncryptionConfiguration{
+		Rules: []*s3.ServerSideEncryptionRule{
+			{
+				ApplyServerSideEncryptionByDefault: &s3.ServerSideEncryptionByDefault{
+					KMSMasterKeyID: aws.String(model.KMSMasterKeyID.ValueString()),
+					SSEAlgorithm:   aws.String(model.SSEAlgorithm.ValueString()),
+				},
+			},
+		},
+	}
+
+	err = s3Client.UpdateBucketEncryption(ctx, model.Bucket.ValueString(), configuration)
+	if err != nil {
+		diags.AddError("Error updating bucket encryption", err.Error())
+		return
+	}
+}
+
+func (r *storageBucketServerSideEncryptionResource) readBucketEncryption(ctx context.Context, model *StorageBucketServerSideEncryptionResourceModel, diags *diag.Diagnostics) bool {
+	s3Client, err := r.getS3Client(ctx, model)
+	if err != nil {
+		diags.AddError("Error getting storage client", err.Error())
+		return false
+	}
+
+	configuration, err := s3Client.GetBucketEncryption(ctx, model.Bucket.ValueString())
+	if err != nil {
+		if storage.IsErr(err, storage.NoSuchBucket) {
+			return false
+		}
+		diags.AddError("Unable to read Storage Bucket Server Side Encryption", err.Error())
+		return false
+	}
+	if configuration == nil || len(configuration.Rules) == 0 || configuration.Rules[0].ApplyServerSideEncryptionByDefault == nil {
+		return false
+	}
+
+	byDefault := configuration.Rules[0].ApplyServerSideEncryptionByDefault
+	model.KMSMasterKeyID = types.StringValue(aws.StringValue(byDefault.KMSMasterKeyID))
+	model.SSEAlgorithm = types.StringValue(aws.StringValue(byDefault.SSEAlgorithm))
+	return true
+}
+
+func (r *storageBucketServerSideEncryptionResource) getS3Client(ctx context.Context, model *StorageBucketServerSideEncryptionResourceModel) (*storage.Client, error) {
+	return r.providerConfig.GetS3Client(ctx, model.AccessKey.ValueString(), model.SecretKey.ValueString())
+}
@@ -0,0 +1,107 @@
+package storage_bucket_server_side_encryption_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	test "github.com/yandex-cloud/terraform-provider-yandex/pkg/testhelpers"
+	yandex_framework "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider"
+)
+
+// TestMain - add sweepers flag to the go test command
+// important for sweepers run.
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
+
+func TestAccStorageBucketResourceServerSideEncryption(t *testing.T) {
+	var (
+		bucketName   = test.ResourceName(63)
+		keyName      = test.ResourceName(63)
+		resourceName = "yandex_storage_bucket_server_side_encryption.test-bucket-sse"
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.AccPreCheck(t) },
+		ProtoV6ProviderFactories: test.AccProviderFactories,
+		CheckDestroy:             test.AccCheckBucketDestroy(bucketName),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStorageBucketServerSideEncryptionConfig(bucketName, keyName, test.GetExampleFolderID()),
+				Check: resource.ComposeTestCheckFunc(
+					test.BucketExists(bucketName),
+					testAccStorageBucketServerSideEncryptionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "bucket", bucketName),
+					resource.TestCheckResourceAttr(resourceName, "sse_algorithm", "aws:kms"),
+					resource.TestCheckResourceAttrPair(resourceName, "kms_master_key_id", "yandex_kms_symmetric_key.test-key", "id"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateId:                        bucketName,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "bucket",
+			},
+		},
+	})
+}
+
+func testAccStorageBucketServerSideEncryptionConfig(bucketName, keyName, folderID string) string {
+	return fmt.Sprintf(`
+resource "yandex_kms_symmetric_key" "test-key" {
+  name      = "%s"
+  folder_id = "%s"
+}
+
+resource "yandex_storage_bucket" "test-bucket" {
+  bucket = "%s"
+  folder_id = "%s"
+
+  lifecycle {
+    ignore_changes = [server_side_encryption_configuration]
+  }
+}
+
+resource "yandex_storage_bucket_server_side_encryption" "test-bucket-sse" {
+  bucket            = yandex_storage_bucket.test-bucket.bucket
+  kms_master_key_id = yandex_kms_symmetric_key.test-key.id
+}
+`, keyName, folderID, bucketName, folderID)
+}
+
+func testAccStorageBucketServerSideEncryptionExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := test.AccProvider.(*yandex_framework.Provider).GetConfig()
+
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("can't find %s in state", resourceName)
+		}
+
+		s3Client, err := config.GetS3Client(context.Background(), "", "")
+		if err != nil {
+			return fmt.Errorf("error getting S3 client: %s", err)
+		}
+
+		configuration, err := s3Client.GetBucketEncryption(context.Background(), rs.Primary.Attributes["bucket"])
+		if err != nil {
+			return fmt.Errorf("error getting bucket encryption: %s", err)
+		}
+
+		if configuration == nil || len(configuration.Rules) == 0 {
+			return fmt.Errorf("bucket encryption is not configured")
+		}
+
+		keyID := aws.StringValue(configuration.Rules[0].ApplyServerSideEncryptionByDefault.KMSMasterKeyID)
+		if keyID != rs.Primary.Attributes["kms_master_key_id"] {
+			return fmt.Errorf("expected kms key %q, got %q", rs.Primary.Attributes["kms_master_key_id"], keyID)
+		}
+
+		return nil
+	}
+}
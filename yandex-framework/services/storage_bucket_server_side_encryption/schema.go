@@ -0,0 +1,52 @@
+package storage_bucket_server_side_encryption
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	storage "github.com/yandex-cloud/terraform-provider-yandex/pkg/storage/s3"
+)
+
+func ResourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Allows management of [default server-side encryption](https://yandex.cloud/docs/storage/concepts/encryption) of an existing Yandex Cloud Storage Bucket.\n\n~> By default, for authentication, you need to use [IAM token](https://yandex.cloud/docs/iam/concepts/authorization/iam-token) with the necessary permissions.\n\n~> Alternatively, you can provide [static access keys](https://yandex.cloud/docs/iam/concepts/authorization/access-key) (Access and Secret). To generate these keys, you will need a Service Account with the appropriate permissions.\n\n~> Do not use this resource together with the `server_side_encryption_configuration` block of `yandex_storage_bucket` for the same bucket. If the bucket is managed by `yandex_storage_bucket`, add `server_side_encryption_configuration` to its `lifecycle.ignore_changes`, otherwise the bucket resource will overwrite the configuration.",
+		Attributes: map[string]schema.Attribute{
+			"bucket": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the bucket.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"kms_master_key_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The KMS master key ID used for the SSE-KMS encryption.",
+			},
+			"sse_algorithm": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(storage.ServerSideEncryptionAwsKms),
+				MarkdownDescription: "The server-side encryption algorithm to use. The only valid value is `aws:kms`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(storage.ServerSideEncryptionAwsKms),
+				},
+			},
+			"access_key": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The access key to use when applying changes. This value can also be provided as `storage_access_key` specified in provider config (explicitly or within `shared_credentials_file`) is used.",
+			},
+			"secret_key": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The secret key to use when applying changes. This value can also be provided as `storage_secret_key` specified in provider config (explicitly or within `shared_credentials_file`) is used.",
+			},
+		},
+	}
+}
@@ -0,0 +1,12 @@
+package storage_bucket_versioning
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type StorageBucketVersioningResourceModel struct {
+	Bucket    types.String `tfsdk:"bucket"`
+	Enabled   types.Bool   `tfsdk:"enabled"`
+	AccessKey types.String `tfsdk:"access_key"`
+	SecretKey types.String `tfsdk:"secret_key"`
+}
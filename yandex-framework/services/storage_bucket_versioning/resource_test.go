@@ -0,0 +1,93 @@
+package storage_bucket_versioning_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	test "github.com/yandex-cloud/terraform-provider-yandex/pkg/testhelpers"
+	yandex_framework "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider"
+)
+
+// TestMain - add sweepers flag to the go test command
+// important for sweepers run.
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
+
+func TestAccStorageBucketResourceVersioning(t *testing.T) {
+	var (
+		bucketName   = test.ResourceName(63)
+		resourceName = "yandex_storage_bucket_versioning.test-bucket-versioning"
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.AccPreCheck(t) },
+		ProtoV6ProviderFactories: test.AccProviderFactories,
+		CheckDestroy:             test.AccCheckBucketDestroy(bucketName),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStorageBucketVersioningConfig(bucketName, test.GetExampleFolderID(), true),
+				Check: resource.ComposeTestCheckFunc(
+					test.BucketExists(bucketName),
+					testAccStorageBucketVersioningStatus(bucketName, "Enabled"),
+					resource.TestCheckResourceAttr(resourceName, "bucket", bucketName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+				),
+			},
+			{
+				Config: testAccStorageBucketVersioningConfig(bucketName, test.GetExampleFolderID(), false),
+				Check: resource.ComposeTestCheckFunc(
+					test.BucketExists(bucketName),
+					testAccStorageBucketVersioningStatus(bucketName, "Suspended"),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateId:                        bucketName,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "bucket",
+			},
+		},
+	})
+}
+
+func testAccStorageBucketVersioningConfig(bucketName, folderID string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "yandex_storage_bucket" "test-bucket" {
+  bucket = "%s"
+  folder_id = "%s"
+}
+
+resource "yandex_storage_bucket_versioning" "test-bucket-versioning" {
+  bucket  = yandex_storage_bucket.test-bucket.bucket
+  enabled = %t
+}
+`, bucketName, folderID, enabled)
+}
+
+func testAccStorageBucketVersioningStatus(bucketName, expected string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := test.AccProvider.(*yandex_framework.Provider).GetConfig()
+
+		s3Client, err := config.GetS3Client(context.Background(), "", "")
+		if err != nil {
+			return fmt.Errorf("error getting S3 client: %s", err)
+		}
+
+		status, err := s3Client.GetBucketVersioning(context.Background(), bucketName)
+		if err != nil {
+			return fmt.Errorf("error getting bucket versioning: %s", err)
+		}
+
+		if status != expected {
+			return fmt.Errorf("expected bucket versioning status %q, got %q", expected, status)
+		}
+
+		return nil
+	}
+}
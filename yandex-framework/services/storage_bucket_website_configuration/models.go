@@ -0,0 +1,17 @@
+package storage_bucket_website_configuration
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type StorageBucketWebsiteConfigurationResourceModel struct {
+	Bucket                types.String `tfsdk:"bucket"`
+	IndexDocument         types.String `tfsdk:"index_document"`
+	ErrorDocument         types.String `tfsdk:"error_document"`
+	RedirectAllRequestsTo types.String `tfsdk:"redirect_all_requests_to"`
+	RoutingRules          types.String `tfsdk:"routing_rules"`
+	WebsiteEndpoint       types.String `tfsdk:"website_endpoint"`
+	WebsiteDomain         types.String `tfsdk:"website_domain"`
+	AccessKey             types.String `tfsdk:"access_key"`
+	SecretKey             types.String `tfsdk:"secret_key"`
+}
@@ -0,0 +1,221 @@
+package storage_bucket_website_configuration
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	storage "github.com/yandex-cloud/terraform-provider-yandex/pkg/storage/s3"
+	provider_config "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
+)
+
+var (
+	_ resource.Resource                = &storageBucketWebsiteConfigurationResource{}
+	_ resource.ResourceWithConfigure   = &storageBucketWebsiteConfigurationResource{}
+	_ resource.ResourceWithImportState = &storageBucketWebsiteConfigurationResource{}
+)
+
+type storageBucketWebsiteConfigurationResource struct {
+	providerConfig *provider_config.Config
+}
+
+func NewResource() resource.Resource {
+	return &storageBucketWebsiteConfigurationResource{}
+}
+
+func (r *storageBucketWebsiteConfigurationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_storage_bucket_website_configuration"
+}
+
+func (r *storageBucketWebsiteConfigurationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = ResourceSchema(ctx)
+}
+
+func (r *storageBucketWebsiteConfigurationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerConfig, ok := req.ProviderData.(*provider_config.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider_config.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.providerConfig = providerConfig
+}
+
+func (r *storageBucketWebsiteConfigurationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("bucket"), req, resp)
+}
+
+func (r *storageBucketWebsiteConfigurationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan StorageBucketWebsiteConfigurationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.updateBucketWebsite(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *storageBucketWebsiteConfigurationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state StorageBucketWebsiteConfigurationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	found := r.readBucketWebsite(ctx, &state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *storageBucketWebsiteConfigurationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan StorageBucketWebsiteConfigurationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.updateBucketWebsite(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *storageBucketWebsiteConfigurationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state StorageBucketWebsiteConfigurationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	s3Client, err := r.getS3Client(ctx, &state)
+	if err != nil {
+		resp.Diagnostics.AddError("Error getting storage client", err.Error())
+		return
+	}
+
+	err = s3Client.UpdateBucketWebsite(ctx, state.Bucket.ValueString(), nil)
+	if err != nil && !storage.IsErr(err, storage.NoSuchBucket) {
+		resp.Diagnostics.AddError("Error deleting bucket website configuration", err.Error())
+		return
+	}
+}
+
+func (r *storageBucketWebsiteConfigurationResource) updateBucketWebsite(ctx context.Context, model *StorageBucketWebsiteConfigurationResourceModel, diags *diag.Diagnostics) {
+	s3Client, err := r.getS3Client(ctx, model)
+	if err != nil {
+		diags.AddError("Error getting storage client", err.Error())
+		return
+	}
+
+	website := &s3.WebsiteConfiguration{}
+	if v := model.IndexDocument.ValueString(); v != "" {
+		website.IndexDocument = &s3.IndexDocument{Suffix: aws.String(v)}
+	}
+	if v := model.ErrorDocument.ValueString(); v != "" {
+		website.ErrorDocument = &s3.ErrorDocument{Key: aws.String(v)}
+	}
+	if v := model.RedirectAllRequestsTo.ValueString(); v != "" {
+		website.RedirectAllRequestsTo = expandRedirectAllRequestsTo(v)
+	}
+	if v := model.RoutingRules.ValueString(); v != "" {
+		rules, err := expandRoutingRules(v)
+		if err != nil {
+			diags.AddError("Error updating bucket website configuration", err.Error())
+			return
+		}
+		website.RoutingRules = rules
+	}
+
+	bucket := model.Bucket.ValueString()
+	err = s3Client.UpdateBucketWebsite(ctx, bucket, website)
+	if err != nil {
+		diags.AddError("Error updating bucket website configuration", err.Error())
+		return
+	}
+
+	model.WebsiteEndpoint = types.StringValue(fmt.Sprintf("%s.%s", bucket, websiteDomainURL))
+	model.WebsiteDomain = types.StringValue(websiteDomainURL)
+}
+
+func (r *storageBucketWebsiteConfigurationResource) readBucketWebsite(ctx context.Context, model *StorageBucketWebsiteConfigurationResourceModel, diags *diag.Diagnostics) bool {
+	s3Client, err := r.getS3Client(ctx, model)
+	if err != nil {
+		diags.AddError("Error getting storage client", err.Error())
+		return false
+	}
+
+	bucket := model.Bucket.ValueString()
+	website, err := s3Client.GetBucketWebsite(ctx, bucket)
+	if err != nil {
+		if storage.IsErr(err, storage.NoSuchBucket) {
+			return false
+		}
+		diags.AddError("Unable to read Storage Bucket Website Configuration", err.Error())
+		return false
+	}
+	if website == nil || (website.IndexDocument == nil && website.RedirectAllRequestsTo == nil) {
+		return false
+	}
+
+	model.IndexDocument = types.StringNull()
+	if website.IndexDocument != nil {
+		model.IndexDocument = types.StringPointerValue(website.IndexDocument.Suffix)
+	}
+	model.ErrorDocument = types.StringNull()
+	if website.ErrorDocument != nil {
+		model.ErrorDocument = types.StringPointerValue(website.ErrorDocument.Key)
+	}
+	model.RedirectAllRequestsTo = types.StringNull()
+	if website.RedirectAllRequestsTo != nil {
+		model.RedirectAllRequestsTo = types.StringValue(flattenRedirectAllRequestsTo(website.RedirectAllRequestsTo))
+	}
+
+	if len(website.RoutingRules) == 0 {
+		model.RoutingRules = types.StringNull()
+	} else {
+		rules, err := flattenRoutingRules(website.RoutingRules)
+		if err != nil {
+			diags.AddError("Unable to read Storage Bucket Website Configuration", err.Error())
+			return false
+		}
+		// Keep the configured JSON formatting when the rules are semantically equal
+		if !routingRulesEqual(model.RoutingRules.ValueString(), rules) {
+			model.RoutingRules = types.StringValue(rules)
+		}
+	}
+
+	model.WebsiteEndpoint = types.StringValue(fmt.Sprintf("%s.%s", bucket, websiteDomainURL))
+	model.WebsiteDomain = types.StringValue(websiteDomainURL)
+	return true
+}
+
+func (r *storageBucketWebsiteConfigurationResource) getS3Client(ctx context.Context, model *StorageBucketWebsiteConfigurationResourceModel) (*storage.Client, error) {
+	return r.providerConfig.GetS3Client(ctx, model.AccessKey.ValueString(), model.SecretKey.ValueString())
+}
@@ -0,0 +1,135 @@
+package storage_bucket_website_configuration_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	test "github.com/yandex-cloud/terraform-provider-yandex/pkg/testhelpers"
+	yandex_framework "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider"
+)
+
+// TestMain - add sweepers flag to the go test command
+// important for sweepers run.
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
+
+func TestAccStorageBucketResourceWebsiteConfiguration(t *testing.T) {
+	var (
+		bucketName   = test.ResourceName(63)
+		resourceName = "yandex_storage_bucket_website_configuration.test-bucket-website"
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.AccPreCheck(t) },
+		ProtoV6ProviderFactories: test.AccProviderFactories,
+		CheckDestroy:             test.AccCheckBucketDestroy(bucketName),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStorageBucketWebsiteConfigurationConfig(bucketName, test.GetExampleFolderID()),
+				Check: resource.ComposeTestCheckFunc(
+					test.BucketExists(bucketName),
+					testAccStorageBucketWebsiteIndexDocument(bucketName, "index.html"),
+					resource.TestCheckResourceAttr(resourceName, "bucket", bucketName),
+					resource.TestCheckResourceAttr(resourceName, "index_document", "index.html"),
+					resource.TestCheckResourceAttr(resourceName, "error_document", "error.html"),
+					resource.TestCheckResourceAttrSet(resourceName, "routing_rules"),
+					resource.TestCheckResourceAttr(resourceName, "website_endpoint", bucketName+".website.yandexcloud.net"),
+					resource.TestCheckResourceAttr(resourceName, "website_domain", "website.yandexcloud.net"),
+				),
+			},
+			{
+				Config: testAccStorageBucketWebsiteConfigurationConfigRedirect(bucketName, test.GetExampleFolderID()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "redirect_all_requests_to", "https://example.com"),
+					resource.TestCheckNoResourceAttr(resourceName, "index_document"),
+					resource.TestCheckNoResourceAttr(resourceName, "routing_rules"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateId:                        bucketName,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "bucket",
+			},
+		},
+	})
+}
+
+func testAccStorageBucketWebsiteConfigurationConfig(bucketName, folderID string) string {
+	return fmt.Sprintf(`
+resource "yandex_storage_bucket" "test-bucket" {
+  bucket = "%s"
+  folder_id = "%s"
+
+  lifecycle {
+    ignore_changes = [website]
+  }
+}
+
+resource "yandex_storage_bucket_website_configuration" "test-bucket-website" {
+  bucket         = yandex_storage_bucket.test-bucket.bucket
+  index_document = "index.html"
+  error_document = "error.html"
+
+  routing_rules = <<EOF
+[{
+  "Condition": {
+    "KeyPrefixEquals": "docs/"
+  },
+  "Redirect": {
+    "ReplaceKeyPrefixWith": "documents/"
+  }
+}]
+EOF
+}
+`, bucketName, folderID)
+}
+
+func testAccStorageBucketWebsiteConfigurationConfigRedirect(bucketName, folderID string) string {
+	return fmt.Sprintf(`
+resource "yandex_storage_bucket" "test-bucket" {
+  bucket = "%s"
+  folder_id = "%s"
+
+  lifecycle {
+    ignore_changes = [website]
+  }
+}
+
+resource "yandex_storage_bucket_website_configuration" "test-bucket-website" {
+  bucket                   = yandex_storage_bucket.test-bucket.bucket
+  redirect_all_requests_to = "https://example.com"
+}
+`, bucketName, folderID)
+}
+
+func testAccStorageBucketWebsiteIndexDocument(bucketName, expected string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := test.AccProvider.(*yandex_framework.Provider).GetConfig()
+
+		s3Client, err := config.GetS3Client(context.Background(), "", "")
+		if err != nil {
+			return fmt.Errorf("error getting S3 client: %s", err)
+		}
+
+		website, err := s3Client.GetBucketWebsite(context.Background(), bucketName)
+		if err != nil {
+			return fmt.Errorf("error getting bucket website configuration: %s", err)
+		}
+
+		if website == nil || website.IndexDocument == nil {
+			return fmt.Errorf("bucket website is not configured")
+		}
+		if got := aws.StringValue(website.IndexDocument.Suffix); got != expected {
+			return fmt.Errorf("expected index document %q, got %q", expected, got)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,80 @@
+package storage_bucket_website_configuration
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+func ResourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Allows management of [static website hosting](https://yandex.cloud/docs/storage/concepts/hosting) of an existing Yandex Cloud Storage Bucket.\n\n~> By default, for authentication, you need to use [IAM token](https://yandex.cloud/docs/iam/concepts/authorization/iam-token) with the necessary permissions.\n\n~> Alternatively, you can provide [static access keys](https://yandex.cloud/docs/iam/concepts/authorization/access-key) (Access and Secret). To generate these keys, you will need a Service Account with the appropriate permissions.\n\n~> Do not use this resource together with the `website` block of `yandex_storage_bucket` for the same bucket. If the bucket is managed by `yandex_storage_bucket`, add `website` to its `lifecycle.ignore_changes`, otherwise the bucket resource will overwrite the configuration.",
+		Attributes: map[string]schema.Attribute{
+			"bucket": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the bucket.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"index_document": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Storage returns this index document when requests are made to the root domain or any of the subfolders (unless using `redirect_all_requests_to`).",
+				Validators: []validator.String{
+					stringvalidator.AtLeastOneOf(path.MatchRoot("redirect_all_requests_to")),
+				},
+			},
+			"error_document": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "An absolute path to the document to return in case of a 4XX error.",
+			},
+			"redirect_all_requests_to": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "A hostname to redirect all website requests for this bucket to. Hostname can optionally be prefixed with a protocol (`http://` or `https://`) to use when redirecting requests. The default is the protocol that is used in the original request.",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(
+						path.MatchRoot("index_document"),
+						path.MatchRoot("error_document"),
+						path.MatchRoot("routing_rules"),
+					),
+				},
+			},
+			"routing_rules": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "A JSON array containing [routing rules](https://yandex.cloud/docs/storage/s3/api-ref/hosting/upload#request-scheme) describing redirect behavior and when redirects are applied.",
+				Validators: []validator.String{
+					routingRulesValidator{},
+				},
+			},
+			"website_endpoint": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The website endpoint of the bucket.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"website_domain": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The domain of the website endpoint. This is used to create DNS alias records.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"access_key": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The access key to use when applying changes. This value can also be provided as `storage_access_key` specified in provider config (explicitly or within `shared_credentials_file`) is used.",
+			},
+			"secret_key": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The secret key to use when applying changes. This value can also be provided as `storage_secret_key` specified in provider config (explicitly or within `shared_credentials_file`) is used.",
+			},
+		},
+	}
+}
@@ -0,0 +1,131 @@
+package storage_bucket_website_configuration
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+const websiteDomainURL = "website.yandexcloud.net"
+
+var _ validator.String = routingRulesValidator{}
+
+// routingRulesValidator checks that the value is a JSON array of routing rules
+type routingRulesValidator struct{}
+
+func (v routingRulesValidator) Description(ctx context.Context) string {
+	return "value must be a JSON array of routing rules"
+}
+
+func (v routingRulesValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v routingRulesValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := expandRoutingRules(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid routing rules", err.Error())
+	}
+}
+
+func expandRoutingRules(s string) ([]*s3.RoutingRule, error) {
+	var rules []*s3.RoutingRule
+	if err := json.Unmarshal([]byte(s), &rules); err != nil {
+		return nil, fmt.Errorf("error unmarshaling routing_rules: %w", err)
+	}
+	return rules, nil
+}
+
+// flattenRoutingRules marshals routing rules to JSON omitting empty fields
+func flattenRoutingRules(rules []*s3.RoutingRule) (string, error) {
+	withNulls, err := json.Marshal(rules)
+	if err != nil {
+		return "", err
+	}
+
+	var cleanRules []any
+	if err := json.Unmarshal(withNulls, &cleanRules); err != nil {
+		return "", err
+	}
+	for i := range cleanRules {
+		cleanRules[i] = removeNil(cleanRules[i])
+	}
+
+	withoutNulls, err := json.Marshal(cleanRules)
+	if err != nil {
+		return "", err
+	}
+	return string(withoutNulls), nil
+}
+
+func removeNil(data any) any {
+	m, ok := data.(map[string]any)
+	if !ok {
+		return data
+	}
+
+	withoutNil := make(map[string]any)
+	for k, v := range m {
+		if v == nil {
+			continue
+		}
+		withoutNil[k] = removeNil(v)
+	}
+	return withoutNil
+}
+
+// routingRulesEqual reports whether two JSON documents with routing rules are semantically equal
+func routingRulesEqual(a, b string) bool {
+	rulesA, err := expandRoutingRules(a)
+	if err != nil {
+		return false
+	}
+	rulesB, err := expandRoutingRules(b)
+	if err != nil {
+		return false
+	}
+
+	normalizedA, errA := flattenRoutingRules(rulesA)
+	normalizedB, errB := flattenRoutingRules(rulesB)
+	return errA == nil && errB == nil && normalizedA == normalizedB
+}
+
+func expandRedirectAllRequestsTo(s string) *s3.RedirectAllRequestsTo {
+	redirect, err := url.Parse(s)
+	if err == nil && redirect.Scheme != "" {
+		var redirectHostBuf bytes.Buffer
+		redirectHostBuf.WriteString(redirect.Host)
+		if redirect.Path != "" {
+			redirectHostBuf.WriteString(redirect.Path)
+		}
+		if redirect.RawQuery != "" {
+			redirectHostBuf.WriteString("?")
+			redirectHostBuf.WriteString(redirect.RawQuery)
+		}
+		return &s3.RedirectAllRequestsTo{
+			HostName: aws.String(redirectHostBuf.String()),
+			Protocol: aws.String(redirect.Scheme),
+		}
+	}
+	return &s3.RedirectAllRequestsTo{HostName: aws.String(s)}
+}
+
+func flattenRedirectAllRequestsTo(redirect *s3.RedirectAllRequestsTo) string {
+	if redirect == nil || redirect.HostName == nil {
+		return ""
+	}
+
+	if protocol := aws.StringValue(redirect.Protocol); protocol != "" {
+		return protocol + "://" + aws.StringValue(redirect.HostName)
+	}
+	return aws.StringValue(redirect.HostName)
+}
@@ -0,0 +1,44 @@
+package storage_bucket_website_configuration
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedirectAllRequestsTo(t *testing.T) {
+	tests := []string{
+		"example.com",
+		"https://example.com",
+		"http://example.com/path?query=1",
+	}
+	for _, tt := range tests {
+		t.Run(tt, func(t *testing.T) {
+			assert.Equal(t, tt, flattenRedirectAllRequestsTo(expandRedirectAllRequestsTo(tt)))
+		})
+	}
+}
+
+func TestRoutingRulesEqual(t *testing.T) {
+	configured := `[
+  {
+    "Condition": {"KeyPrefixEquals": "docs/"},
+    "Redirect": {"ReplaceKeyPrefixWith": "documents/"}
+  }
+]`
+
+	rules, err := expandRoutingRules(configured)
+	require.NoError(t, err)
+
+	flattened, err := flattenRoutingRules(rules)
+	require.NoError(t, err)
+	assert.Equal(t, `[{"Condition":{"KeyPrefixEquals":"docs/"},"Redirect":{"ReplaceKeyPrefixWith":"documents/"}}]`, flattened)
+
+	assert.True(t, routingRulesEqual(configured, flattened))
+	assert.False(t, routingRulesEqual(configured, `[{"Redirect":{"ReplaceKeyPrefixWith":"documents/"}}]`))
+	assert.False(t, routingRulesEqual("", flattened))
+
+	_, err = expandRoutingRules(`{"Condition": {}}`)
+	assert.Error(t, err)
+}
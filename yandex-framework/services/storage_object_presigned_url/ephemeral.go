@@ -0,0 +1,118 @@
+package storage_object_presigned_url
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	storage "github.com/yandex-cloud/terraform-provider-yandex/pkg/storage/s3"
+	provider_config "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
+)
+
+var (
+	_ ephemeral.EphemeralResource                   = &storageObjectPresignedURLEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure      = &storageObjectPresignedURLEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithValidateConfig = &storageObjectPresignedURLEphemeralResource{}
+)
+
+type storageObjectPresignedURLEphemeralResource struct {
+	providerConfig *provider_config.Config
+}
+
+func NewEphemeralResource() ephemeral.EphemeralResource {
+	return &storageObjectPresignedURLEphemeralResource{}
+}
+
+func (r *storageObjectPresignedURLEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_storage_object_presigned_url"
+}
+
+func (r *storageObjectPresignedURLEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = EphemeralResourceSchema(ctx)
+}
+
+func (r *storageObjectPresignedURLEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerConfig, ok := req.ProviderData.(*provider_config.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *provider_config.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.providerConfig = providerConfig
+}
+
+func (r *storageObjectPresignedURLEphemeralResource) ValidateConfig(ctx context.Context, req ephemeral.ValidateConfigRequest, resp *ephemeral.ValidateConfigResponse) {
+	var config StorageObjectPresignedURLModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.ContentType.IsNull() || config.ContentType.IsUnknown() || config.Method.IsUnknown() {
+		return
+	}
+	if config.Method.ValueString() != storage.PresignMethodPut {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("content_type"),
+			"Invalid Attribute Combination",
+			fmt.Sprintf("content_type can only be set when method is %q", storage.PresignMethodPut),
+		)
+	}
+}
+
+func (r *storageObjectPresignedURLEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data StorageObjectPresignedURLModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Method.IsNull() {
+		data.Method = types.StringValue(storage.PresignMethodGet)
+	}
+	if data.ExpiresIn.IsNull() {
+		data.ExpiresIn = types.Int64Value(defaultExpiresIn)
+	}
+
+	s3Client, err := r.providerConfig.GetS3Client(ctx, data.AccessKey.ValueString(), data.SecretKey.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get S3 client", err.Error())
+		return
+	}
+
+	presigned, err := s3Client.PresignObjectURL(
+		ctx,
+		data.Method.ValueString(),
+		data.Bucket.ValueString(),
+		data.Key.ValueString(),
+		data.ContentType.ValueString(),
+		time.Duration(data.ExpiresIn.ValueInt64())*time.Second,
+	)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to generate pre-signed URL", err.Error())
+		return
+	}
+
+	signedHeaders, diags := types.MapValueFrom(ctx, types.StringType, presigned.SignedHeaders)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.URL = types.StringValue(presigned.URL)
+	data.SignedHeaders = signedHeaders
+	data.ExpiresAt = types.StringValue(presigned.ExpiresAt.Format(time.RFC3339))
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -0,0 +1,132 @@
+package storage_object_presigned_url_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	test "github.com/yandex-cloud/terraform-provider-yandex/pkg/testhelpers"
+)
+
+// TestMain - add sweepers flag to the go test command
+// important for sweepers run.
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
+
+func TestAccStorageObjectPresignedURLEphemeral(t *testing.T) {
+	var (
+		bucketName = test.ResourceName(63)
+		saName     = test.ResourceName(63)
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { test.AccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV6ProviderFactories: testAccProviderFactoriesWithEcho(),
+		CheckDestroy:             test.AccCheckBucketDestroy(bucketName),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStorageObjectPresignedURLConfig(bucketName, saName, test.GetExampleFolderID(), `
+  expires_in = 600`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("method"), knownvalue.StringExact("GET")),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("url"),
+						knownvalue.StringRegexp(regexp.MustCompile(`/test-object\?.*X-Amz-Expires=600`))),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("signed_headers"), knownvalue.MapExact(map[string]knownvalue.Check{})),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("expires_at"), knownvalue.NotNull()),
+				},
+			},
+			{
+				Config: testAccStorageObjectPresignedURLConfig(bucketName, saName, test.GetExampleFolderID(), `
+  method       = "PUT"
+  content_type = "application/json"`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("url"),
+						knownvalue.StringRegexp(regexp.MustCompile(`X-Amz-Expires=3600`))),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("signed_headers"), knownvalue.MapExact(map[string]knownvalue.Check{
+						"Content-Type": knownvalue.StringExact("application/json"),
+					})),
+				},
+			},
+			{
+				Config: testAccStorageObjectPresignedURLConfig(bucketName, saName, test.GetExampleFolderID(), `
+  content_type = "application/json"`),
+				ExpectError: regexp.MustCompile("content_type can only be set when method is \"PUT\""),
+			},
+		},
+	})
+}
+
+func testAccProviderFactoriesWithEcho() map[string]func() (tfprotov6.ProviderServer, error) {
+	factories := map[string]func() (tfprotov6.ProviderServer, error){
+		"echo": echoprovider.NewProviderServer(),
+	}
+	for name, factory := range test.AccProviderFactories {
+		factories[name] = factory
+	}
+	return factories
+}
+
+func testAccStorageObjectPresignedURLConfig(bucketName, saName, folderID, arguments string) string {
+	return fmt.Sprintf(`
+resource "yandex_iam_service_account" "sa" {
+  name      = "%[2]s"
+  folder_id = "%[3]s"
+}
+
+resource "yandex_resourcemanager_folder_iam_member" "sa-editor" {
+  folder_id = "%[3]s"
+  role      = "storage.editor"
+  member    = "serviceAccount:${yandex_iam_service_account.sa.id}"
+}
+
+resource "yandex_iam_service_account_static_access_key" "sa-key" {
+  service_account_id = yandex_iam_service_account.sa.id
+
+  depends_on = [yandex_resourcemanager_folder_iam_member.sa-editor]
+}
+
+resource "yandex_storage_bucket" "test" {
+  bucket        = "%[1]s"
+  folder_id     = "%[3]s"
+  force_destroy = true
+
+  access_key = yandex_iam_service_account_static_access_key.sa-key.access_key
+  secret_key = yandex_iam_service_account_static_access_key.sa-key.secret_key
+}
+
+resource "yandex_storage_object" "test" {
+  bucket  = yandex_storage_bucket.test.bucket
+  key     = "test-object"
+  content = "hello"
+
+  access_key = yandex_iam_service_account_static_access_key.sa-key.access_key
+  secret_key = yandex_iam_service_account_static_access_key.sa-key.secret_key
+}
+
+ephemeral "yandex_storage_object_presigned_url" "test" {
+  bucket = yandex_storage_object.test.bucket
+  key    = yandex_storage_object.test.key
+
+  access_key = yandex_iam_service_account_static_access_key.sa-key.access_key
+  secret_key = yandex_iam_service_account_static_access_key.sa-key.secret_key
+%[4]s
+}
+
+provider "echo" {
+  data = ephemeral.yandex_storage_object_presigned_url.test
+}
+
+resource "echo" "test" {}
+`, bucketName, saName, folderID, arguments)
+}
@@ -0,0 +1,16 @@
+package storage_object_presigned_url
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+type StorageObjectPresignedURLModel struct {
+	Bucket        types.String `tfsdk:"bucket"`
+	Key           types.String `tfsdk:"key"`
+	Method        types.String `tfsdk:"method"`
+	ExpiresIn     types.Int64  `tfsdk:"expires_in"`
+	ContentType   types.String `tfsdk:"content_type"`
+	AccessKey     types.String `tfsdk:"access_key"`
+	SecretKey     types.String `tfsdk:"secret_key"`
+	URL           types.String `tfsdk:"url"`
+	SignedHeaders types.Map    `tfsdk:"signed_headers"`
+	ExpiresAt     types.String `tfsdk:"expires_at"`
+}
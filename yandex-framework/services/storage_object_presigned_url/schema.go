@@ -0,0 +1,87 @@
+package storage_object_presigned_url
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	storage "github.com/yandex-cloud/terraform-provider-yandex/pkg/storage/s3"
+)
+
+const defaultExpiresIn = 3600
+
+func EphemeralResourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Generates a [pre-signed URL](https://yandex.cloud/docs/storage/concepts/pre-signed-urls) granting temporary access to an object in Yandex Cloud Storage. The URL is not stored in the Terraform state or plan.\n\n~> Pre-signed URLs are signed with [static access keys](https://yandex.cloud/docs/iam/concepts/authorization/access-key) (Access and Secret), so either `access_key` and `secret_key` or `storage_access_key` and `storage_secret_key` in provider config must be specified. The URL grants the permissions of the service account the keys belong to.\n\n~> A new URL is generated on every Terraform run.",
+		Attributes: map[string]schema.Attribute{
+			"bucket": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the bucket.",
+			},
+			"key": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the object in the bucket.",
+			},
+			"method": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The HTTP method the URL is signed for. Valid values are `GET` to download and `PUT` to upload the object. Defaults to `GET`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(storage.PresignMethodValues...),
+				},
+			},
+			"expires_in": schema.Int64Attribute{
+				Optional: true,
+				MarkdownDescription: fmt.Sprintf(
+					"The number of seconds the URL is valid for. Must be between `1` and `%d` (7 days). Defaults to `%d`.",
+					int64(storage.PresignMaxExpiration.Seconds()), defaultExpiresIn,
+				),
+				Validators: []validator.Int64{
+					int64validator.Between(1, int64(storage.PresignMaxExpiration.Seconds())),
+				},
+			},
+			"content_type": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The content type the object has to be uploaded with. Can only be set with `PUT` method, the upload must then be made with the same `Content-Type` header.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"access_key": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The access key to sign the URL with. This value can also be provided as `storage_access_key` specified in provider config (explicitly or within `shared_credentials_file`) is used.",
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("secret_key")),
+				},
+			},
+			"secret_key": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The secret key to sign the URL with. This value can also be provided as `storage_secret_key` specified in provider config (explicitly or within `shared_credentials_file`) is used.",
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("access_key")),
+				},
+			},
+			"url": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The pre-signed URL.",
+			},
+			"signed_headers": schema.MapAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Headers that were signed along with the URL and must be sent unchanged with the request, e.g. `Content-Type` when `content_type` is set.",
+			},
+			"expires_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The time the URL expires at, in RFC3339 format.",
+			},
+		},
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/vpc/v1"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/sgrules"
 	sg "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/vpc_security_group"
 )
 
@@ -59,6 +60,78 @@ func (r securityGroupRuleModel) BodyEqual(o securityGroupRuleModel) bool {
 		cidrsEqual(r.V6CidrBlocks, o.V6CidrBlocks)
 }
 
+// securityGroupRuleModelToRule returns the rule body for analysis. It returns false if the direction,
+// protocol or ports are not known yet. Unknown targets are left empty.
+func securityGroupRuleModelToRule(m *securityGroupRuleModel) (sgrules.Rule, bool) {
+	if m.Direction.IsUnknown() || m.Protocol.IsUnknown() || m.Port.IsUnknown() || m.FromPort.IsUnknown() || m.ToPort.IsUnknown() {
+		return sgrules.Rule{}, false
+	}
+
+	rule := sgrules.Rule{
+		ID:        m.ID.ValueString(),
+		Direction: strings.ToUpper(m.Direction.ValueString()),
+		Protocol:  m.Protocol.ValueString(),
+		FromPort:  m.FromPort.ValueInt64(),
+		ToPort:    m.ToPort.ValueInt64(),
+	}
+	if port := m.Port.ValueInt64(); port != sgrules.AnyPort {
+		rule.FromPort, rule.ToPort = port, port
+	}
+
+	var known bool
+	if rule.V4CidrBlocks, known = knownStrings(m.V4CidrBlocks); !known {
+		return sgrules.Rule{}, false
+	}
+	if rule.V6CidrBlocks, known = knownStrings(m.V6CidrBlocks); !known {
+		return sgrules.Rule{}, false
+	}
+	if !m.SecurityGroupID.IsUnknown() {
+		rule.SecurityGroupID = m.SecurityGroupID.ValueString()
+	}
+	if !m.PredefinedTarget.IsUnknown() {
+		rule.PredefinedTarget = m.PredefinedTarget.ValueString()
+	}
+
+	return rule, true
+}
+
+// knownStrings returns elements of the list. An unknown list is empty, a list with unknown elements is not known.
+func knownStrings(l types.List) ([]string, bool) {
+	if l.IsNull() || l.IsUnknown() {
+		return nil, true
+	}
+
+	result := make([]string, 0, len(l.Elements()))
+	for _, e := range l.Elements() {
+		s, ok := e.(types.String)
+		if !ok || s.IsUnknown() {
+			return nil, false
+		}
+		result = append(result, s.ValueString())
+	}
+	return result, true
+}
+
+func securityGroupRuleToRule(rule *vpc.SecurityGroupRule) sgrules.Rule {
+	port, fromPort, toPort := sg.FlattenRulePorts(rule)
+	if port != sgrules.AnyPort {
+		fromPort, toPort = port, port
+	}
+	v4Cidrs, v6Cidrs := sg.SplitCidrs(rule.GetCidrBlocks())
+
+	return sgrules.Rule{
+		ID:               rule.GetId(),
+		Direction:        rule.GetDirection().String(),
+		Protocol:         rule.GetProtocolName(),
+		FromPort:         fromPort,
+		ToPort:           toPort,
+		V4CidrBlocks:     v4Cidrs,
+		V6CidrBlocks:     v6Cidrs,
+		SecurityGroupID:  rule.GetSecurityGroupId(),
+		PredefinedTarget: rule.GetPredefinedTarget(),
+	}
+}
+
 func cidrsEqual(r, o types.List) bool {
 	if (r.IsNull() || r.IsUnknown()) && (o.IsNull() || o.IsUnknown()) {
 		return true
@@ -27,6 +27,7 @@ import (
 	"github.com/yandex-cloud/terraform-provider-yandex/common"
 	"github.com/yandex-cloud/terraform-provider-yandex/pkg/globallock"
 	"github.com/yandex-cloud/terraform-provider-yandex/pkg/resourceid"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/sgrules"
 	spm "github.com/yandex-cloud/terraform-provider-yandex/pkg/stringplanmodifier"
 	provider_config "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
 	sg "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/vpc_security_group"
@@ -39,6 +40,7 @@ var (
 	_ resource.ResourceWithConfigure      = &securityGroupRuleResource{}
 	_ resource.ResourceWithImportState    = &securityGroupRuleResource{}
 	_ resource.ResourceWithValidateConfig = &securityGroupRuleResource{}
+	_ resource.ResourceWithModifyPlan     = &securityGroupRuleResource{}
 )
 
 type securityGroupRuleResource struct {
@@ -55,7 +57,7 @@ func NewResource() resource.Resource {
 func (r *securityGroupRuleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	tflog.Debug(ctx, "Initializing VPC SecurityGroupRule schema")
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Manages `Security Group Rule` within the Yandex Cloud. For more information, see [Documentation](https://yandex.cloud/docs/vpc/concepts/security-groups).\n\n~> There is another way to manage security group rules by `ingress` and `egress` arguments in `yandex_vpc_security_group` resource. Both ways are similar but not compatible with each other. Using `Security Group Rule` at the same time with `yandex_vpc_security_group` resource will cause a conflict of rules configuration and it's not recommended!\n\n~> Either one `port` argument or both `from_port` and `to_port` arguments can be specified.\n\n~> If `port` or `from_port`/`to_port` aren't specified or set by -1, ANY port will be sent.\n~> Can't use specified port if protocol is one of `ICMP` or `IPV6_ICMP`.\n\n~> One of arguments `v4_cidr_blocks`/`v6_cidr_blocks` or `predefined_target` or `security_group_id` must be specified.\n\n",
+		MarkdownDescription: "Manages `Security Group Rule` within the Yandex Cloud. For more information, see [Documentation](https://yandex.cloud/docs/vpc/concepts/security-groups).\n\n~> There is another way to manage security group rules by `ingress` and `egress` arguments in `yandex_vpc_security_group` resource. Both ways are similar but not compatible with each other. Using `Security Group Rule` at the same time with `yandex_vpc_security_group` resource will cause a conflict of rules configuration and it's not recommended! The plan warns about rules of the group that duplicate or shadow the rule. Rules created by `Security Group Rule` are removed by a group with `exclusive = true`.\n\n~> Either one `port` argument or both `from_port` and `to_port` arguments can be specified.\n\n~> If `port` or `from_port`/`to_port` aren't specified or set by -1, ANY port will be sent.\n~> Can't use specified port if protocol is one of `ICMP` or `IPV6_ICMP`.\n\n~> One of arguments `v4_cidr_blocks`/`v6_cidr_blocks` or `predefined_target` or `security_group_id` must be specified.\n\n",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				MarkdownDescription: common.ResourceDescriptions["id"],
@@ -474,6 +476,73 @@ func (r *securityGroupRuleResource) ValidateConfig(ctx context.Context, req reso
 	}
 }
 
+// ModifyPlan implements resource.ResourceWithModifyPlan. It reports rules of the security group that
+// duplicate or shadow the planned rule, e.g. rules declared by `ingress` and `egress` of the group.
+func (r *securityGroupRuleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.providerConfig == nil {
+		return
+	}
+
+	var plan securityGroupRuleModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.SecurityGroupBinding.IsUnknown() {
+		return
+	}
+	rule, known := securityGroupRuleModelToRule(&plan)
+	if !known {
+		return
+	}
+	if !req.State.Raw.IsNull() {
+		// the rule being replaced must not conflict with itself
+		var state securityGroupRuleModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		rule.ID = state.ID.ValueString()
+	}
+
+	if err := sgrules.Validate(rule); err != nil {
+		resp.Diagnostics.AddError("Invalid SecurityGroupRule", err.Error())
+		return
+	}
+
+	// Failures to read groups must not fail the plan: the rule is checked by the API on apply anyway.
+	var readDiags diag.Diagnostics
+	sgID := plan.SecurityGroupBinding.ValueString()
+	group := sg_api.ReadSecurityGroup(ctx, r.providerConfig.SDKv2, &readDiags, sgID)
+	if group == nil {
+		return
+	}
+
+	if target := rule.SecurityGroupID; target != "" && target != sgID {
+		if sg_api.ReadSecurityGroup(ctx, r.providerConfig.SDKv2, &readDiags, target) == nil && !readDiags.HasError() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("security_group_id"),
+				"Security group not found",
+				fmt.Sprintf("Rule %s references security group %q that does not exist", rule, target),
+			)
+			return
+		}
+	}
+
+	existing := make([]sgrules.Rule, 0, len(group.GetRules()))
+	for _, cloudRule := range group.GetRules() {
+		existing = append(existing, securityGroupRuleToRule(cloudRule))
+	}
+	for _, c := range sgrules.AnalyzeAgainst(rule, existing) {
+		resp.Diagnostics.AddWarning(
+			"Conflicting SecurityGroupRule",
+			fmt.Sprintf("In security group %q %s. The other rule may be declared by `ingress` or `egress` of `yandex_vpc_security_group` or `yandex_vpc_default_security_group`, "+
+				"managing the same rules in both ways causes perpetual diffs.", sgID, c),
+		)
+	}
+}
+
 func (r *securityGroupRuleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
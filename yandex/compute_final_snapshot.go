@@ -0,0 +1,202 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/compute/v1"
+	computesdk "github.com/yandex-cloud/go-sdk/services/compute/v1"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/common"
+)
+
+const (
+	computeFinalSnapshotDefaultNamePrefix = "final"
+	// computeFinalSnapshotPrefixLabel marks final snapshots, its value is the name prefix of the snapshot.
+	// Retention is applied only to snapshots with this label.
+	computeFinalSnapshotPrefixLabel = "final-snapshot-prefix"
+	// computeFinalSnapshotDiskLabel holds the ID of the snapshotted disk, retention never touches snapshots of other disks.
+	computeFinalSnapshotDiskLabel  = "final-snapshot-disk-id"
+	computeFinalSnapshotTimeFormat = "20060102150405"
+)
+
+var computeFinalSnapshotNamePrefixRe = regexp.MustCompile(`^[a-z]([-a-z0-9]{0,25}[a-z0-9])?$`)
+
+// computeFinalSnapshot is the final_snapshot block of disks and instances.
+type computeFinalSnapshot struct {
+	NamePrefix string
+	Labels     map[string]string
+	Retention  time.Duration
+}
+
+func computeFinalSnapshotSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: description,
+		Optional:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"enabled": {
+					Type:        schema.TypeBool,
+					Description: "Create a snapshot before the disk is deleted. Default is `true`.",
+					Optional:    true,
+					Default:     true,
+				},
+				"name_prefix": {
+					Type:         schema.TypeString,
+					Description:  "Prefix of the snapshot name. The snapshot is named `<name_prefix>-<disk_id>-<YYYYMMDDhhmmss>`. Default is `final`.",
+					Optional:     true,
+					Default:      computeFinalSnapshotDefaultNamePrefix,
+					ValidateFunc: validation.StringMatch(computeFinalSnapshotNamePrefixRe, "must start with a lowercase letter, contain only lowercase letters, digits and hyphens and be no longer than 27 characters"),
+				},
+				"labels": {
+					Type:        schema.TypeMap,
+					Description: common.ResourceDescriptions["labels"],
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Set:         schema.HashString,
+				},
+				"retention": {
+					Type:         schema.TypeString,
+					Description:  "Time to keep final snapshots of the disk with the same `name_prefix` for. Older ones are deleted when a new final snapshot of the disk is created, snapshots of other disks are never deleted. A failure to delete them does not fail the deletion of the disk. If not set, final snapshots are kept forever. Valid time units are `s`, `m`, `h`, e.g. `168h`.",
+					Optional:     true,
+					ValidateFunc: validateParsableValue(parseComputeFinalSnapshotRetention),
+				},
+			},
+		},
+	}
+}
+
+func parseComputeFinalSnapshotRetention(v string) (time.Duration, error) {
+	retention, err := time.ParseDuration(v)
+	if err != nil {
+		return 0, err
+	}
+	if retention <= 0 {
+		return 0, fmt.Errorf("retention must be positive, got %s", v)
+	}
+	return retention, nil
+}
+
+// expandComputeFinalSnapshot returns nil if final snapshot is not enabled.
+func expandComputeFinalSnapshot(d *schema.ResourceData) (*computeFinalSnapshot, error) {
+	v, ok := d.GetOk("final_snapshot.0")
+	if !ok || v == nil {
+		return nil, nil
+	}
+	m := v.(map[string]interface{})
+	if !m["enabled"].(bool) {
+		return nil, nil
+	}
+
+	labels, err := expandLabels(m["labels"])
+	if err != nil {
+		return nil, fmt.Errorf("Error expanding final snapshot labels: %s", err)
+	}
+
+	fs := &computeFinalSnapshot{
+		NamePrefix: m["name_prefix"].(string),
+		Labels:     labels,
+	}
+	if fs.NamePrefix == "" {
+		fs.NamePrefix = computeFinalSnapshotDefaultNamePrefix
+	}
+	if retention := m["retention"].(string); retention != "" {
+		fs.Retention, err = parseComputeFinalSnapshotRetention(retention)
+		if err != nil {
+			return nil, fmt.Errorf("Error parsing final snapshot retention: %s", err)
+		}
+	}
+
+	return fs, nil
+}
+
+// createComputeFinalSnapshot snapshots the disk and waits for the snapshot to be ready.
+func createComputeFinalSnapshot(ctx context.Context, config *Config, fs *computeFinalSnapshot, disk *compute.Disk) error {
+	now := time.Now().UTC()
+
+	labels := make(map[string]string, len(fs.Labels)+2)
+	for k, v := range fs.Labels {
+		labels[k] = v
+	}
+	labels[computeFinalSnapshotPrefixLabel] = fs.NamePrefix
+	labels[computeFinalSnapshotDiskLabel] = disk.GetId()
+
+	req := &compute.CreateSnapshotRequest{
+		FolderId:    disk.GetFolderId(),
+		DiskId:      disk.GetId(),
+		Name:        fmt.Sprintf("%s-%s-%s", fs.NamePrefix, disk.GetId(), now.Format(computeFinalSnapshotTimeFormat)),
+		Description: fmt.Sprintf("Final snapshot of disk %q created before deletion", disk.GetName()),
+		Labels:      labels,
+	}
+
+	log.Printf("[DEBUG] Creating final snapshot %q of Disk %q", req.Name, disk.GetId())
+
+	op, err := computesdk.NewSnapshotClient(config.SDK).Create(ctx, req)
+	if err != nil {
+		return fmt.Errorf("Error while requesting API to create final snapshot of disk %q: %s", disk.GetId(), err)
+	}
+
+	_, err = op.Wait(ctx)
+	if err != nil {
+		return fmt.Errorf("Error while waiting operation to create final snapshot of disk %q: %s", disk.GetId(), err)
+	}
+
+	// The final snapshot is taken, so the failed cleanup must not block the deletion of the disk.
+	if fs.Retention > 0 {
+		if err := deleteExpiredComputeFinalSnapshots(ctx, config, disk, fs, now); err != nil {
+			log.Printf("[WARN] Failed to delete expired final snapshots of disk %q: %s", disk.GetId(), err)
+		}
+	}
+
+	return nil
+}
+
+// deleteExpiredComputeFinalSnapshots deletes final snapshots of the disk with the same name prefix older than retention.
+func deleteExpiredComputeFinalSnapshots(ctx context.Context, config *Config, disk *compute.Disk, fs *computeFinalSnapshot, now time.Time) error {
+	folderID := disk.GetFolderId()
+	client := computesdk.NewSnapshotClient(config.SDK)
+
+	req := &compute.ListSnapshotsRequest{
+		FolderId: folderID,
+		PageSize: 1000,
+	}
+	for {
+		resp, err := client.List(ctx, req)
+		if err != nil {
+			return fmt.Errorf("Error while requesting API to list snapshots in folder %q: %s", folderID, err)
+		}
+
+		for _, snapshot := range resp.GetSnapshots() {
+			labels := snapshot.GetLabels()
+			if labels[computeFinalSnapshotPrefixLabel] != fs.NamePrefix || labels[computeFinalSnapshotDiskLabel] != disk.GetId() {
+				continue
+			}
+			if now.Sub(snapshot.GetCreatedAt().AsTime()) <= fs.Retention {
+				continue
+			}
+
+			log.Printf("[DEBUG] Deleting expired final snapshot %q", snapshot.GetId())
+			op, err := client.Delete(ctx, &compute.DeleteSnapshotRequest{
+				SnapshotId: snapshot.GetId(),
+			})
+			if err != nil {
+				return fmt.Errorf("Error while requesting API to delete expired final snapshot %q: %s", snapshot.GetId(), err)
+			}
+			if _, err = op.Wait(ctx); err != nil {
+				return fmt.Errorf("Error while waiting operation to delete expired final snapshot %q: %s", snapshot.GetId(), err)
+			}
+		}
+
+		if resp.GetNextPageToken() == "" {
+			return nil
+		}
+		req.PageToken = resp.GetNextPageToken()
+	}
+}
@@ -0,0 +1,175 @@
+package yandex
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mitchellh/go-homedir"
+	storagepb "github.com/yandex-cloud/go-genproto/yandex/cloud/storage/v1"
+	storagesdk "github.com/yandex-cloud/go-sdk/services/storage/v1"
+	storage "github.com/yandex-cloud/terraform-provider-yandex/pkg/storage/s3"
+)
+
+const (
+	computeImageStagingBucketPrefix = "tf-image-staging-"
+	computeImageStagingKeyPrefix    = "tf-image-staging"
+	// computeImageStagingURLExpiration is the lifetime of the pre-signed URL the image is created from.
+	computeImageStagingURLExpiration = 12 * time.Hour
+	// computeImageStagingCleanupTimeout bounds the removal of the staging object and bucket,
+	// which runs even if the create timeout is exceeded.
+	computeImageStagingCleanupTimeout = 5 * time.Minute
+)
+
+// computeImageSourceFileHash returns the hex encoded SHA256 of the file content.
+func computeImageSourceFileHash(path string) (string, error) {
+	path, err := homedir.Expand(path)
+	if err != nil {
+		return "", fmt.Errorf("error expanding homedir in source_file (%s): %s", path, err)
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", fmt.Errorf("error reading source_file (%s): %s", path, err)
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// resourceYandexComputeImageSourceFileDiff recreates the image when the content of `source_file` changes.
+func resourceYandexComputeImageSourceFileDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	path := d.Get("source_file").(string)
+	if path == "" {
+		return nil
+	}
+
+	hash, err := computeImageSourceFileHash(path)
+	if err != nil {
+		if os.IsNotExist(err) && d.Id() != "" {
+			// CI pipelines usually do not keep built files between runs, the image must not be recreated because of that.
+			log.Printf("[WARN] Source file %q of Image %q does not exist, skipping change detection", path, d.Id())
+			return nil
+		}
+		return fmt.Errorf("Error calculating hash of source_file: %s", err)
+	}
+
+	if d.Get("source_file_hash").(string) == hash {
+		return nil
+	}
+	if err := d.SetNew("source_file_hash", hash); err != nil {
+		return err
+	}
+	if d.Id() == "" {
+		return nil
+	}
+	return d.ForceNew("source_file_hash")
+}
+
+// uploadComputeImageSourceFile uploads `source_file` to the staging bucket and returns the URL to create the image from
+// and the function that removes the staging object and the provider-managed bucket.
+func uploadComputeImageSourceFile(ctx context.Context, d *schema.ResourceData, config *Config, folderID string) (string, func(), error) {
+	// The staging object is private, the image service reads it by the pre-signed URL, which requires static keys.
+	accessKey, secretKey := config.resolveStorageAccessKeys()
+	if accessKey == "" || secretKey == "" {
+		return "", nil, fmt.Errorf("source_file requires static storage access keys (`storage_access_key` and `storage_secret_key` of the provider) " +
+			"to pre-sign the URL of the uploaded object the image is created from")
+	}
+
+	s3Client, err := getS3ClientByKeys(ctx, accessKey, secretKey, config)
+	if err != nil {
+		return "", nil, fmt.Errorf("error getting storage client: %s", err)
+	}
+	presignClient, err := storage.NewClient(ctx, accessKey, secretKey, "", config.StorageEndpoint)
+	if err != nil {
+		return "", nil, fmt.Errorf("error getting storage client: %s", err)
+	}
+
+	path := d.Get("source_file").(string)
+	hash := d.Get("source_file_hash").(string)
+	if hash == "" {
+		if hash, err = computeImageSourceFileHash(path); err != nil {
+			return "", nil, fmt.Errorf("Error calculating hash of source_file: %s", err)
+		}
+	}
+
+	bucket := d.Get("source_file_bucket").(string)
+	managedBucket := bucket == ""
+	if managedBucket {
+		bucket, err = createComputeImageStagingBucket(ctx, config, folderID)
+		if err != nil {
+			return "", nil, err
+		}
+	}
+	key := fmt.Sprintf("%s/%s/%s", computeImageStagingKeyPrefix, hash, filepath.Base(path))
+
+	cleanup := func() {
+		// The create context may be already cancelled by the timeout.
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), computeImageStagingCleanupTimeout)
+		defer cancel()
+
+		log.Printf("[DEBUG] Deleting staging object %q in bucket %q", key, bucket)
+		if err := s3Client.DeleteObject(ctx, bucket, key); err != nil {
+			log.Printf("[WARN] Failed to delete staging object %q in bucket %q: %s", key, bucket, err)
+		}
+		if managedBucket {
+			deleteComputeImageStagingBucket(ctx, config, bucket)
+		}
+	}
+
+	log.Printf("[DEBUG] Uploading source file %q to bucket %q as %q", path, bucket, key)
+	if err := s3Client.UploadFile(ctx, bucket, key, path); err != nil {
+		cleanup()
+		return "", nil, fmt.Errorf("Error uploading source_file: %s", err)
+	}
+
+	presigned, err := presignClient.PresignObjectURL(ctx, storage.PresignMethodGet, bucket, key, "", computeImageStagingURLExpiration)
+	if err != nil {
+		cleanup()
+		return "", nil, fmt.Errorf("Error pre-signing URL of the uploaded source_file: %s", err)
+	}
+
+	return presigned.URL, cleanup, nil
+}
+
+func createComputeImageStagingBucket(ctx context.Context, config *Config, folderID string) (string, error) {
+	name := id.PrefixedUniqueId(computeImageStagingBucketPrefix)
+
+	log.Printf("[DEBUG] Creating staging bucket %q in folder %q", name, folderID)
+	op, err := storagesdk.NewBucketClient(config.SDK).Create(ctx, &storagepb.CreateBucketRequest{
+		Name:     name,
+		FolderId: folderID,
+	})
+	if err != nil {
+		return "", fmt.Errorf("Error while requesting API to create staging bucket: %s", err)
+	}
+	if _, err = op.Wait(ctx); err != nil {
+		return "", fmt.Errorf("Error while waiting operation to create staging bucket: %s", err)
+	}
+
+	return name, nil
+}
+
+func deleteComputeImageStagingBucket(ctx context.Context, config *Config, name string) {
+	log.Printf("[DEBUG] Deleting staging bucket %q", name)
+	op, err := storagesdk.NewBucketClient(config.SDK).Delete(ctx, &storagepb.DeleteBucketRequest{
+		Name: name,
+	})
+	if err == nil {
+		_, err = op.Wait(ctx)
+	}
+	if err != nil {
+		log.Printf("[WARN] Failed to delete staging bucket %q: %s", name, err)
+	}
+}
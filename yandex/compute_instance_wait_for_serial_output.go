@@ -0,0 +1,127 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/serialport"
+)
+
+const (
+	computeInstanceSerialOutputDefaultTimeout = "10m"
+	computeInstanceSerialOutputPollInterval   = 10 * time.Second
+	// computeInstanceSerialOutputTailLines is the number of the last lines of the output reported on failure.
+	computeInstanceSerialOutputTailLines = 30
+)
+
+// computeInstanceSerialOutputWait is the wait_for_serial_output block of an instance.
+type computeInstanceSerialOutputWait struct {
+	Regex        *regexp.Regexp
+	FailureRegex *regexp.Regexp
+	Port         int
+	Timeout      time.Duration
+}
+
+func computeInstanceSerialOutputWaitSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "Wait on instance creation until the serial port output matches a regular expression, e.g. `cloud-init.*finished`. If the output does not match in time or matches `failure_regex`, the creation fails with the last lines of the output and the instance is marked as tainted. Changing this block does not affect existing instances.",
+		Optional:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"regex": {
+					Type:         schema.TypeString,
+					Description:  "Regular expression that marks the instance as ready.",
+					Required:     true,
+					ValidateFunc: validation.StringIsValidRegExp,
+				},
+				"failure_regex": {
+					Type:         schema.TypeString,
+					Description:  "Regular expression that marks the instance as failed, e.g. `cloud-init.*(ERROR|failed)`. It is checked before `regex`.",
+					Optional:     true,
+					ValidateFunc: validation.StringIsValidRegExp,
+				},
+				"port": {
+					Type:         schema.TypeInt,
+					Description:  "Number of the serial port, from `1` to `4`. Default is `1`.",
+					Optional:     true,
+					Default:      serialport.MinPort,
+					ValidateFunc: validation.IntBetween(serialport.MinPort, serialport.MaxPort),
+				},
+				"timeout": {
+					Type:         schema.TypeString,
+					Description:  "Time to wait for after the instance is created. It is not limited by the `create` timeout of the instance. Valid time units are `s`, `m`, `h`. Default is `10m`.",
+					Optional:     true,
+					Default:      computeInstanceSerialOutputDefaultTimeout,
+					ValidateFunc: validateParsableValue(time.ParseDuration),
+				},
+			},
+		},
+	}
+}
+
+func expandComputeInstanceSerialOutputWait(d *schema.ResourceData) (*computeInstanceSerialOutputWait, error) {
+	v, ok := d.GetOk("wait_for_serial_output.0")
+	if !ok || v == nil {
+		return nil, nil
+	}
+	m := v.(map[string]interface{})
+
+	timeout, err := time.ParseDuration(m["timeout"].(string))
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing wait_for_serial_output timeout: %s", err)
+	}
+
+	w := &computeInstanceSerialOutputWait{
+		Regex:   regexp.MustCompile(m["regex"].(string)),
+		Port:    m["port"].(int),
+		Timeout: timeout,
+	}
+	if failure := m["failure_regex"].(string); failure != "" {
+		w.FailureRegex = regexp.MustCompile(failure)
+	}
+
+	return w, nil
+}
+
+// waitForComputeInstanceSerialOutput polls the serial port output of the instance until it matches the wait condition.
+func waitForComputeInstanceSerialOutput(config *Config, instanceID string, w *computeInstanceSerialOutputWait) error {
+	ctx, cancel := context.WithTimeout(config.Context(), w.Timeout)
+	defer cancel()
+
+	log.Printf("[DEBUG] Waiting for serial port %d output of Instance %q to match %q", w.Port, instanceID, w.Regex)
+
+	var output string
+	for {
+		current, err := getComputeInstanceSerialPortOutput(ctx, config, instanceID, w.Port)
+		if err != nil {
+			// The output may be unavailable right after the instance is started.
+			log.Printf("[WARN] %s", err)
+		} else {
+			output = current
+		}
+
+		if w.FailureRegex != nil && w.FailureRegex.MatchString(output) {
+			return fmt.Errorf("serial port %d output of Instance %q matches failure_regex %q, last lines of the output:\n%s",
+				w.Port, instanceID, w.FailureRegex, serialport.Tail(output, computeInstanceSerialOutputTailLines))
+		}
+		if w.Regex.MatchString(output) {
+			log.Printf("[DEBUG] Serial port %d output of Instance %q matches %q", w.Port, instanceID, w.Regex)
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("serial port %d output of Instance %q does not match %q in %s, last lines of the output:\n%s",
+				w.Port, instanceID, w.Regex, w.Timeout, serialport.Tail(output, computeInstanceSerialOutputTailLines))
+		case <-time.After(computeInstanceSerialOutputPollInterval):
+		}
+	}
+}
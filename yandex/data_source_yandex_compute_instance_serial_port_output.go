@@ -0,0 +1,94 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/compute/v1"
+	computesdk "github.com/yandex-cloud/go-sdk/services/compute/v1"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/serialport"
+)
+
+func dataSourceYandexComputeInstanceSerialPortOutput() *schema.Resource {
+	return &schema.Resource{
+		Description: "Get the serial port output of a Compute instance. For more information, see [the official documentation](https://yandex.cloud/docs/compute/operations/vm-info/get-serial-port-output).\n\n~> The output is read every time the data source is refreshed, so its value changes between runs.\n",
+
+		Read: dataSourceYandexComputeInstanceSerialPortOutputRead,
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the instance to get the serial port output of.",
+				Required:    true,
+			},
+			"port": {
+				Type:         schema.TypeInt,
+				Description:  "Number of the serial port, from `1` to `4`. Default is `1`.",
+				Optional:     true,
+				Default:      serialport.MinPort,
+				ValidateFunc: validation.IntBetween(serialport.MinPort, serialport.MaxPort),
+			},
+			"tail_lines": {
+				Type:         schema.TypeInt,
+				Description:  "Number of the last lines of the output to return in `contents`. If not set, the whole output is returned.",
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"regex": {
+				Type:         schema.TypeString,
+				Description:  "Regular expression to extract `matches` from the output with. If it has capture groups, the first group of each match is extracted.",
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			"contents": {
+				Type:        schema.TypeString,
+				Description: "The serial port output without terminal control sequences.",
+				Computed:    true,
+			},
+			"matches": {
+				Type:        schema.TypeList,
+				Description: "Matches of `regex` in the whole output.",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceYandexComputeInstanceSerialPortOutputRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	ctx := config.Context()
+
+	instanceID := d.Get("instance_id").(string)
+	port := d.Get("port").(int)
+
+	output, err := getComputeInstanceSerialPortOutput(ctx, config, instanceID, port)
+	if err != nil {
+		return err
+	}
+
+	matches := []string{}
+	if v, ok := d.GetOk("regex"); ok {
+		matches = serialport.Extract(output, regexp.MustCompile(v.(string)))
+	}
+
+	d.SetId(fmt.Sprintf("%s:%d", instanceID, port))
+	d.Set("contents", serialport.Tail(output, d.Get("tail_lines").(int)))
+	return d.Set("matches", matches)
+}
+
+// getComputeInstanceSerialPortOutput returns the serial port output without terminal control sequences.
+func getComputeInstanceSerialPortOutput(ctx context.Context, config *Config, instanceID string, port int) (string, error) {
+	resp, err := computesdk.NewInstanceClient(config.SDK).GetSerialPortOutput(ctx, &compute.GetInstanceSerialPortOutputRequest{
+		InstanceId: instanceID,
+		Port:       int64(port),
+	})
+	if err != nil {
+		return "", fmt.Errorf("Error while requesting API to get serial port %d output of Instance %q: %s", port, instanceID, err)
+	}
+
+	return serialport.Clean(resp.GetContents()), nil
+}
@@ -0,0 +1,85 @@
+package yandex
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataSourceComputeInstanceSerialPortOutput_basic(t *testing.T) {
+	t.Parallel()
+
+	instanceName := fmt.Sprintf("data-serial-test-%s", acctest.RandString(10))
+	dataSourceName := "data.yandex_compute_instance_serial_port_output.output"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactoriesV6,
+		CheckDestroy:             testAccCheckComputeInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceComputeInstanceSerialPortOutputConfig(instanceName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "instance_id", "yandex_compute_instance.foo", "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "port", "1"),
+					resource.TestMatchResourceAttr(dataSourceName, "contents", regexp.MustCompile(`(?s)^([^\n]*\n){0,5}[^\n]*$`)),
+					resource.TestCheckResourceAttr(dataSourceName, "matches.#", "1"),
+					resource.TestMatchResourceAttr(dataSourceName, "matches.0", regexp.MustCompile(`^[0-9.]+$`)),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceComputeInstanceSerialPortOutputConfig(instanceName string) string {
+	return fmt.Sprintf(`
+data "yandex_compute_image" "ubuntu" {
+  family = "ubuntu-2204-lts"
+}
+
+resource "yandex_compute_instance" "foo" {
+  name        = "%s"
+  platform_id = "standard-v3"
+  zone        = "ru-central1-a"
+
+  resources {
+    cores         = 2
+    core_fraction = 20
+    memory        = 2
+  }
+
+  boot_disk {
+    initialize_params {
+      image_id = data.yandex_compute_image.ubuntu.id
+    }
+  }
+
+  network_interface {
+    subnet_id = yandex_vpc_subnet.inst-test-subnet.id
+  }
+
+  wait_for_serial_output {
+    regex         = "Cloud-init v\\. \\S+ finished"
+    failure_regex = "Failed to start .*cloud"
+    timeout       = "10m"
+  }
+}
+
+resource "yandex_vpc_network" "inst-test-network" {}
+
+resource "yandex_vpc_subnet" "inst-test-subnet" {
+  zone           = "ru-central1-a"
+  network_id     = yandex_vpc_network.inst-test-network.id
+  v4_cidr_blocks = ["192.168.0.0/24"]
+}
+
+data "yandex_compute_instance_serial_port_output" "output" {
+  instance_id = yandex_compute_instance.foo.id
+  tail_lines  = 5
+  regex       = "Cloud-init v\\. \\S+ finished at .* Up ([0-9.]+) seconds"
+}
+`, instanceName)
+}
@@ -0,0 +1,171 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/iam/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/k8s/v1"
+	iamsdk "github.com/yandex-cloud/go-sdk/services/iam/v1"
+	k8ssdk "github.com/yandex-cloud/go-sdk/services/k8s/v1"
+	sdkresolversv2 "github.com/yandex-cloud/go-sdk/v2/pkg/sdkresolvers"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/common"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/kubeconfig"
+)
+
+func dataSourceYandexKubernetesClusterKubeconfig() *schema.Resource {
+	return &schema.Resource{
+		Description: "Generates a kubeconfig for a Yandex Cloud Managed Kubernetes Cluster authenticating with an IAM token of the provider's identity or of a given service account. For more information, see [the official documentation](https://yandex.cloud/docs/managed-kubernetes/operations/connect/create-static-conf).\n\n~> One of `cluster_id` or `name` should be specified.\n\n~> The IAM token is stored in the Terraform state and is valid for no more than 12 hours. To configure `kubernetes` or `helm` providers, prefer the `yandex_kubernetes_cluster_credentials` ephemeral resource, which is not stored in the state.\n",
+
+		Read: dataSourceYandexKubernetesClusterKubeconfigRead,
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:        schema.TypeString,
+				Description: "ID of a specific Kubernetes cluster.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: common.ResourceDescriptions["name"],
+				Optional:    true,
+				Computed:    true,
+			},
+			"folder_id": {
+				Type:        schema.TypeString,
+				Description: common.ResourceDescriptions["folder_id"],
+				Optional:    true,
+				Computed:    true,
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Description:  "Type of the Kubernetes master endpoint to connect to: `external` or `internal`. Default is `external`.",
+				Optional:     true,
+				Default:      kubeconfig.EndpointTypeExternal,
+				ValidateFunc: validation.StringInSlice(kubeconfig.EndpointTypeValues, false),
+			},
+			"service_account_id": {
+				Type:        schema.TypeString,
+				Description: "ID of a service account to issue the IAM token for. The provider's identity must have the `iam.serviceAccounts.tokenCreator` role for it. If not set, the IAM token of the provider's identity is used.",
+				Optional:    true,
+			},
+			"endpoint": {
+				Type:        schema.TypeString,
+				Description: "The selected Kubernetes master endpoint.",
+				Computed:    true,
+			},
+			"cluster_ca_certificate": {
+				Type:        schema.TypeString,
+				Description: "PEM-encoded public certificate that is the root of trust for the Kubernetes cluster.",
+				Computed:    true,
+			},
+			"token": {
+				Type:        schema.TypeString,
+				Description: "IAM token to authenticate in the Kubernetes cluster with.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"expires_at": {
+				Type:        schema.TypeString,
+				Description: "The time the IAM token expires at, in RFC3339 format.",
+				Computed:    true,
+			},
+			"kubeconfig": {
+				Type:        schema.TypeString,
+				Description: "The kubeconfig in YAML format.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+func dataSourceYandexKubernetesClusterKubeconfigRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	ctx := config.Context()
+
+	err := checkOneOf(d, "cluster_id", "name")
+	if err != nil {
+		return err
+	}
+
+	client := k8ssdk.NewClusterClient(config.SDK)
+
+	clusterID := d.Get("cluster_id").(string)
+	if _, ok := d.GetOk("name"); ok {
+		clusterID, err = resolveObjectIDV2(ctx, config, d, func(name string, opts ...sdkresolversv2.ResolveOption) sdkresolversv2.Resolver {
+			return k8ssdk.ClusterResolver(name, client, opts...)
+		})
+		if err != nil {
+			return fmt.Errorf("failed to resolve Kubernetes cluster by name: %v", err)
+		}
+	}
+
+	cluster, err := client.Get(ctx, &k8s.GetClusterRequest{
+		ClusterId: clusterID,
+	})
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("Kubernetes cluster with ID %q", clusterID))
+	}
+
+	endpoints := kubeconfig.Endpoints{
+		Internal: cluster.GetMaster().GetEndpoints().GetInternalV4Endpoint(),
+		External: cluster.GetMaster().GetEndpoints().GetExternalV4Endpoint(),
+	}
+	endpoint, err := endpoints.Select(d.Get("endpoint_type").(string))
+	if err != nil {
+		return fmt.Errorf("failed to select endpoint of Kubernetes cluster %q: %w", clusterID, err)
+	}
+
+	token, expiresAt, err := createKubernetesClusterIAMToken(ctx, config, d.Get("service_account_id").(string))
+	if err != nil {
+		return err
+	}
+
+	caCertificate := cluster.GetMaster().GetMasterAuth().GetClusterCaCertificate()
+	rendered, err := kubeconfig.Render(kubeconfig.Params{
+		ClusterID:            clusterID,
+		Server:               endpoint,
+		ClusterCACertificate: caCertificate,
+		Token:                token,
+	})
+	if err != nil {
+		return err
+	}
+
+	d.Set("cluster_id", cluster.GetId())
+	d.Set("name", cluster.GetName())
+	d.Set("folder_id", cluster.GetFolderId())
+	d.Set("endpoint", endpoint)
+	d.Set("cluster_ca_certificate", caCertificate)
+	d.Set("token", token)
+	d.Set("expires_at", expiresAt.Format(time.RFC3339))
+	d.Set("kubeconfig", rendered)
+	d.SetId(cluster.GetId())
+
+	return nil
+}
+
+// createKubernetesClusterIAMToken issues an IAM token for the service account or, if serviceAccountID is empty,
+// returns the IAM token of the provider's identity.
+func createKubernetesClusterIAMToken(ctx context.Context, config *Config, serviceAccountID string) (string, time.Time, error) {
+	if serviceAccountID == "" {
+		resp, err := config.SDK.CreateIAMToken(ctx)
+		if err != nil {
+			return "", time.Time{}, fmt.Errorf("failed to get IAM token: %w", err)
+		}
+		return resp.GetIamToken(), resp.GetExpiresAt(), nil
+	}
+
+	resp, err := iamsdk.NewIamTokenClient(config.SDK).CreateForServiceAccount(ctx, &iam.CreateIamTokenForServiceAccountRequest{
+		ServiceAccountId: serviceAccountID,
+	})
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to create IAM token for service account %q: %w", serviceAccountID, err)
+	}
+	return resp.GetIamToken(), resp.GetExpiresAt().AsTime(), nil
+}
@@ -0,0 +1,74 @@
+package yandex
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+//revive:disable:var-naming
+func TestAccDataSourceKubernetesClusterKubeconfig_basic(t *testing.T) {
+	clusterResource := clusterInfoWithSecurityGroupsNetworkAndMaintenancePolicies("testAccDataSourceKubernetesClusterKubeconfig_basic",
+		true, true, dailyMaintenancePolicy)
+	clusterResourceFullName := clusterResource.ResourceFullName(true)
+	externalDataSourceName := "data.yandex_kubernetes_cluster_kubeconfig.external"
+	internalDataSourceName := "data.yandex_kubernetes_cluster_kubeconfig.internal"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactoriesV6,
+		CheckDestroy:             testAccCheckKubernetesClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceKubernetesClusterKubeconfigConfig_basic(clusterResource),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(externalDataSourceName, "cluster_id", clusterResourceFullName, "id"),
+					resource.TestCheckResourceAttrPair(externalDataSourceName, "endpoint", clusterResourceFullName, "master.0.external_v4_endpoint"),
+					resource.TestCheckResourceAttrPair(externalDataSourceName, "cluster_ca_certificate", clusterResourceFullName, "master.0.cluster_ca_certificate"),
+					resource.TestCheckResourceAttrSet(externalDataSourceName, "token"),
+					resource.TestCheckResourceAttrSet(externalDataSourceName, "expires_at"),
+					resource.TestMatchResourceAttr(externalDataSourceName, "kubeconfig", regexp.MustCompile("current-context: yc-managed-k8s-")),
+					testAccCheckKubernetesClusterKubeconfigHasEndpoint(externalDataSourceName),
+					resource.TestCheckResourceAttrPair(internalDataSourceName, "cluster_id", clusterResourceFullName, "id"),
+					resource.TestCheckResourceAttrPair(internalDataSourceName, "endpoint", clusterResourceFullName, "master.0.internal_v4_endpoint"),
+					testAccCheckKubernetesClusterKubeconfigHasEndpoint(internalDataSourceName),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKubernetesClusterKubeconfigHasEndpoint(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		endpoint := rs.Primary.Attributes["endpoint"]
+		if !regexp.MustCompile("server: " + regexp.QuoteMeta(endpoint)).MatchString(rs.Primary.Attributes["kubeconfig"]) {
+			return fmt.Errorf("kubeconfig of %s does not point to endpoint %q", n, endpoint)
+		}
+		return nil
+	}
+}
+
+const dataClusterKubeconfigConfigTemplate = `
+data "yandex_kubernetes_cluster_kubeconfig" "external" {
+  cluster_id = yandex_kubernetes_cluster.{{.ClusterResourceName}}.id
+}
+
+data "yandex_kubernetes_cluster_kubeconfig" "internal" {
+  name          = yandex_kubernetes_cluster.{{.ClusterResourceName}}.name
+  endpoint_type = "internal"
+}
+`
+
+func testAccDataSourceKubernetesClusterKubeconfigConfig_basic(in resourceClusterInfo) string {
+	resourceConfig := testAccKubernetesClusterZonalConfig_basic(in)
+	resourceConfig += templateConfig(dataClusterKubeconfigConfigTemplate, in.Map())
+	return resourceConfig
+}
@@ -0,0 +1,163 @@
+package yandex
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex/internal/storage/s3"
+)
+
+// storageBucketDataSourceAttributes lists attributes of the yandex_storage_bucket resource
+// exposed by the data source.
+var storageBucketDataSourceAttributes = []string{
+	"bucket_domain_name",
+	"policy",
+	"cors_rule",
+	"website",
+	"website_endpoint",
+	"website_domain",
+	"versioning",
+	"object_lock_configuration",
+	"logging",
+	"lifecycle_rule",
+	"server_side_encryption_configuration",
+	"tags",
+	"default_storage_class",
+	"folder_id",
+	"max_size",
+	"anonymous_access_flags",
+	"https",
+	"disabled_statickey_auth",
+}
+
+func dataSourceYandexStorageBucket() *schema.Resource {
+	resourceSchema := resourceYandexStorageBucket().Schema
+
+	dataSchema := map[string]*schema.Schema{
+		"bucket": {
+			Type:        schema.TypeString,
+			Description: "The name of the bucket.",
+			Required:    true,
+		},
+		"access_key": {
+			Type:        schema.TypeString,
+			Description: "The access key to use when reading the bucket. This value can also be provided as `storage_access_key` specified in provider config (explicitly or within `shared_credentials_file`) is used.",
+			Optional:    true,
+		},
+		"secret_key": {
+			Type:        schema.TypeString,
+			Description: "The secret key to use when reading the bucket. This value can also be provided as `storage_secret_key` specified in provider config (explicitly or within `shared_credentials_file`) is used.",
+			Optional:    true,
+			Sensitive:   true,
+		},
+	}
+	for _, name := range storageBucketDataSourceAttributes {
+		dataSchema[name] = storageBucketComputedSchema(resourceSchema[name])
+	}
+
+	return &schema.Resource{
+		Description: "Get information about a Yandex Object Storage bucket. For more information, see [the official documentation](https://yandex.cloud/docs/storage/concepts/bucket).\n\n~> Reading bucket settings such as policy, lifecycle or encryption requires a service account with `storage.viewer` role on the bucket or its folder.\n",
+
+		ReadContext: dataSourceYandexStorageBucketRead,
+		Schema:      dataSchema,
+	}
+}
+
+// storageBucketComputedSchema returns a read-only copy of a resource attribute schema
+// with all its nested attributes.
+func storageBucketComputedSchema(s *schema.Schema) *schema.Schema {
+	computed := &schema.Schema{
+		Type:        s.Type,
+		Description: s.Description,
+		Computed:    true,
+		Sensitive:   s.Sensitive,
+		Set:         s.Set,
+	}
+
+	switch elem := s.Elem.(type) {
+	case *schema.Resource:
+		nested := make(map[string]*schema.Schema, len(elem.Schema))
+		for name, nestedSchema := range elem.Schema {
+			nested[name] = storageBucketComputedSchema(nestedSchema)
+		}
+		computed.Elem = &schema.Resource{Schema: nested}
+	case *schema.Schema:
+		computed.Elem = &schema.Schema{Type: elem.Type}
+	}
+
+	return computed
+}
+
+func dataSourceYandexStorageBucketRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	s3Client, err := getS3Client(ctx, d, config)
+	if err != nil {
+		return diag.Errorf("error getting storage client: %s", err)
+	}
+
+	bucketName := d.Get("bucket").(string)
+	bucket, err := s3Client.GetBucket(ctx, bucketName, config.StorageEndpoint, "")
+	if err != nil {
+		if errors.Is(err, s3.ErrBucketNotFound) {
+			return diag.Errorf("storage bucket %q not found", bucketName)
+		}
+		return diag.Errorf("error reading storage bucket %q: %s", bucketName, err)
+	}
+
+	d.SetId(bucketName)
+	if err := flattenStorageBucketDataSource(d, bucket); err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = resourceYandexStorageBucketReadExtended(d, meta)
+	if err != nil {
+		log.Printf("[WARN] Got an error reading Storage Bucket's extended properties: %s", err)
+	}
+	if d.Id() == "" {
+		return diag.Errorf("storage bucket %q not found", bucketName)
+	}
+
+	return nil
+}
+
+func flattenStorageBucketDataSource(d *schema.ResourceData, bucket *s3.Bucket) error {
+	d.Set("bucket_domain_name", bucket.DomainName)
+	if err := d.Set("policy", bucket.Policy); err != nil {
+		return fmt.Errorf("error setting policy: %w", err)
+	}
+	if err := d.Set("cors_rule", bucket.CORSRules); err != nil {
+		return fmt.Errorf("error setting cors_rule: %w", err)
+	}
+	if bucket.Website != nil {
+		if err := d.Set("website", bucket.Website.RawData); err != nil {
+			return fmt.Errorf("error setting website: %w", err)
+		}
+		d.Set("website_endpoint", bucket.Website.Endpoint)
+		d.Set("website_domain", bucket.Website.Domain)
+	}
+	if err := d.Set("versioning", bucket.Versioning); err != nil {
+		return fmt.Errorf("error setting versioning: %w", err)
+	}
+	if err := d.Set("object_lock_configuration", bucket.ObjectLock); err != nil {
+		return fmt.Errorf("error setting object lock configuration: %w", err)
+	}
+	if err := d.Set("logging", bucket.Logging); err != nil {
+		return fmt.Errorf("error setting logging: %w", err)
+	}
+	if err := d.Set("lifecycle_rule", bucket.Lifecycle); err != nil {
+		return fmt.Errorf("error setting lifecycle_rule: %w", err)
+	}
+	if err := d.Set("server_side_encryption_configuration", bucket.Encryption); err != nil {
+		return fmt.Errorf("error setting server_side_encryption_configuration: %w", err)
+	}
+	if err := d.Set("tags", s3.TagsToRaw(bucket.Tags)); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,157 @@
+package yandex
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex/internal/storage/s3"
+)
+
+const storageBucketObjectsDefaultMaxKeys = 1000
+
+func dataSourceYandexStorageBucketObjects() *schema.Resource {
+	return &schema.Resource{
+		Description: "Lists objects in a Yandex Object Storage bucket. For more information, see [the official documentation](https://yandex.cloud/docs/storage/concepts/object).\n\n~> Objects are returned in ascending lexicographical order of their keys, so use `start_after` to continue listing from the last key returned by a previous read.\n",
+
+		ReadContext: dataSourceYandexStorageBucketObjectsRead,
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:        schema.TypeString,
+				Description: "The name of the bucket.",
+				Required:    true,
+			},
+			"access_key": {
+				Type:        schema.TypeString,
+				Description: "The access key to use when listing objects. This value can also be provided as `storage_access_key` specified in provider config (explicitly or within `shared_credentials_file`) is used.",
+				Optional:    true,
+			},
+			"secret_key": {
+				Type:        schema.TypeString,
+				Description: "The secret key to use when listing objects. This value can also be provided as `storage_secret_key` specified in provider config (explicitly or within `shared_credentials_file`) is used.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"prefix": {
+				Type:        schema.TypeString,
+				Description: "Limits the listing to keys that begin with the specified prefix.",
+				Optional:    true,
+			},
+			"delimiter": {
+				Type:        schema.TypeString,
+				Description: "A character used to group keys. Keys that contain the delimiter after the `prefix` are rolled up into `common_prefixes`.",
+				Optional:    true,
+			},
+			"start_after": {
+				Type:        schema.TypeString,
+				Description: "Returns keys that come after the specified key in lexicographical order.",
+				Optional:    true,
+			},
+			"max_keys": {
+				Type:         schema.TypeInt,
+				Description:  "Maximum number of keys and common prefixes to return. Defaults to `1000`.",
+				Optional:     true,
+				Default:      storageBucketObjectsDefaultMaxKeys,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"keys": {
+				Type:        schema.TypeList,
+				Description: "List of object keys.",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"common_prefixes": {
+				Type:        schema.TypeList,
+				Description: "List of key prefixes rolled up by `delimiter`.",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"objects": {
+				Type:        schema.TypeList,
+				Description: "List of objects.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:        schema.TypeString,
+							Description: "The key of the object.",
+							Computed:    true,
+						},
+						"etag": {
+							Type:        schema.TypeString,
+							Description: "The entity tag of the object.",
+							Computed:    true,
+						},
+						"size": {
+							Type:        schema.TypeInt,
+							Description: "The size of the object in bytes.",
+							Computed:    true,
+						},
+						"last_modified": {
+							Type:        schema.TypeString,
+							Description: "The time the object was last modified, in RFC3339 format.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceYandexStorageBucketObjectsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	s3Client, err := getS3Client(ctx, d, config)
+	if err != nil {
+		return diag.Errorf("error getting storage client: %s", err)
+	}
+
+	bucket := d.Get("bucket").(string)
+	prefix := d.Get("prefix").(string)
+	delimiter := d.Get("delimiter").(string)
+	startAfter := d.Get("start_after").(string)
+	maxKeys := int64(d.Get("max_keys").(int))
+
+	listing, err := s3Client.ListObjectsPage(ctx, bucket, prefix, delimiter, startAfter, maxKeys)
+	if err != nil {
+		if errors.Is(err, s3.ErrBucketNotFound) {
+			return diag.Errorf("storage bucket %q not found", bucket)
+		}
+		return diag.FromErr(err)
+	}
+
+	keys, objects := flattenStorageBucketObjects(listing.Objects)
+	if err := d.Set("keys", keys); err != nil {
+		return diag.Errorf("error setting keys: %s", err)
+	}
+	if err := d.Set("objects", objects); err != nil {
+		return diag.Errorf("error setting objects: %s", err)
+	}
+	if err := d.Set("common_prefixes", listing.CommonPrefixes); err != nil {
+		return diag.Errorf("error setting common_prefixes: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s/%s/%d", bucket, prefix, delimiter, startAfter, maxKeys))
+
+	return nil
+}
+
+func flattenStorageBucketObjects(summaries []s3.ObjectSummary) ([]string, []map[string]interface{}) {
+	keys := make([]string, 0, len(summaries))
+	objects := make([]map[string]interface{}, 0, len(summaries))
+	for _, summary := range summaries {
+		keys = append(keys, summary.Key)
+		objects = append(objects, map[string]interface{}{
+			"key":           summary.Key,
+			"etag":          summary.ETag,
+			"size":          summary.Size,
+			"last_modified": summary.LastModified.Format(time.RFC3339),
+		})
+	}
+	return keys, objects
+}
@@ -0,0 +1,110 @@
+package yandex
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex/internal/storage/s3"
+)
+
+func TestAccDataSourceStorageBucketObjects_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+	dataSourceName := "data.yandex_storage_bucket_objects.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactoriesV6,
+		CheckDestroy:             testAccCheckStorageBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceStorageBucketObjectsConfig(rInt, `
+	prefix    = "releases/"
+	delimiter = "/"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "keys.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "keys.0", "releases/app-1.0.tar.gz"),
+					resource.TestCheckResourceAttr(dataSourceName, "keys.1", "releases/app-1.1.tar.gz"),
+					resource.TestCheckResourceAttr(dataSourceName, "common_prefixes.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "common_prefixes.0", "releases/nightly/"),
+					resource.TestCheckResourceAttr(dataSourceName, "objects.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "objects.0.size", "3"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "objects.0.etag"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "objects.0.last_modified"),
+				),
+			},
+			{
+				Config: testAccDataSourceStorageBucketObjectsConfig(rInt, `
+	prefix      = "releases/"
+	start_after = "releases/app-1.0.tar.gz"
+	max_keys    = 1`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "keys.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "keys.0", "releases/app-1.1.tar.gz"),
+					resource.TestCheckResourceAttr(dataSourceName, "common_prefixes.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestFlattenStorageBucketObjects(t *testing.T) {
+	lastModified := time.Date(2024, 5, 1, 10, 30, 0, 0, time.UTC)
+
+	keys, objects := flattenStorageBucketObjects([]s3.ObjectSummary{
+		{Key: "a.txt", ETag: "etag-a", Size: 1, LastModified: lastModified},
+		{Key: "b/c.txt", ETag: "etag-c", Size: 42, LastModified: lastModified},
+	})
+
+	assert.Equal(t, []string{"a.txt", "b/c.txt"}, keys)
+	assert.Equal(t, []map[string]interface{}{
+		{"key": "a.txt", "etag": "etag-a", "size": int64(1), "last_modified": "2024-05-01T10:30:00Z"},
+		{"key": "b/c.txt", "etag": "etag-c", "size": int64(42), "last_modified": "2024-05-01T10:30:00Z"},
+	}, objects)
+
+	keys, objects = flattenStorageBucketObjects(nil)
+	assert.Empty(t, keys)
+	assert.Empty(t, objects)
+}
+
+func testAccDataSourceStorageBucketObjectsConfig(randInt int, arguments string) string {
+	bucketConfig := newBucketConfigBuilder(randInt).asEditor().render()
+
+	objectsConfig := `
+locals {
+	test_object_keys = [
+		"releases/app-1.0.tar.gz",
+		"releases/app-1.1.tar.gz",
+		"releases/nightly/app-1.2.tar.gz",
+		"other/readme.txt",
+	]
+}
+
+resource "yandex_storage_object" "test" {
+	for_each = toset(local.test_object_keys)
+
+	bucket = yandex_storage_bucket.test.bucket
+
+	access_key = yandex_iam_service_account_static_access_key.sa-key.access_key
+	secret_key = yandex_iam_service_account_static_access_key.sa-key.secret_key
+
+	key     = each.value
+	content = "abc"
+}
+
+data "yandex_storage_bucket_objects" "test" {
+	bucket = yandex_storage_bucket.test.bucket
+
+	access_key = yandex_iam_service_account_static_access_key.sa-key.access_key
+	secret_key = yandex_iam_service_account_static_access_key.sa-key.secret_key
+` + arguments + `
+
+	depends_on = [yandex_storage_object.test]
+}
+`
+
+	return bucketConfig + objectsConfig
+}
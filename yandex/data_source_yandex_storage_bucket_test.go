@@ -0,0 +1,93 @@
+package yandex
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccDataSourceStorageBucket_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+	dataSourceName := "data.yandex_storage_bucket.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactoriesV6,
+		CheckDestroy:             testAccCheckStorageBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceStorageBucketConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "bucket", testAccBucketName(rInt)),
+					resource.TestCheckResourceAttr(dataSourceName, "bucket_domain_name", testAccBucketDomainName(rInt)),
+					resource.TestCheckResourceAttr(dataSourceName, "versioning.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "versioning.0.enabled", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "lifecycle_rule.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "lifecycle_rule.0.id", "logs"),
+					resource.TestCheckResourceAttr(dataSourceName, "lifecycle_rule.0.expiration.0.days", "30"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.some", "value"),
+					resource.TestCheckResourceAttr(dataSourceName, "default_storage_class", "STANDARD"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "folder_id"),
+				),
+			},
+		},
+	})
+}
+
+func TestDataSourceStorageBucketSchema(t *testing.T) {
+	dataSource := dataSourceYandexStorageBucket()
+
+	var checkComputed func(prefix string, s map[string]*schema.Schema)
+	checkComputed = func(prefix string, s map[string]*schema.Schema) {
+		for name, attr := range s {
+			assert.True(t, attr.Computed, "%s%s should be computed", prefix, name)
+			assert.False(t, attr.Optional || attr.Required, "%s%s should not be configurable", prefix, name)
+			if nested, ok := attr.Elem.(*schema.Resource); ok {
+				checkComputed(prefix+name+".", nested.Schema)
+			}
+		}
+	}
+	for _, name := range storageBucketDataSourceAttributes {
+		checkComputed("", map[string]*schema.Schema{name: dataSource.Schema[name]})
+	}
+
+	assert.True(t, dataSource.Schema["bucket"].Required)
+	assert.NoError(t, dataSource.InternalValidate(nil, false))
+}
+
+func testAccDataSourceStorageBucketConfig(randInt int) string {
+	const statements = `versioning {
+		enabled = true
+	}
+
+	lifecycle_rule {
+		id      = "logs"
+		enabled = true
+		prefix  = "logs/"
+
+		expiration {
+			days = 30
+		}
+	}
+
+	tags = {
+		some = "value"
+	}`
+
+	bucketConfig := newBucketConfigBuilder(randInt).
+		addStatement(statements).
+		asAdmin().
+		render()
+
+	return bucketConfig + `
+data "yandex_storage_bucket" "test" {
+	bucket = yandex_storage_bucket.test.bucket
+
+	access_key = yandex_iam_service_account_static_access_key.sa-key.access_key
+	secret_key = yandex_iam_service_account_static_access_key.sa-key.secret_key
+}
+`
+}
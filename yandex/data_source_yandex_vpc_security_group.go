@@ -172,7 +172,7 @@ func dataSourceYandexVPCSecurityGroupRead(d *schema.ResourceData, meta interface
 		}
 	}
 
-	if err := yandexVPCSecurityGroupRead(d, meta, sgID); err != nil {
+	if err := yandexVPCSecurityGroupRead(d, meta, sgID, nil); err != nil {
 		return err
 	}
 
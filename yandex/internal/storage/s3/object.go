@@ -9,12 +9,14 @@ import (
 	"io"
 	"log"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
 	"github.com/mitchellh/go-homedir"
 )
 
@@ -289,10 +291,24 @@ func (c *Client) DeleteObject(ctx context.Context, bucket, key string) error {
 		return fmt.Errorf("error getting version id for deliting storage object %q in bucket %s: %w", key, bucket, err)
 	}
 
+	// Listing by prefix may also return versions of other keys sharing the same prefix,
+	// so pick the latest version of the exact key.
+	var versionID *string
+	for _, version := range versionOutput.Versions {
+		if aws.StringValue(version.Key) == key {
+			versionID = version.VersionId
+			break
+		}
+	}
+	if versionID == nil {
+		log.Printf("[DEBUG] Storage object %q not found in bucket %q, nothing to delete", key, bucket)
+		return nil
+	}
+
 	_, err = c.s3.DeleteObjectWithContext(ctx, &s3.DeleteObjectInput{
 		Bucket:    aws.String(bucket),
 		Key:       aws.String(key),
-		VersionId: versionOutput.Versions[0].VersionId,
+		VersionId: versionID,
 	})
 	if err != nil {
 		return fmt.Errorf("error deleting storage object %q in bucket %q: %w ", key, bucket, err)
@@ -300,3 +316,132 @@ func (c *Client) DeleteObject(ctx context.Context, bucket, key string) error {
 
 	return nil
 }
+
+type ObjectSummary struct {
+	Key          string
+	ETag         string
+	Size         int64
+	LastModified time.Time
+}
+
+// ListObjects returns all objects in the bucket whose keys start with the given prefix.
+func (c *Client) ListObjects(ctx context.Context, bucket, prefix string) ([]ObjectSummary, error) {
+	input := &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucket),
+		Prefix: aws.String(prefix),
+	}
+
+	var objects []ObjectSummary
+	err := c.s3.ListObjectsV2PagesWithContext(ctx, input, func(page *s3.ListObjectsV2Output, _ bool) bool {
+		for _, object := range page.Contents {
+			objects = append(objects, newObjectSummary(object))
+		}
+		return true
+	})
+	if err != nil {
+		if IsErr(err, NoSuchBucket) {
+			return nil, ErrBucketNotFound
+		}
+		return nil, fmt.Errorf("error listing objects with prefix %q in bucket %q: %w", prefix, bucket, err)
+	}
+
+	return objects, nil
+}
+
+type ObjectsListing struct {
+	Objects        []ObjectSummary
+	CommonPrefixes []string
+}
+
+// ListObjectsPage lists objects in the bucket under the given prefix, grouping keys by delimiter
+// into common prefixes. Listing starts after startAfter key and stops once maxKeys entries
+// (objects and common prefixes together) are collected; zero maxKeys means no limit.
+func (c *Client) ListObjectsPage(
+	ctx context.Context,
+	bucket, prefix, delimiter, startAfter string,
+	maxKeys int64,
+) (*ObjectsListing, error) {
+	input := &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucket),
+	}
+	if prefix != "" {
+		input.Prefix = aws.String(prefix)
+	}
+	if delimiter != "" {
+		input.Delimiter = aws.String(delimiter)
+	}
+	if startAfter != "" {
+		input.StartAfter = aws.String(startAfter)
+	}
+
+	listing := &ObjectsListing{}
+	for {
+		if maxKeys > 0 {
+			input.MaxKeys = aws.Int64(maxKeys - int64(len(listing.Objects)+len(listing.CommonPrefixes)))
+		}
+
+		page, err := c.s3.ListObjectsV2WithContext(ctx, input)
+		if err != nil {
+			if IsErr(err, NoSuchBucket) {
+				return nil, ErrBucketNotFound
+			}
+			return nil, fmt.Errorf("error listing objects with prefix %q in bucket %q: %w", prefix, bucket, err)
+		}
+
+		for _, object := range page.Contents {
+			listing.Objects = append(listing.Objects, newObjectSummary(object))
+		}
+		for _, commonPrefix := range page.CommonPrefixes {
+			listing.CommonPrefixes = append(listing.CommonPrefixes, aws.StringValue(commonPrefix.Prefix))
+		}
+
+		if !aws.BoolValue(page.IsTruncated) || page.NextContinuationToken == nil {
+			break
+		}
+		if maxKeys > 0 && int64(len(listing.Objects)+len(listing.CommonPrefixes)) >= maxKeys {
+			break
+		}
+		input.ContinuationToken = page.NextContinuationToken
+	}
+
+	return listing, nil
+}
+
+func newObjectSummary(object *s3.Object) ObjectSummary {
+	return ObjectSummary{
+		Key:          aws.StringValue(object.Key),
+		ETag:         strings.Trim(aws.StringValue(object.ETag), `"`),
+		Size:         aws.Int64Value(object.Size),
+		LastModified: aws.TimeValue(object.LastModified),
+	}
+}
+
+// UploadFile streams the file to the bucket using multipart upload, so that large files
+// like disk images are not read into memory.
+func (c *Client) UploadFile(ctx context.Context, bucket, key, path string) error {
+	path, err := homedir.Expand(path)
+	if err != nil {
+		return fmt.Errorf("error expanding homedir in file path (%s): %w", path, err)
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("error opening file (%s): %w", path, err)
+	}
+	defer func() {
+		if err := file.Close(); err != nil {
+			log.Printf("[WARN] Error closing file (%s): %s", path, err)
+		}
+	}()
+
+	uploader := s3manager.NewUploaderWithClient(c.s3)
+	_, err = uploader.UploadWithContext(ctx, &s3manager.UploadInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+		Body:   file,
+	})
+	if err != nil {
+		return fmt.Errorf("error uploading file (%s) to bucket %q: %w", path, bucket, err)
+	}
+
+	return nil
+}
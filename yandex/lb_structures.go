@@ -426,6 +426,21 @@ func flattenLBListenerSpecs(nlb *loadbalancer.NetworkLoadBalancer) (*schema.Set,
 	return result, nil
 }
 
+// flattenLBListenerSpec returns the listener with the given name, or nil if the network load balancer has no such listener.
+func flattenLBListenerSpec(nlb *loadbalancer.NetworkLoadBalancer, name string) (map[string]interface{}, error) {
+	listeners, err := flattenLBListenerSpecs(nlb)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range listeners.List() {
+		if l := v.(map[string]interface{}); l["name"] == name {
+			return l, nil
+		}
+	}
+	return nil, nil
+}
+
 func flattenLBExternalAddressSpec(ls *loadbalancer.Listener) (*schema.Set, error) {
 	result := map[string]interface{}{
 		"address": ls.Address,
@@ -440,6 +440,27 @@ func parseKafkaTopicConfig(d *schema.ResourceData, topicConfigPrefix string) (*T
 	return res, nil
 }
 
+// kafkaTopicConfigSettings returns string settings of the topic_config block for kafkacommon.ValidateTopicConfig.
+func kafkaTopicConfigSettings(topicConfig interface{}) map[string]string {
+	result := map[string]string{}
+
+	list, ok := topicConfig.([]interface{})
+	if !ok || len(list) == 0 {
+		return result
+	}
+	cfg, ok := list[0].(map[string]interface{})
+	if !ok {
+		return result
+	}
+
+	for name, value := range cfg {
+		if s, ok := value.(string); ok && s != "" {
+			result[name] = s
+		}
+	}
+	return result
+}
+
 func expandKafkaTopicConfig2_8(d *schema.ResourceData, topicConfigPrefix string) (*kafka.TopicConfig2_8, error) {
 	topicConfig, err := parseKafkaTopicConfig(d, topicConfigPrefix)
 	if err != nil {
@@ -1132,8 +1153,12 @@ func flattenKafkaTopicConfig4(topicConfig *kafka.TopicConfig4) map[string]interf
 }
 
 func flattenKafkaUserPermissions(user *kafka.User) *schema.Set {
+	return flattenKafkaPermissions(user.Permissions)
+}
+
+func flattenKafkaPermissions(permissions []*kafka.Permission) *schema.Set {
 	result := schema.NewSet(kafkaUserPermissionHash, nil)
-	for _, perm := range user.Permissions {
+	for _, perm := range permissions {
 		p := map[string]interface{}{}
 		p["topic_name"] = perm.TopicName
 		p["role"] = perm.Role.String()
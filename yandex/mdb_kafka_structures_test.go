@@ -171,3 +171,21 @@ func TestFlattenKafkaLogMessageTimestampType(t *testing.T) {
 		})
 	}
 }
+
+func TestKafkaTopicConfigSettings(t *testing.T) {
+	assert.Empty(t, kafkaTopicConfigSettings(nil))
+	assert.Empty(t, kafkaTopicConfigSettings([]interface{}{}))
+
+	got := kafkaTopicConfigSettings([]interface{}{
+		map[string]interface{}{
+			"cleanup_policy": "CLEANUP_POLICY_COMPACT",
+			"retention_ms":   "86400000",
+			"segment_bytes":  "",
+			"preallocate":    false,
+		},
+	})
+	assert.Equal(t, map[string]string{
+		"cleanup_policy": "CLEANUP_POLICY_COMPACT",
+		"retention_ms":   "86400000",
+	}, got)
+}
@@ -168,6 +168,7 @@ func sdkProvider(emptyFolder bool) *schema.Provider {
 			"yandex_compute_gpu_cluster":                              dataSourceYandexComputeGpuCluster(),
 			"yandex_compute_image":                                    dataSourceYandexComputeImage(),
 			"yandex_compute_instance":                                 dataSourceYandexComputeInstance(),
+			"yandex_compute_instance_serial_port_output":              dataSourceYandexComputeInstanceSerialPortOutput(),
 			"yandex_compute_instance_group":                           dataSourceYandexComputeInstanceGroup(),
 			"yandex_compute_placement_group":                          dataSourceYandexComputePlacementGroup(),
 			"yandex_compute_snapshot":                                 dataSourceYandexComputeSnapshot(),
@@ -190,6 +191,7 @@ func sdkProvider(emptyFolder bool) *schema.Provider {
 			"yandex_iot_core_device":                                  dataSourceYandexIoTCoreDevice(),
 			"yandex_iot_core_registry":                                dataSourceYandexIoTCoreRegistry(),
 			"yandex_kubernetes_cluster":                               dataSourceYandexKubernetesCluster(),
+			"yandex_kubernetes_cluster_kubeconfig":                    dataSourceYandexKubernetesClusterKubeconfig(),
 			"yandex_kubernetes_node_group":                            dataSourceYandexKubernetesNodeGroup(),
 			"yandex_lb_network_load_balancer":                         dataSourceYandexLBNetworkLoadBalancer(),
 			"yandex_lb_target_group":                                  dataSourceYandexLBTargetGroup(),
@@ -221,6 +223,8 @@ func sdkProvider(emptyFolder bool) *schema.Provider {
 			"yandex_resourcemanager_cloud":                            dataSourceYandexResourceManagerCloud(),
 			"yandex_resourcemanager_folder":                           dataSourceYandexResourceManagerFolder(),
 			"yandex_serverless_container":                             dataSourceYandexServerlessContainer(),
+			"yandex_storage_bucket":                                   dataSourceYandexStorageBucket(),
+			"yandex_storage_bucket_objects":                           dataSourceYandexStorageBucketObjects(),
 			"yandex_vpc_address":                                      dataSourceYandexVPCAddress(),
 			"yandex_vpc_gateway":                                      dataSourceYandexVPCGateway(),
 			"yandex_vpc_network":                                      dataSourceYandexVPCNetwork(),
@@ -238,6 +242,7 @@ func sdkProvider(emptyFolder bool) *schema.Provider {
 			"yandex_alb_load_balancer":                                resourceYandexALBLoadBalancer(),
 			"yandex_alb_target_group":                                 resourceYandexALBTargetGroup(),
 			"yandex_alb_virtual_host":                                 addPassthroughImport(withALBVirtualHostID(resourceYandexALBVirtualHost())),
+			"yandex_alb_virtual_host_route":                           withALBVirtualHostRouteID(resourceYandexALBVirtualHostRoute()),
 			"yandex_api_gateway":                                      resourceYandexApiGateway(),
 			"yandex_audit_trails_trail":                               resourceYandexAuditTrailsTrail(),
 			"yandex_backup_policy":                                    resourceYandexBackupPolicy(),
@@ -257,6 +262,7 @@ func sdkProvider(emptyFolder bool) *schema.Provider {
 			"yandex_dataproc_cluster":                                 resourceYandexDataprocCluster(),
 			"yandex_dns_recordset":                                    resourceYandexDnsRecordSet(),
 			"yandex_dns_zone":                                         resourceYandexDnsZone(),
+			"yandex_dns_zone_records":                                 resourceYandexDnsZoneRecords(),
 			"yandex_serverless_eventrouter_connector":                 resourceYandexServerlessEventrouterConnector(),
 			"yandex_serverless_eventrouter_rule":                      resourceYandexServerlessEventrouterRule(),
 			"yandex_function":                                         resourceYandexFunction(),
@@ -274,6 +280,8 @@ func sdkProvider(emptyFolder bool) *schema.Provider {
 			"yandex_kubernetes_cluster":                               resourceYandexKubernetesCluster(),
 			"yandex_kubernetes_node_group":                            resourceYandexKubernetesNodeGroup(),
 			"yandex_lb_network_load_balancer":                         resourceYandexLBNetworkLoadBalancer(),
+			"yandex_lb_network_load_balancer_listener":                resourceYandexLBNetworkLoadBalancerListener(),
+			"yandex_lb_network_load_balancer_target_group_attachment": resourceYandexLBNetworkLoadBalancerTargetGroupAttachment(),
 			"yandex_lockbox_secret":                                   resourceYandexLockboxSecret(),
 			"yandex_lockbox_secret_version":                           resourceYandexLockboxSecretVersion(),
 			"yandex_lockbox_secret_version_hashed":                    resourceYandexLockboxSecretVersionHashed(),
@@ -303,7 +311,9 @@ func sdkProvider(emptyFolder bool) *schema.Provider {
 			"yandex_serverless_container":                             resourceYandexServerlessContainer(),
 			"yandex_storage_bucket":                                   resourceYandexStorageBucket(),
 			"yandex_storage_object":                                   resourceYandexStorageObject(),
+			"yandex_storage_objects":                                  resourceYandexStorageObjects(),
 			"yandex_vpc_address":                                      resourceYandexVPCAddress(),
+			"yandex_vpc_cidr_pool":                                    resourceYandexVPCCidrPool(),
 			"yandex_vpc_default_security_group":                       resourceYandexVPCDefaultSecurityGroup(),
 			"yandex_vpc_gateway":                                      resourceYandexVPCGateway(),
 			"yandex_vpc_network":                                      resourceYandexVPCNetwork(),
@@ -362,6 +372,32 @@ func wrapParseVirtualHostID(f crudFunc) crudFunc {
 	}
 }
 
+func withALBVirtualHostRouteID(r *schema.Resource) *schema.Resource {
+	r.Read = wrapParseVirtualHostRouteID(r.Read)
+	r.Update = wrapParseVirtualHostRouteID(r.Update)
+	r.Delete = wrapParseVirtualHostRouteID(r.Delete)
+	return r
+}
+
+func wrapParseVirtualHostRouteID(f crudFunc) crudFunc {
+	return func(d *schema.ResourceData, meta interface{}) error {
+		attrs := strings.Split(d.Id(), "/")
+		if len(attrs) != 3 {
+			return fmt.Errorf("error reading virtual_host_route, wrong id: %q", d.Id())
+		}
+		if err := d.Set("http_router_id", attrs[0]); err != nil {
+			return err
+		}
+		if err := d.Set("virtual_host_name", attrs[1]); err != nil {
+			return err
+		}
+		if err := d.Set("name", attrs[2]); err != nil {
+			return err
+		}
+		return f(d, meta)
+	}
+}
+
 func setToDefaultIfNeeded(field string, osEnvName string, defaultVal string) string {
 	if len(field) != 0 {
 		return field
@@ -60,248 +60,260 @@ func resourceYandexALBVirtualHost() *schema.Resource {
 				Description: routeSchemaDescription,
 				Optional:    true,
 				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"name": {
-							Type:        schema.TypeString,
-							Description: routeNameSchemaDescription,
-							Optional:    true,
+					Schema: albRouteSchema(),
+				},
+			},
+			"ignore_routes": {
+				Type:          schema.TypeBool,
+				Description:   "If `true`, routes of the virtual host are not managed by this resource: `route` is not read and existing routes are kept on update. Use it to manage routes with `yandex_alb_virtual_host_route` resources.",
+				Optional:      true,
+				Default:       false,
+				ConflictsWith: []string{"route"},
+			},
+			"route_options": routeOptions(),
+		},
+	}
+}
+
+// albRouteSchema returns the attributes of a route of a virtual host.
+func albRouteSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:        schema.TypeString,
+			Description: routeNameSchemaDescription,
+			Optional:    true,
+		},
+		"http_route": {
+			Type:        schema.TypeList,
+			Description: routeHTTPRouteSchemaDescription,
+			MaxItems:    1,
+			Optional:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"http_route_action": {
+						Type:        schema.TypeList,
+						Description: routeHTTPRouteActionSchemaDescription,
+						MaxItems:    1,
+						Optional:    true,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"backend_group_id": {
+									Type:        schema.TypeString,
+									Description: routeHTTPRouteActionBackendGroupIDSchemaDescription,
+									Required:    true,
+								},
+								"timeout": {
+									Type:             schema.TypeString,
+									Description:      routeHTTPRouteActionTimeoutSchemaDescription,
+									Optional:         true,
+									ValidateFunc:     validateParsableValue(parseDuration),
+									DiffSuppressFunc: shouldSuppressDiffForTimeDuration,
+								},
+								"idle_timeout": {
+									Type:             schema.TypeString,
+									Description:      routeHTTPRouteActionIdleTimeoutSchemaDescription,
+									Optional:         true,
+									ValidateFunc:     validateParsableValue(parseDuration),
+									DiffSuppressFunc: shouldSuppressDiffForTimeDuration,
+								},
+								"prefix_rewrite": {
+									Type:        schema.TypeString,
+									Description: routeHTTPRouteActionPrefixRewriteSchemaDescription,
+									Optional:    true,
+								},
+								regexRewriteSchemaKey: regexRewrite(),
+								"upgrade_types": {
+									Type:        schema.TypeSet,
+									Description: routeHTTPRouteActionUpgradeTypesSchemaDescription,
+									Optional:    true,
+									Elem:        &schema.Schema{Type: schema.TypeString},
+									Set:         schema.HashString,
+								},
+								"host_rewrite": {
+									Type:        schema.TypeString,
+									Description: routeHTTPRouteActionHostRewriteSchemaDescription,
+									Optional:    true,
+								},
+								"auto_host_rewrite": {
+									Type:        schema.TypeBool,
+									Description: routeHTTPRouteActionAutoHostRewriteSchemaDescription,
+									Optional:    true,
+								},
+								rateLimitSchemaKey: rateLimit(),
+							},
+						},
+					},
+					"redirect_action": {
+						Type:        schema.TypeList,
+						Description: routeHTTPRedirectActionSchemaDescription,
+						MaxItems:    1,
+						Optional:    true,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"replace_scheme": {
+									Type:        schema.TypeString,
+									Description: routeHTTPRedirectActionReplaceSchemeSchemaDescription,
+									Optional:    true,
+								},
+								"replace_host": {
+									Type:        schema.TypeString,
+									Description: routeHTTPRedirectActionReplaceHostSchemaDescription,
+									Optional:    true,
+								},
+								"replace_port": {
+									Type:        schema.TypeInt,
+									Description: routeHTTPRedirectActionReplacePortSchemaDescription,
+									Optional:    true,
+								},
+								"remove_query": {
+									Type:        schema.TypeBool,
+									Description: routeHTTPRedirectActionRemoveQuerySchemaDescription,
+									Optional:    true,
+								},
+								"response_code": {
+									Type:             schema.TypeString,
+									Description:      routeHTTPRedirectActionResponseCodeSchemaDescription,
+									Default:          "moved_permanently",
+									Optional:         true,
+									DiffSuppressFunc: CaseInsensitive,
+								},
+								"replace_path": {
+									Type:        schema.TypeString,
+									Description: routeHTTPRedirectActionReplacePathSchemaDescription,
+									Optional:    true,
+								},
+								"replace_prefix": {
+									Type:        schema.TypeString,
+									Description: routeHTTPRedirectActionReplacePrefixSchemaDescription,
+									Optional:    true,
+								},
+							},
+						},
+					},
+					"direct_response_action": {
+						Type:        schema.TypeList,
+						Description: routeHTTPDirectResponseActionSchemaDescription,
+						MaxItems:    1,
+						Optional:    true,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"status": {
+									Type:         schema.TypeInt,
+									Description:  routeHTTPDirectResponseActionStatusSchemaDescription,
+									ValidateFunc: validation.IntBetween(100, 599),
+									Optional:     true,
+								},
+								"body": {
+									Type:        schema.TypeString,
+									Description: routeHTTPDirectResponseActionBodySchemaDescription,
+									Optional:    true,
+								},
+							},
 						},
-						"http_route": {
-							Type:        schema.TypeList,
-							Description: routeHTTPRouteSchemaDescription,
-							MaxItems:    1,
-							Optional:    true,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"http_route_action": {
-										Type:        schema.TypeList,
-										Description: routeHTTPRouteActionSchemaDescription,
-										MaxItems:    1,
-										Optional:    true,
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												"backend_group_id": {
-													Type:        schema.TypeString,
-													Description: routeHTTPRouteActionBackendGroupIDSchemaDescription,
-													Required:    true,
-												},
-												"timeout": {
-													Type:             schema.TypeString,
-													Description:      routeHTTPRouteActionTimeoutSchemaDescription,
-													Optional:         true,
-													ValidateFunc:     validateParsableValue(parseDuration),
-													DiffSuppressFunc: shouldSuppressDiffForTimeDuration,
-												},
-												"idle_timeout": {
-													Type:             schema.TypeString,
-													Description:      routeHTTPRouteActionIdleTimeoutSchemaDescription,
-													Optional:         true,
-													ValidateFunc:     validateParsableValue(parseDuration),
-													DiffSuppressFunc: shouldSuppressDiffForTimeDuration,
-												},
-												"prefix_rewrite": {
-													Type:        schema.TypeString,
-													Description: routeHTTPRouteActionPrefixRewriteSchemaDescription,
-													Optional:    true,
-												},
-												regexRewriteSchemaKey: regexRewrite(),
-												"upgrade_types": {
-													Type:        schema.TypeSet,
-													Description: routeHTTPRouteActionUpgradeTypesSchemaDescription,
-													Optional:    true,
-													Elem:        &schema.Schema{Type: schema.TypeString},
-													Set:         schema.HashString,
-												},
-												"host_rewrite": {
-													Type:        schema.TypeString,
-													Description: routeHTTPRouteActionHostRewriteSchemaDescription,
-													Optional:    true,
-												},
-												"auto_host_rewrite": {
-													Type:        schema.TypeBool,
-													Description: routeHTTPRouteActionAutoHostRewriteSchemaDescription,
-													Optional:    true,
-												},
-												rateLimitSchemaKey: rateLimit(),
-											},
-										},
-									},
-									"redirect_action": {
-										Type:        schema.TypeList,
-										Description: routeHTTPRedirectActionSchemaDescription,
-										MaxItems:    1,
-										Optional:    true,
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												"replace_scheme": {
-													Type:        schema.TypeString,
-													Description: routeHTTPRedirectActionReplaceSchemeSchemaDescription,
-													Optional:    true,
-												},
-												"replace_host": {
-													Type:        schema.TypeString,
-													Description: routeHTTPRedirectActionReplaceHostSchemaDescription,
-													Optional:    true,
-												},
-												"replace_port": {
-													Type:        schema.TypeInt,
-													Description: routeHTTPRedirectActionReplacePortSchemaDescription,
-													Optional:    true,
-												},
-												"remove_query": {
-													Type:        schema.TypeBool,
-													Description: routeHTTPRedirectActionRemoveQuerySchemaDescription,
-													Optional:    true,
-												},
-												"response_code": {
-													Type:             schema.TypeString,
-													Description:      routeHTTPRedirectActionResponseCodeSchemaDescription,
-													Default:          "moved_permanently",
-													Optional:         true,
-													DiffSuppressFunc: CaseInsensitive,
-												},
-												"replace_path": {
-													Type:        schema.TypeString,
-													Description: routeHTTPRedirectActionReplacePathSchemaDescription,
-													Optional:    true,
-												},
-												"replace_prefix": {
-													Type:        schema.TypeString,
-													Description: routeHTTPRedirectActionReplacePrefixSchemaDescription,
-													Optional:    true,
-												},
-											},
-										},
-									},
-									"direct_response_action": {
-										Type:        schema.TypeList,
-										Description: routeHTTPDirectResponseActionSchemaDescription,
-										MaxItems:    1,
-										Optional:    true,
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												"status": {
-													Type:         schema.TypeInt,
-													Description:  routeHTTPDirectResponseActionStatusSchemaDescription,
-													ValidateFunc: validation.IntBetween(100, 599),
-													Optional:     true,
-												},
-												"body": {
-													Type:        schema.TypeString,
-													Description: routeHTTPDirectResponseActionBodySchemaDescription,
-													Optional:    true,
-												},
-											},
-										},
-									},
-									"http_match": {
-										Type:        schema.TypeList,
-										Description: routeHTTPMatchSchemaDescription,
-										Optional:    true,
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												"http_method": {
-													Type:        schema.TypeSet,
-													Description: routeHTTPMatchMethodSchemaDescription,
-													Optional:    true,
-													Elem:        &schema.Schema{Type: schema.TypeString},
-													Set:         schema.HashString,
-												},
-												"path": stringMatch(),
-											},
-										},
-									},
+					},
+					"http_match": {
+						Type:        schema.TypeList,
+						Description: routeHTTPMatchSchemaDescription,
+						Optional:    true,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"http_method": {
+									Type:        schema.TypeSet,
+									Description: routeHTTPMatchMethodSchemaDescription,
+									Optional:    true,
+									Elem:        &schema.Schema{Type: schema.TypeString},
+									Set:         schema.HashString,
 								},
+								"path": stringMatch(),
+							},
+						},
+					},
+				},
+			},
+		},
+		"grpc_route": {
+			Type:        schema.TypeList,
+			Description: routeGRPCRouteSchemaDescription,
+			MaxItems:    1,
+			Optional:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"grpc_match": {
+						Type:        schema.TypeList,
+						Description: routeGRPCRouteMatchSchemaDescription,
+						Optional:    true,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"fqmn": stringMatch(),
 							},
 						},
-						"grpc_route": {
-							Type:        schema.TypeList,
-							Description: routeGRPCRouteSchemaDescription,
-							MaxItems:    1,
-							Optional:    true,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"grpc_match": {
-										Type:        schema.TypeList,
-										Description: routeGRPCRouteMatchSchemaDescription,
-										Optional:    true,
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												"fqmn": stringMatch(),
-											},
-										},
-									},
-									"grpc_route_action": {
-										Type:        schema.TypeList,
-										Description: routeGRPCRouteActionSchemaDescription,
-										MaxItems:    1,
-										Optional:    true,
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												"backend_group_id": {
-													Type:        schema.TypeString,
-													Description: routeGRPCRouteActionBackendGroupIDSchemaDescription,
-													Required:    true,
-												},
-												"max_timeout": {
-													Type:             schema.TypeString,
-													Description:      routeGRPCRouteActionMaxTimeoutSchemaDescription,
-													Optional:         true,
-													ValidateFunc:     validateParsableValue(parseDuration),
-													DiffSuppressFunc: shouldSuppressDiffForTimeDuration,
-												},
-												"idle_timeout": {
-													Type:             schema.TypeString,
-													Description:      routeGRPCRouteActionIdleTimeoutSchemaDescription,
-													Optional:         true,
-													ValidateFunc:     validateParsableValue(parseDuration),
-													DiffSuppressFunc: shouldSuppressDiffForTimeDuration,
-												},
-												"host_rewrite": {
-													Type:        schema.TypeString,
-													Description: routeGRPCRouteActionHostRewriteSchemaDescription,
-													Optional:    true,
-												},
-												"auto_host_rewrite": {
-													Type:        schema.TypeBool,
-													Description: routeGRPCRouteActionAutoHostRewriteSchemaDescription,
-													Optional:    true,
-												},
-												rateLimitSchemaKey: rateLimit(),
-											},
-										},
-									},
-									"grpc_status_response_action": {
-										Type:        schema.TypeList,
-										Description: routeGRPCStatusResponseActionSchemaDescription,
-										MaxItems:    1,
-										Optional:    true,
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												"status": {
-													Type:             schema.TypeString,
-													Description:      routeGRPCStatusResponseActionStatusSchemaDescription,
-													Optional:         true,
-													DiffSuppressFunc: CaseInsensitive,
-												},
-											},
-										},
-									},
+					},
+					"grpc_route_action": {
+						Type:        schema.TypeList,
+						Description: routeGRPCRouteActionSchemaDescription,
+						MaxItems:    1,
+						Optional:    true,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"backend_group_id": {
+									Type:        schema.TypeString,
+									Description: routeGRPCRouteActionBackendGroupIDSchemaDescription,
+									Required:    true,
+								},
+								"max_timeout": {
+									Type:             schema.TypeString,
+									Description:      routeGRPCRouteActionMaxTimeoutSchemaDescription,
+									Optional:         true,
+									ValidateFunc:     validateParsableValue(parseDuration),
+									DiffSuppressFunc: shouldSuppressDiffForTimeDuration,
+								},
+								"idle_timeout": {
+									Type:             schema.TypeString,
+									Description:      routeGRPCRouteActionIdleTimeoutSchemaDescription,
+									Optional:         true,
+									ValidateFunc:     validateParsableValue(parseDuration),
+									DiffSuppressFunc: shouldSuppressDiffForTimeDuration,
 								},
+								"host_rewrite": {
+									Type:        schema.TypeString,
+									Description: routeGRPCRouteActionHostRewriteSchemaDescription,
+									Optional:    true,
+								},
+								"auto_host_rewrite": {
+									Type:        schema.TypeBool,
+									Description: routeGRPCRouteActionAutoHostRewriteSchemaDescription,
+									Optional:    true,
+								},
+								rateLimitSchemaKey: rateLimit(),
 							},
 						},
-						disableSecurityProfileSchemaKey: {
-							Type:        schema.TypeBool,
-							Description: disableSecurityProfileSchemaDescription,
-							Optional:    true,
+					},
+					"grpc_status_response_action": {
+						Type:        schema.TypeList,
+						Description: routeGRPCStatusResponseActionSchemaDescription,
+						MaxItems:    1,
+						Optional:    true,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"status": {
+									Type:             schema.TypeString,
+									Description:      routeGRPCStatusResponseActionStatusSchemaDescription,
+									Optional:         true,
+									DiffSuppressFunc: CaseInsensitive,
+								},
+							},
 						},
-						"route_options":              routeOptions(),
-						"client_certificate_forward": clientCertificateForwardSchema(),
 					},
 				},
 			},
-			"route_options": routeOptions(),
 		},
+		disableSecurityProfileSchemaKey: {
+			Type:        schema.TypeBool,
+			Description: disableSecurityProfileSchemaDescription,
+			Optional:    true,
+		},
+		"route_options":              routeOptions(),
+		"client_certificate_forward": clientCertificateForwardSchema(),
 	}
 }
 
@@ -571,8 +583,10 @@ func resourceYandexALBVirtualHostRead(d *schema.ResourceData, meta interface{})
 		return err
 	}
 
-	if err := d.Set("route", routes); err != nil {
-		return err
+	if !d.Get("ignore_routes").(bool) {
+		if err := d.Set("route", routes); err != nil {
+			return err
+		}
 	}
 
 	if err := d.Set("route_options", ro); err != nil {
@@ -602,6 +616,22 @@ func resourceYandexALBVirtualHostUpdate(d *schema.ResourceData, meta interface{}
 
 	client := albsdk.NewVirtualHostClient(config.SDK)
 
+	if d.Get("ignore_routes").(bool) {
+		// The update replaces all routes, so the routes managed elsewhere are sent back as is.
+		mutexKey := albVirtualHostMutexKey(req.HttpRouterId, req.VirtualHostName)
+		mutexKV.Lock(mutexKey)
+		defer mutexKV.Unlock(mutexKey)
+
+		virtualHost, err := client.Get(ctx, &apploadbalancer.GetVirtualHostRequest{
+			HttpRouterId:    req.HttpRouterId,
+			VirtualHostName: req.VirtualHostName,
+		})
+		if err != nil {
+			return fmt.Errorf("Error while requesting API to get Application Virtual Host %q: %w", d.Id(), err)
+		}
+		req.Routes = virtualHost.GetRoutes()
+	}
+
 	op, err := client.Update(ctx, req)
 	if err != nil {
 		return fmt.Errorf("Error while requesting API to update Application Virtual Host %q: %w", d.Id(), err)
@@ -0,0 +1,377 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/apploadbalancer/v1"
+	albsdk "github.com/yandex-cloud/go-sdk/services/apploadbalancer/v1"
+	"google.golang.org/genproto/protobuf/field_mask"
+)
+
+var albVirtualHostRoutePositionKeys = []string{"priority", "before", "after"}
+
+func resourceYandexALBVirtualHostRoute() *schema.Resource {
+	routeSchema := albRouteSchema()
+
+	routeSchema["http_router_id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "The ID of the HTTP router to which the virtual host belongs.",
+		Required:    true,
+		ForceNew:    true,
+	}
+	routeSchema["virtual_host_name"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "The name of the virtual host the route belongs to.",
+		Required:    true,
+		ForceNew:    true,
+	}
+	routeSchema["name"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "The name of the route. It must be unique within the virtual host.",
+		Required:    true,
+		ForceNew:    true,
+	}
+	routeSchema["priority"] = &schema.Schema{
+		Type:          schema.TypeInt,
+		Description:   "Position of the route in the virtual host on insertion, starting from `0`. If it is not less than the number of routes, the route is appended. Changing it moves the route.",
+		Optional:      true,
+		ValidateFunc:  validation.IntAtLeast(0),
+		ConflictsWith: []string{"before", "after"},
+	}
+	routeSchema["before"] = &schema.Schema{
+		Type:          schema.TypeString,
+		Description:   "The name of the route to insert this route before. Changing it moves the route.",
+		Optional:      true,
+		ConflictsWith: []string{"priority", "after"},
+	}
+	routeSchema["after"] = &schema.Schema{
+		Type:          schema.TypeString,
+		Description:   "The name of the route to insert this route after. Changing it moves the route.",
+		Optional:      true,
+		ConflictsWith: []string{"priority", "before"},
+	}
+	// UpdateRoute changes the route action and options only, the rest is changed by reinserting the route.
+	routeSchema[disableSecurityProfileSchemaKey].ForceNew = true
+	routeSchema["client_certificate_forward"].ForceNew = true
+
+	return &schema.Resource{
+		Description: "Manages a single route of a virtual host. Routes of a shared virtual host may be managed by several configurations, the virtual host itself should have `ignore_routes` set. If no position is specified, the route is appended to the end of the virtual host routes. For more information, see [the official documentation](https://yandex.cloud/docs/application-load-balancer/concepts/http-router).\n\n~> The position is only applied when the route is inserted or moved: routes inserted later may shift it.\n",
+		Create:      resourceYandexALBVirtualHostRouteCreate,
+		Read:        resourceYandexALBVirtualHostRouteRead,
+		Update:      resourceYandexALBVirtualHostRouteUpdate,
+		Delete:      resourceYandexALBVirtualHostRouteDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(yandexALBVirtualHostDefaultTimeout),
+			Update: schema.DefaultTimeout(yandexALBVirtualHostDefaultTimeout),
+			Delete: schema.DefaultTimeout(yandexALBVirtualHostDefaultTimeout),
+		},
+
+		SchemaVersion: 0,
+
+		Schema: routeSchema,
+	}
+}
+
+// albVirtualHostMutexKey serializes changes of routes of a virtual host.
+func albVirtualHostMutexKey(httpRouterID, virtualHostName string) string {
+	return fmt.Sprintf("alb-virtual-host-%s/%s", httpRouterID, virtualHostName)
+}
+
+func resourceYandexALBVirtualHostRouteCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	httpRouterID := d.Get("http_router_id").(string)
+	virtualHostName := d.Get("virtual_host_name").(string)
+
+	log.Printf("[DEBUG] Creating Application Virtual Host Route %q", d.Get("name"))
+
+	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+
+	mutexKey := albVirtualHostMutexKey(httpRouterID, virtualHostName)
+	mutexKV.Lock(mutexKey)
+	defer mutexKV.Unlock(mutexKey)
+
+	if err := insertALBVirtualHostRoute(ctx, d, config); err != nil {
+		return err
+	}
+
+	d.SetId(strings.Join([]string{httpRouterID, virtualHostName, d.Get("name").(string)}, "/"))
+
+	log.Printf("[DEBUG] Finished creating Application Virtual Host Route %q", d.Id())
+	return resourceYandexALBVirtualHostRouteRead(d, meta)
+}
+
+func insertALBVirtualHostRoute(ctx context.Context, d *schema.ResourceData, config *Config) error {
+	route, err := expandALBRoute(d, "")
+	if err != nil {
+		return fmt.Errorf("Error expanding route while creating Application Virtual Host Route: %w", err)
+	}
+
+	req := &apploadbalancer.InsertRouteRequest{
+		HttpRouterId:    d.Get("http_router_id").(string),
+		VirtualHostName: d.Get("virtual_host_name").(string),
+		Route:           route,
+		BeforeRoute:     d.Get("before").(string),
+		AfterRoute:      d.Get("after").(string),
+	}
+
+	client := albsdk.NewVirtualHostClient(config.SDK)
+
+	if v, ok := d.GetOkExists("priority"); ok {
+		virtualHost, err := client.Get(ctx, &apploadbalancer.GetVirtualHostRequest{
+			HttpRouterId:    req.HttpRouterId,
+			VirtualHostName: req.VirtualHostName,
+		})
+		if err != nil {
+			return fmt.Errorf("Error while requesting API to get Application Virtual Host %q: %w", req.VirtualHostName, err)
+		}
+
+		var others []*apploadbalancer.Route
+		for _, r := range virtualHost.GetRoutes() {
+			if r.GetName() != route.GetName() {
+				others = append(others, r)
+			}
+		}
+		if priority := v.(int); priority < len(others) {
+			req.BeforeRoute = others[priority].GetName()
+		}
+	}
+
+	op, err := client.InsertRoute(ctx, req)
+	if err != nil {
+		return fmt.Errorf("Error while requesting API to insert Application Virtual Host Route %q: %w", route.GetName(), err)
+	}
+
+	_, err = op.Wait(ctx)
+	if err != nil {
+		return fmt.Errorf("Error while waiting operation to insert Application Virtual Host Route %q: %w", route.GetName(), err)
+	}
+
+	return nil
+}
+
+func resourceYandexALBVirtualHostRouteRead(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] Reading Application Virtual Host Route %q", d.Id())
+	config := meta.(*Config)
+
+	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutRead))
+	defer cancel()
+
+	virtualHost, err := albsdk.NewVirtualHostClient(config.SDK).Get(ctx, &apploadbalancer.GetVirtualHostRequest{
+		HttpRouterId:    d.Get("http_router_id").(string),
+		VirtualHostName: d.Get("virtual_host_name").(string),
+	})
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("Application Virtual Host %q", d.Get("virtual_host_name").(string)))
+	}
+
+	var route *apploadbalancer.Route
+	for _, r := range virtualHost.GetRoutes() {
+		if r.GetName() == d.Get("name").(string) {
+			route = r
+			break
+		}
+	}
+	if route == nil {
+		log.Printf("[WARN] Removing Application Virtual Host Route %q because it's gone", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	routes, err := flattenALBRoutes([]*apploadbalancer.Route{route})
+	if err != nil {
+		return err
+	}
+
+	for _, key := range []string{"http_route", "grpc_route", disableSecurityProfileSchemaKey, "route_options", "client_certificate_forward"} {
+		if err := d.Set(key, routes[0][key]); err != nil {
+			return err
+		}
+	}
+
+	log.Printf("[DEBUG] Finished reading Application Virtual Host Route %q", d.Id())
+	return nil
+}
+
+func resourceYandexALBVirtualHostRouteUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	httpRouterID := d.Get("http_router_id").(string)
+	virtualHostName := d.Get("virtual_host_name").(string)
+	name := d.Get("name").(string)
+
+	log.Printf("[DEBUG] Updating Application Virtual Host Route %q", d.Id())
+
+	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutUpdate))
+	defer cancel()
+
+	mutexKey := albVirtualHostMutexKey(httpRouterID, virtualHostName)
+	mutexKV.Lock(mutexKey)
+	defer mutexKV.Unlock(mutexKey)
+
+	if d.HasChanges(albVirtualHostRoutePositionKeys...) {
+		log.Printf("[DEBUG] Moving Application Virtual Host Route %q", d.Id())
+		if err := moveALBVirtualHostRoute(ctx, d, config); err != nil {
+			return err
+		}
+
+		log.Printf("[DEBUG] Finished updating Application Virtual Host Route %q", d.Id())
+		return resourceYandexALBVirtualHostRouteRead(d, meta)
+	}
+
+	route, err := expandALBRoute(d, "")
+	if err != nil {
+		return fmt.Errorf("Error expanding route while updating Application Virtual Host Route: %w", err)
+	}
+
+	req := &apploadbalancer.UpdateRouteRequest{
+		HttpRouterId:    httpRouterID,
+		VirtualHostName: virtualHostName,
+		RouteName:       name,
+		UpdateMask:      &field_mask.FieldMask{},
+	}
+	if d.HasChanges("http_route", "grpc_route") {
+		switch {
+		case route.GetHttp() != nil:
+			req.SetHttp(route.GetHttp())
+		case route.GetGrpc() != nil:
+			req.SetGrpc(route.GetGrpc())
+		}
+		req.UpdateMask.Paths = append(req.UpdateMask.Paths, "http", "grpc")
+	}
+	if d.HasChange("route_options") {
+		req.RouteOptions = route.GetRouteOptions()
+		req.UpdateMask.Paths = append(req.UpdateMask.Paths, "route_options")
+	}
+
+	op, err := albsdk.NewVirtualHostClient(config.SDK).UpdateRoute(ctx, req)
+	if err != nil {
+		return fmt.Errorf("Error while requesting API to update Application Virtual Host Route %q: %w", d.Id(), err)
+	}
+
+	_, err = op.Wait(ctx)
+	if err != nil {
+		return fmt.Errorf("Error updating Application Virtual Host Route %q: %w", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] Finished updating Application Virtual Host Route %q", d.Id())
+	return resourceYandexALBVirtualHostRouteRead(d, meta)
+}
+
+// moveALBVirtualHostRoute moves the route to the new position with the new settings. There is no way
+// to move a route, so all routes of the virtual host are replaced at once: the route is never missing.
+func moveALBVirtualHostRoute(ctx context.Context, d *schema.ResourceData, config *Config) error {
+	route, err := expandALBRoute(d, "")
+	if err != nil {
+		return fmt.Errorf("Error expanding route while updating Application Virtual Host Route: %w", err)
+	}
+
+	httpRouterID := d.Get("http_router_id").(string)
+	virtualHostName := d.Get("virtual_host_name").(string)
+	client := albsdk.NewVirtualHostClient(config.SDK)
+
+	virtualHost, err := client.Get(ctx, &apploadbalancer.GetVirtualHostRequest{
+		HttpRouterId:    httpRouterID,
+		VirtualHostName: virtualHostName,
+	})
+	if err != nil {
+		return fmt.Errorf("Error while requesting API to get Application Virtual Host %q: %w", virtualHostName, err)
+	}
+
+	var others []*apploadbalancer.Route
+	for _, r := range virtualHost.GetRoutes() {
+		if r.GetName() != route.GetName() {
+			others = append(others, r)
+		}
+	}
+
+	position := len(others)
+	if v, ok := d.GetOkExists("priority"); ok && v.(int) < len(others) {
+		position = v.(int)
+	}
+	if neighbour, shift := d.Get("before").(string), 0; neighbour != "" || d.Get("after").(string) != "" {
+		if neighbour == "" {
+			neighbour, shift = d.Get("after").(string), 1
+		}
+		position = -1
+		for i, r := range others {
+			if r.GetName() == neighbour {
+				position = i + shift
+				break
+			}
+		}
+		if position < 0 {
+			return fmt.Errorf("Error moving Application Virtual Host Route %q: route %q not found in virtual host %q", route.GetName(), neighbour, virtualHostName)
+		}
+	}
+
+	routes := make([]*apploadbalancer.Route, 0, len(others)+1)
+	routes = append(routes, others[:position]...)
+	routes = append(routes, route)
+	routes = append(routes, others[position:]...)
+
+	op, err := client.Update(ctx, &apploadbalancer.UpdateVirtualHostRequest{
+		HttpRouterId:    httpRouterID,
+		VirtualHostName: virtualHostName,
+		UpdateMask:      &field_mask.FieldMask{Paths: []string{"routes"}},
+		Routes:          routes,
+	})
+	if err != nil {
+		return fmt.Errorf("Error while requesting API to move Application Virtual Host Route %q: %w", route.GetName(), err)
+	}
+
+	_, err = op.Wait(ctx)
+	if err != nil {
+		return fmt.Errorf("Error while waiting operation to move Application Virtual Host Route %q: %w", route.GetName(), err)
+	}
+
+	return nil
+}
+
+func resourceYandexALBVirtualHostRouteDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	httpRouterID := d.Get("http_router_id").(string)
+	virtualHostName := d.Get("virtual_host_name").(string)
+
+	log.Printf("[DEBUG] Deleting Application Virtual Host Route %q", d.Id())
+
+	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutDelete))
+	defer cancel()
+
+	mutexKey := albVirtualHostMutexKey(httpRouterID, virtualHostName)
+	mutexKV.Lock(mutexKey)
+	defer mutexKV.Unlock(mutexKey)
+
+	err := removeALBVirtualHostRoute(ctx, config, httpRouterID, virtualHostName, d.Get("name").(string))
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("Application Virtual Host Route %q", d.Get("name").(string)))
+	}
+
+	log.Printf("[DEBUG] Finished deleting Application Virtual Host Route %q", d.Id())
+	return nil
+}
+
+func removeALBVirtualHostRoute(ctx context.Context, config *Config, httpRouterID, virtualHostName, name string) error {
+	op, err := albsdk.NewVirtualHostClient(config.SDK).RemoveRoute(ctx, &apploadbalancer.RemoveRouteRequest{
+		HttpRouterId:    httpRouterID,
+		VirtualHostName: virtualHostName,
+		RouteName:       name,
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = op.Wait(ctx)
+	return err
+}
@@ -0,0 +1,152 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/apploadbalancer/v1"
+	albsdk "github.com/yandex-cloud/go-sdk/services/apploadbalancer/v1"
+)
+
+func TestAccALBVirtualHostRoute_basic(t *testing.T) {
+	t.Parallel()
+
+	virtualHostName := acctest.RandomWithPrefix("tf-virtual-host")
+	httpRouterName := acctest.RandomWithPrefix("tf-http-router")
+	httpRouterDesc := acctest.RandomWithPrefix("tf-http-router-desc")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckALBVirtualHostDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccALBVirtualHostRoutes(httpRouterName, httpRouterDesc, virtualHostName, `after = yandex_alb_virtual_host_route.first.name`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(albVHResource, "route.#", "0"),
+					resource.TestCheckResourceAttr("yandex_alb_virtual_host_route.second", "http_route.0.direct_response_action.0.status", "418"),
+					testAccCheckALBVirtualHostRouteOrder(albVHResource, "first", "second"),
+				),
+			},
+			{
+				Config: testAccALBVirtualHostRoutes(httpRouterName, httpRouterDesc, virtualHostName, `priority = 0`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckALBVirtualHostRouteOrder(albVHResource, "second", "first"),
+				),
+			},
+			{
+				ResourceName:            "yandex_alb_virtual_host_route.second",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"priority"},
+			},
+		},
+	})
+}
+
+func testAccCheckALBVirtualHostRouteOrder(virtualHostName string, routes ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[virtualHostName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", virtualHostName)
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		found, err := albsdk.NewVirtualHostClient(config.SDK).Get(context.Background(), &apploadbalancer.GetVirtualHostRequest{
+			HttpRouterId:    rs.Primary.Attributes["http_router_id"],
+			VirtualHostName: rs.Primary.Attributes["name"],
+		})
+		if err != nil {
+			return err
+		}
+
+		var actual []string
+		for _, r := range found.GetRoutes() {
+			actual = append(actual, r.GetName())
+		}
+		if fmt.Sprint(actual) != fmt.Sprint(routes) {
+			return fmt.Errorf("Expected routes %v, got %v", routes, actual)
+		}
+		return nil
+	}
+}
+
+func testAccALBVirtualHostRoutes(httpRouterName, httpRouterDesc, virtualHostName, secondPosition string) string {
+	return testAccALBGeneralHTTPRouterTemplate(httpRouterName, httpRouterDesc) + fmt.Sprintf(`
+resource "yandex_alb_virtual_host" "test-vh" {
+  http_router_id = yandex_alb_http_router.test-router.id
+  name           = "%s"
+  ignore_routes  = true
+}
+
+resource "yandex_alb_virtual_host_route" "first" {
+  http_router_id    = yandex_alb_http_router.test-router.id
+  virtual_host_name = yandex_alb_virtual_host.test-vh.name
+  name              = "first"
+
+  http_route {
+    http_match {
+      path {
+        prefix = "/first/"
+      }
+    }
+    direct_response_action {
+      status = 200
+      body   = "first"
+    }
+  }
+}
+
+resource "yandex_alb_virtual_host_route" "second" {
+  http_router_id    = yandex_alb_http_router.test-router.id
+  virtual_host_name = yandex_alb_virtual_host.test-vh.name
+  name              = "second"
+  %s
+
+  http_route {
+    direct_response_action {
+      status = 418
+      body   = "I'm a teapot"
+    }
+  }
+}
+`, virtualHostName, secondPosition)
+}
+
+func TestUnitALBVirtualHostRouteFromResource(t *testing.T) {
+	t.Parallel()
+
+	type M = map[string]interface{}
+	type S = []interface{}
+
+	rawValues := M{
+		"http_router_id":    "my-router-id",
+		"virtual_host_name": "vh-name",
+		"name":              "grpc-route",
+		"before":            "other-route",
+		"grpc_route": S{
+			M{
+				"grpc_status_response_action": S{
+					M{
+						"status": "unavailable",
+					},
+				},
+			},
+		},
+	}
+	resourceData := schema.TestResourceDataRaw(t, resourceYandexALBVirtualHostRoute().Schema, rawValues)
+
+	route, err := expandALBRoute(resourceData, "")
+	require.NoError(t, err, "failed to expand route")
+
+	assert.Equal(t, "grpc-route", route.GetName())
+	assert.Nil(t, route.GetHttp())
+	assert.Equal(t, apploadbalancer.GrpcStatusResponseAction_UNAVAILABLE, route.GetGrpc().GetStatusResponse().GetStatus())
+}
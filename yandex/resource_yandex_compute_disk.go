@@ -128,6 +128,8 @@ func resourceYandexComputeDisk() *schema.Resource {
 				Computed:    true,
 			},
 
+			"final_snapshot": computeFinalSnapshotSchema("Create a snapshot of the disk before it is deleted, e.g. on `terraform destroy`. It does not prevent the deletion, but keeps the data of the disk."),
+
 			"disk_placement_policy": {
 				Type:        schema.TypeList,
 				Description: "Disk placement policy configuration.",
@@ -489,6 +491,19 @@ func resourceYandexComputeDiskDelete(d *schema.ResourceData, meta interface{}) e
 		return handleNotFoundError(err, d, fmt.Sprintf("Disk %q", d.Get("name").(string)))
 	}
 
+	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutDelete))
+	defer cancel()
+
+	finalSnapshot, err := expandComputeFinalSnapshot(d)
+	if err != nil {
+		return err
+	}
+	if finalSnapshot != nil {
+		if err := createComputeFinalSnapshot(ctx, config, finalSnapshot, disk); err != nil {
+			return err
+		}
+	}
+
 	for _, instanceID := range disk.GetInstanceIds() {
 		req := &compute.DetachInstanceDiskRequest{
 			InstanceId: instanceID,
@@ -508,9 +523,6 @@ func resourceYandexComputeDiskDelete(d *schema.ResourceData, meta interface{}) e
 		DiskId: d.Id(),
 	}
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutDelete))
-	defer cancel()
-
 	op, err := computesdk.NewDiskClient(config.SDK).Delete(ctx, req)
 	if err != nil {
 		return handleNotFoundError(err, d, fmt.Sprintf("Disk %q", d.Get("name").(string)))
@@ -96,6 +96,32 @@ func TestAccComputeDisk_basic(t *testing.T) {
 	})
 }
 
+func TestAccComputeDisk_finalSnapshot(t *testing.T) {
+	t.Parallel()
+
+	diskName := acctest.RandomWithPrefix("tf-test")
+	var disk compute.Disk
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testAccCheckComputeDiskDestroy,
+			testAccCheckComputeFinalSnapshotCreated(&disk, "tf-final"),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeDisk_finalSnapshot(diskName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeDiskExists("yandex_compute_disk.foobar", &disk),
+					resource.TestCheckResourceAttr("yandex_compute_disk.foobar", "final_snapshot.0.enabled", "true"),
+					resource.TestCheckResourceAttr("yandex_compute_disk.foobar", "final_snapshot.0.name_prefix", "tf-final"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccComputeDisk_timeout(t *testing.T) {
 	t.Parallel()
 
@@ -269,6 +295,40 @@ func testAccCheckComputeDiskDestroy(s *terraform.State) error {
 	return nil
 }
 
+// testAccCheckComputeFinalSnapshotCreated checks that a final snapshot of the deleted disk exists and deletes it.
+func testAccCheckComputeFinalSnapshotCreated(disk *compute.Disk, namePrefix string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+		client := computesdk.NewSnapshotClient(config.SDK)
+
+		resp, err := client.List(context.Background(), &compute.ListSnapshotsRequest{
+			FolderId: disk.FolderId,
+			PageSize: 1000,
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, snapshot := range resp.Snapshots {
+			if snapshot.SourceDiskId != disk.Id {
+				continue
+			}
+			if snapshot.Labels[computeFinalSnapshotPrefixLabel] != namePrefix {
+				return fmt.Errorf("final snapshot %s of disk %s has no %q label", snapshot.Id, disk.Id, computeFinalSnapshotPrefixLabel)
+			}
+
+			op, err := client.Delete(context.Background(), &compute.DeleteSnapshotRequest{SnapshotId: snapshot.Id})
+			if err != nil {
+				return err
+			}
+			_, err = op.Wait(context.Background())
+			return err
+		}
+
+		return fmt.Errorf("final snapshot of disk %s not found", disk.Id)
+	}
+}
+
 func testAccCheckComputeDiskExists(n string, disk *compute.Disk) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		//p := getTestProjectFromEnv()
@@ -343,6 +403,25 @@ resource "yandex_compute_disk" "foobar" {
 `, diskName)
 }
 
+func testAccComputeDisk_finalSnapshot(diskName string) string {
+	return fmt.Sprintf(`
+resource "yandex_compute_disk" "foobar" {
+  name = "%s"
+  size = 4
+  type = "network-hdd"
+
+  final_snapshot {
+    name_prefix = "tf-final"
+    retention   = "1h"
+
+    labels = {
+      env = "test"
+    }
+  }
+}
+`, diskName)
+}
+
 func testAccComputeDisk_with_folder(diskName string, folderId string, allowRecreate bool) string {
 	return fmt.Sprintf(`
 data "yandex_compute_image" "ubuntu" {
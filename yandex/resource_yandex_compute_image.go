@@ -20,7 +20,7 @@ const StandardImagesFolderID = "standard-images"
 
 func resourceYandexComputeImage() *schema.Resource {
 	return &schema.Resource{
-		Description: "Creates a virtual machine image resource for the Yandex Compute Cloud service from an existing tarball. For more information, see [the official documentation](https://yandex.cloud/docs/compute/concepts/image).\n\n~> One of `source_family`, `source_image`, `source_snapshot`, `source_disk` or `source_url` must be specified.\n",
+		Description: "Creates a virtual machine image resource for the Yandex Compute Cloud service from an existing tarball. For more information, see [the official documentation](https://yandex.cloud/docs/compute/concepts/image).\n\n~> One of `source_family`, `source_image`, `source_snapshot`, `source_disk`, `source_url` or `source_file` must be specified.\n",
 
 		Create: resourceYandexComputeImageCreate,
 		Read:   resourceYandexComputeImageRead,
@@ -30,6 +30,8 @@ func resourceYandexComputeImage() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: resourceYandexComputeImageSourceFileDiff,
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(yandexComputeImageDefaultTimeout),
 			Update: schema.DefaultTimeout(yandexComputeImageDefaultTimeout),
@@ -104,7 +106,7 @@ func resourceYandexComputeImage() *schema.Resource {
 				Computed:      true,
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{"source_snapshot", "source_disk", "source_url", "source_image"},
+				ConflictsWith: []string{"source_snapshot", "source_disk", "source_url", "source_image", "source_file"},
 			},
 
 			"source_image": {
@@ -113,7 +115,7 @@ func resourceYandexComputeImage() *schema.Resource {
 				Computed:      true,
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{"source_snapshot", "source_disk", "source_url", "source_family"},
+				ConflictsWith: []string{"source_snapshot", "source_disk", "source_url", "source_family", "source_file"},
 			},
 
 			"source_snapshot": {
@@ -122,7 +124,7 @@ func resourceYandexComputeImage() *schema.Resource {
 				Computed:      true,
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{"source_image", "source_disk", "source_url", "source_family"},
+				ConflictsWith: []string{"source_image", "source_disk", "source_url", "source_family", "source_file"},
 			},
 
 			"source_disk": {
@@ -131,7 +133,7 @@ func resourceYandexComputeImage() *schema.Resource {
 				Computed:      true,
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{"source_image", "source_snapshot", "source_url", "source_family"},
+				ConflictsWith: []string{"source_image", "source_snapshot", "source_url", "source_family", "source_file"},
 			},
 
 			"source_url": {
@@ -140,7 +142,28 @@ func resourceYandexComputeImage() *schema.Resource {
 				Computed:      true,
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{"source_image", "source_snapshot", "source_disk", "source_family"},
+				ConflictsWith: []string{"source_image", "source_snapshot", "source_disk", "source_family", "source_file"},
+			},
+
+			"source_file": {
+				Type:          schema.TypeString,
+				Description:   "The path to a local image file, e.g. `qcow2` or raw, to use as the source of the image. The file is uploaded to `source_file_bucket`, the image is created from the uploaded object and the object is deleted. Changing the path or the content of the file forces a new resource to be created.\n\n~> Uploading a large file may take longer than the default `create` timeout.\n",
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"source_image", "source_snapshot", "source_disk", "source_url", "source_family"},
+			},
+
+			"source_file_bucket": {
+				Type:         schema.TypeString,
+				Description:  "The name of an existing bucket to upload `source_file` to. If not set, a temporary bucket is created in the folder of the image and deleted after the image is created. The static storage access keys of the provider, `storage_access_key` and `storage_secret_key`, are required: they are used for the upload and to pre-sign the URL of the object the image is created from.",
+				Optional:     true,
+				RequiredWith: []string{"source_file"},
+			},
+
+			"source_file_hash": {
+				Type:        schema.TypeString,
+				Description: "The SHA256 hash of `source_file` content the image is created from. It is used to detect changes of the file.",
+				Computed:    true,
 			},
 
 			"product_ids": {
@@ -260,14 +283,26 @@ func resourceYandexComputeImageCreate(d *schema.ResourceData, meta interface{})
 		HardwareGeneration: hardwareGeneration,
 	}
 
-	err = prepareSourceForImage(&req, d, meta)
-	if err != nil {
-		return fmt.Errorf("Error while prepare request to create image: %s", err)
-	}
-
 	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutCreate))
 	defer cancel()
 
+	if _, ok := d.GetOk("source_file"); ok {
+		url, cleanup, err := uploadComputeImageSourceFile(ctx, d, config, folderID)
+		if err != nil {
+			return err
+		}
+		defer cleanup()
+
+		req.Source = &compute.CreateImageRequest_Uri{
+			Uri: url,
+		}
+	} else {
+		err = prepareSourceForImage(&req, d, meta)
+		if err != nil {
+			return fmt.Errorf("Error while prepare request to create image: %s", err)
+		}
+	}
+
 	op, err := computesdk.NewImageClient(config.SDK).Create(ctx, &req)
 	if err != nil {
 		return fmt.Errorf("Error while requesting API to create image: %s", err)
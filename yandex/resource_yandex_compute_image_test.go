@@ -1,8 +1,11 @@
 package yandex
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -172,6 +175,49 @@ func TestAccComputeImage_osType(t *testing.T) {
 	})
 }
 
+func TestAccComputeImage_sourceFile(t *testing.T) {
+	t.Parallel()
+
+	var image, recreated compute.Image
+	name := "image-file-" + acctest.RandString(8)
+	path := filepath.Join(t.TempDir(), "image.raw")
+	writeImageFile := func(fill byte) {
+		if err := os.WriteFile(path, bytes.Repeat([]byte{fill}, 4<<20), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	writeImageFile(0)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeImageDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeImage_sourceFile(name, path),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeImageExists("yandex_compute_image.file", &image),
+					resource.TestCheckResourceAttr("yandex_compute_image.file", "status", "ready"),
+					resource.TestCheckResourceAttrSet("yandex_compute_image.file", "source_file_hash"),
+				),
+			},
+			{
+				PreConfig: func() { writeImageFile(1) },
+				Config:    testAccComputeImage_sourceFile(name, path),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeImageExists("yandex_compute_image.file", &recreated),
+					func(*terraform.State) error {
+						if image.Id == recreated.Id {
+							return fmt.Errorf("image %s was not recreated after source file change", image.Id)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckComputeImageDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*Config)
 
@@ -401,6 +447,16 @@ resource "yandex_compute_image" "foobar" {
 `, acctest.RandString(8), acctest.RandString(8))
 }
 
+func testAccComputeImage_sourceFile(name, path string) string {
+	return fmt.Sprintf(`
+resource "yandex_compute_image" "file" {
+  name        = "%s"
+  source_file = "%s"
+  os_type     = "LINUX"
+}
+`, name, path)
+}
+
 func testAccComputeImage_osTypeLinux(name string) string {
 	return fmt.Sprintf(`
 resource "yandex_compute_image" "linux" {
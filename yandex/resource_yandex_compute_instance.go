@@ -463,6 +463,10 @@ func resourceYandexComputeInstance() *schema.Resource {
 				Optional: true,
 			},
 
+			"wait_for_serial_output": computeInstanceSerialOutputWaitSchema(),
+
+			"final_snapshot": computeFinalSnapshotSchema("Create snapshots of the boot and secondary disks that are deleted together with the instance (`auto_delete = true`) before the instance is deleted, e.g. on `terraform destroy`. Snapshots of disks of a running instance are crash-consistent."),
+
 			"secondary_disk": {
 				Type:        schema.TypeSet,
 				Description: "A set of disks to attach to the instance. The structure is documented below.\n\n~> The [`allow_stopping_for_update`](#allow_stopping_for_update) property must be set to `true` in order to update this structure.",
@@ -771,6 +775,11 @@ func resourceYandexComputeInstanceCreate(d *schema.ResourceData, meta interface{
 		return err
 	}
 
+	serialOutputWait, err := expandComputeInstanceSerialOutputWait(d)
+	if err != nil {
+		return err
+	}
+
 	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutCreate))
 	defer cancel()
 
@@ -787,6 +796,12 @@ func resourceYandexComputeInstanceCreate(d *schema.ResourceData, meta interface{
 		return fmt.Errorf("Error while waiting operation to create instance: %s", err)
 	}
 
+	if serialOutputWait != nil {
+		if err := waitForComputeInstanceSerialOutput(config, d.Id(), serialOutputWait); err != nil {
+			return err
+		}
+	}
+
 	return resourceYandexComputeInstanceRead(d, meta)
 }
 
@@ -1507,15 +1522,25 @@ func resourceYandexComputeInstanceUpdate(d *schema.ResourceData, meta interface{
 func resourceYandexComputeInstanceDelete(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
+	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutDelete))
+	defer cancel()
+
+	finalSnapshot, err := expandComputeFinalSnapshot(d)
+	if err != nil {
+		return err
+	}
+	if finalSnapshot != nil {
+		if err := createComputeInstanceFinalSnapshots(ctx, config, finalSnapshot, d.Id()); err != nil {
+			return handleNotFoundError(err, d, fmt.Sprintf("Instance %q", d.Get("name").(string)))
+		}
+	}
+
 	log.Printf("[DEBUG] Deleting Instance %q", d.Id())
 
 	req := &compute.DeleteInstanceRequest{
 		InstanceId: d.Id(),
 	}
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutDelete))
-	defer cancel()
-
 	op, err := computesdk.NewInstanceClient(config.SDK).Delete(ctx, req)
 	if err != nil {
 		return handleNotFoundError(err, d, fmt.Sprintf("Instance %q", d.Get("name").(string)))
@@ -1530,6 +1555,36 @@ func resourceYandexComputeInstanceDelete(d *schema.ResourceData, meta interface{
 	return nil
 }
 
+// createComputeInstanceFinalSnapshots snapshots disks of the instance that are deleted together with it.
+func createComputeInstanceFinalSnapshots(ctx context.Context, config *Config, fs *computeFinalSnapshot, instanceID string) error {
+	instance, err := computesdk.NewInstanceClient(config.SDK).Get(ctx, &compute.GetInstanceRequest{
+		InstanceId: instanceID,
+	})
+	if err != nil {
+		return err
+	}
+
+	attachedDisks := append([]*compute.AttachedDisk{instance.GetBootDisk()}, instance.GetSecondaryDisks()...)
+	for _, attachedDisk := range attachedDisks {
+		if !attachedDisk.GetAutoDelete() {
+			continue
+		}
+
+		disk, err := computesdk.NewDiskClient(config.SDK).Get(ctx, &compute.GetDiskRequest{
+			DiskId: attachedDisk.GetDiskId(),
+		})
+		if err != nil {
+			return fmt.Errorf("Error while requesting API to get disk %q of instance %q: %s", attachedDisk.GetDiskId(), instanceID, err)
+		}
+
+		if err := createComputeFinalSnapshot(ctx, config, fs, disk); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func prepareCreateInstanceRequest(d *schema.ResourceData, meta *Config) (*compute.CreateInstanceRequest, error) {
 	zone, err := getZone(d, meta)
 	if err != nil {
@@ -1280,6 +1280,8 @@ func resourceYandexComputeInstanceGroup() *schema.Resource {
 				Optional:    true,
 				Default:     false,
 			},
+
+			"wait_for_healthy": waitForHealthySchema("Wait on create and update until instances of the group are running and healthy in the load balancers its target groups are attached to. If not enough instances become healthy in time, the apply fails with the list of unhealthy instances. Changing this block does not update the instance group."),
 		},
 	}
 }
@@ -1307,6 +1309,10 @@ func resourceYandexComputeInstanceGroupCreate(d *schema.ResourceData, meta inter
 
 	d.SetId(instanceGroup.Id)
 
+	if err := waitForInstanceGroupHealthy(d, config); err != nil {
+		return err
+	}
+
 	return resourceYandexComputeInstanceGroupRead(d, meta)
 }
 
@@ -1430,14 +1436,20 @@ func flattenInstanceGroup(d *schema.ResourceData, instanceGroup *instancegroup.I
 func resourceYandexComputeInstanceGroupUpdate(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
-	req, err := prepareUpdateInstanceGroupRequest(d, config)
-	if err != nil {
-		return err
-	}
+	if d.HasChangesExcept("wait_for_healthy") {
+		req, err := prepareUpdateInstanceGroupRequest(d, config)
+		if err != nil {
+			return err
+		}
 
-	err = makeInstanceGroupUpdateRequest(req, d, meta)
-	if err != nil {
-		return err
+		err = makeInstanceGroupUpdateRequest(req, d, meta)
+		if err != nil {
+			return err
+		}
+
+		if err := waitForInstanceGroupHealthy(d, config); err != nil {
+			return err
+		}
 	}
 
 	return resourceYandexComputeInstanceGroupRead(d, meta)
@@ -568,6 +568,44 @@ func TestAccComputeInstanceGroup_DeletionProtection(t *testing.T) {
 	})
 }
 
+func TestAccComputeInstanceGroup_waitForHealthy(t *testing.T) {
+	t.Parallel()
+
+	var ig instancegroup.InstanceGroup
+
+	name := acctest.RandomWithPrefix("tf-test")
+	saName := acctest.RandomWithPrefix("tf-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactoriesV6,
+		CheckDestroy:             testAccCheckComputeInstanceGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeInstanceGroupConfigWaitForHealthy(name, saName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeInstanceGroupExists("yandex_compute_instance_group.group1", &ig),
+					resource.TestCheckResourceAttr("yandex_compute_instance_group.group1", "wait_for_healthy.0.min_healthy_percent", "100"),
+					resource.TestCheckResourceAttr("yandex_compute_instance_group.group1", "instances.#", "2"),
+				),
+			},
+			{
+				Config: testAccComputeInstanceGroupConfigWaitForHealthy(name, saName, 3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeInstanceGroupExists("yandex_compute_instance_group.group1", &ig),
+					resource.TestCheckResourceAttr("yandex_compute_instance_group.group1", "instances.#", "3"),
+				),
+			},
+			{
+				ResourceName:            "yandex_compute_instance_group.group1",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"wait_for_healthy"},
+			},
+		},
+	})
+}
+
 func TestAccComputeInstanceGroup_createPlacementGroup(t *testing.T) {
 	t.Parallel()
 
@@ -1099,6 +1137,115 @@ resource "yandex_resourcemanager_folder_iam_member" "test_account" {
 `, getExampleFolderID(), igName, saName, deletionProtection)
 }
 
+func testAccComputeInstanceGroupConfigWaitForHealthy(igName string, saName string, size int) string {
+	return fmt.Sprintf(`
+data "yandex_compute_image" "ubuntu" {
+  family = "ubuntu-2204-lts"
+}
+
+data "yandex_resourcemanager_folder" "test_folder" {
+  folder_id = "%[1]s"
+}
+
+resource "yandex_compute_instance_group" "group1" {
+  depends_on         = ["yandex_iam_service_account.test_account", "yandex_resourcemanager_folder_iam_member.test_account"]
+  name               = "%[2]s"
+  folder_id          = "${data.yandex_resourcemanager_folder.test_folder.id}"
+  service_account_id = "${yandex_iam_service_account.test_account.id}"
+  instance_template {
+    platform_id = "standard-v3"
+
+    resources {
+      memory        = 2
+      cores         = 2
+      core_fraction = 20
+    }
+
+    boot_disk {
+      initialize_params {
+        image_id = "${data.yandex_compute_image.ubuntu.id}"
+        size     = 10
+      }
+    }
+
+    network_interface {
+      network_id = "${yandex_vpc_network.inst-group-test-network.id}"
+      subnet_ids = ["${yandex_vpc_subnet.inst-group-test-subnet.id}"]
+    }
+  }
+
+  scale_policy {
+    fixed_scale {
+      size = %[4]d
+    }
+  }
+
+  allocation_policy {
+    zones = ["ru-central1-a"]
+  }
+
+  deploy_policy {
+    max_unavailable = 1
+    max_expansion   = 0
+  }
+
+  load_balancer {
+    target_group_name = "%[2]s"
+  }
+
+  wait_for_healthy {
+    timeout = "15m"
+  }
+}
+
+resource "yandex_lb_network_load_balancer" "test_nlb" {
+  name = "%[2]s"
+
+  listener {
+    name = "ssh"
+    port = 22
+    external_address_spec {
+      ip_version = "ipv4"
+    }
+  }
+
+  attached_target_group {
+    target_group_id = "${yandex_compute_instance_group.group1.load_balancer.0.target_group_id}"
+
+    healthcheck {
+      name = "ssh"
+      tcp_options {
+        port = 22
+      }
+    }
+  }
+}
+
+resource "yandex_vpc_network" "inst-group-test-network" {
+  description = "tf-test"
+}
+
+resource "yandex_vpc_subnet" "inst-group-test-subnet" {
+  description    = "tf-test"
+  zone           = "ru-central1-a"
+  network_id     = "${yandex_vpc_network.inst-group-test-network.id}"
+  v4_cidr_blocks = ["192.168.0.0/24"]
+}
+
+resource "yandex_iam_service_account" "test_account" {
+  name        = "%[3]s"
+  description = "tf-test"
+}
+
+resource "yandex_resourcemanager_folder_iam_member" "test_account" {
+  folder_id   = "${data.yandex_resourcemanager_folder.test_folder.id}"
+  member      = "serviceAccount:${yandex_iam_service_account.test_account.id}"
+  role        = "editor"
+  sleep_after = 30
+}
+`, getExampleFolderID(), igName, saName, size)
+}
+
 func testAccComputeInstanceGroupConfigWithLabels(igName string, saName string) string {
 	return fmt.Sprintf(`
 data "yandex_compute_image" "ubuntu" {
@@ -0,0 +1,432 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/dns/v1"
+	dnssdk "github.com/yandex-cloud/go-sdk/services/dns/v1"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/dnsrecords"
+)
+
+const (
+	yandexDnsZoneRecordsDefaultTTL = 600
+	yandexDnsZoneRecordsPageSize   = 1000
+)
+
+func resourceYandexDnsZoneRecords() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manages all record sets of a DNS zone within Yandex Cloud authoritatively. Record sets of the zone that are not declared in the resource are deleted. All changes are applied in a single atomic request. For more information, see [the official documentation](https://yandex.cloud/docs/dns/concepts/resource-record).\n\n~> SOA record and NS records of the zone apex are maintained by the DNS service and are neither managed nor deleted by this resource.\n\n~> Do not use this resource together with `yandex_dns_recordset` resources for the same zone, they will conflict with each other.\n",
+		Create:      resourceYandexDnsZoneRecordsCreate,
+		Read:        resourceYandexDnsZoneRecordsRead,
+		Update:      resourceYandexDnsZoneRecordsUpdate,
+		Delete:      resourceYandexDnsZoneRecordsDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceYandexDnsZoneRecordsImportState,
+		},
+
+		CustomizeDiff: resourceYandexDnsZoneRecordsCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(yandexDnsDefaultTimeout),
+			Update: schema.DefaultTimeout(yandexDnsDefaultTimeout),
+			Delete: schema.DefaultTimeout(yandexDnsDefaultTimeout),
+		},
+
+		SchemaVersion: 0,
+
+		Schema: map[string]*schema.Schema{
+			"zone_id": {
+				Type:        schema.TypeString,
+				Description: "The id of the zone whose record sets are managed.",
+				Required:    true,
+				ForceNew:    true,
+			},
+
+			"zone_file": {
+				Type:        schema.TypeString,
+				Description: "Content of a zone file in [RFC 1035](https://www.rfc-editor.org/rfc/rfc1035#section-5) format to load record sets from, e.g. `file(\"example.com.zone\")`. `$ORIGIN` and `$TTL` directives are supported, relative names are resolved against the zone name. SOA and zone apex NS records in the file are ignored.",
+				Optional:    true,
+			},
+
+			"default_ttl": {
+				Type:         schema.TypeInt,
+				Description:  "The time-to-live (seconds) of `zone_file` records that have no TTL specified and are not covered by a `$TTL` directive. Default is `600`.",
+				Optional:     true,
+				Default:      yandexDnsZoneRecordsDefaultTTL,
+				ValidateFunc: validation.IntBetween(0, dnsrecords.MaxTTL),
+			},
+
+			"recordset": {
+				Type:        schema.TypeSet,
+				Description: "A record set of the zone. Record sets are merged with ones loaded from `zone_file`, the same name and type pair cannot be declared twice.",
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Description:  "The DNS name of the record set, either absolute with a trailing dot or relative to the zone name. Use `@` for the zone apex.",
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 254),
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Description:  "The DNS record set type.",
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(dnsrecords.SupportedTypes, true),
+						},
+						"ttl": {
+							Type:         schema.TypeInt,
+							Description:  "The time-to-live of this record set (seconds).",
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, dnsrecords.MaxTTL),
+						},
+						"data": {
+							Type:        schema.TypeSet,
+							Description: "The string data for the records in this record set. TXT records longer than 255 characters are split into several strings.",
+							Required:    true,
+							MinItems:    1,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+							Set: schema.HashString,
+						},
+					},
+				},
+			},
+
+			"records": {
+				Type:        schema.TypeSet,
+				Description: "All record sets of the zone managed by the resource, with absolute names and records in canonical form.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Description: "The absolute DNS name of the record set.",
+							Computed:    true,
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Description: "The DNS record set type.",
+							Computed:    true,
+						},
+						"ttl": {
+							Type:        schema.TypeInt,
+							Description: "The time-to-live of this record set (seconds).",
+							Computed:    true,
+						},
+						"data": {
+							Type:        schema.TypeList,
+							Description: "The string data for the records in this record set.",
+							Computed:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceYandexDnsZoneRecordsCreate(d *schema.ResourceData, meta interface{}) error {
+	d.SetId(d.Get("zone_id").(string))
+
+	if err := applyDnsZoneRecords(d, meta, schema.TimeoutCreate); err != nil {
+		d.SetId("")
+		return err
+	}
+
+	return resourceYandexDnsZoneRecordsRead(d, meta)
+}
+
+func resourceYandexDnsZoneRecordsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	ctx := config.Context()
+
+	zone, err := getDnsZoneForRecords(ctx, config, d.Get("zone_id").(string))
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("DnsZone %q", d.Get("zone_id")))
+	}
+
+	current, _, err := listDnsZoneRecords(ctx, config, zone)
+	if err != nil {
+		return err
+	}
+
+	return d.Set("records", flattenDnsZoneRecords(current))
+}
+
+func resourceYandexDnsZoneRecordsUpdate(d *schema.ResourceData, meta interface{}) error {
+	if err := applyDnsZoneRecords(d, meta, schema.TimeoutUpdate); err != nil {
+		return err
+	}
+
+	return resourceYandexDnsZoneRecordsRead(d, meta)
+}
+
+func resourceYandexDnsZoneRecordsDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutDelete))
+	defer cancel()
+
+	zone, err := getDnsZoneForRecords(ctx, config, d.Get("zone_id").(string))
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("DnsZone %q", d.Get("zone_id")))
+	}
+
+	_, raw, err := listDnsZoneRecords(ctx, config, zone)
+	if err != nil {
+		return err
+	}
+
+	var deletions []*dns.RecordSet
+	for _, v := range d.Get("records").(*schema.Set).List() {
+		m := v.(map[string]interface{})
+		key := dnsrecords.RecordSet{Name: m["name"].(string), Type: m["type"].(string)}.Key()
+		if existing, ok := raw[key]; ok {
+			deletions = append(deletions, existing)
+		}
+	}
+
+	if err := upsertDnsZoneRecords(ctx, config, zone.GetId(), deletions, nil); err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Finished deleting %d record sets of DnsZone %q", len(deletions), zone.GetId())
+	return nil
+}
+
+func resourceYandexDnsZoneRecordsImportState(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	if err := d.Set("zone_id", d.Id()); err != nil {
+		return nil, fmt.Errorf("Error setting zone_id: %s", err)
+	}
+	if err := d.Set("default_ttl", yandexDnsZoneRecordsDefaultTTL); err != nil {
+		return nil, fmt.Errorf("Error setting default_ttl: %s", err)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceYandexDnsZoneRecordsCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("zone_id") || !d.NewValueKnown("zone_file") || !d.NewValueKnown("recordset") {
+		return d.SetNewComputed("records")
+	}
+
+	config := meta.(*Config)
+	zone, err := getDnsZoneForRecords(ctx, config, d.Get("zone_id").(string))
+	if err != nil {
+		return fmt.Errorf("Error while requesting API to get DnsZone %q: %s", d.Get("zone_id"), err)
+	}
+
+	desired, err := dnsZoneRecordsDesired(d, zone.GetZone())
+	if err != nil {
+		return err
+	}
+
+	return d.SetNew("records", flattenDnsZoneRecords(desired))
+}
+
+// applyDnsZoneRecords brings record sets of the zone to the declared state with a single UpsertRecordSets request.
+func applyDnsZoneRecords(d *schema.ResourceData, meta interface{}, timeoutKey string) error {
+	config := meta.(*Config)
+
+	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(timeoutKey))
+	defer cancel()
+
+	zone, err := getDnsZoneForRecords(ctx, config, d.Get("zone_id").(string))
+	if err != nil {
+		return fmt.Errorf("Error while requesting API to get DnsZone %q: %s", d.Get("zone_id"), err)
+	}
+
+	desired, err := dnsZoneRecordsDesired(d, zone.GetZone())
+	if err != nil {
+		return err
+	}
+
+	current, raw, err := listDnsZoneRecords(ctx, config, zone)
+	if err != nil {
+		return err
+	}
+
+	deletionKeys, replacements := dnsrecords.Diff(current, desired)
+
+	deletions := make([]*dns.RecordSet, 0, len(deletionKeys))
+	for _, key := range deletionKeys {
+		deletions = append(deletions, raw[key])
+	}
+
+	return upsertDnsZoneRecords(ctx, config, zone.GetId(), deletions, expandDnsZoneRecordSets(replacements))
+}
+
+func upsertDnsZoneRecords(ctx context.Context, config *Config, zoneID string, deletions, replacements []*dns.RecordSet) error {
+	if len(deletions) == 0 && len(replacements) == 0 {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Upserting record sets of DnsZone %q: %d deletions, %d replacements", zoneID, len(deletions), len(replacements))
+
+	op, err := dnssdk.NewDnsZoneClient(config.SDK).UpsertRecordSets(ctx, &dns.UpsertRecordSetsRequest{
+		DnsZoneId:    zoneID,
+		Deletions:    deletions,
+		Replacements: replacements,
+	})
+	if err != nil {
+		return fmt.Errorf("Error while requesting API to upsert record sets of DnsZone %q: %s", zoneID, err)
+	}
+
+	_, err = op.Wait(ctx)
+	if err != nil {
+		return fmt.Errorf("Error while waiting operation to upsert record sets of DnsZone %q: %s", zoneID, err)
+	}
+
+	return nil
+}
+
+func getDnsZoneForRecords(ctx context.Context, config *Config, zoneID string) (*dns.DnsZone, error) {
+	return dnssdk.NewDnsZoneClient(config.SDK).Get(ctx, &dns.GetDnsZoneRequest{
+		DnsZoneId: zoneID,
+	})
+}
+
+// listDnsZoneRecords returns normalized record sets of the zone except those maintained by the DNS service,
+// along with record sets as returned by API keyed the same way.
+func listDnsZoneRecords(ctx context.Context, config *Config, zone *dns.DnsZone) ([]dnsrecords.RecordSet, map[string]*dns.RecordSet, error) {
+	client := dnssdk.NewDnsZoneClient(config.SDK)
+
+	var (
+		current []dnsrecords.RecordSet
+		raw     = make(map[string]*dns.RecordSet)
+	)
+
+	req := &dns.ListDnsZoneRecordSetsRequest{
+		DnsZoneId: zone.GetId(),
+		PageSize:  yandexDnsZoneRecordsPageSize,
+	}
+	for {
+		resp, err := client.ListRecordSets(ctx, req)
+		if err != nil {
+			return nil, nil, fmt.Errorf("Error while requesting API to list record sets of DnsZone %q: %s", zone.GetId(), err)
+		}
+
+		for _, rs := range resp.GetRecordSets() {
+			record := dnsrecords.RecordSet{
+				Name: rs.GetName(),
+				Type: rs.GetType(),
+				TTL:  rs.GetTtl(),
+				Data: rs.GetData(),
+			}
+			if normalized, err := dnsrecords.Normalize(record, zone.GetZone()); err == nil {
+				record = normalized
+			} else {
+				log.Printf("[WARN] Cannot normalize record set %s %s of DnsZone %q: %s", rs.GetName(), rs.GetType(), zone.GetId(), err)
+			}
+			if record.IsServiceManaged(zone.GetZone()) {
+				continue
+			}
+
+			current = append(current, record)
+			raw[record.Key()] = rs
+		}
+
+		if resp.GetNextPageToken() == "" {
+			break
+		}
+		req.PageToken = resp.GetNextPageToken()
+	}
+
+	dnsrecords.Sort(current)
+	return current, raw, nil
+}
+
+// dnsZoneRecordsDesired merges record sets loaded from zone_file with ones declared in recordset blocks.
+func dnsZoneRecordsDesired(d dnsZoneRecordsGetter, origin string) ([]dnsrecords.RecordSet, error) {
+	var desired []dnsrecords.RecordSet
+	if zoneFile := d.Get("zone_file").(string); zoneFile != "" {
+		records, err := dnsrecords.ParseZoneFile(zoneFile, origin, int64(d.Get("default_ttl").(int)))
+		if err != nil {
+			return nil, fmt.Errorf("Error parsing zone_file: %s", err)
+		}
+		desired = records
+	}
+
+	declared, err := expandDnsZoneRecords(d.Get("recordset").(*schema.Set).List(), origin)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make(map[string]struct{}, len(desired)+len(declared))
+	for _, rs := range desired {
+		keys[rs.Key()] = struct{}{}
+	}
+	for _, rs := range declared {
+		if rs.IsServiceManaged(origin) {
+			return nil, fmt.Errorf("record set %s %s is maintained by the DNS service and cannot be managed", rs.Name, rs.Type)
+		}
+		if _, ok := keys[rs.Key()]; ok {
+			return nil, fmt.Errorf("record set %s %s is declared more than once", rs.Name, rs.Type)
+		}
+		keys[rs.Key()] = struct{}{}
+		desired = append(desired, rs)
+	}
+
+	dnsrecords.Sort(desired)
+	return desired, nil
+}
+
+// dnsZoneRecordsGetter is implemented by both schema.ResourceData and schema.ResourceDiff.
+type dnsZoneRecordsGetter interface {
+	Get(key string) interface{}
+}
+
+func expandDnsZoneRecords(v []interface{}, origin string) ([]dnsrecords.RecordSet, error) {
+	records := make([]dnsrecords.RecordSet, 0, len(v))
+	for _, raw := range v {
+		m := raw.(map[string]interface{})
+
+		rs, err := dnsrecords.Normalize(dnsrecords.RecordSet{
+			Name: m["name"].(string),
+			Type: m["type"].(string),
+			TTL:  int64(m["ttl"].(int)),
+			Data: convertStringSet(m["data"].(*schema.Set)),
+		}, origin)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, rs)
+	}
+	return records, nil
+}
+
+func expandDnsZoneRecordSets(records []dnsrecords.RecordSet) []*dns.RecordSet {
+	result := make([]*dns.RecordSet, 0, len(records))
+	for _, rs := range records {
+		result = append(result, &dns.RecordSet{
+			Name: rs.Name,
+			Type: rs.Type,
+			Ttl:  rs.TTL,
+			Data: rs.Data,
+		})
+	}
+	return result
+}
+
+func flattenDnsZoneRecords(records []dnsrecords.RecordSet) []interface{} {
+	result := make([]interface{}, 0, len(records))
+	for _, rs := range records {
+		result = append(result, map[string]interface{}{
+			"name": rs.Name,
+			"type": rs.Type,
+			"ttl":  int(rs.TTL),
+			"data": rs.Data,
+		})
+	}
+	return result
+}
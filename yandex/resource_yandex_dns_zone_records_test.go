@@ -0,0 +1,188 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/dns/v1"
+	dnssdk "github.com/yandex-cloud/go-sdk/services/dns/v1"
+)
+
+func TestAccDNSZoneRecords_basic(t *testing.T) {
+	t.Parallel()
+
+	zoneName := acctest.RandomWithPrefix("tf-dns-zone")
+	fqdn := acctest.RandomWithPrefix("tf-test") + ".dnstest.test."
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDNSZoneRecordsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDNSZoneRecordsBasic(zoneName, fqdn),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("yandex_dns_zone_records.records", "records.#", "4"),
+					resource.TestCheckTypeSetElemNestedAttrs("yandex_dns_zone_records.records", "records.*", map[string]string{
+						"name":   "www." + fqdn,
+						"type":   "A",
+						"ttl":    "300",
+						"data.#": "2",
+						"data.0": "192.168.0.1",
+						"data.1": "192.168.0.2",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("yandex_dns_zone_records.records", "records.*", map[string]string{
+						"name":   "ftp." + fqdn,
+						"type":   "CNAME",
+						"data.0": "www." + fqdn,
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("yandex_dns_zone_records.records", "records.*", map[string]string{
+						"name":   fqdn,
+						"type":   "MX",
+						"ttl":    "3600",
+						"data.0": "10 mx1." + fqdn,
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("yandex_dns_zone_records.records", "records.*", map[string]string{
+						"name":   fqdn,
+						"type":   "TXT",
+						"data.0": `"v=spf1 -all"`,
+					}),
+					testAccCheckDNSZoneRecordSetCount("yandex_dns_zone_records.records", 4),
+				),
+			},
+			{
+				Config: testAccDNSZoneRecordsUpdated(zoneName, fqdn),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("yandex_dns_zone_records.records", "records.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("yandex_dns_zone_records.records", "records.*", map[string]string{
+						"name":   "www." + fqdn,
+						"type":   "A",
+						"ttl":    "600",
+						"data.#": "1",
+						"data.0": "192.168.0.3",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("yandex_dns_zone_records.records", "records.*", map[string]string{
+						"name":   "_sip._tcp." + fqdn,
+						"type":   "SRV",
+						"data.0": "10 5 5060 sip." + fqdn,
+					}),
+					testAccCheckDNSZoneRecordSetCount("yandex_dns_zone_records.records", 2),
+				),
+			},
+			{
+				ResourceName:            "yandex_dns_zone_records.records",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"zone_file", "recordset"},
+			},
+		},
+	})
+}
+
+// testAccCheckDNSZoneRecordSetCount checks the number of record sets in the zone
+// except SOA and zone apex NS ones.
+func testAccCheckDNSZoneRecordSetCount(name string, expected int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("not found: %s", name)
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		zone, err := dnssdk.NewDnsZoneClient(config.SDK).Get(context.Background(), &dns.GetDnsZoneRequest{
+			DnsZoneId: rs.Primary.Attributes["zone_id"],
+		})
+		if err != nil {
+			return err
+		}
+
+		current, _, err := listDnsZoneRecords(context.Background(), config, zone)
+		if err != nil {
+			return err
+		}
+
+		if len(current) != expected {
+			return fmt.Errorf("expected %d record sets in zone %s, found %d", expected, zone.GetId(), len(current))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDNSZoneRecordsDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "yandex_dns_zone" {
+			continue
+		}
+
+		_, err := dnssdk.NewDnsZoneClient(config.SDK).Get(context.Background(), &dns.GetDnsZoneRequest{
+			DnsZoneId: rs.Primary.ID,
+		})
+		if err == nil {
+			return fmt.Errorf("DnsZone still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccDNSZoneRecordsBasic(name, fqdn string) string {
+	return fmt.Sprintf(`
+resource "yandex_dns_zone" "zone1" {
+  name = "%[1]s"
+  zone = "%[2]s"
+}
+
+resource "yandex_dns_zone_records" "records" {
+  zone_id = yandex_dns_zone.zone1.id
+
+  zone_file = <<-EOT
+    $TTL 1h
+    @    IN NS  ns1.yandexcloud.net.
+    @       MX  10 mx1
+    ftp     CNAME www
+    @       TXT "v=spf1 -all"
+  EOT
+
+  recordset {
+    name = "www"
+    type = "A"
+    ttl  = 300
+    data = ["192.168.0.2", "192.168.0.1"]
+  }
+}
+`, name, fqdn)
+}
+
+func testAccDNSZoneRecordsUpdated(name, fqdn string) string {
+	return fmt.Sprintf(`
+resource "yandex_dns_zone" "zone1" {
+  name = "%[1]s"
+  zone = "%[2]s"
+}
+
+resource "yandex_dns_zone_records" "records" {
+  zone_id = yandex_dns_zone.zone1.id
+
+  recordset {
+    name = "www.%[2]s"
+    type = "a"
+    ttl  = 600
+    data = ["192.168.0.3"]
+  }
+
+  recordset {
+    name = "_sip._tcp"
+    type = "SRV"
+    ttl  = 600
+    data = ["10 5 5060 sip"]
+  }
+}
+`, name, fqdn)
+}
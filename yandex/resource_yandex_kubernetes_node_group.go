@@ -551,6 +551,7 @@ func resourceYandexKubernetesNodeGroup() *schema.Resource {
 					},
 				},
 			},
+			"wait_for_healthy": waitForHealthySchema("Wait on create and update until nodes of the group are ready. If not enough nodes become ready in time, the apply fails with the list of nodes that are not ready. Changing this block does not update the node group."),
 			"deploy_policy": {
 				Type:        schema.TypeList,
 				Description: "Deploy policy of the node group.",
@@ -671,6 +672,10 @@ func resourceYandexKubernetesNodeGroupCreate(d *schema.ResourceData, meta interf
 		return fmt.Errorf("error while waiting operation to create Kubernetes node group: %s", err)
 	}
 
+	if err := waitForKubernetesNodeGroupHealthy(d, config); err != nil {
+		return err
+	}
+
 	return resourceYandexKubernetesNodeGroupRead(d, meta)
 }
 
@@ -1329,6 +1334,9 @@ func resourceYandexKubernetesNodeGroupUpdate(d *schema.ResourceData, meta interf
 	}
 
 	if len(updatePath) == 0 {
+		if d.HasChange("wait_for_healthy") {
+			return resourceYandexKubernetesNodeGroupRead(d, meta)
+		}
 		return fmt.Errorf("error while updating Kubernetes node group, didn't detect any changes")
 	}
 
@@ -1348,6 +1356,10 @@ func resourceYandexKubernetesNodeGroupUpdate(d *schema.ResourceData, meta interf
 		return fmt.Errorf("error updating Kubernetes node group %q: %s", ngID, err)
 	}
 
+	if err := waitForKubernetesNodeGroupHealthy(d, config); err != nil {
+		return err
+	}
+
 	return resourceYandexKubernetesNodeGroupRead(d, meta)
 }
 
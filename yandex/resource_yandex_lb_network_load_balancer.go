@@ -13,6 +13,7 @@ import (
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/loadbalancer/v1"
 	loadbalancersdk "github.com/yandex-cloud/go-sdk/services/loadbalancer/v1"
 	"github.com/yandex-cloud/terraform-provider-yandex/common"
+	"google.golang.org/genproto/protobuf/field_mask"
 )
 
 const yandexLBNetworkLoadBalancerDefaultTimeout = 5 * time.Minute
@@ -87,84 +88,7 @@ func resourceYandexLBNetworkLoadBalancer() *schema.Resource {
 				Optional:    true,
 				Set:         resourceLBNetworkLoadBalancerListenerHash,
 				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"name": {
-							Type:        schema.TypeString,
-							Description: "Name of the listener. The name must be unique for each listener on a single load balancer.",
-							Required:    true,
-						},
-						"port": {
-							Type:        schema.TypeInt,
-							Description: "Port for incoming traffic.",
-							Required:    true,
-						},
-						"target_port": {
-							Type:        schema.TypeInt,
-							Description: "Port of a target. The default is the same as listener's port.",
-							Optional:    true,
-							Computed:    true,
-						},
-						"protocol": {
-							Type:         schema.TypeString,
-							Description:  "Protocol for incoming traffic. TCP or UDP and the default is TCP.",
-							Optional:     true,
-							Computed:     true,
-							ValidateFunc: validation.StringInSlice([]string{"tcp", "udp"}, false),
-						},
-						"external_address_spec": {
-							Type:        schema.TypeSet,
-							Description: "External IP address specification. ",
-							Optional:    true,
-							Set:         resourceLBNetworkLoadBalancerExternalAddressHash,
-							MaxItems:    1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"address": {
-										Type:        schema.TypeString,
-										Description: "External IP address for a listener. IP address will be allocated if it wasn't been set.",
-										Optional:    true,
-										Computed:    true,
-									},
-									"ip_version": {
-										Type:         schema.TypeString,
-										Description:  "IP version of the external addresses that the load balancer works with. Must be one of `ipv4` or `ipv6`. The default is `ipv4`.",
-										Optional:     true,
-										Default:      "ipv4",
-										ValidateFunc: validation.StringInSlice([]string{"ipv4", "ipv6"}, false),
-									},
-								},
-							},
-						},
-						"internal_address_spec": {
-							Type:        schema.TypeSet,
-							Description: "Internal IP address specification. ",
-							Optional:    true,
-							Set:         resourceLBNetworkLoadBalancerInternalAddressHash,
-							MaxItems:    1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"subnet_id": {
-										Type:        schema.TypeString,
-										Description: "ID of the subnet to which the internal IP address belongs.",
-										Required:    true,
-									},
-									"address": {
-										Type:        schema.TypeString,
-										Description: "Internal IP address for a listener. Must belong to the subnet that is referenced in subnet_id. IP address will be allocated if it wasn't been set.",
-										Optional:    true,
-										Computed:    true,
-									},
-									"ip_version": {
-										Type:         schema.TypeString,
-										Description:  "IP version of the external addresses that the load balancer works with. Must be one of `ipv4` or `ipv6`. The default is `ipv4`.",
-										Optional:     true,
-										Default:      "ipv4",
-										ValidateFunc: validation.StringInSlice([]string{"ipv4", "ipv6"}, false),
-									},
-								},
-							},
-						},
-					},
+					Schema: lbNetworkLoadBalancerListenerSchema(),
 				},
 			},
 
@@ -180,83 +104,27 @@ func resourceYandexLBNetworkLoadBalancer() *schema.Resource {
 							Description: "ID of the target group.",
 							Required:    true,
 						},
-						"healthcheck": {
-							Type:        schema.TypeList,
-							Description: "A HealthCheck resource.\n\n~> One of `http_options` or `tcp_options` should be specified.\n",
-							Required:    true,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"name": {
-										Type:        schema.TypeString,
-										Description: "Name of the health check. The name must be unique for each target group that attached to a single load balancer.",
-										Required:    true,
-									},
-									"interval": {
-										Type:        schema.TypeInt,
-										Description: "The interval between health checks. The default is 2 seconds.",
-										Default:     2,
-										Optional:    true,
-									},
-									"timeout": {
-										Type:        schema.TypeInt,
-										Description: "Timeout for a target to return a response for the health check. The default is 1 second.",
-										Default:     1,
-										Optional:    true,
-									},
-									"unhealthy_threshold": {
-										Type:        schema.TypeInt,
-										Description: "Number of failed health checks before changing the status to `UNHEALTHY`. The default is 2.",
-										Default:     2,
-										Optional:    true,
-									},
-									"healthy_threshold": {
-										Type:        schema.TypeInt,
-										Description: "Number of successful health checks required in order to set the `HEALTHY` status for the target.",
-										Default:     2,
-										Optional:    true,
-									},
-									"http_options": {
-										Type:        schema.TypeList,
-										Description: "Options for HTTP health check.",
-										Optional:    true,
-										MaxItems:    1,
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												"port": {
-													Type:        schema.TypeInt,
-													Description: "Port to use for HTTP health checks.",
-													Required:    true,
-												},
-												"path": {
-													Type:        schema.TypeString,
-													Description: "URL path to set for health checking requests for every target in the target group. For example `/ping`. The default path is `/`.",
-													Optional:    true,
-												},
-											},
-										},
-									},
-									"tcp_options": {
-										Type:        schema.TypeList,
-										Description: "Options for TCP health check.",
-										Optional:    true,
-										MaxItems:    1,
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												"port": {
-													Type:        schema.TypeInt,
-													Description: "Port to use for TCP health checks.",
-													Required:    true,
-												},
-											},
-										},
-									},
-								},
-							},
-						},
+						"healthcheck": lbNetworkLoadBalancerHealthcheckSchema(),
 					},
 				},
 			},
 
+			"ignore_listeners": {
+				Type:          schema.TypeBool,
+				Description:   "If `true`, listeners of the network load balancer are not managed by this resource: `listener` is not read and existing listeners are kept on update. Use it to manage listeners with `yandex_lb_network_load_balancer_listener` resources.",
+				Optional:      true,
+				Default:       false,
+				ConflictsWith: []string{"listener"},
+			},
+
+			"ignore_attached_target_groups": {
+				Type:          schema.TypeBool,
+				Description:   "If `true`, target groups attached to the network load balancer are not managed by this resource: `attached_target_group` is not read and existing attachments are kept on update. Use it to attach target groups with `yandex_lb_network_load_balancer_target_group_attachment` resources.",
+				Optional:      true,
+				Default:       false,
+				ConflictsWith: []string{"attached_target_group"},
+			},
+
 			"created_at": {
 				Type:        schema.TypeString,
 				Description: common.ResourceDescriptions["created_at"],
@@ -279,6 +147,165 @@ func resourceYandexLBNetworkLoadBalancer() *schema.Resource {
 
 }
 
+// lbNetworkLoadBalancerListenerSchema returns the attributes of a listener of a network load balancer.
+func lbNetworkLoadBalancerListenerSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:        schema.TypeString,
+			Description: "Name of the listener. The name must be unique for each listener on a single load balancer.",
+			Required:    true,
+		},
+		"port": {
+			Type:        schema.TypeInt,
+			Description: "Port for incoming traffic.",
+			Required:    true,
+		},
+		"target_port": {
+			Type:        schema.TypeInt,
+			Description: "Port of a target. The default is the same as listener's port.",
+			Optional:    true,
+			Computed:    true,
+		},
+		"protocol": {
+			Type:         schema.TypeString,
+			Description:  "Protocol for incoming traffic. TCP or UDP and the default is TCP.",
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringInSlice([]string{"tcp", "udp"}, false),
+		},
+		"external_address_spec": {
+			Type:        schema.TypeSet,
+			Description: "External IP address specification. ",
+			Optional:    true,
+			Set:         resourceLBNetworkLoadBalancerExternalAddressHash,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"address": {
+						Type:        schema.TypeString,
+						Description: "External IP address for a listener. IP address will be allocated if it wasn't been set.",
+						Optional:    true,
+						Computed:    true,
+					},
+					"ip_version": {
+						Type:         schema.TypeString,
+						Description:  "IP version of the external addresses that the load balancer works with. Must be one of `ipv4` or `ipv6`. The default is `ipv4`.",
+						Optional:     true,
+						Default:      "ipv4",
+						ValidateFunc: validation.StringInSlice([]string{"ipv4", "ipv6"}, false),
+					},
+				},
+			},
+		},
+		"internal_address_spec": {
+			Type:        schema.TypeSet,
+			Description: "Internal IP address specification. ",
+			Optional:    true,
+			Set:         resourceLBNetworkLoadBalancerInternalAddressHash,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"subnet_id": {
+						Type:        schema.TypeString,
+						Description: "ID of the subnet to which the internal IP address belongs.",
+						Required:    true,
+					},
+					"address": {
+						Type:        schema.TypeString,
+						Description: "Internal IP address for a listener. Must belong to the subnet that is referenced in subnet_id. IP address will be allocated if it wasn't been set.",
+						Optional:    true,
+						Computed:    true,
+					},
+					"ip_version": {
+						Type:         schema.TypeString,
+						Description:  "IP version of the external addresses that the load balancer works with. Must be one of `ipv4` or `ipv6`. The default is `ipv4`.",
+						Optional:     true,
+						Default:      "ipv4",
+						ValidateFunc: validation.StringInSlice([]string{"ipv4", "ipv6"}, false),
+					},
+				},
+			},
+		},
+	}
+}
+
+// lbNetworkLoadBalancerHealthcheckSchema returns the health checks of a target group attached to a network load balancer.
+func lbNetworkLoadBalancerHealthcheckSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "A HealthCheck resource.\n\n~> One of `http_options` or `tcp_options` should be specified.\n",
+		Required:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:        schema.TypeString,
+					Description: "Name of the health check. The name must be unique for each target group that attached to a single load balancer.",
+					Required:    true,
+				},
+				"interval": {
+					Type:        schema.TypeInt,
+					Description: "The interval between health checks. The default is 2 seconds.",
+					Default:     2,
+					Optional:    true,
+				},
+				"timeout": {
+					Type:        schema.TypeInt,
+					Description: "Timeout for a target to return a response for the health check. The default is 1 second.",
+					Default:     1,
+					Optional:    true,
+				},
+				"unhealthy_threshold": {
+					Type:        schema.TypeInt,
+					Description: "Number of failed health checks before changing the status to `UNHEALTHY`. The default is 2.",
+					Default:     2,
+					Optional:    true,
+				},
+				"healthy_threshold": {
+					Type:        schema.TypeInt,
+					Description: "Number of successful health checks required in order to set the `HEALTHY` status for the target.",
+					Default:     2,
+					Optional:    true,
+				},
+				"http_options": {
+					Type:        schema.TypeList,
+					Description: "Options for HTTP health check.",
+					Optional:    true,
+					MaxItems:    1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"port": {
+								Type:        schema.TypeInt,
+								Description: "Port to use for HTTP health checks.",
+								Required:    true,
+							},
+							"path": {
+								Type:        schema.TypeString,
+								Description: "URL path to set for health checking requests for every target in the target group. For example `/ping`. The default path is `/`.",
+								Optional:    true,
+							},
+						},
+					},
+				},
+				"tcp_options": {
+					Type:        schema.TypeList,
+					Description: "Options for TCP health check.",
+					Optional:    true,
+					MaxItems:    1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"port": {
+								Type:        schema.TypeInt,
+								Description: "Port to use for TCP health checks.",
+								Required:    true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func resourceYandexLBNetworkLoadBalancerCreate(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 	client := loadbalancersdk.NewNetworkLoadBalancerClient(config.SDK)
@@ -372,12 +399,16 @@ func resourceYandexLBNetworkLoadBalancerRead(d *schema.ResourceData, meta interf
 	d.Set("deletion_protection", nlb.DeletionProtection)
 	d.Set("allow_zonal_shift", nlb.AllowZonalShift)
 
-	if err := d.Set("listener", ls); err != nil {
-		return err
+	if !d.Get("ignore_listeners").(bool) {
+		if err := d.Set("listener", ls); err != nil {
+			return err
+		}
 	}
 
-	if err := d.Set("attached_target_group", atgs); err != nil {
-		return err
+	if !d.Get("ignore_attached_target_groups").(bool) {
+		if err := d.Set("attached_target_group", atgs); err != nil {
+			return err
+		}
 	}
 
 	return d.Set("labels", nlb.Labels)
@@ -413,6 +444,25 @@ func resourceYandexLBNetworkLoadBalancerUpdate(d *schema.ResourceData, meta inte
 		AllowZonalShift:       d.Get("allow_zonal_shift").(bool),
 	}
 
+	ignoreListeners := d.Get("ignore_listeners").(bool)
+	ignoreAttachedTargetGroups := d.Get("ignore_attached_target_groups").(bool)
+	if ignoreListeners || ignoreAttachedTargetGroups {
+		// Listeners and attachments managed elsewhere are left out of the update mask.
+		req.UpdateMask = &field_mask.FieldMask{
+			Paths: []string{"name", "description", "labels", "deletion_protection", "allow_zonal_shift"},
+		}
+		if !ignoreListeners {
+			req.UpdateMask.Paths = append(req.UpdateMask.Paths, "listener_specs")
+		}
+		if !ignoreAttachedTargetGroups {
+			req.UpdateMask.Paths = append(req.UpdateMask.Paths, "attached_target_groups")
+		}
+
+		mutexKey := lbNetworkLoadBalancerMutexKey(d.Id())
+		mutexKV.Lock(mutexKey)
+		defer mutexKV.Unlock(mutexKey)
+	}
+
 	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutUpdate))
 	defer cancel()
 
@@ -453,3 +503,8 @@ func resourceYandexLBNetworkLoadBalancerDelete(d *schema.ResourceData, meta inte
 	log.Printf("[DEBUG] Finished deleting NetworkLoadBalancer %q", d.Id())
 	return nil
 }
+
+// lbNetworkLoadBalancerMutexKey serializes changes of listeners and attached target groups of a network load balancer.
+func lbNetworkLoadBalancerMutexKey(nlbID string) string {
+	return fmt.Sprintf("lb-network-load-balancer-%s", nlbID)
+}
@@ -0,0 +1,172 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/loadbalancer/v1"
+	loadbalancersdk "github.com/yandex-cloud/go-sdk/services/loadbalancer/v1"
+)
+
+func resourceYandexLBNetworkLoadBalancerListener() *schema.Resource {
+	s := lbNetworkLoadBalancerListenerSchema()
+	for _, v := range s {
+		v.ForceNew = true
+	}
+	s["network_load_balancer_id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "ID of the network load balancer to add the listener to.",
+		Required:    true,
+		ForceNew:    true,
+	}
+
+	return &schema.Resource{
+		Description: "Manages a single listener of a network load balancer. Listeners are added and removed without changing other listeners and attached target groups of the load balancer. For more information, see [the official documentation](https://yandex.cloud/docs/network-load-balancer/concepts/listener).\n\n~> Set `ignore_listeners = true` on the `yandex_lb_network_load_balancer` resource, otherwise it removes listeners managed by this resource.\n\n~> One of `external_address_spec` or `internal_address_spec` should be specified, according to the type of the network load balancer.\n",
+
+		Create: resourceYandexLBNetworkLoadBalancerListenerCreate,
+		Read:   resourceYandexLBNetworkLoadBalancerListenerRead,
+		Delete: resourceYandexLBNetworkLoadBalancerListenerDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(yandexLBNetworkLoadBalancerDefaultTimeout),
+			Delete: schema.DefaultTimeout(yandexLBNetworkLoadBalancerDefaultTimeout),
+		},
+
+		SchemaVersion: 0,
+		Schema:        s,
+	}
+}
+
+func resourceYandexLBNetworkLoadBalancerListenerCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	client := loadbalancersdk.NewNetworkLoadBalancerClient(config.SDK)
+
+	nlbID := d.Get("network_load_balancer_id").(string)
+	ls, err := expandLBListenerSpec(map[string]interface{}{
+		"name":                  d.Get("name"),
+		"port":                  d.Get("port"),
+		"target_port":           d.Get("target_port"),
+		"protocol":              d.Get("protocol"),
+		"external_address_spec": d.Get("external_address_spec"),
+		"internal_address_spec": d.Get("internal_address_spec"),
+	})
+	if err != nil {
+		return fmt.Errorf("Error expanding listener while adding it to network load balancer: %s", err)
+	}
+
+	mutexKey := lbNetworkLoadBalancerMutexKey(nlbID)
+	mutexKV.Lock(mutexKey)
+	defer mutexKV.Unlock(mutexKey)
+
+	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+
+	op, err := client.AddListener(ctx, &loadbalancer.AddNetworkLoadBalancerListenerRequest{
+		NetworkLoadBalancerId: nlbID,
+		ListenerSpec:          ls,
+	})
+	if err != nil {
+		return fmt.Errorf("Error while requesting API to add listener %q to NetworkLoadBalancer %q: %s", ls.Name, nlbID, err)
+	}
+
+	if _, err = op.Wait(ctx); err != nil {
+		return fmt.Errorf("Error while waiting operation to add listener %q to NetworkLoadBalancer %q: %s", ls.Name, nlbID, err)
+	}
+
+	d.SetId(lbNetworkLoadBalancerChildID(nlbID, ls.Name))
+
+	return resourceYandexLBNetworkLoadBalancerListenerRead(d, meta)
+}
+
+func resourceYandexLBNetworkLoadBalancerListenerRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	client := loadbalancersdk.NewNetworkLoadBalancerClient(config.SDK)
+
+	nlbID, name, err := parseLBNetworkLoadBalancerChildID(d.Id(), "listener")
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutRead))
+	defer cancel()
+
+	nlb, err := client.Get(ctx, &loadbalancer.GetNetworkLoadBalancerRequest{
+		NetworkLoadBalancerId: nlbID,
+	})
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("NetworkLoadBalancer %q", nlbID))
+	}
+
+	listener, err := flattenLBListenerSpec(nlb, name)
+	if err != nil {
+		return err
+	}
+	if listener == nil {
+		log.Printf("[WARN] Listener %q of NetworkLoadBalancer %q not found, removing from state", name, nlbID)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("network_load_balancer_id", nlbID)
+	for k, v := range listener {
+		if err := d.Set(k, v); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resourceYandexLBNetworkLoadBalancerListenerDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	client := loadbalancersdk.NewNetworkLoadBalancerClient(config.SDK)
+
+	nlbID, name, err := parseLBNetworkLoadBalancerChildID(d.Id(), "listener")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Removing listener %q from NetworkLoadBalancer %q", name, nlbID)
+
+	mutexKey := lbNetworkLoadBalancerMutexKey(nlbID)
+	mutexKV.Lock(mutexKey)
+	defer mutexKV.Unlock(mutexKey)
+
+	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutDelete))
+	defer cancel()
+
+	op, err := client.RemoveListener(ctx, &loadbalancer.RemoveNetworkLoadBalancerListenerRequest{
+		NetworkLoadBalancerId: nlbID,
+		ListenerName:          name,
+	})
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("Listener %q of NetworkLoadBalancer %q", name, nlbID))
+	}
+
+	if _, err = op.Wait(ctx); err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Finished removing listener %q from NetworkLoadBalancer %q", name, nlbID)
+	return nil
+}
+
+// lbNetworkLoadBalancerChildID returns the ID of a listener or a target group attachment of a network load balancer.
+func lbNetworkLoadBalancerChildID(nlbID, name string) string {
+	return nlbID + "/" + name
+}
+
+func parseLBNetworkLoadBalancerChildID(id, what string) (string, string, error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("invalid %s ID %q, expected <network_load_balancer_id>/<name>", what, id)
+	}
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,128 @@
+package yandex
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/loadbalancer/v1"
+)
+
+const (
+	nlbListenerResource   = "yandex_lb_network_load_balancer_listener.test-listener"
+	nlbAttachmentResource = "yandex_lb_network_load_balancer_target_group_attachment.test-attachment"
+)
+
+func TestAccLBNetworkLoadBalancerListener_basic(t *testing.T) {
+	t.Parallel()
+
+	var nlb loadbalancer.NetworkLoadBalancer
+	nlbName := acctest.RandomWithPrefix("tf-network-load-balancer")
+	tgName := acctest.RandomWithPrefix("tf-target-group")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactoriesV6,
+		CheckDestroy:             testAccCheckLBNetworkLoadBalancerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLBNetworkLoadBalancerListenerConfig(nlbName, tgName, 8080, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBNetworkLoadBalancerExists(nlbResource, &nlb),
+					resource.TestCheckResourceAttrPair(nlbListenerResource, "network_load_balancer_id", nlbResource, "id"),
+					resource.TestCheckResourceAttr(nlbListenerResource, "port", "8080"),
+					resource.TestCheckResourceAttr(nlbListenerResource, "target_port", "8080"),
+					resource.TestCheckResourceAttr(nlbListenerResource, "protocol", "tcp"),
+					resource.TestCheckResourceAttr(nlbListenerResource, "external_address_spec.#", "1"),
+					resource.TestCheckResourceAttr(nlbResource, "listener.#", "0"),
+					testAccCheckLBNetworkLoadBalancerCounts(&nlb, 1, 0),
+				),
+			},
+			{
+				// the target group is attached without recreating the listener
+				Config: testAccLBNetworkLoadBalancerListenerConfig(nlbName, tgName, 8080, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBNetworkLoadBalancerExists(nlbResource, &nlb),
+					resource.TestCheckResourceAttrPair(nlbAttachmentResource, "target_group_id", "yandex_lb_target_group.test-tg", "id"),
+					resource.TestCheckResourceAttr(nlbAttachmentResource, "healthcheck.0.tcp_options.0.port", "8080"),
+					resource.TestCheckResourceAttr(nlbResource, "attached_target_group.#", "0"),
+					testAccCheckLBNetworkLoadBalancerCounts(&nlb, 1, 1),
+				),
+			},
+			{
+				ResourceName:      nlbListenerResource,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      nlbAttachmentResource,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccLBNetworkLoadBalancerListenerConfig(nlbName, tgName, 8081, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBNetworkLoadBalancerExists(nlbResource, &nlb),
+					resource.TestCheckResourceAttr(nlbListenerResource, "port", "8081"),
+					testAccCheckLBNetworkLoadBalancerCounts(&nlb, 1, 0),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckLBNetworkLoadBalancerCounts(nlb *loadbalancer.NetworkLoadBalancer, listeners, attachedTargetGroups int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if len(nlb.GetListeners()) != listeners {
+			return fmt.Errorf("NetworkLoadBalancer has %d listeners, expected %d", len(nlb.GetListeners()), listeners)
+		}
+		if len(nlb.GetAttachedTargetGroups()) != attachedTargetGroups {
+			return fmt.Errorf("NetworkLoadBalancer has %d attached target groups, expected %d", len(nlb.GetAttachedTargetGroups()), attachedTargetGroups)
+		}
+		return nil
+	}
+}
+
+func testAccLBNetworkLoadBalancerListenerConfig(nlbName, tgName string, port int, attach bool) string {
+	config := fmt.Sprintf(`
+resource "yandex_lb_network_load_balancer" "test-nlb" {
+  name                          = "%s"
+  ignore_listeners              = true
+  ignore_attached_target_groups = true
+}
+
+resource "yandex_lb_network_load_balancer_listener" "test-listener" {
+  network_load_balancer_id = yandex_lb_network_load_balancer.test-nlb.id
+  name                     = "test-listener"
+  port                     = %d
+
+  external_address_spec {
+    ip_version = "ipv4"
+  }
+}
+
+resource "yandex_lb_target_group" "test-tg" {
+  name = "%s"
+}
+`, nlbName, port, tgName)
+
+	if attach {
+		config += fmt.Sprintf(`
+resource "yandex_lb_network_load_balancer_target_group_attachment" "test-attachment" {
+  network_load_balancer_id = yandex_lb_network_load_balancer.test-nlb.id
+  target_group_id          = yandex_lb_target_group.test-tg.id
+
+  healthcheck {
+    name = "tcp"
+    tcp_options {
+      port = %d
+    }
+  }
+}
+`, port)
+	}
+	return config
+}
@@ -0,0 +1,232 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/loadbalancer/v1"
+	loadbalancersdk "github.com/yandex-cloud/go-sdk/services/loadbalancer/v1"
+	"google.golang.org/genproto/protobuf/field_mask"
+)
+
+func resourceYandexLBNetworkLoadBalancerTargetGroupAttachment() *schema.Resource {
+	return &schema.Resource{
+		Description: "Attaches a target group to a network load balancer. Target groups are attached and detached without changing listeners and other attached target groups of the load balancer, the health check is changed in place. For more information, see [the official documentation](https://yandex.cloud/docs/network-load-balancer/concepts/target-resources).\n\n~> Set `ignore_attached_target_groups = true` on the `yandex_lb_network_load_balancer` resource, otherwise it detaches target groups attached by this resource.\n",
+
+		Create: resourceYandexLBNetworkLoadBalancerTargetGroupAttachmentCreate,
+		Read:   resourceYandexLBNetworkLoadBalancerTargetGroupAttachmentRead,
+		Update: resourceYandexLBNetworkLoadBalancerTargetGroupAttachmentUpdate,
+		Delete: resourceYandexLBNetworkLoadBalancerTargetGroupAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(yandexLBNetworkLoadBalancerDefaultTimeout),
+			Update: schema.DefaultTimeout(yandexLBNetworkLoadBalancerDefaultTimeout),
+			Delete: schema.DefaultTimeout(yandexLBNetworkLoadBalancerDefaultTimeout),
+		},
+
+		SchemaVersion: 0,
+
+		Schema: map[string]*schema.Schema{
+			"network_load_balancer_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the network load balancer to attach the target group to.",
+				Required:    true,
+				ForceNew:    true,
+			},
+
+			"target_group_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the target group.",
+				Required:    true,
+				ForceNew:    true,
+			},
+
+			"healthcheck": lbNetworkLoadBalancerHealthcheckSchema(),
+		},
+	}
+}
+
+func resourceYandexLBNetworkLoadBalancerTargetGroupAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	client := loadbalancersdk.NewNetworkLoadBalancerClient(config.SDK)
+
+	nlbID := d.Get("network_load_balancer_id").(string)
+	atg, err := expandLBAttachedTargetGroup(map[string]interface{}{
+		"target_group_id": d.Get("target_group_id"),
+		"healthcheck":     d.Get("healthcheck"),
+	})
+	if err != nil {
+		return fmt.Errorf("Error expanding attached target group while attaching it to network load balancer: %s", err)
+	}
+
+	mutexKey := lbNetworkLoadBalancerMutexKey(nlbID)
+	mutexKV.Lock(mutexKey)
+	defer mutexKV.Unlock(mutexKey)
+
+	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+
+	op, err := client.AttachTargetGroup(ctx, &loadbalancer.AttachNetworkLoadBalancerTargetGroupRequest{
+		NetworkLoadBalancerId: nlbID,
+		AttachedTargetGroup:   atg,
+	})
+	if err != nil {
+		return fmt.Errorf("Error while requesting API to attach target group %q to NetworkLoadBalancer %q: %s", atg.TargetGroupId, nlbID, err)
+	}
+
+	if _, err = op.Wait(ctx); err != nil {
+		return fmt.Errorf("Error while waiting operation to attach target group %q to NetworkLoadBalancer %q: %s", atg.TargetGroupId, nlbID, err)
+	}
+
+	d.SetId(lbNetworkLoadBalancerChildID(nlbID, atg.TargetGroupId))
+
+	return resourceYandexLBNetworkLoadBalancerTargetGroupAttachmentRead(d, meta)
+}
+
+func resourceYandexLBNetworkLoadBalancerTargetGroupAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	client := loadbalancersdk.NewNetworkLoadBalancerClient(config.SDK)
+
+	nlbID, tgID, err := parseLBNetworkLoadBalancerChildID(d.Id(), "target group attachment")
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutRead))
+	defer cancel()
+
+	nlb, err := client.Get(ctx, &loadbalancer.GetNetworkLoadBalancerRequest{
+		NetworkLoadBalancerId: nlbID,
+	})
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("NetworkLoadBalancer %q", nlbID))
+	}
+
+	var atg *loadbalancer.AttachedTargetGroup
+	for _, v := range nlb.GetAttachedTargetGroups() {
+		if v.GetTargetGroupId() == tgID {
+			atg = v
+			break
+		}
+	}
+	if atg == nil {
+		log.Printf("[WARN] Target group %q is not attached to NetworkLoadBalancer %q, removing from state", tgID, nlbID)
+		d.SetId("")
+		return nil
+	}
+
+	hcs, err := flattenLBHealthchecks(atg)
+	if err != nil {
+		return err
+	}
+
+	d.Set("network_load_balancer_id", nlbID)
+	d.Set("target_group_id", tgID)
+
+	return d.Set("healthcheck", hcs)
+}
+
+// resourceYandexLBNetworkLoadBalancerTargetGroupAttachmentUpdate changes the health check without detaching the target group:
+// the attached target groups are updated as a whole, the other attachments are sent unchanged.
+func resourceYandexLBNetworkLoadBalancerTargetGroupAttachmentUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	client := loadbalancersdk.NewNetworkLoadBalancerClient(config.SDK)
+
+	nlbID, tgID, err := parseLBNetworkLoadBalancerChildID(d.Id(), "target group attachment")
+	if err != nil {
+		return err
+	}
+
+	atg, err := expandLBAttachedTargetGroup(map[string]interface{}{
+		"target_group_id": tgID,
+		"healthcheck":     d.Get("healthcheck"),
+	})
+	if err != nil {
+		return fmt.Errorf("Error expanding attached target group while updating network load balancer: %s", err)
+	}
+
+	mutexKey := lbNetworkLoadBalancerMutexKey(nlbID)
+	mutexKV.Lock(mutexKey)
+	defer mutexKV.Unlock(mutexKey)
+
+	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutUpdate))
+	defer cancel()
+
+	nlb, err := client.Get(ctx, &loadbalancer.GetNetworkLoadBalancerRequest{
+		NetworkLoadBalancerId: nlbID,
+	})
+	if err != nil {
+		return fmt.Errorf("Error while requesting API to get NetworkLoadBalancer %q: %s", nlbID, err)
+	}
+
+	found := false
+	atgs := make([]*loadbalancer.AttachedTargetGroup, 0, len(nlb.GetAttachedTargetGroups()))
+	for _, v := range nlb.GetAttachedTargetGroups() {
+		if v.GetTargetGroupId() == tgID {
+			v, found = atg, true
+		}
+		atgs = append(atgs, v)
+	}
+	if !found {
+		return fmt.Errorf("target group %q is not attached to NetworkLoadBalancer %q", tgID, nlbID)
+	}
+
+	log.Printf("[DEBUG] Updating health check of target group %q attached to NetworkLoadBalancer %q", tgID, nlbID)
+
+	op, err := client.Update(ctx, &loadbalancer.UpdateNetworkLoadBalancerRequest{
+		NetworkLoadBalancerId: nlbID,
+		AttachedTargetGroups:  atgs,
+		UpdateMask: &field_mask.FieldMask{
+			Paths: []string{"attached_target_groups"},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("Error while requesting API to update target group %q attached to NetworkLoadBalancer %q: %s", tgID, nlbID, err)
+	}
+
+	if _, err = op.Wait(ctx); err != nil {
+		return fmt.Errorf("Error while waiting operation to update target group %q attached to NetworkLoadBalancer %q: %s", tgID, nlbID, err)
+	}
+
+	return resourceYandexLBNetworkLoadBalancerTargetGroupAttachmentRead(d, meta)
+}
+
+func resourceYandexLBNetworkLoadBalancerTargetGroupAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	client := loadbalancersdk.NewNetworkLoadBalancerClient(config.SDK)
+
+	nlbID, tgID, err := parseLBNetworkLoadBalancerChildID(d.Id(), "target group attachment")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Detaching target group %q from NetworkLoadBalancer %q", tgID, nlbID)
+
+	mutexKey := lbNetworkLoadBalancerMutexKey(nlbID)
+	mutexKV.Lock(mutexKey)
+	defer mutexKV.Unlock(mutexKey)
+
+	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutDelete))
+	defer cancel()
+
+	op, err := client.DetachTargetGroup(ctx, &loadbalancer.DetachNetworkLoadBalancerTargetGroupRequest{
+		NetworkLoadBalancerId: nlbID,
+		TargetGroupId:         tgID,
+	})
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("Target group %q attachment to NetworkLoadBalancer %q", tgID, nlbID))
+	}
+
+	if _, err = op.Wait(ctx); err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Finished detaching target group %q from NetworkLoadBalancer %q", tgID, nlbID)
+	return nil
+}
@@ -0,0 +1,107 @@
+package yandex
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/loadbalancer/v1"
+)
+
+func TestAccLBNetworkLoadBalancerTargetGroupAttachment_basic(t *testing.T) {
+	t.Parallel()
+
+	var nlb loadbalancer.NetworkLoadBalancer
+	nlbName := acctest.RandomWithPrefix("tf-network-load-balancer")
+	tgName := acctest.RandomWithPrefix("tf-target-group")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactoriesV6,
+		CheckDestroy:             testAccCheckLBNetworkLoadBalancerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLBNetworkLoadBalancerTargetGroupAttachmentConfig(nlbName, tgName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBNetworkLoadBalancerExists(nlbResource, &nlb),
+					resource.TestCheckResourceAttrPair(nlbAttachmentResource, "network_load_balancer_id", nlbResource, "id"),
+					resource.TestCheckResourceAttrPair(nlbAttachmentResource, "target_group_id", "yandex_lb_target_group.test-tg", "id"),
+					resource.TestCheckResourceAttr(nlbAttachmentResource, "healthcheck.0.interval", "2"),
+					testAccCheckLBNetworkLoadBalancerCounts(&nlb, 1, 1),
+				),
+			},
+			{
+				ResourceName:      nlbAttachmentResource,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// the health check is changed without detaching the target group
+				Config: testAccLBNetworkLoadBalancerTargetGroupAttachmentConfig(nlbName, tgName, 5),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(nlbAttachmentResource, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBNetworkLoadBalancerExists(nlbResource, &nlb),
+					resource.TestCheckResourceAttr(nlbAttachmentResource, "healthcheck.0.interval", "5"),
+					testAccCheckLBNetworkLoadBalancerCounts(&nlb, 1, 1),
+					testAccCheckLBNetworkLoadBalancerAttachedHealthcheckInterval(&nlb, 5),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckLBNetworkLoadBalancerAttachedHealthcheckInterval(nlb *loadbalancer.NetworkLoadBalancer, interval int64) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, atg := range nlb.GetAttachedTargetGroups() {
+			for _, hc := range atg.GetHealthChecks() {
+				if hc.GetInterval().GetSeconds() != interval {
+					return fmt.Errorf("health check %q of target group %q has interval %s, expected %ds", hc.GetName(), atg.GetTargetGroupId(), hc.GetInterval().AsDuration(), interval)
+				}
+			}
+		}
+		return nil
+	}
+}
+
+func testAccLBNetworkLoadBalancerTargetGroupAttachmentConfig(nlbName, tgName string, interval int) string {
+	return fmt.Sprintf(`
+resource "yandex_lb_network_load_balancer" "test-nlb" {
+  name                          = "%s"
+  ignore_attached_target_groups = true
+
+  listener {
+    name = "test-listener"
+    port = 8080
+
+    external_address_spec {
+      ip_version = "ipv4"
+    }
+  }
+}
+
+resource "yandex_lb_target_group" "test-tg" {
+  name = "%s"
+}
+
+resource "yandex_lb_network_load_balancer_target_group_attachment" "test-attachment" {
+  network_load_balancer_id = yandex_lb_network_load_balancer.test-nlb.id
+  target_group_id          = yandex_lb_target_group.test-tg.id
+
+  healthcheck {
+    name     = "tcp"
+    interval = %d
+    tcp_options {
+      port = 8080
+    }
+  }
+}
+`, nlbName, tgName, interval)
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/kafka/v1"
 	kafkasdk "github.com/yandex-cloud/go-sdk/services/mdb/kafka/v1"
 	"github.com/yandex-cloud/terraform-provider-yandex/common"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/kafkacommon"
 )
 
 const (
@@ -34,6 +35,9 @@ func resourceYandexMDBKafkaCluster() *schema.Resource {
 		Read:   resourceYandexMDBKafkaClusterRead,
 		Update: resourceYandexMDBKafkaClusterUpdate,
 		Delete: resourceYandexMDBKafkaClusterDelete,
+
+		CustomizeDiff: resourceYandexMDBKafkaClusterCustomizeDiff,
+
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -854,6 +858,30 @@ func resourceYandexMDBKafkaClusterCreate(d *schema.ResourceData, meta interface{
 	return resourceYandexMDBKafkaClusterRead(d, meta)
 }
 
+// Validates the values of topic_config of the inline topics at plan time.
+func resourceYandexMDBKafkaClusterCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("config.0.version") || !d.NewValueKnown("topic") {
+		return nil
+	}
+
+	version := d.Get("config.0.version").(string)
+	for _, t := range d.Get("topic").([]interface{}) {
+		topic, ok := t.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		settings := kafkaTopicConfigSettings(topic["topic_config"])
+		if len(settings) == 0 {
+			continue
+		}
+		if err := kafkacommon.ValidateTopicConfig(version, settings); err != nil {
+			return fmt.Errorf("invalid topic_config of Kafka topic %q: %s", topic["name"], err)
+		}
+	}
+
+	return nil
+}
+
 // Returns request for creating the Cluster.
 func prepareKafkaCreateRequest(d *schema.ResourceData, meta *Config) (*kafka.CreateClusterRequest, error) {
 	labels, err := expandLabels(d.Get("labels"))
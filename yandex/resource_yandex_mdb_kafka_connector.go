@@ -16,7 +16,9 @@ import (
 
 func resourceYandexMDBKafkaConnector() *schema.Resource {
 	return &schema.Resource{
-		Description: "Manages a connector of a Kafka cluster within the Yandex Cloud. For more information, see [the official documentation](https://yandex.cloud/docs/managed-kafka/concepts).",
+		Description: "Manages a connector of a Kafka cluster within the Yandex Cloud. For more information, see [the official documentation](https://yandex.cloud/docs/managed-kafka/concepts).\n\n" +
+			"~> Only the connectors provided by Managed Service for Apache Kafka® can be created: MirrorMaker, S3 Sink and Iceberg Sink. The service doesn't accept arbitrary connector classes or plugins. " +
+			"Settings of a connector that are not exposed by its block can be passed by `properties`.\n",
 
 		Create: resourceYandexMDBKafkaConnectorCreate,
 		Read:   resourceYandexMDBKafkaConnectorRead,
@@ -48,7 +50,7 @@ func resourceYandexMDBKafkaConnector() *schema.Resource {
 			},
 			"properties": {
 				Type:        schema.TypeMap,
-				Description: "Additional properties for connector.",
+				Description: "Additional properties for connector. Use them to set the connector settings that are not exposed by the connector-specific block.",
 				Optional:    true,
 				Computed:    true,
 				Elem:        &schema.Schema{Type: schema.TypeString},
@@ -533,7 +535,7 @@ func resourceYandexMDBKafkaConnectorRead(d *schema.ResourceData, meta interface{
 			return err
 		}
 	default:
-		return fmt.Errorf("this type of connector is not supported by current version of terraform provider")
+		return fmt.Errorf("connector %q has a type %T that is not supported by current version of terraform provider", connectorName, conn.GetConnectorConfig())
 	}
 	return nil
 }
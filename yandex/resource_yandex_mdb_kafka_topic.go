@@ -12,7 +12,9 @@ import (
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/kafka/v1"
 	kafkasdk "github.com/yandex-cloud/go-sdk/services/mdb/kafka/v1"
 	"github.com/yandex-cloud/terraform-provider-yandex/common"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/kafkacommon"
 	"google.golang.org/genproto/protobuf/field_mask"
+	"google.golang.org/grpc/codes"
 )
 
 const (
@@ -32,6 +34,9 @@ func resourceYandexMDBKafkaTopic() *schema.Resource {
 		Read:   resourceYandexMDBKafkaTopicRead,
 		Update: resourceYandexMDBKafkaTopicUpdate,
 		Delete: resourceYandexMDBKafkaTopicDelete,
+
+		CustomizeDiff: resourceYandexMDBKafkaTopicCustomizeDiff,
+
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -124,6 +129,38 @@ func resourceYandexMDBKafkaTopicCreate(d *schema.ResourceData, meta interface{})
 	return resourceYandexMDBKafkaTopicRead(d, meta)
 }
 
+// Validates the values of topic_config at plan time, the cluster is read for its Kafka version.
+// The check is skipped when the cluster is created in the same plan.
+func resourceYandexMDBKafkaTopicCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" && !d.HasChange("topic_config") {
+		return nil
+	}
+	if !d.NewValueKnown("cluster_id") || !d.NewValueKnown("topic_config") {
+		return nil
+	}
+
+	settings := kafkaTopicConfigSettings(d.Get("topic_config"))
+	if len(settings) == 0 {
+		return nil
+	}
+
+	config := meta.(*Config)
+	clusterID := d.Get("cluster_id").(string)
+	cluster, err := kafkasdk.NewClusterClient(config.SDK).Get(ctx, &kafka.GetClusterRequest{ClusterId: clusterID})
+	if err != nil {
+		if isStatusWithCode(err, codes.NotFound) {
+			return nil
+		}
+		return fmt.Errorf("error while requesting API to get Kafka cluster %q: %s", clusterID, err)
+	}
+
+	if err := kafkacommon.ValidateTopicConfig(cluster.GetConfig().GetVersion(), settings); err != nil {
+		return fmt.Errorf("invalid topic_config of Kafka topic %q: %s", d.Get("name").(string), err)
+	}
+
+	return nil
+}
+
 func getKafkaVersion(ctx context.Context, d *schema.ResourceData, config *Config) (string, error) {
 	clusterID := d.Get("cluster_id").(string)
 	req := &kafka.GetClusterRequest{ClusterId: clusterID}
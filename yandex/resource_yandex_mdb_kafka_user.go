@@ -1,14 +1,20 @@
 package yandex
 
 import (
+	"context"
 	"fmt"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/kafka/v1"
 	kafkasdk "github.com/yandex-cloud/go-sdk/services/mdb/kafka/v1"
 	"github.com/yandex-cloud/terraform-provider-yandex/common"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/globallock"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/kafkacommon"
 	"google.golang.org/genproto/protobuf/field_mask"
+	"google.golang.org/grpc/codes"
 )
 
 const (
@@ -20,7 +26,9 @@ const (
 
 func resourceYandexMDBKafkaUser() *schema.Resource {
 	return &schema.Resource{
-		Description: "Manages a user of a Kafka User within the Yandex Cloud. For more information, see [the official documentation](https://yandex.cloud/docs/managed-kafka/concepts).",
+		Description: "Manages a user of a Kafka User within the Yandex Cloud. For more information, see [the official documentation](https://yandex.cloud/docs/managed-kafka/concepts).\n\n" +
+			"~> By default `permission` blocks manage all permissions of the user. Set `ignore_external_permissions = true` to grant permissions with `yandex_mdb_kafka_user_permission` resources: " +
+			"the permissions that are not declared by `permission` blocks are then ignored and kept on update, and declaring a permission that is already granted this way is rejected at plan time.\n",
 
 		Create: resourceYandexMDBKafkaUserCreate,
 		Read:   resourceYandexMDBKafkaUserRead,
@@ -30,6 +38,8 @@ func resourceYandexMDBKafkaUser() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: resourceYandexMDBKafkaUserCustomizeDiff,
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(yandexMDBKafkaUserCreateTimeout),
 			Read:   schema.DefaultTimeout(yandexMDBKafkaUserReadTimeout),
@@ -65,6 +75,12 @@ func resourceYandexMDBKafkaUser() *schema.Resource {
 				Set:         kafkaUserPermissionHash,
 				Elem:        resourceYandexMDBKafkaPermission(),
 			},
+			"ignore_external_permissions": {
+				Type:        schema.TypeBool,
+				Description: "If `true`, permissions of the user that are not declared by `permission` blocks are not managed by this resource: they are not read and are kept on update. Use it to grant permissions with `yandex_mdb_kafka_user_permission` resources.",
+				Optional:    true,
+				Default:     false,
+			},
 		},
 	}
 }
@@ -128,7 +144,7 @@ func resourceYandexMDBKafkaUserRead(d *schema.ResourceData, meta interface{}) er
 	if err != nil {
 		return handleNotFoundError(err, d, fmt.Sprintf("User %q", userName))
 	}
-	perms := flattenKafkaUserPermissions(user)
+	perms := flattenKafkaPermissions(filterKafkaPermissions(user.GetPermissions(), kafkaUserDeclaredPermissionKeys(d)))
 	if err = d.Set("cluster_id", clusterID); err != nil {
 		return err
 	}
@@ -149,13 +165,26 @@ func resourceYandexMDBKafkaUserUpdate(d *schema.ResourceData, meta interface{})
 		Password:  d.Get("password").(string),
 	}
 
-	permissions, ok, err := buildKafkaUserPermissions(d)
+	permissions, _, err := buildKafkaUserPermissions(d)
 	if err != nil {
 		return err
 	}
-	if ok {
-		request.SetPermissions(permissions)
+
+	if d.HasChange("permission") && d.Get("ignore_external_permissions").(bool) {
+		mutexKV := globallock.GetMutexKV()
+		mutexKey := kafkacommon.UserMutexKey(request.ClusterId, request.UserName)
+		mutexKV.Lock(mutexKey)
+		defer mutexKV.Unlock(mutexKey)
+
+		// The update replaces all the permissions of the user, so the undeclared ones are sent back as is.
+		undeclared, err := readKafkaUserUndeclaredPermissions(ctx, config, d)
+		if err != nil {
+			return err
+		}
+		permissions = append(permissions, undeclared...)
+		sortPermissions(permissions)
 	}
+	request.SetPermissions(permissions)
 
 	updatePaths := make([]string, 0, 2)
 	for tfField, maskField := range mdbKafkaUserUpdateFieldsMap {
@@ -174,6 +203,120 @@ func resourceYandexMDBKafkaUserUpdate(d *schema.ResourceData, meta interface{})
 	return resourceYandexMDBKafkaUserRead(d, meta)
 }
 
+// Rejects the permissions declared by new permission blocks that are already granted to the user
+// outside of the resource, e.g. by yandex_mdb_kafka_user_permission resources.
+// The check is skipped when the user is created in the same plan or manages all of its permissions.
+func resourceYandexMDBKafkaUserCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.Get("ignore_external_permissions").(bool) || !d.HasChange("permission") || !d.NewValueKnown("permission") {
+		return nil
+	}
+
+	oldPermissions, newPermissions := d.GetChange("permission")
+	declared := kafkaPermissionKeys(oldPermissions)
+	added := make(map[string]bool)
+	for key := range kafkaPermissionKeys(newPermissions) {
+		if !declared[key] {
+			added[key] = true
+		}
+	}
+	if len(added) == 0 {
+		return nil
+	}
+
+	config := meta.(*Config)
+	clusterID := d.Get("cluster_id").(string)
+	userName := d.Get("name").(string)
+	user, err := kafkasdk.NewUserClient(config.SDK).Get(ctx, &kafka.GetUserRequest{
+		ClusterId: clusterID,
+		UserName:  userName,
+	})
+	if err != nil {
+		if isStatusWithCode(err, codes.NotFound) {
+			return nil
+		}
+		return fmt.Errorf("error while requesting API to get Kafka user %q in cluster %q: %s", userName, clusterID, err)
+	}
+
+	var conflicts []string
+	for _, p := range user.GetPermissions() {
+		if added[kafkaPermissionKey(p)] {
+			conflicts = append(conflicts, fmt.Sprintf("%s on topic %q", p.GetRole().String(), p.GetTopicName()))
+		}
+	}
+	if len(conflicts) == 0 {
+		return nil
+	}
+	sort.Strings(conflicts)
+	return fmt.Errorf("permissions %s of Kafka user %q in cluster %q are already granted outside of permission blocks, e.g. by yandex_mdb_kafka_user_permission resources: "+
+		"remove the other declaration or revoke the permission before declaring it in the user", strings.Join(conflicts, ", "), userName, clusterID)
+}
+
+// kafkaUserDeclaredPermissionKeys returns keys of the permissions declared by permission blocks.
+// It returns nil if all permissions of the user must be read: when the user manages all of its
+// permissions or after import.
+func kafkaUserDeclaredPermissionKeys(d *schema.ResourceData) map[string]bool {
+	if !d.Get("ignore_external_permissions").(bool) || d.Get("cluster_id").(string) == "" {
+		return nil
+	}
+	return kafkaPermissionKeys(d.Get("permission"))
+}
+
+// readKafkaUserUndeclaredPermissions returns the permissions of the user that are declared
+// neither before nor after the update.
+func readKafkaUserUndeclaredPermissions(ctx context.Context, config *Config, d *schema.ResourceData) ([]*kafka.Permission, error) {
+	clusterID := d.Get("cluster_id").(string)
+	userName := d.Get("name").(string)
+	user, err := kafkasdk.NewUserClient(config.SDK).Get(ctx, &kafka.GetUserRequest{
+		ClusterId: clusterID,
+		UserName:  userName,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error while requesting API to get Kafka user %q in cluster %q: %s", userName, clusterID, err)
+	}
+
+	oldPermissions, newPermissions := d.GetChange("permission")
+	declared := kafkaPermissionKeys(oldPermissions)
+	for key := range kafkaPermissionKeys(newPermissions) {
+		declared[key] = true
+	}
+
+	var result []*kafka.Permission
+	for _, p := range user.GetPermissions() {
+		if !declared[kafkaPermissionKey(p)] {
+			result = append(result, p)
+		}
+	}
+	return result, nil
+}
+
+// filterKafkaPermissions returns the permissions with the given keys, all permissions if keys are nil.
+func filterKafkaPermissions(permissions []*kafka.Permission, keys map[string]bool) []*kafka.Permission {
+	if keys == nil {
+		return permissions
+	}
+
+	result := make([]*kafka.Permission, 0, len(permissions))
+	for _, p := range permissions {
+		if keys[kafkaPermissionKey(p)] {
+			result = append(result, p)
+		}
+	}
+	return result
+}
+
+func kafkaPermissionKeys(permissions interface{}) map[string]bool {
+	keys := make(map[string]bool)
+	for _, p := range permissions.(*schema.Set).List() {
+		m := p.(map[string]interface{})
+		keys[kafkacommon.PermissionKey(m["topic_name"].(string), m["role"].(string))] = true
+	}
+	return keys
+}
+
+func kafkaPermissionKey(p *kafka.Permission) string {
+	return kafkacommon.PermissionKey(p.GetTopicName(), p.GetRole().String())
+}
+
 var mdbKafkaUserUpdateFieldsMap = map[string]string{
 	"password":   "password",
 	"permission": "permissions",
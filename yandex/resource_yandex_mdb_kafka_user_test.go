@@ -82,6 +82,27 @@ func TestBuildKafkaUserSpec(t *testing.T) {
 	assert.Equal(t, expected, userSpec)
 }
 
+func TestFilterKafkaPermissions(t *testing.T) {
+	permissions := []*kafka.Permission{
+		{TopicName: "events", Role: kafka.Permission_ACCESS_ROLE_PRODUCER},
+		{TopicName: "events", Role: kafka.Permission_ACCESS_ROLE_CONSUMER, AllowHosts: []string{"10.1.0.10"}},
+		{TopicName: "logs", Role: kafka.Permission_ACCESS_ROLE_CONSUMER},
+	}
+
+	declared := kafkaPermissionKeys(schema.NewSet(kafkaUserPermissionHash, []interface{}{
+		map[string]interface{}{
+			"topic_name": "events",
+			"role":       "ACCESS_ROLE_CONSUMER",
+		},
+	}))
+
+	// Allowed hosts don't identify the permission.
+	assert.Equal(t, permissions[1:2], filterKafkaPermissions(permissions, declared))
+	// All permissions are read after import.
+	assert.Equal(t, permissions, filterKafkaPermissions(permissions, nil))
+	assert.Empty(t, filterKafkaPermissions(permissions, map[string]bool{}))
+}
+
 func TestAccMDBKafkaUser(t *testing.T) {
 	t.Parallel()
 	clusterName := acctest.RandomWithPrefix("tf-kafka")
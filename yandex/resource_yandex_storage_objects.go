@@ -0,0 +1,572 @@
+package yandex
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"mime"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/mitchellh/go-homedir"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex/internal/storage/s3"
+)
+
+const (
+	defaultStorageObjectsParallelism = 10
+	defaultStorageObjectsContentType = "application/octet-stream"
+)
+
+// storageObjectsContentTypes is used before falling back to the system MIME table,
+// so that the detected content types do not depend on the machine running terraform.
+var storageObjectsContentTypes = map[string]string{
+	".css":         "text/css; charset=utf-8",
+	".csv":         "text/csv; charset=utf-8",
+	".gif":         "image/gif",
+	".htm":         "text/html; charset=utf-8",
+	".html":        "text/html; charset=utf-8",
+	".ico":         "image/vnd.microsoft.icon",
+	".jpeg":        "image/jpeg",
+	".jpg":         "image/jpeg",
+	".js":          "text/javascript; charset=utf-8",
+	".json":        "application/json",
+	".map":         "application/json",
+	".md":          "text/markdown; charset=utf-8",
+	".mjs":         "text/javascript; charset=utf-8",
+	".mp4":         "video/mp4",
+	".otf":         "font/otf",
+	".pdf":         "application/pdf",
+	".png":         "image/png",
+	".svg":         "image/svg+xml",
+	".ttf":         "font/ttf",
+	".txt":         "text/plain; charset=utf-8",
+	".wasm":        "application/wasm",
+	".webmanifest": "application/manifest+json",
+	".webp":        "image/webp",
+	".woff":        "font/woff",
+	".woff2":       "font/woff2",
+	".xml":         "text/xml; charset=utf-8",
+	".zip":         "application/zip",
+}
+
+func resourceYandexStorageObjects() *schema.Resource {
+	return &schema.Resource{
+		Description: "Allows management of a set of [Yandex Cloud Storage Objects](https://yandex.cloud/docs/storage/concepts/object) uploaded from a local directory tree.\n\n" +
+			"Every regular file found in `source_dir` is uploaded to the bucket under `prefix` followed by the file path relative to `source_dir`. " +
+			"MD5 hashes of uploaded files are tracked in the state, so only new and changed files are uploaded on subsequent applies.",
+		CreateContext: resourceYandexStorageObjectsCreate,
+		ReadContext:   resourceYandexStorageObjectsRead,
+		UpdateContext: resourceYandexStorageObjectsUpdate,
+		DeleteContext: resourceYandexStorageObjectsDelete,
+		CustomizeDiff: resourceYandexStorageObjectsCustomizeDiff,
+
+		SchemaVersion: 0,
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:        schema.TypeString,
+				Description: "The name of the containing bucket.",
+				Required:    true,
+				ForceNew:    true,
+			},
+
+			"prefix": {
+				Type:        schema.TypeString,
+				Description: "The prefix prepended to the relative path of every file to form the object key, e.g. `static/`. Defaults to an empty prefix.",
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "",
+			},
+
+			"source_dir": {
+				Type:        schema.TypeString,
+				Description: "The path to a local directory which content is uploaded to the bucket.",
+				Required:    true,
+			},
+
+			"access_key": {
+				Type:        schema.TypeString,
+				Description: "The access key to use when applying changes. This value can also be provided as `storage_access_key` specified in provider config (explicitly or within `shared_credentials_file`) is used.",
+				Optional:    true,
+			},
+
+			"secret_key": {
+				Type:        schema.TypeString,
+				Description: "The secret key to use when applying changes. This value can also be provided as `storage_secret_key` specified in provider config (explicitly or within `shared_credentials_file`) is used.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+
+			"acl": {
+				Type:        schema.TypeString,
+				Description: "The [predefined ACL](https://yandex.cloud/docs/storage/concepts/acl#predefined_acls) to apply to every object. Defaults to `private`. Changing it re-uploads all objects.",
+				Default:     "private",
+				Optional:    true,
+			},
+
+			"content_types": {
+				Type:        schema.TypeMap,
+				Description: "Content types by file extension (including the leading dot, e.g. `.html`), overriding the built-in detection. Files with unknown extensions are uploaded as `application/octet-stream`. Changing it re-uploads all objects.",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+
+			"delete_orphans": {
+				Type:        schema.TypeBool,
+				Description: "If `true`, objects under `prefix` that are not present in `source_dir` are deleted from the bucket. Defaults to `false`.",
+				Optional:    true,
+				Default:     false,
+			},
+
+			"parallelism": {
+				Type:         schema.TypeInt,
+				Description:  "The number of objects uploaded or deleted simultaneously. Defaults to `10`.",
+				Optional:     true,
+				Default:      defaultStorageObjectsParallelism,
+				ValidateFunc: validation.IntBetween(1, 100),
+			},
+
+			"files": {
+				Type:        schema.TypeMap,
+				Description: "Map of uploaded file paths relative to `source_dir` to the MD5 hashes of their content.",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceYandexStorageObjectsCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("source_dir") {
+		return d.SetNewComputed("files")
+	}
+
+	files, err := collectStorageObjectsFiles(d.Get("source_dir").(string))
+	if err != nil {
+		return err
+	}
+
+	hashes := make(map[string]interface{}, len(files))
+	for name, file := range files {
+		hashes[name] = file.Hash
+	}
+
+	if d.Id() != "" && storageObjectsHashesEqual(d.Get("files").(map[string]interface{}), hashes) {
+		return nil
+	}
+	return d.SetNew("files", hashes)
+}
+
+func resourceYandexStorageObjectsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	s3Client, err := getS3Client(ctx, d, config)
+	if err != nil {
+		return diag.Errorf("error getting storage client: %s", err)
+	}
+
+	files, err := collectStorageObjectsFiles(d.Get("source_dir").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	bucket := d.Get("bucket").(string)
+	prefix := d.Get("prefix").(string)
+
+	log.Printf("[DEBUG] Uploading %d storage objects to bucket %q with prefix %q", len(files), bucket, prefix)
+	uploaded, err := uploadStorageObjectsFiles(ctx, s3Client, d, files, storageObjectsSortedNames(files))
+	if err == nil || len(uploaded) > 0 {
+		// Keep partially uploaded objects in the state, so that they are cleaned up on destroy.
+		d.SetId(storageObjectsID(bucket, prefix))
+	}
+	if setErr := d.Set("files", uploaded); setErr != nil {
+		return diag.Errorf("error setting files: %s", setErr)
+	}
+	if err != nil {
+		return diag.Errorf("error uploading storage objects: %s", err)
+	}
+
+	if d.Get("delete_orphans").(bool) {
+		if err := deleteStorageObjectsOrphans(ctx, s3Client, d, files); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceYandexStorageObjectsRead(ctx, d, meta)
+}
+
+func resourceYandexStorageObjectsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	s3Client, err := getS3Client(ctx, d, config)
+	if err != nil {
+		return diag.Errorf("error getting storage client: %s", err)
+	}
+
+	bucket := d.Get("bucket").(string)
+	prefix := d.Get("prefix").(string)
+
+	objects, err := s3Client.ListObjects(ctx, bucket, prefix)
+	if err != nil {
+		if errors.Is(err, s3.ErrBucketNotFound) {
+			log.Printf("[WARN] Storage Bucket (%s) not found, removing storage objects from state", bucket)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	remote := make(map[string]struct{}, len(objects))
+	for _, object := range objects {
+		remote[strings.TrimPrefix(object.Key, prefix)] = struct{}{}
+	}
+
+	files := make(map[string]interface{})
+	for name, hash := range d.Get("files").(map[string]interface{}) {
+		// Objects removed outside of terraform are dropped from the state,
+		// so that they are uploaded again on the next apply.
+		if _, ok := remote[name]; ok {
+			files[name] = hash
+		}
+	}
+	if d.Get("delete_orphans").(bool) {
+		// Orphans are tracked with an empty hash, so that the plan shows their removal.
+		for name := range remote {
+			if _, ok := files[name]; !ok {
+				files[name] = ""
+			}
+		}
+	}
+
+	if err := d.Set("files", files); err != nil {
+		return diag.Errorf("error setting files: %s", err)
+	}
+
+	return nil
+}
+
+func resourceYandexStorageObjectsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	s3Client, err := getS3Client(ctx, d, config)
+	if err != nil {
+		return diag.Errorf("error getting storage client: %s", err)
+	}
+
+	files, err := collectStorageObjectsFiles(d.Get("source_dir").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	oldRaw, _ := d.GetChange("files")
+	oldFiles := oldRaw.(map[string]interface{})
+	reuploadAll := d.HasChanges("acl", "content_types")
+
+	var changed []string
+	for _, name := range storageObjectsSortedNames(files) {
+		if reuploadAll || oldFiles[name] != files[name].Hash {
+			changed = append(changed, name)
+		}
+	}
+
+	var removed []string
+	for name := range oldFiles {
+		if _, ok := files[name]; !ok {
+			removed = append(removed, name)
+		}
+	}
+	sort.Strings(removed)
+
+	log.Printf("[DEBUG] Updating storage objects: %d to upload, %d to delete", len(changed), len(removed))
+	uploaded, uploadErr := uploadStorageObjectsFiles(ctx, s3Client, d, files, changed)
+	deleted, deleteErr := deleteStorageObjects(ctx, s3Client, d, removed)
+
+	state := make(map[string]interface{}, len(files))
+	for name, hash := range oldFiles {
+		if _, ok := deleted[name]; !ok {
+			state[name] = hash
+		}
+	}
+	for name, hash := range uploaded {
+		state[name] = hash
+	}
+	if err := d.Set("files", state); err != nil {
+		return diag.Errorf("error setting files: %s", err)
+	}
+
+	result := &multierror.Error{}
+	if uploadErr != nil {
+		result = multierror.Append(result, fmt.Errorf("error uploading storage objects: %w", uploadErr))
+	}
+	if deleteErr != nil {
+		result = multierror.Append(result, fmt.Errorf("error deleting storage objects: %w", deleteErr))
+	}
+	if err := result.ErrorOrNil(); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceYandexStorageObjectsRead(ctx, d, meta)
+}
+
+func resourceYandexStorageObjectsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	s3Client, err := getS3Client(ctx, d, config)
+	if err != nil {
+		return diag.Errorf("error getting storage client: %s", err)
+	}
+
+	names := make([]string, 0)
+	for name := range d.Get("files").(map[string]interface{}) {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	log.Printf("[DEBUG] Deleting %d storage objects from bucket %q", len(names), d.Get("bucket").(string))
+	if _, err := deleteStorageObjects(ctx, s3Client, d, names); err != nil {
+		return diag.Errorf("error deleting storage objects: %s", err)
+	}
+
+	return nil
+}
+
+type storageObjectsFile struct {
+	Path string
+	Hash string
+}
+
+// collectStorageObjectsFiles walks the directory tree and returns regular files
+// keyed by their slash-separated paths relative to the directory.
+func collectStorageObjectsFiles(dir string) (map[string]storageObjectsFile, error) {
+	root, err := homedir.Expand(dir)
+	if err != nil {
+		return nil, fmt.Errorf("error expanding homedir in source_dir (%s): %w", dir, err)
+	}
+
+	files := make(map[string]storageObjectsFile)
+	err = filepath.WalkDir(root, func(p string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !entry.Type().IsRegular() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(root, p)
+		if err != nil {
+			return err
+		}
+		hash, err := storageObjectsFileMD5(p)
+		if err != nil {
+			return err
+		}
+
+		files[filepath.ToSlash(rel)] = storageObjectsFile{
+			Path: p,
+			Hash: hash,
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error reading source_dir (%s): %w", dir, err)
+	}
+
+	return files, nil
+}
+
+func storageObjectsFileMD5(p string) (string, error) {
+	file, err := os.Open(p)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	hash := md5.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+func storageObjectsContentType(name string, overrides map[string]interface{}) string {
+	ext := strings.ToLower(path.Ext(name))
+	if v, ok := overrides[ext]; ok {
+		return v.(string)
+	}
+	if v, ok := storageObjectsContentTypes[ext]; ok {
+		return v
+	}
+	if v := mime.TypeByExtension(ext); v != "" {
+		return v
+	}
+	return defaultStorageObjectsContentType
+}
+
+func uploadStorageObjectsFiles(
+	ctx context.Context,
+	s3Client *s3.Client,
+	d *schema.ResourceData,
+	files map[string]storageObjectsFile,
+	names []string,
+) (map[string]interface{}, error) {
+	bucket := d.Get("bucket").(string)
+	prefix := d.Get("prefix").(string)
+	acl := d.Get("acl").(string)
+	overrides := d.Get("content_types").(map[string]interface{})
+
+	var mu sync.Mutex
+	uploaded := make(map[string]interface{}, len(names))
+	err := runStorageObjectsParallel(ctx, d.Get("parallelism").(int), names, func(name string) error {
+		file := files[name]
+		_, err := s3Client.CreateObject(ctx, s3.CreationData{
+			Source: &s3.Source{
+				Type:  s3.SourceTypeFile,
+				Value: file.Path,
+			},
+			Bucket:      bucket,
+			Key:         prefix + name,
+			ACL:         acl,
+			ContentType: storageObjectsContentType(name, overrides),
+		})
+		if err != nil {
+			return err
+		}
+
+		mu.Lock()
+		uploaded[name] = file.Hash
+		mu.Unlock()
+		return nil
+	})
+
+	return uploaded, err
+}
+
+func deleteStorageObjects(
+	ctx context.Context,
+	s3Client *s3.Client,
+	d *schema.ResourceData,
+	names []string,
+) (map[string]struct{}, error) {
+	bucket := d.Get("bucket").(string)
+	prefix := d.Get("prefix").(string)
+
+	var mu sync.Mutex
+	deleted := make(map[string]struct{}, len(names))
+	err := runStorageObjectsParallel(ctx, d.Get("parallelism").(int), names, func(name string) error {
+		if err := s3Client.DeleteObject(ctx, bucket, prefix+name); err != nil {
+			return err
+		}
+
+		mu.Lock()
+		deleted[name] = struct{}{}
+		mu.Unlock()
+		return nil
+	})
+
+	return deleted, err
+}
+
+func deleteStorageObjectsOrphans(
+	ctx context.Context,
+	s3Client *s3.Client,
+	d *schema.ResourceData,
+	files map[string]storageObjectsFile,
+) error {
+	bucket := d.Get("bucket").(string)
+	prefix := d.Get("prefix").(string)
+
+	objects, err := s3Client.ListObjects(ctx, bucket, prefix)
+	if err != nil {
+		return err
+	}
+
+	var orphans []string
+	for _, object := range objects {
+		name := strings.TrimPrefix(object.Key, prefix)
+		if _, ok := files[name]; !ok {
+			orphans = append(orphans, name)
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting %d orphaned storage objects from bucket %q with prefix %q", len(orphans), bucket, prefix)
+	if _, err := deleteStorageObjects(ctx, s3Client, d, orphans); err != nil {
+		return fmt.Errorf("error deleting orphaned storage objects: %w", err)
+	}
+	return nil
+}
+
+// runStorageObjectsParallel calls f for every name running at most parallelism calls simultaneously.
+// All names are processed even if some calls fail, the errors are combined.
+func runStorageObjectsParallel(ctx context.Context, parallelism int, names []string, f func(name string) error) error {
+	if parallelism < 1 {
+		parallelism = 1
+	}
+
+	var (
+		wg     sync.WaitGroup
+		mu     sync.Mutex
+		result = &multierror.Error{}
+		sem    = make(chan struct{}, parallelism)
+	)
+	for _, name := range names {
+		if err := ctx.Err(); err != nil {
+			mu.Lock()
+			result = multierror.Append(result, err)
+			mu.Unlock()
+			break
+		}
+
+		sem <- struct{}{}
+		wg.Add(1)
+		go func(name string) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			if err := f(name); err != nil {
+				mu.Lock()
+				result = multierror.Append(result, fmt.Errorf("%s: %w", name, err))
+				mu.Unlock()
+			}
+		}(name)
+	}
+	wg.Wait()
+
+	return result.ErrorOrNil()
+}
+
+func storageObjectsSortedNames(files map[string]storageObjectsFile) []string {
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func storageObjectsHashesEqual(a, b map[string]interface{}) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for name, hash := range a {
+		if v, ok := b[name]; !ok || v != hash {
+			return false
+		}
+	}
+	return true
+}
+
+func storageObjectsID(bucket, prefix string) string {
+	return bucket + "/" + prefix
+}
@@ -0,0 +1,248 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsS3 "github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccStorageObjects_basic(t *testing.T) {
+	resourceName := "yandex_storage_objects.test"
+	rInt := acctest.RandInt()
+
+	dir := testAccStorageObjectsCreateTempDir(t, map[string]string{
+		"index.html":    "<html></html>",
+		"css/main.css":  "body {}",
+		"js/app.min.js": "console.log(1)",
+	})
+	defer os.RemoveAll(dir)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactoriesV6,
+		CheckDestroy:             testAccCheckStorageObjectsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStorageObjectsConfig(rInt, dir),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "files.%", "3"),
+					testAccCheckStorageObjectsKeys(resourceName, "site/css/main.css", "site/index.html", "site/js/app.min.js"),
+					testAccCheckStorageObjectsContentType(resourceName, "site/index.html", "text/html; charset=utf-8"),
+					testAccCheckStorageObjectsContentType(resourceName, "site/css/main.css", "text/css; charset=utf-8"),
+				),
+			},
+			{
+				PreConfig: func() {
+					testAccStorageObjectsWriteFile(t, dir, "index.html", "<html><body></body></html>")
+					testAccStorageObjectsWriteFile(t, dir, "img/logo.svg", "<svg></svg>")
+					require.NoError(t, os.Remove(filepath.Join(dir, "js", "app.min.js")))
+				},
+				Config: testAccStorageObjectsConfig(rInt, dir),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "files.%", "3"),
+					testAccCheckStorageObjectsKeys(resourceName, "site/css/main.css", "site/img/logo.svg", "site/index.html"),
+					testAccCheckStorageObjectsContentType(resourceName, "site/img/logo.svg", "image/svg+xml"),
+				),
+			},
+			{
+				Config:   testAccStorageObjectsConfig(rInt, dir),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestStorageObjectsCollectFiles(t *testing.T) {
+	dir := testAccStorageObjectsCreateTempDir(t, map[string]string{
+		"index.html":   "hello",
+		"a/b/c/d.json": "{}",
+	})
+	defer os.RemoveAll(dir)
+
+	files, err := collectStorageObjectsFiles(dir)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"a/b/c/d.json", "index.html"}, storageObjectsSortedNames(files))
+	assert.Equal(t, "5d41402abc4b2a76b9719d911017c592", files["index.html"].Hash)
+	assert.Equal(t, filepath.Join(dir, "a", "b", "c", "d.json"), files["a/b/c/d.json"].Path)
+
+	_, err = collectStorageObjectsFiles(filepath.Join(dir, "missing"))
+	assert.Error(t, err)
+}
+
+func TestStorageObjectsContentType(t *testing.T) {
+	overrides := map[string]interface{}{
+		".html": "text/html",
+		".bin":  "application/x-custom",
+	}
+
+	tests := []struct {
+		name string
+		want string
+	}{
+		{name: "index.html", want: "text/html"},
+		{name: "assets/APP.JS", want: "text/javascript; charset=utf-8"},
+		{name: "fonts/font.woff2", want: "font/woff2"},
+		{name: "data.bin", want: "application/x-custom"},
+		{name: "LICENSE", want: "application/octet-stream"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, storageObjectsContentType(tt.name, overrides))
+		})
+	}
+}
+
+func TestStorageObjectsRunParallel(t *testing.T) {
+	names := []string{"a", "b", "c", "d", "e"}
+
+	visited := make(chan string, len(names))
+	err := runStorageObjectsParallel(context.Background(), 2, names, func(name string) error {
+		visited <- name
+		if name == "c" {
+			return fmt.Errorf("failed")
+		}
+		return nil
+	})
+	close(visited)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "c: failed")
+
+	var got []string
+	for name := range visited {
+		got = append(got, name)
+	}
+	sort.Strings(got)
+	assert.Equal(t, names, got)
+}
+
+func testAccStorageObjectsCreateTempDir(t *testing.T, files map[string]string) string {
+	dir, err := os.MkdirTemp("", "tf-acc-storage-objects")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, data := range files {
+		testAccStorageObjectsWriteFile(t, dir, name, data)
+	}
+
+	return dir
+}
+
+func testAccStorageObjectsWriteFile(t *testing.T, dir, name, data string) {
+	p := filepath.Join(dir, filepath.FromSlash(name))
+	if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(p, []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func testAccCheckStorageObjectsDestroy(s *terraform.State) error {
+	s3Client, err := getS3ClientByKeys(context.TODO(), "", "", testAccProvider.Meta().(*Config))
+	if err != nil {
+		return err
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "yandex_storage_objects" {
+			continue
+		}
+
+		objects, err := s3Client.ListObjects(context.TODO(), rs.Primary.Attributes["bucket"], rs.Primary.Attributes["prefix"])
+		if err != nil {
+			continue
+		}
+		if len(objects) > 0 {
+			return fmt.Errorf("storage objects still exist: %s", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccCheckStorageObjectsKeys(n string, want ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		s3Client, err := getS3ClientByKeys(context.TODO(), "", "", testAccProvider.Meta().(*Config))
+		if err != nil {
+			return err
+		}
+
+		objects, err := s3Client.ListObjects(context.TODO(), rs.Primary.Attributes["bucket"], rs.Primary.Attributes["prefix"])
+		if err != nil {
+			return err
+		}
+
+		got := make([]string, 0, len(objects))
+		for _, object := range objects {
+			got = append(got, object.Key)
+		}
+		sort.Strings(got)
+		if fmt.Sprint(got) != fmt.Sprint(want) {
+			return fmt.Errorf("expected storage objects %v, got %v", want, got)
+		}
+		return nil
+	}
+}
+
+func testAccCheckStorageObjectsContentType(n, key, want string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		s3Client, err := getS3ClientByKeys(context.TODO(), "", "", testAccProvider.Meta().(*Config))
+		if err != nil {
+			return err
+		}
+
+		out, err := s3Client.S3().HeadObject(&awsS3.HeadObjectInput{
+			Bucket: aws.String(rs.Primary.Attributes["bucket"]),
+			Key:    aws.String(key),
+		})
+		if err != nil {
+			return fmt.Errorf("storage object %q error: %s", key, err)
+		}
+		if got := aws.StringValue(out.ContentType); got != want {
+			return fmt.Errorf("expected content type of %q to be %q, got %q", key, want, got)
+		}
+		return nil
+	}
+}
+
+func testAccStorageObjectsConfig(randInt int, dir string) string {
+	bucketConfig := newBucketConfigBuilder(randInt).asEditor().render()
+
+	objectsConfig := fmt.Sprintf(`
+resource "yandex_storage_objects" "test" {
+	bucket = "${yandex_storage_bucket.test.bucket}"
+
+	access_key = yandex_iam_service_account_static_access_key.sa-key.access_key
+	secret_key = yandex_iam_service_account_static_access_key.sa-key.secret_key
+
+	prefix         = "site/"
+	source_dir     = "%s"
+	delete_orphans = true
+}
+`, dir)
+
+	return bucketConfig + objectsConfig
+}
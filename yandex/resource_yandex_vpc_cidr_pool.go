@@ -0,0 +1,180 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"google.golang.org/grpc/codes"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/vpc/v1"
+	vpcsdk "github.com/yandex-cloud/go-sdk/services/vpc/v1"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/cidrpool"
+)
+
+// vpcCidrPoolAllocationAttempts limits allocation of a subnet block when the allocated blocks are taken concurrently.
+const vpcCidrPoolAllocationAttempts = 5
+
+func resourceYandexVPCCidrPool() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manages a pool of IPv4 address blocks within a network. Subnets with `cidr_pool_id` get the next free block of the pool that does not overlap existing subnets of the network.\n\n~> The pool is managed by the provider only and is not stored in the Yandex Cloud. Its ID contains the network ID and the blocks of the pool, so the same pool may be declared in several configurations.\n",
+
+		Create: resourceYandexVPCCidrPoolCreate,
+		Read:   resourceYandexVPCCidrPoolRead,
+		Delete: resourceYandexVPCCidrPoolDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		SchemaVersion: 0,
+
+		Schema: map[string]*schema.Schema{
+			"network_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the network the subnets of the pool belong to.",
+				Required:    true,
+				ForceNew:    true,
+			},
+
+			"v4_cidr_blocks": {
+				Type:        schema.TypeList,
+				Description: "A list of non-overlapping blocks of IPv4 addresses to allocate subnet blocks from, e.g. `10.0.0.0/16`. Blocks are used in the order they are listed. Adding a block does not recreate subnets allocated from the pool.",
+				Required:    true,
+				ForceNew:    true,
+				MinItems:    1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateCidrBlocks,
+				},
+			},
+
+			"allocated_v4_cidr_blocks": {
+				Type:        schema.TypeList,
+				Description: "Blocks of the existing subnets of the network that are inside the pool.",
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func resourceYandexVPCCidrPoolCreate(d *schema.ResourceData, meta interface{}) error {
+	supernets, err := cidrpool.ParseSupernets(expandStringSlice(d.Get("v4_cidr_blocks").([]interface{})))
+	if err != nil {
+		return fmt.Errorf("Error parsing v4_cidr_blocks of CIDR pool: %s", err)
+	}
+
+	d.SetId(cidrpool.FormatID(d.Get("network_id").(string), supernets))
+
+	return resourceYandexVPCCidrPoolRead(d, meta)
+}
+
+func resourceYandexVPCCidrPoolRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	networkID, supernets, err := cidrpool.ParseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	ctx := config.Context()
+	_, err = vpcsdk.NewNetworkClient(config.SDK).Get(ctx, &vpc.GetNetworkRequest{
+		NetworkId: networkID,
+	})
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("Network %q of CIDR pool", networkID))
+	}
+
+	used, err := listVPCNetworkV4CidrBlocks(ctx, config, networkID)
+	if err != nil {
+		return err
+	}
+
+	allocated := []string{}
+	for _, block := range used {
+		if cidrpool.Contains(supernets, block) {
+			allocated = append(allocated, block.String())
+		}
+	}
+
+	blocks := make([]string, len(supernets))
+	for i, s := range supernets {
+		blocks[i] = s.String()
+	}
+
+	d.Set("network_id", networkID)
+	if err := d.Set("v4_cidr_blocks", blocks); err != nil {
+		return err
+	}
+	return d.Set("allocated_v4_cidr_blocks", allocated)
+}
+
+func resourceYandexVPCCidrPoolDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] Removing CIDR pool %q from state, subnets allocated from it are kept", d.Id())
+	d.SetId("")
+	return nil
+}
+
+// listVPCNetworkV4CidrBlocks returns the IPv4 blocks of all subnets of the network.
+func listVPCNetworkV4CidrBlocks(ctx context.Context, config *Config, networkID string) ([]netip.Prefix, error) {
+	client := vpcsdk.NewNetworkClient(config.SDK)
+
+	var cidrs []string
+	req := &vpc.ListNetworkSubnetsRequest{NetworkId: networkID}
+	for {
+		resp, err := client.ListSubnets(ctx, req)
+		if err != nil {
+			return nil, fmt.Errorf("Error while requesting API to list subnets of Network %q: %s", networkID, err)
+		}
+		for _, subnet := range resp.GetSubnets() {
+			cidrs = append(cidrs, subnet.GetV4CidrBlocks()...)
+		}
+		if resp.GetNextPageToken() == "" {
+			break
+		}
+		req.PageToken = resp.GetNextPageToken()
+	}
+
+	return cidrpool.ParseUsed(cidrs)
+}
+
+// allocateVPCSubnetV4CidrBlock returns the next free block of the pool for a subnet of the network.
+// The caller must hold vpcCidrPoolMutexKey of the network until the subnet is created.
+func allocateVPCSubnetV4CidrBlock(ctx context.Context, config *Config, poolID, networkID string, prefixLength int) (string, error) {
+	poolNetworkID, supernets, err := cidrpool.ParseID(poolID)
+	if err != nil {
+		return "", err
+	}
+	if poolNetworkID != networkID {
+		return "", fmt.Errorf("CIDR pool %q belongs to network %q, not to network %q of the subnet", poolID, poolNetworkID, networkID)
+	}
+
+	used, err := listVPCNetworkV4CidrBlocks(ctx, config, networkID)
+	if err != nil {
+		return "", err
+	}
+
+	block, err := cidrpool.Allocate(supernets, used, prefixLength)
+	if err != nil {
+		return "", fmt.Errorf("Error allocating subnet block from CIDR pool %q: %s", poolID, err)
+	}
+
+	log.Printf("[DEBUG] Allocated block %s from CIDR pool %q", block, poolID)
+	return block.String(), nil
+}
+
+// isVPCSubnetCidrConflict reports whether the subnet was not created because its block overlaps
+// with another subnet of the network, the API reports it with the AlreadyExists or FailedPrecondition code.
+func isVPCSubnetCidrConflict(err error) bool {
+	return isStatusWithCode(err, codes.AlreadyExists) || isStatusWithCode(err, codes.FailedPrecondition)
+}
+
+// vpcCidrPoolMutexKey serializes allocation of subnet blocks within a network.
+func vpcCidrPoolMutexKey(networkID string) string {
+	return fmt.Sprintf("vpc-cidr-pool-%s", networkID)
+}
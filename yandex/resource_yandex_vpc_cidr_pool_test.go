@@ -0,0 +1,117 @@
+package yandex
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestIsVPCSubnetCidrConflict(t *testing.T) {
+	cases := map[codes.Code]bool{
+		codes.AlreadyExists:      true,
+		codes.FailedPrecondition: true,
+		codes.InvalidArgument:    false,
+		codes.PermissionDenied:   false,
+	}
+	for code, expected := range cases {
+		err := fmt.Errorf("error while requesting API to create subnet: %w", status.Error(code, "v4_cidr_blocks overlap"))
+		if actual := isVPCSubnetCidrConflict(err); actual != expected {
+			t.Errorf("isVPCSubnetCidrConflict(%s) = %t, expected %t", code, actual, expected)
+		}
+	}
+}
+
+func TestAccVPCCidrPool_basic(t *testing.T) {
+	t.Parallel()
+
+	networkName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVPCSubnetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCCidrPool_basic(networkName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("yandex_vpc_cidr_pool.pool", "v4_cidr_blocks.#", "1"),
+					resource.TestCheckResourceAttr("yandex_vpc_subnet.manual", "v4_cidr_blocks.0", "10.10.0.0/24"),
+					resource.TestCheckResourceAttr("yandex_vpc_subnet.pooled.0", "v4_cidr_blocks.#", "1"),
+					resource.TestCheckResourceAttr("yandex_vpc_subnet.pooled.2", "v4_cidr_blocks.#", "1"),
+					testAccCheckVPCCidrPoolSubnetsDoNotOverlap("yandex_vpc_subnet.manual", "yandex_vpc_subnet.pooled.0", "yandex_vpc_subnet.pooled.1", "yandex_vpc_subnet.pooled.2"),
+				),
+			},
+			{
+				Config: testAccVPCCidrPool_basic(networkName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("yandex_vpc_cidr_pool.pool", "allocated_v4_cidr_blocks.#", "4"),
+				),
+			},
+			{
+				ResourceName:      "yandex_vpc_cidr_pool.pool",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:            "yandex_vpc_subnet.pooled.0",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"cidr_pool_id", "prefix_length"},
+			},
+		},
+	})
+}
+
+func testAccCheckVPCCidrPoolSubnetsDoNotOverlap(names ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		seen := map[string]string{}
+		for _, name := range names {
+			rs, ok := s.RootModule().Resources[name]
+			if !ok {
+				return fmt.Errorf("Not found: %s", name)
+			}
+
+			block := rs.Primary.Attributes["v4_cidr_blocks.0"]
+			if other, ok := seen[block]; ok {
+				return fmt.Errorf("Subnets %s and %s have the same block %s", other, name, block)
+			}
+			seen[block] = name
+		}
+		return nil
+	}
+}
+
+//revive:disable:var-naming
+func testAccVPCCidrPool_basic(networkName string) string {
+	return fmt.Sprintf(`
+resource "yandex_vpc_network" "foo" {
+  name = "%s"
+}
+
+resource "yandex_vpc_cidr_pool" "pool" {
+  network_id     = yandex_vpc_network.foo.id
+  v4_cidr_blocks = ["10.10.0.0/16"]
+}
+
+resource "yandex_vpc_subnet" "manual" {
+  zone           = "ru-central1-a"
+  network_id     = yandex_vpc_network.foo.id
+  v4_cidr_blocks = ["10.10.0.0/24"]
+}
+
+resource "yandex_vpc_subnet" "pooled" {
+  count      = 3
+  depends_on = [yandex_vpc_subnet.manual]
+
+  zone          = "ru-central1-a"
+  network_id    = yandex_vpc_network.foo.id
+  cidr_pool_id  = yandex_vpc_cidr_pool.pool.id
+  prefix_length = 24
+}
+`, networkName)
+}
@@ -29,7 +29,7 @@ func yandexVPCDefaultSecurityGroupSchema() map[string]*schema.Schema {
 
 func resourceYandexVPCDefaultSecurityGroup() *schema.Resource {
 	return &schema.Resource{
-		Description: "Manages a Default Security Group within the Yandex Cloud. For more information, see the official documentation of [security group](https://yandex.cloud/docs/vpc/concepts/security-groups) or [default security group](https://yandex.cloud/docs/vpc/concepts/security-groups#default-security-group).\n\n~> This resource is not intended for managing security group in general case. To manage normal security group use [yandex_vpc_security_group](vpc_security_group.html)\n\nWhen [network](https://yandex.cloud/docs/vpc/concepts/network) is created, a non-removable security group, called a *default security group*, is automatically attached to it. Life time of default security group cannot be controlled, so in fact the resource `yandex_vpc_default_security_group` does not create or delete any security groups, instead it simply takes or releases control of the default security group.\n\n~> When Terraform takes over management of the default security group, it **deletes** all info in it (including security group rules) and replace it with specified configuration. When Terraform drops the management (i.e. when resource is deleted from statefile and management), the state of the security group **remains the same** as it was before the deletion.\n\n~> Duplicating a resource (specifying same `network_id` for two different default security groups) will cause errors in the apply stage of your's configuration.\n",
+		Description: "Manages a Default Security Group within the Yandex Cloud. For more information, see the official documentation of [security group](https://yandex.cloud/docs/vpc/concepts/security-groups) or [default security group](https://yandex.cloud/docs/vpc/concepts/security-groups#default-security-group).\n\n~> This resource is not intended for managing security group in general case. To manage normal security group use [yandex_vpc_security_group](vpc_security_group.html)\n\nWhen [network](https://yandex.cloud/docs/vpc/concepts/network) is created, a non-removable security group, called a *default security group*, is automatically attached to it. Life time of default security group cannot be controlled, so in fact the resource `yandex_vpc_default_security_group` does not create or delete any security groups, instead it simply takes or releases control of the default security group.\n\n~> When Terraform takes over management of the default security group, it **deletes** all info in it (including security group rules) and replace it with specified configuration. When Terraform drops the management (i.e. when resource is deleted from statefile and management), the state of the security group **remains the same** as it was before the deletion.\n\n~> Duplicating a resource (specifying same `network_id` for two different default security groups) will cause errors in the apply stage of your's configuration.\n\n~> Rules that are not declared by `ingress` and `egress` blocks, e.g. created by `yandex_vpc_security_group_rule` resources, are ignored unless `exclusive` is `true`. The plan warns about duplicate and shadowed rules, rules with several targets and rules that reference a missing security group are rejected.\n\n~> **Upgrade note:** previous versions of the provider showed the rules that are not declared by `ingress` and `egress` blocks as drift and removed them on apply. To keep this behaviour, set `exclusive = true`.\n",
 		Create:      resourceYandexVPCDefaultSecurityGroupCreate,
 		Read:        resourceYandexVPCDefaultSecurityGroupRead,
 		Update:      resourceYandexVPCDefaultSecurityGroupUpdate,
@@ -39,6 +39,11 @@ func resourceYandexVPCDefaultSecurityGroup() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: resourceYandexVPCSecurityGroupCustomizeDiff,
+		ValidateRawResourceConfigFuncs: []schema.ValidateRawResourceConfigFunc{
+			resourceYandexVPCSecurityGroupValidateRuleConflicts,
+		},
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(yandexVPCDefaultSecurityGroupDefaultTimeout),
 			Read:   schema.DefaultTimeout(yandexVPCDefaultSecurityGroupDefaultTimeout),
@@ -128,7 +133,7 @@ func resourceYandexVPCDefaultSecurityGroupCreate(d *schema.ResourceData, meta in
 }
 
 func resourceYandexVPCDefaultSecurityGroupRead(d *schema.ResourceData, meta interface{}) error {
-	return yandexVPCSecurityGroupRead(d, meta, d.Id())
+	return resourceYandexVPCSecurityGroupRead(d, meta)
 }
 
 func resourceYandexVPCDefaultSecurityGroupUpdate(d *schema.ResourceData, meta interface{}) error {
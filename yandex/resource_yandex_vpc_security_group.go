@@ -4,17 +4,22 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"log"
 	"reflect"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/vpc/v1"
 	vpcsdk "github.com/yandex-cloud/go-sdk/services/vpc/v1"
 	"github.com/yandex-cloud/terraform-provider-yandex/common"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/sgrules"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex/internal/hashcode"
 	"google.golang.org/genproto/protobuf/field_mask"
+	"google.golang.org/grpc/codes"
 )
 
 const yandexVPCSecurityGroupDefaultTimeout = 3 * time.Minute
@@ -76,6 +81,13 @@ func yandexVPCSecurityGroupSchema() map[string]*schema.Schema {
 			Set:         resourceYandexVPCSecurityGroupRuleHash,
 		},
 
+		"exclusive": {
+			Type:        schema.TypeBool,
+			Description: "If `true`, rules of the security group that are not declared by `ingress` and `egress` blocks, e.g. created by `yandex_vpc_security_group_rule` resources or by hand, are read into `ingress` and `egress` and removed on apply. By default such rules are ignored. Rules of a direction are removed only if blocks of the direction are declared. Default is `false`.",
+			Optional:    true,
+			Default:     false,
+		},
+
 		"status": {
 			Type:        schema.TypeString,
 			Description: "Status of this security group.",
@@ -92,7 +104,7 @@ func yandexVPCSecurityGroupSchema() map[string]*schema.Schema {
 
 func resourceYandexVPCSecurityGroup() *schema.Resource {
 	return &schema.Resource{
-		Description: "Manages a Default Security Group within the Yandex Cloud. For more information, see the official documentation of [security group](https://yandex.cloud/docs/vpc/concepts/security-groups) or [default security group](https://yandex.cloud/docs/vpc/concepts/security-groups#default-security-group).\n\n~> This resource is not intended for managing security group in general case. To manage normal security group use [yandex_vpc_security_group](vpc_security_group.html)\n\nWhen [network](https://yandex.cloud/docs/vpc/concepts/network) is created, a non-removable security group, called a *default security group*, is automatically attached to it. Life time of default security group cannot be controlled, so in fact the resource `yandex_vpc_default_security_group` does not create or delete any security groups, instead it simply takes or releases control of the default security group.\n\n~> When Terraform takes over management of the default security group, it **deletes** all info in it (including security group rules) and replace it with specified configuration. When Terraform drops the management (i.e. when resource is deleted from statefile and management), the state of the security group **remains the same** as it was before the deletion.\n\n~> Duplicating a resource (specifying same `network_id` for two different default security groups) will cause errors in the apply stage of your's configuration.\n",
+		Description: "Manages a Default Security Group within the Yandex Cloud. For more information, see the official documentation of [security group](https://yandex.cloud/docs/vpc/concepts/security-groups) or [default security group](https://yandex.cloud/docs/vpc/concepts/security-groups#default-security-group).\n\n~> This resource is not intended for managing security group in general case. To manage normal security group use [yandex_vpc_security_group](vpc_security_group.html)\n\nWhen [network](https://yandex.cloud/docs/vpc/concepts/network) is created, a non-removable security group, called a *default security group*, is automatically attached to it. Life time of default security group cannot be controlled, so in fact the resource `yandex_vpc_default_security_group` does not create or delete any security groups, instead it simply takes or releases control of the default security group.\n\n~> When Terraform takes over management of the default security group, it **deletes** all info in it (including security group rules) and replace it with specified configuration. When Terraform drops the management (i.e. when resource is deleted from statefile and management), the state of the security group **remains the same** as it was before the deletion.\n\n~> Duplicating a resource (specifying same `network_id` for two different default security groups) will cause errors in the apply stage of your's configuration.\n\n~> Rules that are not declared by `ingress` and `egress` blocks, e.g. created by `yandex_vpc_security_group_rule` resources, are ignored unless `exclusive` is `true`. The plan warns about duplicate and shadowed rules, rules with several targets and rules that reference a missing security group are rejected.\n\n~> **Upgrade note:** previous versions of the provider showed the rules that are not declared by `ingress` and `egress` blocks as drift and removed them on apply. To keep this behaviour, set `exclusive = true`.\n",
 
 		Create: resourceYandexVPCSecurityGroupCreate,
 		Read:   resourceYandexVPCSecurityGroupRead,
@@ -102,6 +114,11 @@ func resourceYandexVPCSecurityGroup() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: resourceYandexVPCSecurityGroupCustomizeDiff,
+		ValidateRawResourceConfigFuncs: []schema.ValidateRawResourceConfigFunc{
+			resourceYandexVPCSecurityGroupValidateRuleConflicts,
+		},
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(yandexVPCSecurityGroupDefaultTimeout),
 			Update: schema.DefaultTimeout(yandexVPCSecurityGroupDefaultTimeout),
@@ -239,10 +256,17 @@ func resourceYandexVPCSecurityGroupCreate(d *schema.ResourceData, meta interface
 }
 
 func resourceYandexVPCSecurityGroupRead(d *schema.ResourceData, meta interface{}) error {
-	return yandexVPCSecurityGroupRead(d, meta, d.Id())
+	// exclusive is not stored in the cloud, keep the configured value or set the default after import
+	if err := d.Set("exclusive", d.Get("exclusive").(bool)); err != nil {
+		return err
+	}
+
+	return yandexVPCSecurityGroupRead(d, meta, d.Id(), securityGroupDeclaredRuleIDs(d, nil))
 }
 
-func yandexVPCSecurityGroupRead(d *schema.ResourceData, meta interface{}, id string) error {
+// yandexVPCSecurityGroupRead reads the security group into d. If declaredRuleIDs is not nil, only rules
+// with these IDs are read into ingress and egress.
+func yandexVPCSecurityGroupRead(d *schema.ResourceData, meta interface{}, id string, declaredRuleIDs map[string]bool) error {
 	config := meta.(*Config)
 
 	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutRead))
@@ -277,7 +301,19 @@ func yandexVPCSecurityGroupRead(d *schema.ResourceData, meta interface{}, id str
 		return err
 	}
 
-	ingress, egress := flattenSecurityGroupRulesSpec(securityGroup.Rules)
+	rules := securityGroup.GetRules()
+	if declaredRuleIDs != nil {
+		rules = make([]*vpc.SecurityGroupRule, 0, len(declaredRuleIDs))
+		for _, r := range securityGroup.GetRules() {
+			if declaredRuleIDs[r.GetId()] {
+				rules = append(rules, r)
+			} else {
+				log.Printf("[DEBUG] Ignoring rule %q of Security group %q that is not declared by ingress or egress", r.GetId(), id)
+			}
+		}
+	}
+
+	ingress, egress := flattenSecurityGroupRulesSpec(rules)
 
 	if err := d.Set("ingress", ingress); err != nil {
 		return err
@@ -336,8 +372,11 @@ func resourceYandexVPCSecurityGroupUpdate(d *schema.ResourceData, meta interface
 
 	}
 
-	if d.HasChange("egress") || d.HasChange("ingress") {
-		if err := resourceYandexVPCSecurityGroupUpdateRules(ctx, d, config); err != nil {
+	var addedRuleIDs []string
+	if d.HasChange("egress") || d.HasChange("ingress") || (d.HasChange("exclusive") && d.Get("exclusive").(bool)) {
+		var err error
+		addedRuleIDs, err = resourceYandexVPCSecurityGroupUpdateRules(ctx, d, config)
+		if err != nil {
 			return err
 		}
 
@@ -345,10 +384,12 @@ func resourceYandexVPCSecurityGroupUpdate(d *schema.ResourceData, meta interface
 
 	d.Partial(false)
 
-	return resourceYandexVPCSecurityGroupRead(d, meta)
+	return yandexVPCSecurityGroupRead(d, meta, d.Id(), securityGroupDeclaredRuleIDs(d, addedRuleIDs))
 }
 
-func resourceYandexVPCSecurityGroupUpdateRules(ctx context.Context, d *schema.ResourceData, config *Config) error {
+// resourceYandexVPCSecurityGroupUpdateRules brings rules of the security group to the declared state
+// and returns IDs of the added rules.
+func resourceYandexVPCSecurityGroupUpdateRules(ctx context.Context, d *schema.ResourceData, config *Config) ([]string, error) {
 	client := vpcsdk.NewSecurityGroupClient(config.SDK)
 
 	sg, err := client.Get(ctx, &vpc.GetSecurityGroupRequest{
@@ -356,7 +397,7 @@ func resourceYandexVPCSecurityGroupUpdateRules(ctx context.Context, d *schema.Re
 	})
 
 	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Security group %q", d.Id()))
+		return nil, handleNotFoundError(err, d, fmt.Sprintf("Security group %q", d.Id()))
 	}
 
 	cloudRules := map[string]*vpc.SecurityGroupRule{}
@@ -374,7 +415,7 @@ func resourceYandexVPCSecurityGroupUpdateRules(ctx context.Context, d *schema.Re
 			for _, v := range v.(*schema.Set).List() {
 				rule, ok := v.(map[string]interface{})
 				if !ok {
-					return fmt.Errorf("fail to cast %#v to map[string]interface{}", v)
+					return nil, fmt.Errorf("fail to cast %#v to map[string]interface{}", v)
 				}
 
 				if id, ok := rule["id"].(string); ok && id != "" {
@@ -382,7 +423,7 @@ func resourceYandexVPCSecurityGroupUpdateRules(ctx context.Context, d *schema.Re
 					if cloudRule, ok := cloudRules[id]; ok {
 						ruleSpec, err := securityRuleDescriptionToRuleSpec(dir, v)
 						if err != nil {
-							return err
+							return nil, err
 						}
 
 						if ruleChanged(cloudRule, ruleSpec) {
@@ -393,7 +434,7 @@ func resourceYandexVPCSecurityGroupUpdateRules(ctx context.Context, d *schema.Re
 						}
 
 					} else {
-						return fmt.Errorf("no rule with id %s on cloud", id)
+						return nil, fmt.Errorf("no rule with id %s on cloud", id)
 					}
 
 					ruleIds = append(ruleIds, id)
@@ -401,7 +442,7 @@ func resourceYandexVPCSecurityGroupUpdateRules(ctx context.Context, d *schema.Re
 					// new rule
 					ruleSpec, err := securityRuleDescriptionToRuleSpec(dir, v)
 					if err != nil {
-						return err
+						return nil, err
 					}
 					newRules = append(newRules, ruleSpec)
 				}
@@ -409,6 +450,9 @@ func resourceYandexVPCSecurityGroupUpdateRules(ctx context.Context, d *schema.Re
 		}
 	}
 
+	exclusive := d.Get("exclusive").(bool)
+	previousRuleIDs := securityGroupPreviousRuleIDs(d)
+
 	for cid := range cloudRules {
 		found := false
 		for _, id := range ruleIds {
@@ -418,9 +462,14 @@ func resourceYandexVPCSecurityGroupUpdateRules(ctx context.Context, d *schema.Re
 			}
 		}
 
-		if !found {
-			delRules = append(delRules, cid)
+		if found {
+			continue
+		}
+		if !exclusive && !previousRuleIDs[cid] {
+			log.Printf("[DEBUG] Keeping rule %q of Security group %q that is not declared by ingress or egress", cid, d.Id())
+			continue
 		}
+		delRules = append(delRules, cid)
 	}
 
 	req := &vpc.UpdateSecurityGroupRulesRequest{
@@ -430,16 +479,188 @@ func resourceYandexVPCSecurityGroupUpdateRules(ctx context.Context, d *schema.Re
 	}
 	op, err := client.UpdateRules(ctx, req)
 	if err != nil {
-		return fmt.Errorf("error while requesting API to update Security group rules %q: %s", d.Id(), err)
+		return nil, fmt.Errorf("error while requesting API to update Security group rules %q: %s", d.Id(), err)
 	}
 	_, err = op.Wait(ctx)
 	if err != nil {
-		return fmt.Errorf("error updating Security group rules %q: %s", d.Id(), err)
+		return nil, fmt.Errorf("error updating Security group rules %q: %s", d.Id(), err)
+	}
+
+	return op.Metadata().GetAddedRuleIds(), nil
+}
+
+// securityGroupDeclaredRuleIDs returns IDs of the rules declared by ingress and egress blocks, including
+// the just added ones. It returns nil if all rules of the group must be read: when the group is exclusive
+// or is read for the first time after creation or import.
+func securityGroupDeclaredRuleIDs(d *schema.ResourceData, addedRuleIDs []string) map[string]bool {
+	if d.Get("exclusive").(bool) || d.Get("created_at").(string) == "" {
+		return nil
+	}
+
+	ids := securityGroupRuleIDs(d.Get("ingress"), d.Get("egress"))
+	for _, id := range addedRuleIDs {
+		ids[id] = true
+	}
+	return ids
+}
+
+// securityGroupPreviousRuleIDs returns IDs of the rules that were declared before the update.
+func securityGroupPreviousRuleIDs(d *schema.ResourceData) map[string]bool {
+	ingress, _ := d.GetChange("ingress")
+	egress, _ := d.GetChange("egress")
+	return securityGroupRuleIDs(ingress, egress)
+}
+
+func securityGroupRuleIDs(sets ...interface{}) map[string]bool {
+	ids := make(map[string]bool)
+	for _, v := range sets {
+		set, ok := v.(*schema.Set)
+		if !ok {
+			continue
+		}
+		for _, r := range set.List() {
+			if id, ok := r.(map[string]interface{})["id"].(string); ok && id != "" {
+				ids[id] = true
+			}
+		}
+	}
+	return ids
+}
+
+func resourceYandexVPCSecurityGroupCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("ingress") || !d.NewValueKnown("egress") {
+		return nil
+	}
+
+	rules := make([]sgrules.Rule, 0)
+	for _, dir := range []string{"ingress", "egress"} {
+		for _, v := range d.Get(dir).(*schema.Set).List() {
+			rule, ok := v.(map[string]interface{})
+			if !ok {
+				return fmt.Errorf("fail to cast %#v to map[string]interface{}", v)
+			}
+			rules = append(rules, securityGroupRuleFromMap(dir, rule))
+		}
+	}
+
+	for _, r := range rules {
+		if err := sgrules.Validate(r); err != nil {
+			return err
+		}
+	}
+
+	config := meta.(*Config)
+	client := vpcsdk.NewSecurityGroupClient(config.SDK)
+	checked := make(map[string]bool)
+	for _, r := range rules {
+		target := r.SecurityGroupID
+		if target == "" || target == d.Id() || checked[target] {
+			continue
+		}
+		checked[target] = true
+
+		_, err := client.Get(ctx, &vpc.GetSecurityGroupRequest{SecurityGroupId: target})
+		if isStatusWithCode(err, codes.NotFound) {
+			return fmt.Errorf("rule %s references security group %q that does not exist", r, target)
+		}
+		// The group may be in a folder the provider has no access to, so the plan fails on a missing group only.
+		if err != nil {
+			log.Printf("[DEBUG] Skipping the check of Security group %q referenced by rule %s: %s", target, r, err)
+		}
 	}
 
 	return nil
 }
 
+// resourceYandexVPCSecurityGroupValidateRuleConflicts warns about the declared rules that have no effect
+// because of other declared rules. CustomizeDiff can only fail the plan, so the conflicts are reported here.
+func resourceYandexVPCSecurityGroupValidateRuleConflicts(_ context.Context, req schema.ValidateResourceConfigFuncRequest, resp *schema.ValidateResourceConfigFuncResponse) {
+	if req.RawConfig.IsNull() || !req.RawConfig.IsKnown() {
+		return
+	}
+
+	rules := make([]sgrules.Rule, 0)
+	for _, dir := range []string{"ingress", "egress"} {
+		set := req.RawConfig.GetAttr(dir)
+		if set.IsNull() || !set.IsKnown() {
+			continue
+		}
+		for it := set.ElementIterator(); it.Next(); {
+			_, v := it.Element()
+			if !v.IsWhollyKnown() {
+				continue
+			}
+			rules = append(rules, securityGroupRuleFromMap(dir, securityGroupRuleConfigToMap(v)))
+		}
+	}
+
+	for _, c := range sgrules.Analyze(rules) {
+		resp.Diagnostics = append(resp.Diagnostics, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Security group rule has no effect",
+			Detail:   fmt.Sprintf("%s. Rules are compared without description and labels, consider removing or merging one of the rules.", c),
+		})
+	}
+}
+
+// securityGroupRuleConfigToMap converts the configured rule to the form of schema.ResourceData, unset attributes are omitted.
+func securityGroupRuleConfigToMap(v cty.Value) map[string]interface{} {
+	rule := make(map[string]interface{})
+	for _, name := range []string{"protocol", "security_group_id", "predefined_target"} {
+		if attr := v.GetAttr(name); !attr.IsNull() {
+			rule[name] = attr.AsString()
+		}
+	}
+	for _, name := range []string{"port", "from_port", "to_port"} {
+		if attr := v.GetAttr(name); !attr.IsNull() {
+			port, _ := attr.AsBigFloat().Int64()
+			rule[name] = int(port)
+		}
+	}
+	for _, name := range []string{"v4_cidr_blocks", "v6_cidr_blocks"} {
+		if attr := v.GetAttr(name); !attr.IsNull() {
+			blocks := make([]interface{}, 0, attr.LengthInt())
+			for _, b := range attr.AsValueSlice() {
+				blocks = append(blocks, b.AsString())
+			}
+			rule[name] = blocks
+		}
+	}
+	return rule
+}
+
+func securityGroupRuleFromMap(dir string, rule map[string]interface{}) sgrules.Rule {
+	r := sgrules.Rule{
+		Direction: strings.ToUpper(dir),
+		FromPort:  sgrules.AnyPort,
+		ToPort:    sgrules.AnyPort,
+	}
+	r.ID, _ = rule["id"].(string)
+	r.Protocol, _ = rule["protocol"].(string)
+	r.SecurityGroupID, _ = rule["security_group_id"].(string)
+	r.PredefinedTarget, _ = rule["predefined_target"].(string)
+
+	if port, ok := rule["port"].(int); ok && port != sgrules.AnyPort {
+		r.FromPort, r.ToPort = int64(port), int64(port)
+	} else {
+		if v, ok := rule["from_port"].(int); ok {
+			r.FromPort = int64(v)
+		}
+		if v, ok := rule["to_port"].(int); ok {
+			r.ToPort = int64(v)
+		}
+	}
+
+	if v, ok := rule["v4_cidr_blocks"].([]interface{}); ok {
+		r.V4CidrBlocks = expandStringSlice(v)
+	}
+	if v, ok := rule["v6_cidr_blocks"].([]interface{}); ok {
+		r.V6CidrBlocks = expandStringSlice(v)
+	}
+
+	return r
+}
+
 func ruleChanged(r1 *vpc.SecurityGroupRule, r2 *vpc.SecurityGroupRuleSpec) bool {
 	if r1.GetDescription() != r2.GetDescription() {
 		return true
@@ -192,6 +192,113 @@ func TestAccVPCSecurityGroup_update(t *testing.T) {
 	})
 }
 
+func TestAccVPCSecurityGroup_exclusive(t *testing.T) {
+	var securityGroup vpc.SecurityGroup
+
+	networkName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	sgName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactoriesV6,
+		CheckDestroy:             testAccCheckVPCSecurityGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCSecurityGroupExclusive(networkName, sgName, false, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCSecurityGroupExists("yandex_vpc_security_group.sg", &securityGroup),
+					resource.TestCheckResourceAttr("yandex_vpc_security_group.sg", "exclusive", "false"),
+					resource.TestCheckResourceAttr("yandex_vpc_security_group.sg", "ingress.#", "1"),
+					testAccCheckVPCSecurityGroupRulesCount(&securityGroup, 2),
+					testAccAddVPCSecurityGroupRule(&securityGroup),
+				),
+			},
+			{
+				// neither the rule resource nor the rule added by hand cause a diff
+				Config:   testAccVPCSecurityGroupExclusive(networkName, sgName, false, true),
+				PlanOnly: true,
+			},
+			{
+				Config: testAccVPCSecurityGroupExclusive(networkName, sgName, true, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCSecurityGroupExists("yandex_vpc_security_group.sg", &securityGroup),
+					resource.TestCheckResourceAttr("yandex_vpc_security_group.sg", "exclusive", "true"),
+					resource.TestCheckResourceAttr("yandex_vpc_security_group.sg", "ingress.#", "1"),
+					testAccCheckVPCSecurityGroupRulesCount(&securityGroup, 1),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckVPCSecurityGroupRulesCount(securityGroup *vpc.SecurityGroup, expected int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if len(securityGroup.GetRules()) != expected {
+			return fmt.Errorf("security group %s has %d rules, expected %d", securityGroup.GetId(), len(securityGroup.GetRules()), expected)
+		}
+		return nil
+	}
+}
+
+// testAccAddVPCSecurityGroupRule adds a rule that is not managed by Terraform.
+func testAccAddVPCSecurityGroupRule(securityGroup *vpc.SecurityGroup) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := vpcsdk.NewSecurityGroupClient(testAccProvider.Meta().(*Config).SDK)
+
+		rule := &vpc.SecurityGroupRuleSpec{
+			Direction: vpc.SecurityGroupRule_INGRESS,
+			Ports:     &vpc.PortRange{FromPort: 53, ToPort: 53},
+		}
+		rule.SetProtocolName("UDP")
+		rule.SetCidrBlocks(&vpc.CidrBlocks{V4CidrBlocks: []string{"10.0.3.0/24"}})
+
+		ctx := context.Background()
+		op, err := client.UpdateRules(ctx, &vpc.UpdateSecurityGroupRulesRequest{
+			SecurityGroupId:   securityGroup.GetId(),
+			AdditionRuleSpecs: []*vpc.SecurityGroupRuleSpec{rule},
+		})
+		if err != nil {
+			return err
+		}
+		_, err = op.Wait(ctx)
+		return err
+	}
+}
+
+func testAccVPCSecurityGroupExclusive(networkName, sgName string, exclusive, withRule bool) string {
+	config := fmt.Sprintf(`
+resource "yandex_vpc_network" "foo" {
+  name = "%s"
+}
+
+resource "yandex_vpc_security_group" "sg" {
+  name       = "%s"
+  network_id = yandex_vpc_network.foo.id
+  folder_id  = "%s"
+  exclusive  = %t
+
+  ingress {
+    protocol       = "TCP"
+    v4_cidr_blocks = ["10.0.1.0/24"]
+    port           = 443
+  }
+}
+`, networkName, sgName, getExampleFolderID(), exclusive)
+
+	if withRule {
+		config += `
+resource "yandex_vpc_security_group_rule" "rule" {
+  security_group_binding = yandex_vpc_security_group.sg.id
+  direction              = "ingress"
+  protocol               = "TCP"
+  v4_cidr_blocks         = ["10.0.2.0/24"]
+  port                   = 22
+}
+`
+	}
+	return config
+}
+
 func testAccCheckVPCSecurityGroupExists(name string, securityGroup *vpc.SecurityGroup) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[name]
@@ -0,0 +1,81 @@
+package yandex
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func testSecurityGroupRuleConfig(ruleType cty.Type, attrs map[string]cty.Value) cty.Value {
+	vals := make(map[string]cty.Value)
+	for name, t := range ruleType.AttributeTypes() {
+		if v, ok := attrs[name]; ok {
+			vals[name] = v
+		} else {
+			vals[name] = cty.NullVal(t)
+		}
+	}
+	return cty.ObjectVal(vals)
+}
+
+func TestResourceYandexVPCSecurityGroupValidateRuleConflicts(t *testing.T) {
+	ruleType := resourceYandexVPCSecurityGroup().CoreConfigSchema().ImpliedType().AttributeType("ingress").ElementType()
+	rule := func(port int64, cidr, description string) cty.Value {
+		return testSecurityGroupRuleConfig(ruleType, map[string]cty.Value{
+			"protocol":       cty.StringVal("TCP"),
+			"port":           cty.NumberIntVal(port),
+			"v4_cidr_blocks": cty.ListVal([]cty.Value{cty.StringVal(cidr)}),
+			"description":    cty.StringVal(description),
+		})
+	}
+
+	tests := []struct {
+		name     string
+		ingress  cty.Value
+		warnings int
+	}{
+		{
+			name:     "disjoint rules",
+			ingress:  cty.SetVal([]cty.Value{rule(443, "10.0.1.0/24", "https"), rule(22, "10.0.1.0/24", "ssh")}),
+			warnings: 0,
+		},
+		{
+			name:     "rules differing only in description",
+			ingress:  cty.SetVal([]cty.Value{rule(443, "10.0.1.0/24", "https"), rule(443, "10.0.1.0/24", "web")}),
+			warnings: 1,
+		},
+		{
+			name:     "shadowed rule",
+			ingress:  cty.SetVal([]cty.Value{rule(443, "10.0.0.0/16", "https"), rule(443, "10.0.1.0/24", "https office")}),
+			warnings: 1,
+		},
+		{
+			name:     "unknown rules",
+			ingress:  cty.UnknownVal(cty.Set(ruleType)),
+			warnings: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := schema.ValidateResourceConfigFuncRequest{
+				RawConfig: cty.ObjectVal(map[string]cty.Value{
+					"ingress": tt.ingress,
+					"egress":  cty.NullVal(cty.Set(ruleType)),
+				}),
+			}
+			resp := &schema.ValidateResourceConfigFuncResponse{}
+
+			resourceYandexVPCSecurityGroupValidateRuleConflicts(context.Background(), req, resp)
+
+			assert.Len(t, resp.Diagnostics, tt.warnings)
+			for _, d := range resp.Diagnostics {
+				assert.Equal(t, diag.Warning, d.Severity)
+			}
+		})
+	}
+}
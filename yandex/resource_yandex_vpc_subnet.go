@@ -7,11 +7,13 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"google.golang.org/genproto/protobuf/field_mask"
 
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/vpc/v1"
 	vpcsdk "github.com/yandex-cloud/go-sdk/services/vpc/v1"
 	"github.com/yandex-cloud/terraform-provider-yandex/common"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/cidrpool"
 )
 
 const yandexVPCSubnetDefaultTimeout = 3 * time.Minute
@@ -45,15 +47,34 @@ func resourceYandexVPCSubnet() *schema.Resource {
 			},
 
 			"v4_cidr_blocks": {
-				Type:        schema.TypeList,
-				Description: "A list of blocks of internal IPv4 addresses that are owned by this subnet. Provide this property when you create the subnet. For example, `10.0.0.0/22` or `192.168.0.0/16`. Blocks of addresses must be unique and non-overlapping within a network. Minimum subnet size is `/28`, and maximum subnet size is `/16`. Only IPv4 is supported.",
-				Required:    true,
+				Type:         schema.TypeList,
+				Description:  "A list of blocks of internal IPv4 addresses that are owned by this subnet. Provide this property when you create the subnet. For example, `10.0.0.0/22` or `192.168.0.0/16`. Blocks of addresses must be unique and non-overlapping within a network. Minimum subnet size is `/28`, and maximum subnet size is `/16`. Only IPv4 is supported. Either `v4_cidr_blocks` or `cidr_pool_id` must be specified.",
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"v4_cidr_blocks", "cidr_pool_id"},
 				Elem: &schema.Schema{
 					Type:         schema.TypeString,
 					ValidateFunc: validateCidrBlocks,
 				},
 			},
 
+			"cidr_pool_id": {
+				Type:         schema.TypeString,
+				Description:  "ID of the `yandex_vpc_cidr_pool` to allocate the next free block of `prefix_length` from on creation. The pool must belong to the same network. If the block is taken concurrently, e.g. by another configuration, another block is allocated. Changing the pool does not move an existing subnet.",
+				Optional:     true,
+				ExactlyOneOf: []string{"v4_cidr_blocks", "cidr_pool_id"},
+				RequiredWith: []string{"prefix_length"},
+			},
+
+			"prefix_length": {
+				Type:         schema.TypeInt,
+				Description:  "Prefix length of the block allocated from `cidr_pool_id`, from `16` to `28`.",
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"cidr_pool_id"},
+				ValidateFunc: validation.IntBetween(cidrpool.MinPrefixLength, cidrpool.MaxPrefixLength),
+			},
+
 			"name": {
 				Type:        schema.TypeString,
 				Description: common.ResourceDescriptions["name"],
@@ -177,6 +198,9 @@ func resourceYandexVPCSubnetCreate(d *schema.ResourceData, meta interface{}) err
 		return fmt.Errorf("Error expanding dhcp options while creating subnet: %s", err)
 	}
 
+	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+
 	req := vpc.CreateSubnetRequest{
 		FolderId:     folderID,
 		ZoneId:       zone,
@@ -189,14 +213,42 @@ func resourceYandexVPCSubnetCreate(d *schema.ResourceData, meta interface{}) err
 		DhcpOptions:  dhcpOptions,
 	}
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutCreate))
-	defer cancel()
+	if poolID, ok := d.GetOk("cidr_pool_id"); ok {
+		// The block is free until the subnet is created, so subnets of the network are created one by one.
+		mutexKey := vpcCidrPoolMutexKey(req.NetworkId)
+		mutexKV.Lock(mutexKey)
+		defer mutexKV.Unlock(mutexKey)
+
+		// Subnets created concurrently by other processes may take the allocated block,
+		// then the block is allocated again from the updated list of subnets.
+		for attempt := 1; ; attempt++ {
+			block, err := allocateVPCSubnetV4CidrBlock(ctx, config, poolID.(string), req.NetworkId, d.Get("prefix_length").(int))
+			if err != nil {
+				return err
+			}
+			req.V4CidrBlocks = []string{block}
 
-	client := vpcsdk.NewSubnetClient(config.SDK)
+			err = createVPCSubnet(ctx, d, config, &req)
+			if err == nil {
+				break
+			}
+			if attempt == vpcCidrPoolAllocationAttempts || !isVPCSubnetCidrConflict(err) {
+				return err
+			}
+			d.SetId("")
+			log.Printf("[WARN] Block %s allocated from CIDR pool %q is already taken, allocating another one: %s", block, poolID, err)
+		}
+	} else if err := createVPCSubnet(ctx, d, config, &req); err != nil {
+		return err
+	}
+
+	return resourceYandexVPCSubnetRead(d, meta)
+}
 
-	op, err := client.Create(ctx, &req)
+func createVPCSubnet(ctx context.Context, d *schema.ResourceData, config *Config, req *vpc.CreateSubnetRequest) error {
+	op, err := vpcsdk.NewSubnetClient(config.SDK).Create(ctx, req)
 	if err != nil {
-		return fmt.Errorf("Error while requesting API to create subnet: %s", err)
+		return fmt.Errorf("Error while requesting API to create subnet: %w", err)
 	}
 
 	md := op.Metadata()
@@ -204,10 +256,10 @@ func resourceYandexVPCSubnetCreate(d *schema.ResourceData, meta interface{}) err
 
 	_, err = op.Wait(ctx)
 	if err != nil {
-		return fmt.Errorf("Error while waiting operation to create subnet: %s", err)
+		return fmt.Errorf("Error while waiting operation to create subnet: %w", err)
 	}
 
-	return resourceYandexVPCSubnetRead(d, meta)
+	return nil
 }
 
 func resourceYandexVPCSubnetRead(d *schema.ResourceData, meta interface{}) error {
@@ -304,6 +356,12 @@ func resourceYandexVPCSubnetUpdate(d *schema.ResourceData, meta interface{}) err
 		req.UpdateMask.Paths = append(req.UpdateMask.Paths, "v4_cidr_blocks")
 	}
 
+	if len(req.UpdateMask.Paths) == 0 {
+		// Only cidr_pool_id is changed, it is used on creation only.
+		d.Partial(false)
+		return resourceYandexVPCSubnetRead(d, meta)
+	}
+
 	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutUpdate))
 	defer cancel()
 
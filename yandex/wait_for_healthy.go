@@ -0,0 +1,341 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/apploadbalancer/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/compute/v1/instancegroup"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/k8s/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/loadbalancer/v1"
+	albsdk "github.com/yandex-cloud/go-sdk/services/apploadbalancer/v1"
+	instancegroupsdk "github.com/yandex-cloud/go-sdk/services/compute/v1/instancegroup"
+	k8ssdk "github.com/yandex-cloud/go-sdk/services/k8s/v1"
+	loadbalancersdk "github.com/yandex-cloud/go-sdk/services/loadbalancer/v1"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/healthwait"
+)
+
+// waitForHealthy is the wait_for_healthy block of instance groups and Kubernetes node groups.
+type waitForHealthy struct {
+	MinHealthyPercent int
+	Timeout           time.Duration
+}
+
+func waitForHealthySchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: description,
+		Optional:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"min_healthy_percent": {
+					Type:         schema.TypeInt,
+					Description:  "Minimum percent of healthy members out of the target size of the group, from `1` to `100`. The members that are not created yet are not healthy. Default is `100`.",
+					Optional:     true,
+					Default:      healthwait.DefaultMinHealthyPercent,
+					ValidateFunc: validation.IntBetween(1, 100),
+				},
+				"timeout": {
+					Type:         schema.TypeString,
+					Description:  "Time to wait for after the operation is completed. It is not limited by the `create` and `update` timeouts of the resource. Valid time units are `s`, `m`, `h`. Default is `15m`.",
+					Optional:     true,
+					Default:      healthwait.DefaultTimeout,
+					ValidateFunc: validateParsableValue(time.ParseDuration),
+				},
+			},
+		},
+	}
+}
+
+// expandWaitForHealthy returns nil if wait_for_healthy is not set.
+func expandWaitForHealthy(d *schema.ResourceData) (*waitForHealthy, error) {
+	v, ok := d.GetOk("wait_for_healthy.0")
+	if !ok || v == nil {
+		return nil, nil
+	}
+	m := v.(map[string]interface{})
+
+	timeout, err := time.ParseDuration(m["timeout"].(string))
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing wait_for_healthy timeout: %s", err)
+	}
+
+	return &waitForHealthy{
+		MinHealthyPercent: m["min_healthy_percent"].(int),
+		Timeout:           timeout,
+	}, nil
+}
+
+// waitForInstanceGroupHealthy waits for instances of the group to be running and healthy
+// in the network and application load balancers the group target groups are attached to.
+func waitForInstanceGroupHealthy(d *schema.ResourceData, config *Config) error {
+	w, err := expandWaitForHealthy(d)
+	if err != nil || w == nil {
+		return err
+	}
+
+	return healthwait.Wait(config.Context(), fmt.Sprintf("Instance group %q", d.Id()), w.MinHealthyPercent, w.Timeout, healthwait.DefaultPollInterval,
+		func(ctx context.Context) ([]healthwait.Member, int, error) {
+			return checkInstanceGroupHealth(ctx, config, d.Id())
+		})
+}
+
+// waitForKubernetesNodeGroupHealthy waits for nodes of the group to be ready.
+func waitForKubernetesNodeGroupHealthy(d *schema.ResourceData, config *Config) error {
+	w, err := expandWaitForHealthy(d)
+	if err != nil || w == nil {
+		return err
+	}
+
+	return healthwait.Wait(config.Context(), fmt.Sprintf("Kubernetes node group %q", d.Id()), w.MinHealthyPercent, w.Timeout, healthwait.DefaultPollInterval,
+		func(ctx context.Context) ([]healthwait.Member, int, error) {
+			return checkKubernetesNodeGroupHealth(ctx, config, d.Id())
+		})
+}
+
+// checkInstanceGroupHealth returns the health of the instances of the group and the target size of the group.
+func checkInstanceGroupHealth(ctx context.Context, config *Config, instanceGroupID string) ([]healthwait.Member, int, error) {
+	client := instancegroupsdk.NewInstanceGroupClient(config.SDK)
+
+	ig, err := client.Get(ctx, &instancegroup.GetInstanceGroupRequest{
+		InstanceGroupId: instanceGroupID,
+	})
+	if err != nil {
+		return nil, 0, fmt.Errorf("error while requesting API to get Instance group %q: %s", instanceGroupID, err)
+	}
+
+	var instances []*instancegroup.ManagedInstance
+	req := &instancegroup.ListInstanceGroupInstancesRequest{InstanceGroupId: instanceGroupID}
+	for {
+		resp, err := client.ListInstances(ctx, req)
+		if err != nil {
+			return nil, 0, fmt.Errorf("error while requesting API to list instances of Instance group %q: %s", instanceGroupID, err)
+		}
+		instances = append(instances, resp.GetInstances()...)
+		if resp.GetNextPageToken() == "" {
+			break
+		}
+		req.PageToken = resp.GetNextPageToken()
+	}
+
+	// Reasons of unhealthiness in load balancers by target address.
+	targetReasons := map[string][]string{}
+	if tg := ig.GetLoadBalancerState().GetTargetGroupId(); tg != "" {
+		if err := collectNetworkLoadBalancerTargetReasons(ctx, config, ig.GetFolderId(), tg, targetReasons); err != nil {
+			return nil, 0, err
+		}
+	}
+	if tg := ig.GetApplicationLoadBalancerState().GetTargetGroupId(); tg != "" {
+		if err := collectApplicationLoadBalancerTargetReasons(ctx, config, ig.GetFolderId(), tg, targetReasons); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	members := make([]healthwait.Member, 0, len(instances))
+	for _, instance := range instances {
+		if instance.GetStatus() == instancegroup.ManagedInstance_DELETING_INSTANCE || instance.GetStatus() == instancegroup.ManagedInstance_DELETED {
+			continue
+		}
+
+		member := healthwait.Member{Name: instance.GetName()}
+		if member.Name == "" {
+			member.Name = instance.GetId()
+		}
+		if instance.GetStatus() != instancegroup.ManagedInstance_RUNNING_ACTUAL {
+			reason := fmt.Sprintf("status %s", instance.GetStatus())
+			if msg := instance.GetStatusMessage(); msg != "" {
+				reason += ": " + msg
+			}
+			member.Reasons = append(member.Reasons, reason)
+		}
+		for _, iface := range instance.GetNetworkInterfaces() {
+			member.Reasons = append(member.Reasons, targetReasons[iface.GetPrimaryV4Address().GetAddress()]...)
+		}
+		member.Healthy = len(member.Reasons) == 0
+
+		members = append(members, member)
+	}
+
+	return members, int(ig.GetManagedInstancesState().GetTargetSize()), nil
+}
+
+// collectNetworkLoadBalancerTargetReasons adds reasons for targets that are not healthy in the network load balancers
+// the target group is attached to.
+func collectNetworkLoadBalancerTargetReasons(ctx context.Context, config *Config, folderID, targetGroupID string, reasons map[string][]string) error {
+	client := loadbalancersdk.NewNetworkLoadBalancerClient(config.SDK)
+
+	req := &loadbalancer.ListNetworkLoadBalancersRequest{FolderId: folderID}
+	for {
+		resp, err := client.List(ctx, req)
+		if err != nil {
+			return fmt.Errorf("error while requesting API to list network load balancers in folder %q: %s", folderID, err)
+		}
+
+		for _, nlb := range resp.GetNetworkLoadBalancers() {
+			attached := false
+			for _, atg := range nlb.GetAttachedTargetGroups() {
+				attached = attached || atg.GetTargetGroupId() == targetGroupID
+			}
+			if !attached {
+				continue
+			}
+
+			states, err := client.GetTargetStates(ctx, &loadbalancer.GetTargetStatesRequest{
+				NetworkLoadBalancerId: nlb.GetId(),
+				TargetGroupId:         targetGroupID,
+			})
+			if err != nil {
+				return fmt.Errorf("error while requesting API to get target states of network load balancer %q: %s", nlb.GetId(), err)
+			}
+			for _, state := range states.GetTargetStates() {
+				if state.GetStatus() != loadbalancer.TargetState_HEALTHY {
+					reasons[state.GetAddress()] = append(reasons[state.GetAddress()],
+						fmt.Sprintf("%s in network load balancer %q", state.GetStatus(), nlb.GetName()))
+				}
+			}
+		}
+
+		if resp.GetNextPageToken() == "" {
+			return nil
+		}
+		req.PageToken = resp.GetNextPageToken()
+	}
+}
+
+// collectApplicationLoadBalancerTargetReasons adds reasons for targets that are not healthy in the application load balancers
+// that use the target group through backend groups.
+func collectApplicationLoadBalancerTargetReasons(ctx context.Context, config *Config, folderID, targetGroupID string, reasons map[string][]string) error {
+	backendGroupIDs, err := listALBBackendGroupsWithTargetGroup(ctx, config, folderID, targetGroupID)
+	if err != nil || len(backendGroupIDs) == 0 {
+		return err
+	}
+
+	client := albsdk.NewLoadBalancerClient(config.SDK)
+	req := &apploadbalancer.ListLoadBalancersRequest{FolderId: folderID}
+	for {
+		resp, err := client.List(ctx, req)
+		if err != nil {
+			return fmt.Errorf("error while requesting API to list application load balancers in folder %q: %s", folderID, err)
+		}
+
+		for _, alb := range resp.GetLoadBalancers() {
+			for _, backendGroupID := range backendGroupIDs {
+				states, err := client.GetTargetStates(ctx, &apploadbalancer.GetTargetStatesRequest{
+					LoadBalancerId: alb.GetId(),
+					BackendGroupId: backendGroupID,
+					TargetGroupId:  targetGroupID,
+				})
+				if err != nil {
+					// The backend group is not used by the load balancer.
+					log.Printf("[DEBUG] Skipping target states of backend group %q in application load balancer %q: %s", backendGroupID, alb.GetId(), err)
+					continue
+				}
+				for _, state := range states.GetTargetStates() {
+					address := state.GetTarget().GetIpAddress()
+					for _, zone := range state.GetStatus().GetZoneStatuses() {
+						if zone.GetStatus() != apploadbalancer.TargetState_HEALTHY {
+							reasons[address] = append(reasons[address],
+								fmt.Sprintf("%s in application load balancer %q in zone %s", zone.GetStatus(), alb.GetName(), zone.GetZoneId()))
+						}
+					}
+				}
+			}
+		}
+
+		if resp.GetNextPageToken() == "" {
+			return nil
+		}
+		req.PageToken = resp.GetNextPageToken()
+	}
+}
+
+func listALBBackendGroupsWithTargetGroup(ctx context.Context, config *Config, folderID, targetGroupID string) ([]string, error) {
+	client := albsdk.NewBackendGroupClient(config.SDK)
+
+	var result []string
+	req := &apploadbalancer.ListBackendGroupsRequest{FolderId: folderID}
+	for {
+		resp, err := client.List(ctx, req)
+		if err != nil {
+			return nil, fmt.Errorf("error while requesting API to list backend groups in folder %q: %s", folderID, err)
+		}
+
+		for _, bg := range resp.GetBackendGroups() {
+			var targetGroupIDs []string
+			for _, backend := range bg.GetHttp().GetBackends() {
+				targetGroupIDs = append(targetGroupIDs, backend.GetTargetGroups().GetTargetGroupIds()...)
+			}
+			for _, backend := range bg.GetGrpc().GetBackends() {
+				targetGroupIDs = append(targetGroupIDs, backend.GetTargetGroups().GetTargetGroupIds()...)
+			}
+			for _, backend := range bg.GetStream().GetBackends() {
+				targetGroupIDs = append(targetGroupIDs, backend.GetTargetGroups().GetTargetGroupIds()...)
+			}
+			for _, id := range targetGroupIDs {
+				if id == targetGroupID {
+					result = append(result, bg.GetId())
+					break
+				}
+			}
+		}
+
+		if resp.GetNextPageToken() == "" {
+			return result, nil
+		}
+		req.PageToken = resp.GetNextPageToken()
+	}
+}
+
+// checkKubernetesNodeGroupHealth returns the health of the nodes of the group and the minimum size of the group.
+func checkKubernetesNodeGroupHealth(ctx context.Context, config *Config, nodeGroupID string) ([]healthwait.Member, int, error) {
+	client := k8ssdk.NewNodeGroupClient(config.SDK)
+
+	ng, err := client.Get(ctx, &k8s.GetNodeGroupRequest{
+		NodeGroupId: nodeGroupID,
+	})
+	if err != nil {
+		return nil, 0, fmt.Errorf("error while requesting API to get Kubernetes node group %q: %s", nodeGroupID, err)
+	}
+	targetSize := ng.GetScalePolicy().GetFixedScale().GetSize()
+	if autoScale := ng.GetScalePolicy().GetAutoScale(); autoScale != nil {
+		targetSize = autoScale.GetMinSize()
+	}
+
+	var members []healthwait.Member
+	req := &k8s.ListNodeGroupNodesRequest{NodeGroupId: nodeGroupID}
+	for {
+		resp, err := client.ListNodes(ctx, req)
+		if err != nil {
+			return nil, 0, fmt.Errorf("error while requesting API to list nodes of Kubernetes node group %q: %s", nodeGroupID, err)
+		}
+
+		for _, node := range resp.GetNodes() {
+			member := healthwait.Member{
+				Name:    node.GetKubernetesStatus().GetId(),
+				Healthy: node.GetStatus() == k8s.Node_READY,
+			}
+			if member.Name == "" {
+				member.Name = node.GetCloudStatus().GetId()
+			}
+			if !member.Healthy {
+				reason := fmt.Sprintf("status %s", node.GetStatus())
+				if msg := node.GetCloudStatus().GetStatusMessage(); msg != "" {
+					reason += ": " + msg
+				}
+				member.Reasons = append(member.Reasons, reason)
+			}
+			members = append(members, member)
+		}
+
+		if resp.GetNextPageToken() == "" {
+			return members, int(targetSize), nil
+		}
+		req.PageToken = resp.GetNextPageToken()
+	}
+}